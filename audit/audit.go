@@ -0,0 +1,133 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/openimsdk/protocol/constant"
+	"google.golang.org/protobuf/proto"
+)
+
+// Digest returns a stable digest of m for AuditEvent.BeforeDigest and AuditEvent.AfterDigest.
+func Digest(m proto.Message) string {
+	if m == nil {
+		return ""
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Match reports whether event satisfies every condition set in x.
+func (x *AuditEventFilter) Match(event *AuditEvent) bool {
+	if x == nil {
+		return true
+	}
+	if x.StartTime > 0 && event.CreateTime < x.StartTime {
+		return false
+	}
+	if x.EndTime > 0 && event.CreateTime >= x.EndTime {
+		return false
+	}
+	if len(x.ActorUserIDs) > 0 && !slices.Contains(x.ActorUserIDs, event.ActorUserID) {
+		return false
+	}
+	if len(x.Actions) > 0 && !slices.Contains(x.Actions, event.Action) {
+		return false
+	}
+	if x.TargetType != "" && event.TargetType != x.TargetType {
+		return false
+	}
+	if x.TargetID != "" && event.TargetID != x.TargetID {
+		return false
+	}
+	if x.RequestID != "" && event.RequestID != x.RequestID {
+		return false
+	}
+	return true
+}
+
+func (x *AuditEventFilter) Check() error {
+	if x == nil {
+		return nil
+	}
+	if x.StartTime > 0 && x.EndTime > 0 && x.StartTime >= x.EndTime {
+		return errors.New("startTime must be less than endTime")
+	}
+	return nil
+}
+
+func (x *AuditEvent) Check() error {
+	if x.ActorUserID == "" {
+		return errors.New("actorUserID is empty")
+	}
+	if x.Action == "" {
+		return errors.New("action is empty")
+	}
+	return nil
+}
+
+func (x *WriteAuditEventsReq) Check() error {
+	if len(x.Events) == 0 {
+		return errors.New("events is empty")
+	}
+	if len(x.Events) > constant.ParamMaxLength {
+		return errors.New("too many Events, need to be less than 1000")
+	}
+	for _, event := range x.Events {
+		if err := event.Check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *SearchAuditEventsReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return x.Filter.Check()
+}
+
+func (x *ExportAuditEventsReq) Check() error {
+	switch x.Format {
+	case constant.AuditExportFormatJSONL, constant.AuditExportFormatCSV:
+	default:
+		return errors.New("format is invalid")
+	}
+	return x.Filter.Check()
+}
+
+func (x *SearchAuditEventsResp) Format() any {
+	if len(x.Events) > 20 {
+		return fmt.Sprintf("len is %v, total is %v", len(x.Events), x.Total)
+	}
+	return x
+}
+
+func (x *ExportAuditEventsResp) Format() any {
+	return fmt.Sprintf("count is %v, url is %v, data len is %v", x.Count, x.Url, len(x.Data))
+}
@@ -0,0 +1,722 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.27.3
+// source: audit/audit.proto
+
+package audit
+
+import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuditEvent 审计事件，记录管理员操作与安全相关操作
+type AuditEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EventID         string                 `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID"`                  // 事件ID，写入时为空由服务端生成
+	ActorUserID     string                 `protobuf:"bytes,2,opt,name=actorUserID,proto3" json:"actorUserID"`          // 操作者用户ID
+	ActorPlatformID int32                  `protobuf:"varint,3,opt,name=actorPlatformID,proto3" json:"actorPlatformID"` // 操作者平台ID
+	ActorIP         string                 `protobuf:"bytes,4,opt,name=actorIP,proto3" json:"actorIP"`                  // 操作者IP
+	Action          string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action"`                    // 操作类型 constant.AuditAction*
+	TargetType      string                 `protobuf:"bytes,6,opt,name=targetType,proto3" json:"targetType"`            // 目标类型 constant.AuditTarget*
+	TargetID        string                 `protobuf:"bytes,7,opt,name=targetID,proto3" json:"targetID"`                // 目标ID
+	BeforeDigest    string                 `protobuf:"bytes,8,opt,name=beforeDigest,proto3" json:"beforeDigest"`        // 操作前数据摘要
+	AfterDigest     string                 `protobuf:"bytes,9,opt,name=afterDigest,proto3" json:"afterDigest"`          // 操作后数据摘要
+	RequestID       string                 `protobuf:"bytes,10,opt,name=requestID,proto3" json:"requestID"`             // 请求ID（operationID）
+	Service         string                 `protobuf:"bytes,11,opt,name=service,proto3" json:"service"`                 // 产生事件的服务
+	Success         bool                   `protobuf:"varint,12,opt,name=success,proto3" json:"success"`                // 操作是否成功
+	ErrMsg          string                 `protobuf:"bytes,13,opt,name=errMsg,proto3" json:"errMsg"`                   // 失败原因
+	CreateTime      int64                  `protobuf:"varint,14,opt,name=createTime,proto3" json:"createTime"`          // 事件时间（毫秒时间戳），为0时由服务端填充
+	Ex              string                 `protobuf:"bytes,15,opt,name=ex,proto3" json:"ex"`                           // 扩展字段
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_audit_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditEvent) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorUserID() string {
+	if x != nil {
+		return x.ActorUserID
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorPlatformID() int32 {
+	if x != nil {
+		return x.ActorPlatformID
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetActorIP() string {
+	if x != nil {
+		return x.ActorIP
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBeforeDigest() string {
+	if x != nil {
+		return x.BeforeDigest
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAfterDigest() string {
+	if x != nil {
+		return x.AfterDigest
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AuditEvent) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+// AuditEventFilter 审计事件查询条件，未设置的条件不参与过滤
+type AuditEventFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartTime     int64                  `protobuf:"varint,1,opt,name=startTime,proto3" json:"startTime"`      // 开始时间（毫秒时间戳，包含）
+	EndTime       int64                  `protobuf:"varint,2,opt,name=endTime,proto3" json:"endTime"`          // 结束时间（毫秒时间戳，不包含）
+	ActorUserIDs  []string               `protobuf:"bytes,3,rep,name=actorUserIDs,proto3" json:"actorUserIDs"` // 操作者
+	Actions       []string               `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions"`           // 操作类型
+	TargetType    string                 `protobuf:"bytes,5,opt,name=targetType,proto3" json:"targetType"`     // 目标类型
+	TargetID      string                 `protobuf:"bytes,6,opt,name=targetID,proto3" json:"targetID"`         // 目标ID
+	RequestID     string                 `protobuf:"bytes,7,opt,name=requestID,proto3" json:"requestID"`       // 请求ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEventFilter) Reset() {
+	*x = AuditEventFilter{}
+	mi := &file_audit_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEventFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEventFilter) ProtoMessage() {}
+
+func (x *AuditEventFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEventFilter.ProtoReflect.Descriptor instead.
+func (*AuditEventFilter) Descriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditEventFilter) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *AuditEventFilter) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *AuditEventFilter) GetActorUserIDs() []string {
+	if x != nil {
+		return x.ActorUserIDs
+	}
+	return nil
+}
+
+func (x *AuditEventFilter) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *AuditEventFilter) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *AuditEventFilter) GetTargetID() string {
+	if x != nil {
+		return x.TargetID
+	}
+	return ""
+}
+
+func (x *AuditEventFilter) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+// WriteAuditEventsReq 写入审计事件（各服务调用）
+type WriteAuditEventsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteAuditEventsReq) Reset() {
+	*x = WriteAuditEventsReq{}
+	mi := &file_audit_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteAuditEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteAuditEventsReq) ProtoMessage() {}
+
+func (x *WriteAuditEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteAuditEventsReq.ProtoReflect.Descriptor instead.
+func (*WriteAuditEventsReq) Descriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WriteAuditEventsReq) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type WriteAuditEventsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventIDs      []string               `protobuf:"bytes,1,rep,name=eventIDs,proto3" json:"eventIDs"` // 与请求中的事件一一对应
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteAuditEventsResp) Reset() {
+	*x = WriteAuditEventsResp{}
+	mi := &file_audit_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteAuditEventsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteAuditEventsResp) ProtoMessage() {}
+
+func (x *WriteAuditEventsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteAuditEventsResp.ProtoReflect.Descriptor instead.
+func (*WriteAuditEventsResp) Descriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WriteAuditEventsResp) GetEventIDs() []string {
+	if x != nil {
+		return x.EventIDs
+	}
+	return nil
+}
+
+// SearchAuditEventsReq 查询审计事件，按时间倒序返回
+type SearchAuditEventsReq struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Filter        *AuditEventFilter        `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter"`
+	Pagination    *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAuditEventsReq) Reset() {
+	*x = SearchAuditEventsReq{}
+	mi := &file_audit_audit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAuditEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAuditEventsReq) ProtoMessage() {}
+
+func (x *SearchAuditEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAuditEventsReq.ProtoReflect.Descriptor instead.
+func (*SearchAuditEventsReq) Descriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchAuditEventsReq) GetFilter() *AuditEventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchAuditEventsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchAuditEventsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Events        []*AuditEvent          `protobuf:"bytes,2,rep,name=events,proto3" json:"events"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAuditEventsResp) Reset() {
+	*x = SearchAuditEventsResp{}
+	mi := &file_audit_audit_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAuditEventsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAuditEventsResp) ProtoMessage() {}
+
+func (x *SearchAuditEventsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAuditEventsResp.ProtoReflect.Descriptor instead.
+func (*SearchAuditEventsResp) Descriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchAuditEventsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchAuditEventsResp) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// ExportAuditEventsReq 导出审计事件
+type ExportAuditEventsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *AuditEventFilter      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format"` // 导出格式 constant.AuditExportFormat*
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditEventsReq) Reset() {
+	*x = ExportAuditEventsReq{}
+	mi := &file_audit_audit_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditEventsReq) ProtoMessage() {}
+
+func (x *ExportAuditEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditEventsReq.ProtoReflect.Descriptor instead.
+func (*ExportAuditEventsReq) Descriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExportAuditEventsReq) GetFilter() *AuditEventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportAuditEventsReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportAuditEventsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count"` // 导出的事件数
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url"`      // 导出文件地址（上传到对象存储时返回）
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data"`    // 导出内容（未上传时直接返回）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditEventsResp) Reset() {
+	*x = ExportAuditEventsResp{}
+	mi := &file_audit_audit_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditEventsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditEventsResp) ProtoMessage() {}
+
+func (x *ExportAuditEventsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditEventsResp.ProtoReflect.Descriptor instead.
+func (*ExportAuditEventsResp) Descriptor() ([]byte, []int) {
+	return file_audit_audit_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportAuditEventsResp) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ExportAuditEventsResp) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ExportAuditEventsResp) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_audit_audit_proto protoreflect.FileDescriptor
+
+const file_audit_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x11audit/audit.proto\x12\fopenim.audit\x1a\x11sdkws/sdkws.proto\"\xc0\x03\n" +
+	"\n" +
+	"AuditEvent\x12\x18\n" +
+	"\aeventID\x18\x01 \x01(\tR\aeventID\x12 \n" +
+	"\vactorUserID\x18\x02 \x01(\tR\vactorUserID\x12(\n" +
+	"\x0factorPlatformID\x18\x03 \x01(\x05R\x0factorPlatformID\x12\x18\n" +
+	"\aactorIP\x18\x04 \x01(\tR\aactorIP\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12\x1e\n" +
+	"\n" +
+	"targetType\x18\x06 \x01(\tR\n" +
+	"targetType\x12\x1a\n" +
+	"\btargetID\x18\a \x01(\tR\btargetID\x12\"\n" +
+	"\fbeforeDigest\x18\b \x01(\tR\fbeforeDigest\x12 \n" +
+	"\vafterDigest\x18\t \x01(\tR\vafterDigest\x12\x1c\n" +
+	"\trequestID\x18\n" +
+	" \x01(\tR\trequestID\x12\x18\n" +
+	"\aservice\x18\v \x01(\tR\aservice\x12\x18\n" +
+	"\asuccess\x18\f \x01(\bR\asuccess\x12\x16\n" +
+	"\x06errMsg\x18\r \x01(\tR\x06errMsg\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x0e \x01(\x03R\n" +
+	"createTime\x12\x0e\n" +
+	"\x02ex\x18\x0f \x01(\tR\x02ex\"\xe2\x01\n" +
+	"\x10AuditEventFilter\x12\x1c\n" +
+	"\tstartTime\x18\x01 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x02 \x01(\x03R\aendTime\x12\"\n" +
+	"\factorUserIDs\x18\x03 \x03(\tR\factorUserIDs\x12\x18\n" +
+	"\aactions\x18\x04 \x03(\tR\aactions\x12\x1e\n" +
+	"\n" +
+	"targetType\x18\x05 \x01(\tR\n" +
+	"targetType\x12\x1a\n" +
+	"\btargetID\x18\x06 \x01(\tR\btargetID\x12\x1c\n" +
+	"\trequestID\x18\a \x01(\tR\trequestID\"G\n" +
+	"\x13WriteAuditEventsReq\x120\n" +
+	"\x06events\x18\x01 \x03(\v2\x18.openim.audit.AuditEventR\x06events\"2\n" +
+	"\x14WriteAuditEventsResp\x12\x1a\n" +
+	"\beventIDs\x18\x01 \x03(\tR\beventIDs\"\x8f\x01\n" +
+	"\x14SearchAuditEventsReq\x126\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1e.openim.audit.AuditEventFilterR\x06filter\x12?\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
+	"pagination\"_\n" +
+	"\x15SearchAuditEventsResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x120\n" +
+	"\x06events\x18\x02 \x03(\v2\x18.openim.audit.AuditEventR\x06events\"f\n" +
+	"\x14ExportAuditEventsReq\x126\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1e.openim.audit.AuditEventFilterR\x06filter\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"S\n" +
+	"\x15ExportAuditEventsResp\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data2\x9e\x02\n" +
+	"\x05audit\x12Y\n" +
+	"\x10WriteAuditEvents\x12!.openim.audit.WriteAuditEventsReq\x1a\".openim.audit.WriteAuditEventsResp\x12\\\n" +
+	"\x11SearchAuditEvents\x12\".openim.audit.SearchAuditEventsReq\x1a#.openim.audit.SearchAuditEventsResp\x12\\\n" +
+	"\x11ExportAuditEvents\x12\".openim.audit.ExportAuditEventsReq\x1a#.openim.audit.ExportAuditEventsRespB%Z#github.com/openimsdk/protocol/auditb\x06proto3"
+
+var (
+	file_audit_audit_proto_rawDescOnce sync.Once
+	file_audit_audit_proto_rawDescData []byte
+)
+
+func file_audit_audit_proto_rawDescGZIP() []byte {
+	file_audit_audit_proto_rawDescOnce.Do(func() {
+		file_audit_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_audit_audit_proto_rawDesc), len(file_audit_audit_proto_rawDesc)))
+	})
+	return file_audit_audit_proto_rawDescData
+}
+
+var file_audit_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_audit_audit_proto_goTypes = []any{
+	(*AuditEvent)(nil),              // 0: openim.audit.AuditEvent
+	(*AuditEventFilter)(nil),        // 1: openim.audit.AuditEventFilter
+	(*WriteAuditEventsReq)(nil),     // 2: openim.audit.WriteAuditEventsReq
+	(*WriteAuditEventsResp)(nil),    // 3: openim.audit.WriteAuditEventsResp
+	(*SearchAuditEventsReq)(nil),    // 4: openim.audit.SearchAuditEventsReq
+	(*SearchAuditEventsResp)(nil),   // 5: openim.audit.SearchAuditEventsResp
+	(*ExportAuditEventsReq)(nil),    // 6: openim.audit.ExportAuditEventsReq
+	(*ExportAuditEventsResp)(nil),   // 7: openim.audit.ExportAuditEventsResp
+	(*sdkws.RequestPagination)(nil), // 8: openim.sdkws.RequestPagination
+}
+var file_audit_audit_proto_depIdxs = []int32{
+	0, // 0: openim.audit.WriteAuditEventsReq.events:type_name -> openim.audit.AuditEvent
+	1, // 1: openim.audit.SearchAuditEventsReq.filter:type_name -> openim.audit.AuditEventFilter
+	8, // 2: openim.audit.SearchAuditEventsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	0, // 3: openim.audit.SearchAuditEventsResp.events:type_name -> openim.audit.AuditEvent
+	1, // 4: openim.audit.ExportAuditEventsReq.filter:type_name -> openim.audit.AuditEventFilter
+	2, // 5: openim.audit.audit.WriteAuditEvents:input_type -> openim.audit.WriteAuditEventsReq
+	4, // 6: openim.audit.audit.SearchAuditEvents:input_type -> openim.audit.SearchAuditEventsReq
+	6, // 7: openim.audit.audit.ExportAuditEvents:input_type -> openim.audit.ExportAuditEventsReq
+	3, // 8: openim.audit.audit.WriteAuditEvents:output_type -> openim.audit.WriteAuditEventsResp
+	5, // 9: openim.audit.audit.SearchAuditEvents:output_type -> openim.audit.SearchAuditEventsResp
+	7, // 10: openim.audit.audit.ExportAuditEvents:output_type -> openim.audit.ExportAuditEventsResp
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_audit_audit_proto_init() }
+func file_audit_audit_proto_init() {
+	if File_audit_audit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_audit_audit_proto_rawDesc), len(file_audit_audit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_audit_audit_proto_goTypes,
+		DependencyIndexes: file_audit_audit_proto_depIdxs,
+		MessageInfos:      file_audit_audit_proto_msgTypes,
+	}.Build()
+	File_audit_audit_proto = out.File
+	file_audit_audit_proto_goTypes = nil
+	file_audit_audit_proto_depIdxs = nil
+}
@@ -0,0 +1,217 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v5.27.3
+// source: audit/audit.proto
+
+package audit
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Audit_WriteAuditEvents_FullMethodName  = "/openim.audit.audit/WriteAuditEvents"
+	Audit_SearchAuditEvents_FullMethodName = "/openim.audit.audit/SearchAuditEvents"
+	Audit_ExportAuditEvents_FullMethodName = "/openim.audit.audit/ExportAuditEvents"
+)
+
+// AuditClient is the client API for Audit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuditClient interface {
+	// 写入审计事件
+	WriteAuditEvents(ctx context.Context, in *WriteAuditEventsReq, opts ...grpc.CallOption) (*WriteAuditEventsResp, error)
+	// 查询审计事件
+	SearchAuditEvents(ctx context.Context, in *SearchAuditEventsReq, opts ...grpc.CallOption) (*SearchAuditEventsResp, error)
+	// 导出审计事件
+	ExportAuditEvents(ctx context.Context, in *ExportAuditEventsReq, opts ...grpc.CallOption) (*ExportAuditEventsResp, error)
+}
+
+type auditClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditClient(cc grpc.ClientConnInterface) AuditClient {
+	return &auditClient{cc}
+}
+
+func (c *auditClient) WriteAuditEvents(ctx context.Context, in *WriteAuditEventsReq, opts ...grpc.CallOption) (*WriteAuditEventsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteAuditEventsResp)
+	err := c.cc.Invoke(ctx, Audit_WriteAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditClient) SearchAuditEvents(ctx context.Context, in *SearchAuditEventsReq, opts ...grpc.CallOption) (*SearchAuditEventsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAuditEventsResp)
+	err := c.cc.Invoke(ctx, Audit_SearchAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditClient) ExportAuditEvents(ctx context.Context, in *ExportAuditEventsReq, opts ...grpc.CallOption) (*ExportAuditEventsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportAuditEventsResp)
+	err := c.cc.Invoke(ctx, Audit_ExportAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServer is the server API for Audit service.
+// All implementations must embed UnimplementedAuditServer
+// for forward compatibility.
+type AuditServer interface {
+	// 写入审计事件
+	WriteAuditEvents(context.Context, *WriteAuditEventsReq) (*WriteAuditEventsResp, error)
+	// 查询审计事件
+	SearchAuditEvents(context.Context, *SearchAuditEventsReq) (*SearchAuditEventsResp, error)
+	// 导出审计事件
+	ExportAuditEvents(context.Context, *ExportAuditEventsReq) (*ExportAuditEventsResp, error)
+	mustEmbedUnimplementedAuditServer()
+}
+
+// UnimplementedAuditServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditServer struct{}
+
+func (UnimplementedAuditServer) WriteAuditEvents(context.Context, *WriteAuditEventsReq) (*WriteAuditEventsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method WriteAuditEvents not implemented")
+}
+func (UnimplementedAuditServer) SearchAuditEvents(context.Context, *SearchAuditEventsReq) (*SearchAuditEventsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchAuditEvents not implemented")
+}
+func (UnimplementedAuditServer) ExportAuditEvents(context.Context, *ExportAuditEventsReq) (*ExportAuditEventsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportAuditEvents not implemented")
+}
+func (UnimplementedAuditServer) mustEmbedUnimplementedAuditServer() {}
+func (UnimplementedAuditServer) testEmbeddedByValue()               {}
+
+// UnsafeAuditServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServer will
+// result in compilation errors.
+type UnsafeAuditServer interface {
+	mustEmbedUnimplementedAuditServer()
+}
+
+func RegisterAuditServer(s grpc.ServiceRegistrar, srv AuditServer) {
+	// If the following call panics, it indicates UnimplementedAuditServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Audit_ServiceDesc, srv)
+}
+
+func _Audit_WriteAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteAuditEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).WriteAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audit_WriteAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).WriteAuditEvents(ctx, req.(*WriteAuditEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Audit_SearchAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAuditEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).SearchAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audit_SearchAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).SearchAuditEvents(ctx, req.(*SearchAuditEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Audit_ExportAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAuditEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).ExportAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Audit_ExportAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).ExportAuditEvents(ctx, req.(*ExportAuditEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Audit_ServiceDesc is the grpc.ServiceDesc for Audit service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Audit_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "openim.audit.audit",
+	HandlerType: (*AuditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WriteAuditEvents",
+			Handler:    _Audit_WriteAuditEvents_Handler,
+		},
+		{
+			MethodName: "SearchAuditEvents",
+			Handler:    _Audit_SearchAuditEvents_Handler,
+		},
+		{
+			MethodName: "ExportAuditEvents",
+			Handler:    _Audit_ExportAuditEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "audit/audit.proto",
+}
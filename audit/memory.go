@@ -0,0 +1,161 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// MemoryServer is an AuditServer that keeps events in memory. It is meant for
+// tests and single node deployments, events are lost on restart.
+type MemoryServer struct {
+	UnimplementedAuditServer
+	mu     sync.RWMutex
+	events []*AuditEvent
+	seq    uint64
+}
+
+func NewMemoryServer() *MemoryServer {
+	return &MemoryServer{}
+}
+
+func (s *MemoryServer) WriteAuditEvents(ctx context.Context, req *WriteAuditEventsReq) (*WriteAuditEventsResp, error) {
+	if err := req.Check(); err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	eventIDs := make([]string, 0, len(req.Events))
+	for _, event := range req.Events {
+		event = proto.Clone(event).(*AuditEvent)
+		if event.EventID == "" {
+			s.seq++
+			event.EventID = strconv.FormatUint(s.seq, 10)
+		}
+		if event.CreateTime == 0 {
+			event.CreateTime = now
+		}
+		s.events = append(s.events, event)
+		eventIDs = append(eventIDs, event.EventID)
+	}
+	return &WriteAuditEventsResp{EventIDs: eventIDs}, nil
+}
+
+func (s *MemoryServer) SearchAuditEvents(ctx context.Context, req *SearchAuditEventsReq) (*SearchAuditEventsResp, error) {
+	if err := req.Check(); err != nil {
+		return nil, err
+	}
+	events := s.find(req.Filter)
+	resp := &SearchAuditEventsResp{Total: int64(len(events))}
+	start := int(req.Pagination.PageNumber-1) * int(req.Pagination.ShowNumber)
+	if req.Pagination.ShowNumber <= 0 || start >= len(events) {
+		return resp, nil
+	}
+	end := min(start+int(req.Pagination.ShowNumber), len(events))
+	resp.Events = events[start:end]
+	return resp, nil
+}
+
+func (s *MemoryServer) ExportAuditEvents(ctx context.Context, req *ExportAuditEventsReq) (*ExportAuditEventsResp, error) {
+	if err := req.Check(); err != nil {
+		return nil, err
+	}
+	events := s.find(req.Filter)
+	var (
+		data []byte
+		err  error
+	)
+	switch req.Format {
+	case constant.AuditExportFormatCSV:
+		data, err = exportCSV(events)
+	default:
+		data, err = exportJSONL(events)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ExportAuditEventsResp{Count: int64(len(events)), Data: data}, nil
+}
+
+// find returns clones of the events matching filter, newest first.
+func (s *MemoryServer) find(filter *AuditEventFilter) []*AuditEvent {
+	s.mu.RLock()
+	var events []*AuditEvent
+	for _, event := range s.events {
+		if filter.Match(event) {
+			events = append(events, proto.Clone(event).(*AuditEvent))
+		}
+	}
+	s.mu.RUnlock()
+	slices.Reverse(events)
+	slices.SortStableFunc(events, func(a, b *AuditEvent) int {
+		switch {
+		case a.CreateTime > b.CreateTime:
+			return -1
+		case a.CreateTime < b.CreateTime:
+			return 1
+		default:
+			return 0
+		}
+	})
+	return events
+}
+
+func exportJSONL(events []*AuditEvent) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, event := range events {
+		line, err := protojson.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+func exportCSV(events []*AuditEvent) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	header := []string{"eventID", "createTime", "actorUserID", "actorPlatformID", "actorIP", "action", "targetType", "targetID",
+		"beforeDigest", "afterDigest", "requestID", "service", "success", "errMsg", "ex"}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	for _, e := range events {
+		record := []string{e.EventID, strconv.FormatInt(e.CreateTime, 10), e.ActorUserID, strconv.Itoa(int(e.ActorPlatformID)), e.ActorIP,
+			e.Action, e.TargetType, e.TargetID, e.BeforeDigest, e.AfterDigest, e.RequestID, e.Service,
+			strconv.FormatBool(e.Success), e.ErrMsg, e.Ex}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,220 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"slices"
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func newTestServer(t *testing.T) *MemoryServer {
+	t.Helper()
+	s := NewMemoryServer()
+	events := []*AuditEvent{
+		{EventID: "e1", ActorUserID: "admin1", Action: constant.AuditActionForceLogout, TargetType: constant.AuditTargetUser, TargetID: "u1", CreateTime: 1000},
+		{EventID: "e2", ActorUserID: "admin2", Action: constant.AuditActionDismissGroup, TargetType: constant.AuditTargetGroup, TargetID: "g1", CreateTime: 2000, RequestID: "r2"},
+		{EventID: "e3", ActorUserID: "admin1", Action: constant.AuditActionKickTokens, TargetType: constant.AuditTargetToken, TargetID: "u1", CreateTime: 3000},
+		{EventID: "e4", ActorUserID: "admin1", Action: constant.AuditActionForceLogout, TargetType: constant.AuditTargetUser, TargetID: "u2", CreateTime: 3000, Success: true},
+	}
+	if _, err := s.WriteAuditEvents(context.Background(), &WriteAuditEventsReq{Events: events}); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func eventIDs(events []*AuditEvent) []string {
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.EventID)
+	}
+	return ids
+}
+
+func TestMemoryServerSearchFilter(t *testing.T) {
+	s := newTestServer(t)
+	tests := []struct {
+		name   string
+		filter *AuditEventFilter
+		want   []string
+	}{
+		{"nil filter", nil, []string{"e4", "e3", "e2", "e1"}},
+		{"start inclusive", &AuditEventFilter{StartTime: 2000}, []string{"e4", "e3", "e2"}},
+		{"end exclusive", &AuditEventFilter{EndTime: 3000}, []string{"e2", "e1"}},
+		{"half open window", &AuditEventFilter{StartTime: 1000, EndTime: 2000}, []string{"e1"}},
+		{"actor", &AuditEventFilter{ActorUserIDs: []string{"admin2"}}, []string{"e2"}},
+		{"actions", &AuditEventFilter{Actions: []string{constant.AuditActionForceLogout}}, []string{"e4", "e1"}},
+		{"target", &AuditEventFilter{TargetType: constant.AuditTargetUser, TargetID: "u1"}, []string{"e1"}},
+		{"request", &AuditEventFilter{RequestID: "r2"}, []string{"e2"}},
+		{"no match", &AuditEventFilter{ActorUserIDs: []string{"nobody"}}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.SearchAuditEvents(context.Background(), &SearchAuditEventsReq{
+				Filter:     tt.filter,
+				Pagination: &sdkws.RequestPagination{PageNumber: 1, ShowNumber: 100},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := eventIDs(resp.Events); !slices.Equal(got, tt.want) {
+				t.Fatalf("events = %v, want %v", got, tt.want)
+			}
+			if resp.Total != int64(len(tt.want)) {
+				t.Fatalf("total = %d, want %d", resp.Total, len(tt.want))
+			}
+		})
+	}
+}
+
+// Events with the same createTime are returned in reverse write order.
+func TestMemoryServerSearchNewestFirst(t *testing.T) {
+	s := newTestServer(t)
+	resp, err := s.SearchAuditEvents(context.Background(), &SearchAuditEventsReq{
+		Filter:     &AuditEventFilter{StartTime: 3000},
+		Pagination: &sdkws.RequestPagination{PageNumber: 1, ShowNumber: 10},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := eventIDs(resp.Events), []string{"e4", "e3"}; !slices.Equal(got, want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+}
+
+func TestMemoryServerSearchPagination(t *testing.T) {
+	s := newTestServer(t)
+	tests := []struct {
+		pageNumber int32
+		showNumber int32
+		want       []string
+	}{
+		{1, 3, []string{"e4", "e3", "e2"}},
+		{2, 3, []string{"e1"}},
+		{3, 3, []string{}},
+		{2, 2, []string{"e2", "e1"}},
+	}
+	for _, tt := range tests {
+		resp, err := s.SearchAuditEvents(context.Background(), &SearchAuditEventsReq{
+			Pagination: &sdkws.RequestPagination{PageNumber: tt.pageNumber, ShowNumber: tt.showNumber},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := eventIDs(resp.Events); !slices.Equal(got, tt.want) {
+			t.Fatalf("page %d/%d events = %v, want %v", tt.pageNumber, tt.showNumber, got, tt.want)
+		}
+		if resp.Total != 4 {
+			t.Fatalf("total = %d, want 4", resp.Total)
+		}
+	}
+	if _, err := s.SearchAuditEvents(context.Background(), &SearchAuditEventsReq{
+		Pagination: &sdkws.RequestPagination{PageNumber: 0, ShowNumber: 10},
+	}); err == nil {
+		t.Fatal("pageNumber 0 should be rejected")
+	}
+}
+
+func TestMemoryServerWriteFillsDefaults(t *testing.T) {
+	s := NewMemoryServer()
+	resp, err := s.WriteAuditEvents(context.Background(), &WriteAuditEventsReq{Events: []*AuditEvent{
+		{ActorUserID: "admin1", Action: constant.AuditActionOASync},
+		{ActorUserID: "admin1", Action: constant.AuditActionOASync},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.EventIDs) != 2 || resp.EventIDs[0] == "" || resp.EventIDs[0] == resp.EventIDs[1] {
+		t.Fatalf("eventIDs = %v, want two distinct generated IDs", resp.EventIDs)
+	}
+	search, err := s.SearchAuditEvents(context.Background(), &SearchAuditEventsReq{
+		Pagination: &sdkws.RequestPagination{PageNumber: 1, ShowNumber: 10},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, event := range search.Events {
+		if event.CreateTime == 0 {
+			t.Fatalf("event %s has no createTime", event.EventID)
+		}
+	}
+}
+
+func TestMemoryServerExportJSONL(t *testing.T) {
+	s := newTestServer(t)
+	resp, err := s.ExportAuditEvents(context.Background(), &ExportAuditEventsReq{
+		Filter: &AuditEventFilter{ActorUserIDs: []string{"admin1"}},
+		Format: constant.AuditExportFormatJSONL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Count != 3 {
+		t.Fatalf("count = %d, want 3", resp.Count)
+	}
+	var got []string
+	scanner := bufio.NewScanner(bytes.NewReader(resp.Data))
+	for scanner.Scan() {
+		var event AuditEvent
+		if err := protojson.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, event.EventID)
+	}
+	if want := []string{"e4", "e3", "e1"}; !slices.Equal(got, want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+}
+
+func TestMemoryServerExportCSV(t *testing.T) {
+	s := newTestServer(t)
+	resp, err := s.ExportAuditEvents(context.Background(), &ExportAuditEventsReq{
+		Filter: &AuditEventFilter{TargetID: "u1"},
+		Format: constant.AuditExportFormatCSV,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(bytes.NewReader(resp.Data)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("records = %d, want header and 2 rows", len(records))
+	}
+	if records[0][0] != "eventID" || records[0][5] != "action" {
+		t.Fatalf("unexpected header %v", records[0])
+	}
+	if records[1][0] != "e3" || records[2][0] != "e1" {
+		t.Fatalf("rows = %v, want e3 then e1", records[1:])
+	}
+	if records[2][5] != constant.AuditActionForceLogout || records[2][1] != "1000" {
+		t.Fatalf("row = %v", records[2])
+	}
+}
+
+func TestMemoryServerExportInvalidFormat(t *testing.T) {
+	s := newTestServer(t)
+	if _, err := s.ExportAuditEvents(context.Background(), &ExportAuditEventsReq{Format: "xml"}); err == nil {
+		t.Fatal("unknown format should be rejected")
+	}
+}
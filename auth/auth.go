@@ -16,6 +16,7 @@ package auth
 
 import (
 	"errors"
+	"time"
 
 	"github.com/openimsdk/protocol/constant"
 )
@@ -44,6 +45,139 @@ func (x *ParseTokenReq) Check() error {
 	return nil
 }
 
+func (x *RefreshTokenReq) Check() error {
+	if x.RefreshToken == "" {
+		return errors.New("refreshToken is empty")
+	}
+	if _, ok := constant.PlatformID2Name[int(x.PlatformID)]; !ok {
+		return errors.New("platformID is invalidate")
+	}
+	return nil
+}
+
+func (x *ListMySessionsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *RevokeSessionReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.SessionID == "" {
+		return errors.New("sessionID is empty")
+	}
+	return nil
+}
+
+func (x *RevokeAllOtherSessionsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.CurrentToken == "" {
+		return errors.New("currentToken is empty")
+	}
+	return nil
+}
+
+func (x *ReportSessionActivityReq) Check() error {
+	if x.Token == "" {
+		return errors.New("token is empty")
+	}
+	return nil
+}
+
+func checkApiKeyScope(scope string) error {
+	switch scope {
+	case constant.ApiKeyScopeSendMsg, constant.ApiKeyScopeReadGroupMember, constant.ApiKeyScopeManageSchedule:
+		return nil
+	default:
+		return errors.New("scope is invalid")
+	}
+}
+
+func (x *CreateApiKeyReq) Check() error {
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if len(x.Scopes) == 0 {
+		return errors.New("scopes is empty")
+	}
+	for _, scope := range x.Scopes {
+		if err := checkApiKeyScope(scope.Scope); err != nil {
+			return err
+		}
+	}
+	if x.ExpireTime != 0 && x.ExpireTime < time.Now().UnixMilli() {
+		return errors.New("expireTime is invalid")
+	}
+	return nil
+}
+
+func (x *ListApiKeysReq) Check() error {
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *RevokeApiKeyReq) Check() error {
+	if x.KeyID == "" {
+		return errors.New("keyID is empty")
+	}
+	return nil
+}
+
+func (x *ReportApiKeyUsageReq) Check() error {
+	if len(x.Usages) == 0 {
+		return errors.New("usages is empty")
+	}
+	return nil
+}
+
+func (x *GetApiKeyUsageReq) Check() error {
+	if x.KeyID == "" {
+		return errors.New("keyID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+// HasScope reports whether the parsed token may use scope on resourceID.
+// User tokens are not scoped and are always allowed.
+func (x *ParseTokenResp) HasScope(scope string, resourceID string) bool {
+	if x.ApiKeyID == "" {
+		return true
+	}
+	for _, s := range x.Scopes {
+		if s.Scope != scope {
+			continue
+		}
+		if len(s.ResourceIDs) == 0 {
+			return true
+		}
+		for _, id := range s.ResourceIDs {
+			if id == resourceID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (x *GetUserTokenReq) Check() error {
 	if x.UserID == "" {
 		errors.New("userID is empty")
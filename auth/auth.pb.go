@@ -21,6 +21,7 @@
 package auth
 
 import (
+	sdkws "github.com/openimsdk/protocol/sdkws"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -276,6 +277,9 @@ type ParseTokenResp struct {
 	UserID            string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
 	PlatformID        int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID"`
 	ExpireTimeSeconds int64                  `protobuf:"varint,4,opt,name=expireTimeSeconds,proto3" json:"expireTimeSeconds"`
+	FamilyID          string                 `protobuf:"bytes,5,opt,name=familyID,proto3" json:"familyID"` // token family shared by every token rotated from the same login
+	ApiKeyID          string                 `protobuf:"bytes,6,opt,name=apiKeyID,proto3" json:"apiKeyID"` // set when the token is an API key
+	Scopes            []*ApiKeyScope         `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes"`     // only set for API keys, user tokens are not scoped
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -331,29 +335,1299 @@ func (x *ParseTokenResp) GetExpireTimeSeconds() int64 {
 	return 0
 }
 
+func (x *ParseTokenResp) GetFamilyID() string {
+	if x != nil {
+		return x.FamilyID
+	}
+	return ""
+}
+
+func (x *ParseTokenResp) GetApiKeyID() string {
+	if x != nil {
+		return x.ApiKeyID
+	}
+	return ""
+}
+
+func (x *ParseTokenResp) GetScopes() []*ApiKeyScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
 type GetUserTokenReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlatformID    int32                  `protobuf:"varint,1,opt,name=platformID,proto3" json:"platformID"`
-	UserID        string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	PlatformID    int32                  `protobuf:"varint,1,opt,name=platformID,proto3" json:"platformID"`
+	UserID        string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Device        *SessionDevice         `protobuf:"bytes,3,opt,name=device,proto3" json:"device"` // optional, shown in the session list
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserTokenReq) Reset() {
+	*x = GetUserTokenReq{}
+	mi := &file_auth_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTokenReq) ProtoMessage() {}
+
+func (x *GetUserTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTokenReq.ProtoReflect.Descriptor instead.
+func (*GetUserTokenReq) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUserTokenReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *GetUserTokenReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetUserTokenReq) GetDevice() *SessionDevice {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+type SessionDevice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeviceName    string                 `protobuf:"bytes,1,opt,name=deviceName,proto3" json:"deviceName"`
+	AppVersion    string                 `protobuf:"bytes,2,opt,name=appVersion,proto3" json:"appVersion"`
+	Ip            string                 `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionDevice) Reset() {
+	*x = SessionDevice{}
+	mi := &file_auth_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionDevice) ProtoMessage() {}
+
+func (x *SessionDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionDevice.ProtoReflect.Descriptor instead.
+func (*SessionDevice) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SessionDevice) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *SessionDevice) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *SessionDevice) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type GetUserTokenResp struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Token                    string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	ExpireTimeSeconds        int64                  `protobuf:"varint,2,opt,name=expireTimeSeconds,proto3" json:"expireTimeSeconds"`
+	RefreshToken             string                 `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken"`
+	RefreshExpireTimeSeconds int64                  `protobuf:"varint,4,opt,name=refreshExpireTimeSeconds,proto3" json:"refreshExpireTimeSeconds"`
+	FamilyID                 string                 `protobuf:"bytes,5,opt,name=familyID,proto3" json:"familyID"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetUserTokenResp) Reset() {
+	*x = GetUserTokenResp{}
+	mi := &file_auth_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTokenResp) ProtoMessage() {}
+
+func (x *GetUserTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTokenResp.ProtoReflect.Descriptor instead.
+func (*GetUserTokenResp) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUserTokenResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetUserTokenResp) GetExpireTimeSeconds() int64 {
+	if x != nil {
+		return x.ExpireTimeSeconds
+	}
+	return 0
+}
+
+func (x *GetUserTokenResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *GetUserTokenResp) GetRefreshExpireTimeSeconds() int64 {
+	if x != nil {
+		return x.RefreshExpireTimeSeconds
+	}
+	return 0
+}
+
+func (x *GetUserTokenResp) GetFamilyID() string {
+	if x != nil {
+		return x.FamilyID
+	}
+	return ""
+}
+
+// A session is one login, identified by the token familyID, so it survives
+// refresh token rotation.
+type SessionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionID     string                 `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID"`
+	PlatformID    int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID"`
+	DeviceName    string                 `protobuf:"bytes,3,opt,name=deviceName,proto3" json:"deviceName"`
+	AppVersion    string                 `protobuf:"bytes,4,opt,name=appVersion,proto3" json:"appVersion"`
+	Ip            string                 `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip"`
+	FirstSeen     int64                  `protobuf:"varint,6,opt,name=firstSeen,proto3" json:"firstSeen"`
+	LastActive    int64                  `protobuf:"varint,7,opt,name=lastActive,proto3" json:"lastActive"`
+	Current       bool                   `protobuf:"varint,8,opt,name=current,proto3" json:"current"` // the session the request was made with
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	mi := &file_auth_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SessionInfo) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetLastActive() int64 {
+	if x != nil {
+		return x.LastActive
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListMySessionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	CurrentToken  string                 `protobuf:"bytes,2,opt,name=currentToken,proto3" json:"currentToken"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMySessionsReq) Reset() {
+	*x = ListMySessionsReq{}
+	mi := &file_auth_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMySessionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySessionsReq) ProtoMessage() {}
+
+func (x *ListMySessionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySessionsReq.ProtoReflect.Descriptor instead.
+func (*ListMySessionsReq) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMySessionsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ListMySessionsReq) GetCurrentToken() string {
+	if x != nil {
+		return x.CurrentToken
+	}
+	return ""
+}
+
+type ListMySessionsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionInfo         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMySessionsResp) Reset() {
+	*x = ListMySessionsResp{}
+	mi := &file_auth_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMySessionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySessionsResp) ProtoMessage() {}
+
+func (x *ListMySessionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySessionsResp.ProtoReflect.Descriptor instead.
+func (*ListMySessionsResp) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMySessionsResp) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	SessionID     string                 `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionReq) Reset() {
+	*x = RevokeSessionReq{}
+	mi := &file_auth_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionReq) ProtoMessage() {}
+
+func (x *RevokeSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionReq.ProtoReflect.Descriptor instead.
+func (*RevokeSessionReq) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RevokeSessionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RevokeSessionReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type RevokeSessionResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResp) Reset() {
+	*x = RevokeSessionResp{}
+	mi := &file_auth_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResp) ProtoMessage() {}
+
+func (x *RevokeSessionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResp.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResp) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{13}
+}
+
+type RevokeAllOtherSessionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	CurrentToken  string                 `protobuf:"bytes,2,opt,name=currentToken,proto3" json:"currentToken"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllOtherSessionsReq) Reset() {
+	*x = RevokeAllOtherSessionsReq{}
+	mi := &file_auth_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllOtherSessionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllOtherSessionsReq) ProtoMessage() {}
+
+func (x *RevokeAllOtherSessionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllOtherSessionsReq.ProtoReflect.Descriptor instead.
+func (*RevokeAllOtherSessionsReq) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevokeAllOtherSessionsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RevokeAllOtherSessionsReq) GetCurrentToken() string {
+	if x != nil {
+		return x.CurrentToken
+	}
+	return ""
+}
+
+type RevokeAllOtherSessionsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionIDs    []string               `protobuf:"bytes,1,rep,name=sessionIDs,proto3" json:"sessionIDs"` // revoked sessions
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllOtherSessionsResp) Reset() {
+	*x = RevokeAllOtherSessionsResp{}
+	mi := &file_auth_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllOtherSessionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllOtherSessionsResp) ProtoMessage() {}
+
+func (x *RevokeAllOtherSessionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllOtherSessionsResp.ProtoReflect.Descriptor instead.
+func (*RevokeAllOtherSessionsResp) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RevokeAllOtherSessionsResp) GetSessionIDs() []string {
+	if x != nil {
+		return x.SessionIDs
+	}
+	return nil
+}
+
+// Called by the gateway when a connection is established.
+type ReportSessionActivityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip"`
+	AppVersion    string                 `protobuf:"bytes,3,opt,name=appVersion,proto3" json:"appVersion"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSessionActivityReq) Reset() {
+	*x = ReportSessionActivityReq{}
+	mi := &file_auth_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSessionActivityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSessionActivityReq) ProtoMessage() {}
+
+func (x *ReportSessionActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSessionActivityReq.ProtoReflect.Descriptor instead.
+func (*ReportSessionActivityReq) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReportSessionActivityReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReportSessionActivityReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ReportSessionActivityReq) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+type ReportSessionActivityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSessionActivityResp) Reset() {
+	*x = ReportSessionActivityResp{}
+	mi := &file_auth_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSessionActivityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSessionActivityResp) ProtoMessage() {}
+
+func (x *ReportSessionActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSessionActivityResp.ProtoReflect.Descriptor instead.
+func (*ReportSessionActivityResp) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{17}
+}
+
+// API keys are long lived credentials for bots and integrations. A key acts as
+// the bound user but is limited to its scopes.
+type ApiKeyScope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope"`             // constant.ApiKeyScope*
+	ResourceIDs   []string               `protobuf:"bytes,2,rep,name=resourceIDs,proto3" json:"resourceIDs"` // e.g. conversation IDs for ApiKeyScopeSendMsg, empty means no restriction
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyScope) Reset() {
+	*x = ApiKeyScope{}
+	mi := &file_auth_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyScope) ProtoMessage() {}
+
+func (x *ApiKeyScope) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyScope.ProtoReflect.Descriptor instead.
+func (*ApiKeyScope) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ApiKeyScope) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ApiKeyScope) GetResourceIDs() []string {
+	if x != nil {
+		return x.ResourceIDs
+	}
+	return nil
+}
+
+type ApiKeyInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyID         string                 `protobuf:"bytes,1,opt,name=keyID,proto3" json:"keyID"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	UserID        string                 `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	Scopes        []*ApiKeyScope         `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes"`
+	ExpireTime    int64                  `protobuf:"varint,5,opt,name=expireTime,proto3" json:"expireTime"` // 0 means never
+	CreateTime    int64                  `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime"`
+	CreatorUserID string                 `protobuf:"bytes,7,opt,name=creatorUserID,proto3" json:"creatorUserID"`
+	LastUsedTime  int64                  `protobuf:"varint,8,opt,name=lastUsedTime,proto3" json:"lastUsedTime"`
+	Revoked       bool                   `protobuf:"varint,9,opt,name=revoked,proto3" json:"revoked"`
+	KeyPrefix     string                 `protobuf:"bytes,10,opt,name=keyPrefix,proto3" json:"keyPrefix"` // leading characters of the key, for identification
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyInfo) Reset() {
+	*x = ApiKeyInfo{}
+	mi := &file_auth_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyInfo) ProtoMessage() {}
+
+func (x *ApiKeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyInfo.ProtoReflect.Descriptor instead.
+func (*ApiKeyInfo) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ApiKeyInfo) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *ApiKeyInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKeyInfo) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ApiKeyInfo) GetScopes() []*ApiKeyScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKeyInfo) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *ApiKeyInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *ApiKeyInfo) GetCreatorUserID() string {
+	if x != nil {
+		return x.CreatorUserID
+	}
+	return ""
+}
+
+func (x *ApiKeyInfo) GetLastUsedTime() int64 {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return 0
+}
+
+func (x *ApiKeyInfo) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *ApiKeyInfo) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+type CreateApiKeyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	UserID        string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Scopes        []*ApiKeyScope         `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes"`
+	ExpireTime    int64                  `protobuf:"varint,4,opt,name=expireTime,proto3" json:"expireTime"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyReq) Reset() {
+	*x = CreateApiKeyReq{}
+	mi := &file_auth_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyReq) ProtoMessage() {}
+
+func (x *CreateApiKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyReq.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyReq) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateApiKeyReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreateApiKeyReq) GetScopes() []*ApiKeyScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateApiKeyReq) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type CreateApiKeyResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *ApiKeyInfo            `protobuf:"bytes,1,opt,name=info,proto3" json:"info"`
+	ApiKey        string                 `protobuf:"bytes,2,opt,name=apiKey,proto3" json:"apiKey"` // plaintext key, only returned once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResp) Reset() {
+	*x = CreateApiKeyResp{}
+	mi := &file_auth_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResp) ProtoMessage() {}
+
+func (x *CreateApiKeyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResp.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResp) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateApiKeyResp) GetInfo() *ApiKeyInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResp) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+type ListApiKeysReq struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	UserID         string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"` // empty lists keys of all users
+	IncludeRevoked bool                     `protobuf:"varint,2,opt,name=includeRevoked,proto3" json:"includeRevoked"`
+	Pagination     *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListApiKeysReq) Reset() {
+	*x = ListApiKeysReq{}
+	mi := &file_auth_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysReq) ProtoMessage() {}
+
+func (x *ListApiKeysReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysReq.ProtoReflect.Descriptor instead.
+func (*ListApiKeysReq) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListApiKeysReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ListApiKeysReq) GetIncludeRevoked() bool {
+	if x != nil {
+		return x.IncludeRevoked
+	}
+	return false
+}
+
+func (x *ListApiKeysReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListApiKeysResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Keys          []*ApiKeyInfo          `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResp) Reset() {
+	*x = ListApiKeysResp{}
+	mi := &file_auth_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResp) ProtoMessage() {}
+
+func (x *ListApiKeysResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResp.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResp) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListApiKeysResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListApiKeysResp) GetKeys() []*ApiKeyInfo {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RevokeApiKeyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyID         string                 `protobuf:"bytes,1,opt,name=keyID,proto3" json:"keyID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyReq) Reset() {
+	*x = RevokeApiKeyReq{}
+	mi := &file_auth_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyReq) ProtoMessage() {}
+
+func (x *RevokeApiKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyReq.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyReq) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeApiKeyReq) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+type RevokeApiKeyResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResp) Reset() {
+	*x = RevokeApiKeyResp{}
+	mi := &file_auth_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResp) ProtoMessage() {}
+
+func (x *RevokeApiKeyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResp.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResp) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{25}
+}
+
+type ApiKeyUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyID         string                 `protobuf:"bytes,1,opt,name=keyID,proto3" json:"keyID"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method"`
+	Scope         string                 `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope"`
+	ResourceID    string                 `protobuf:"bytes,4,opt,name=resourceID,proto3" json:"resourceID"`
+	Allowed       bool                   `protobuf:"varint,5,opt,name=allowed,proto3" json:"allowed"`
+	Ip            string                 `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip"`
+	Time          int64                  `protobuf:"varint,7,opt,name=time,proto3" json:"time"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyUsage) Reset() {
+	*x = ApiKeyUsage{}
+	mi := &file_auth_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyUsage) ProtoMessage() {}
+
+func (x *ApiKeyUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyUsage.ProtoReflect.Descriptor instead.
+func (*ApiKeyUsage) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ApiKeyUsage) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *ApiKeyUsage) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ApiKeyUsage) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *ApiKeyUsage) GetResourceID() string {
+	if x != nil {
+		return x.ResourceID
+	}
+	return ""
+}
+
+func (x *ApiKeyUsage) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *ApiKeyUsage) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ApiKeyUsage) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// Services report each scoped call so the key owner can audit its use.
+type ReportApiKeyUsageReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usages        []*ApiKeyUsage         `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserTokenReq) Reset() {
-	*x = GetUserTokenReq{}
-	mi := &file_auth_auth_proto_msgTypes[6]
+func (x *ReportApiKeyUsageReq) Reset() {
+	*x = ReportApiKeyUsageReq{}
+	mi := &file_auth_auth_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserTokenReq) String() string {
+func (x *ReportApiKeyUsageReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTokenReq) ProtoMessage() {}
+func (*ReportApiKeyUsageReq) ProtoMessage() {}
 
-func (x *GetUserTokenReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[6]
+func (x *ReportApiKeyUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,48 +1638,255 @@ func (x *GetUserTokenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTokenReq.ProtoReflect.Descriptor instead.
-func (*GetUserTokenReq) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ReportApiKeyUsageReq.ProtoReflect.Descriptor instead.
+func (*ReportApiKeyUsageReq) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetUserTokenReq) GetPlatformID() int32 {
+func (x *ReportApiKeyUsageReq) GetUsages() []*ApiKeyUsage {
 	if x != nil {
-		return x.PlatformID
+		return x.Usages
+	}
+	return nil
+}
+
+type ReportApiKeyUsageResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportApiKeyUsageResp) Reset() {
+	*x = ReportApiKeyUsageResp{}
+	mi := &file_auth_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportApiKeyUsageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportApiKeyUsageResp) ProtoMessage() {}
+
+func (x *ReportApiKeyUsageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportApiKeyUsageResp.ProtoReflect.Descriptor instead.
+func (*ReportApiKeyUsageResp) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{28}
+}
+
+type GetApiKeyUsageReq struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	KeyID         string                   `protobuf:"bytes,1,opt,name=keyID,proto3" json:"keyID"`
+	StartTime     int64                    `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime"`
+	EndTime       int64                    `protobuf:"varint,3,opt,name=endTime,proto3" json:"endTime"`
+	Pagination    *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApiKeyUsageReq) Reset() {
+	*x = GetApiKeyUsageReq{}
+	mi := &file_auth_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApiKeyUsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiKeyUsageReq) ProtoMessage() {}
+
+func (x *GetApiKeyUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiKeyUsageReq.ProtoReflect.Descriptor instead.
+func (*GetApiKeyUsageReq) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetApiKeyUsageReq) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *GetApiKeyUsageReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
 	}
 	return 0
 }
 
-func (x *GetUserTokenReq) GetUserID() string {
+func (x *GetApiKeyUsageReq) GetEndTime() int64 {
 	if x != nil {
-		return x.UserID
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *GetApiKeyUsageReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetApiKeyUsageResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Usages        []*ApiKeyUsage         `protobuf:"bytes,2,rep,name=usages,proto3" json:"usages"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApiKeyUsageResp) Reset() {
+	*x = GetApiKeyUsageResp{}
+	mi := &file_auth_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApiKeyUsageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiKeyUsageResp) ProtoMessage() {}
+
+func (x *GetApiKeyUsageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiKeyUsageResp.ProtoReflect.Descriptor instead.
+func (*GetApiKeyUsageResp) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetApiKeyUsageResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetApiKeyUsageResp) GetUsages() []*ApiKeyUsage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+// The refresh token is single use: a successful refresh rotates it. Presenting
+// a refresh token that was already rotated is treated as reuse, and every token
+// in its family is marked as KickedToken.
+type RefreshTokenReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken"`
+	PlatformID    int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenReq) Reset() {
+	*x = RefreshTokenReq{}
+	mi := &file_auth_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenReq) ProtoMessage() {}
+
+func (x *RefreshTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenReq.ProtoReflect.Descriptor instead.
+func (*RefreshTokenReq) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RefreshTokenReq) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
 	}
 	return ""
 }
 
-type GetUserTokenResp struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Token             string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
-	ExpireTimeSeconds int64                  `protobuf:"varint,2,opt,name=expireTimeSeconds,proto3" json:"expireTimeSeconds"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *RefreshTokenReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
 }
 
-func (x *GetUserTokenResp) Reset() {
-	*x = GetUserTokenResp{}
-	mi := &file_auth_auth_proto_msgTypes[7]
+type RefreshTokenResp struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Token                    string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	ExpireTimeSeconds        int64                  `protobuf:"varint,2,opt,name=expireTimeSeconds,proto3" json:"expireTimeSeconds"`
+	RefreshToken             string                 `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken"`
+	RefreshExpireTimeSeconds int64                  `protobuf:"varint,4,opt,name=refreshExpireTimeSeconds,proto3" json:"refreshExpireTimeSeconds"`
+	FamilyID                 string                 `protobuf:"bytes,5,opt,name=familyID,proto3" json:"familyID"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResp) Reset() {
+	*x = RefreshTokenResp{}
+	mi := &file_auth_auth_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserTokenResp) String() string {
+func (x *RefreshTokenResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTokenResp) ProtoMessage() {}
+func (*RefreshTokenResp) ProtoMessage() {}
 
-func (x *GetUserTokenResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[7]
+func (x *RefreshTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_auth_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,25 +1897,46 @@ func (x *GetUserTokenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTokenResp.ProtoReflect.Descriptor instead.
-func (*GetUserTokenResp) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RefreshTokenResp.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResp) Descriptor() ([]byte, []int) {
+	return file_auth_auth_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetUserTokenResp) GetToken() string {
+func (x *RefreshTokenResp) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-func (x *GetUserTokenResp) GetExpireTimeSeconds() int64 {
+func (x *RefreshTokenResp) GetExpireTimeSeconds() int64 {
 	if x != nil {
 		return x.ExpireTimeSeconds
 	}
 	return 0
 }
 
+func (x *RefreshTokenResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResp) GetRefreshExpireTimeSeconds() int64 {
+	if x != nil {
+		return x.RefreshExpireTimeSeconds
+	}
+	return 0
+}
+
+func (x *RefreshTokenResp) GetFamilyID() string {
+	if x != nil {
+		return x.FamilyID
+	}
+	return ""
+}
+
 type InvalidateTokenReq struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	PreservedToken string                 `protobuf:"bytes,1,opt,name=preservedToken,proto3" json:"preservedToken"`
@@ -446,7 +1948,7 @@ type InvalidateTokenReq struct {
 
 func (x *InvalidateTokenReq) Reset() {
 	*x = InvalidateTokenReq{}
-	mi := &file_auth_auth_proto_msgTypes[8]
+	mi := &file_auth_auth_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -458,7 +1960,7 @@ func (x *InvalidateTokenReq) String() string {
 func (*InvalidateTokenReq) ProtoMessage() {}
 
 func (x *InvalidateTokenReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[8]
+	mi := &file_auth_auth_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +1973,7 @@ func (x *InvalidateTokenReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvalidateTokenReq.ProtoReflect.Descriptor instead.
 func (*InvalidateTokenReq) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{8}
+	return file_auth_auth_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *InvalidateTokenReq) GetPreservedToken() string {
@@ -503,7 +2005,7 @@ type InvalidateTokenResp struct {
 
 func (x *InvalidateTokenResp) Reset() {
 	*x = InvalidateTokenResp{}
-	mi := &file_auth_auth_proto_msgTypes[9]
+	mi := &file_auth_auth_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -515,7 +2017,7 @@ func (x *InvalidateTokenResp) String() string {
 func (*InvalidateTokenResp) ProtoMessage() {}
 
 func (x *InvalidateTokenResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[9]
+	mi := &file_auth_auth_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +2030,7 @@ func (x *InvalidateTokenResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvalidateTokenResp.ProtoReflect.Descriptor instead.
 func (*InvalidateTokenResp) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{9}
+	return file_auth_auth_proto_rawDescGZIP(), []int{34}
 }
 
 type KickTokensReq struct {
@@ -540,7 +2042,7 @@ type KickTokensReq struct {
 
 func (x *KickTokensReq) Reset() {
 	*x = KickTokensReq{}
-	mi := &file_auth_auth_proto_msgTypes[10]
+	mi := &file_auth_auth_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -552,7 +2054,7 @@ func (x *KickTokensReq) String() string {
 func (*KickTokensReq) ProtoMessage() {}
 
 func (x *KickTokensReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[10]
+	mi := &file_auth_auth_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +2067,7 @@ func (x *KickTokensReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KickTokensReq.ProtoReflect.Descriptor instead.
 func (*KickTokensReq) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{10}
+	return file_auth_auth_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *KickTokensReq) GetTokens() []string {
@@ -583,7 +2085,7 @@ type KickTokensResp struct {
 
 func (x *KickTokensResp) Reset() {
 	*x = KickTokensResp{}
-	mi := &file_auth_auth_proto_msgTypes[11]
+	mi := &file_auth_auth_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -595,7 +2097,7 @@ func (x *KickTokensResp) String() string {
 func (*KickTokensResp) ProtoMessage() {}
 
 func (x *KickTokensResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[11]
+	mi := &file_auth_auth_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,7 +2110,7 @@ func (x *KickTokensResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KickTokensResp.ProtoReflect.Descriptor instead.
 func (*KickTokensResp) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{11}
+	return file_auth_auth_proto_rawDescGZIP(), []int{36}
 }
 
 type GetExistingTokenReq struct {
@@ -621,7 +2123,7 @@ type GetExistingTokenReq struct {
 
 func (x *GetExistingTokenReq) Reset() {
 	*x = GetExistingTokenReq{}
-	mi := &file_auth_auth_proto_msgTypes[12]
+	mi := &file_auth_auth_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -633,7 +2135,7 @@ func (x *GetExistingTokenReq) String() string {
 func (*GetExistingTokenReq) ProtoMessage() {}
 
 func (x *GetExistingTokenReq) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[12]
+	mi := &file_auth_auth_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +2148,7 @@ func (x *GetExistingTokenReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExistingTokenReq.ProtoReflect.Descriptor instead.
 func (*GetExistingTokenReq) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{12}
+	return file_auth_auth_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetExistingTokenReq) GetUserID() string {
@@ -672,7 +2174,7 @@ type GetExistingTokenResp struct {
 
 func (x *GetExistingTokenResp) Reset() {
 	*x = GetExistingTokenResp{}
-	mi := &file_auth_auth_proto_msgTypes[13]
+	mi := &file_auth_auth_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -684,7 +2186,7 @@ func (x *GetExistingTokenResp) String() string {
 func (*GetExistingTokenResp) ProtoMessage() {}
 
 func (x *GetExistingTokenResp) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_auth_proto_msgTypes[13]
+	mi := &file_auth_auth_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +2199,7 @@ func (x *GetExistingTokenResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExistingTokenResp.ProtoReflect.Descriptor instead.
 func (*GetExistingTokenResp) Descriptor() ([]byte, []int) {
-	return file_auth_auth_proto_rawDescGZIP(), []int{13}
+	return file_auth_auth_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetExistingTokenResp) GetTokenStates() map[string]int32 {
@@ -711,7 +2213,7 @@ var File_auth_auth_proto protoreflect.FileDescriptor
 
 const file_auth_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x0fauth/auth.proto\x12\vopenim.auth\"B\n" +
+	"\x0fauth/auth.proto\x12\vopenim.auth\x1a\x11sdkws/sdkws.proto\"B\n" +
 	"\x10getAdminTokenReq\x12\x16\n" +
 	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x16\n" +
 	"\x06userID\x18\x03 \x01(\tR\x06userID\"W\n" +
@@ -725,21 +2227,152 @@ const file_auth_auth_proto_rawDesc = "" +
 	"\x06userID\x18\x02 \x01(\tR\x06userID\"\x11\n" +
 	"\x0fforceLogoutResp\"%\n" +
 	"\rparseTokenReq\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"v\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xe0\x01\n" +
 	"\x0eparseTokenResp\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1e\n" +
 	"\n" +
 	"platformID\x18\x02 \x01(\x05R\n" +
 	"platformID\x12,\n" +
-	"\x11expireTimeSeconds\x18\x04 \x01(\x03R\x11expireTimeSeconds\"I\n" +
+	"\x11expireTimeSeconds\x18\x04 \x01(\x03R\x11expireTimeSeconds\x12\x1a\n" +
+	"\bfamilyID\x18\x05 \x01(\tR\bfamilyID\x12\x1a\n" +
+	"\bapiKeyID\x18\x06 \x01(\tR\bapiKeyID\x120\n" +
+	"\x06scopes\x18\a \x03(\v2\x18.openim.auth.apiKeyScopeR\x06scopes\"}\n" +
 	"\x0fgetUserTokenReq\x12\x1e\n" +
 	"\n" +
 	"platformID\x18\x01 \x01(\x05R\n" +
 	"platformID\x12\x16\n" +
-	"\x06userID\x18\x02 \x01(\tR\x06userID\"V\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x122\n" +
+	"\x06device\x18\x03 \x01(\v2\x1a.openim.auth.sessionDeviceR\x06device\"_\n" +
+	"\rsessionDevice\x12\x1e\n" +
+	"\n" +
+	"deviceName\x18\x01 \x01(\tR\n" +
+	"deviceName\x12\x1e\n" +
+	"\n" +
+	"appVersion\x18\x02 \x01(\tR\n" +
+	"appVersion\x12\x0e\n" +
+	"\x02ip\x18\x03 \x01(\tR\x02ip\"\xd2\x01\n" +
 	"\x10getUserTokenResp\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12,\n" +
-	"\x11expireTimeSeconds\x18\x02 \x01(\x03R\x11expireTimeSeconds\"t\n" +
+	"\x11expireTimeSeconds\x18\x02 \x01(\x03R\x11expireTimeSeconds\x12\"\n" +
+	"\frefreshToken\x18\x03 \x01(\tR\frefreshToken\x12:\n" +
+	"\x18refreshExpireTimeSeconds\x18\x04 \x01(\x03R\x18refreshExpireTimeSeconds\x12\x1a\n" +
+	"\bfamilyID\x18\x05 \x01(\tR\bfamilyID\"\xf3\x01\n" +
+	"\vsessionInfo\x12\x1c\n" +
+	"\tsessionID\x18\x01 \x01(\tR\tsessionID\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x02 \x01(\x05R\n" +
+	"platformID\x12\x1e\n" +
+	"\n" +
+	"deviceName\x18\x03 \x01(\tR\n" +
+	"deviceName\x12\x1e\n" +
+	"\n" +
+	"appVersion\x18\x04 \x01(\tR\n" +
+	"appVersion\x12\x0e\n" +
+	"\x02ip\x18\x05 \x01(\tR\x02ip\x12\x1c\n" +
+	"\tfirstSeen\x18\x06 \x01(\x03R\tfirstSeen\x12\x1e\n" +
+	"\n" +
+	"lastActive\x18\a \x01(\x03R\n" +
+	"lastActive\x12\x18\n" +
+	"\acurrent\x18\b \x01(\bR\acurrent\"O\n" +
+	"\x11listMySessionsReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\"\n" +
+	"\fcurrentToken\x18\x02 \x01(\tR\fcurrentToken\"J\n" +
+	"\x12listMySessionsResp\x124\n" +
+	"\bsessions\x18\x01 \x03(\v2\x18.openim.auth.sessionInfoR\bsessions\"H\n" +
+	"\x10revokeSessionReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
+	"\tsessionID\x18\x02 \x01(\tR\tsessionID\"\x13\n" +
+	"\x11revokeSessionResp\"W\n" +
+	"\x19revokeAllOtherSessionsReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\"\n" +
+	"\fcurrentToken\x18\x02 \x01(\tR\fcurrentToken\"<\n" +
+	"\x1arevokeAllOtherSessionsResp\x12\x1e\n" +
+	"\n" +
+	"sessionIDs\x18\x01 \x03(\tR\n" +
+	"sessionIDs\"`\n" +
+	"\x18reportSessionActivityReq\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\x12\x1e\n" +
+	"\n" +
+	"appVersion\x18\x03 \x01(\tR\n" +
+	"appVersion\"\x1b\n" +
+	"\x19reportSessionActivityResp\"E\n" +
+	"\vapiKeyScope\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12 \n" +
+	"\vresourceIDs\x18\x02 \x03(\tR\vresourceIDs\"\xc2\x02\n" +
+	"\n" +
+	"apiKeyInfo\x12\x14\n" +
+	"\x05keyID\x18\x01 \x01(\tR\x05keyID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06userID\x18\x03 \x01(\tR\x06userID\x120\n" +
+	"\x06scopes\x18\x04 \x03(\v2\x18.openim.auth.apiKeyScopeR\x06scopes\x12\x1e\n" +
+	"\n" +
+	"expireTime\x18\x05 \x01(\x03R\n" +
+	"expireTime\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x06 \x01(\x03R\n" +
+	"createTime\x12$\n" +
+	"\rcreatorUserID\x18\a \x01(\tR\rcreatorUserID\x12\"\n" +
+	"\flastUsedTime\x18\b \x01(\x03R\flastUsedTime\x12\x18\n" +
+	"\arevoked\x18\t \x01(\bR\arevoked\x12\x1c\n" +
+	"\tkeyPrefix\x18\n" +
+	" \x01(\tR\tkeyPrefix\"\x8f\x01\n" +
+	"\x0fcreateApiKeyReq\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x120\n" +
+	"\x06scopes\x18\x03 \x03(\v2\x18.openim.auth.apiKeyScopeR\x06scopes\x12\x1e\n" +
+	"\n" +
+	"expireTime\x18\x04 \x01(\x03R\n" +
+	"expireTime\"W\n" +
+	"\x10createApiKeyResp\x12+\n" +
+	"\x04info\x18\x01 \x01(\v2\x17.openim.auth.apiKeyInfoR\x04info\x12\x16\n" +
+	"\x06apiKey\x18\x02 \x01(\tR\x06apiKey\"\x91\x01\n" +
+	"\x0elistApiKeysReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12&\n" +
+	"\x0eincludeRevoked\x18\x02 \x01(\bR\x0eincludeRevoked\x12?\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
+	"pagination\"T\n" +
+	"\x0flistApiKeysResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12+\n" +
+	"\x04keys\x18\x02 \x03(\v2\x17.openim.auth.apiKeyInfoR\x04keys\"'\n" +
+	"\x0frevokeApiKeyReq\x12\x14\n" +
+	"\x05keyID\x18\x01 \x01(\tR\x05keyID\"\x12\n" +
+	"\x10revokeApiKeyResp\"\xaf\x01\n" +
+	"\vapiKeyUsage\x12\x14\n" +
+	"\x05keyID\x18\x01 \x01(\tR\x05keyID\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x14\n" +
+	"\x05scope\x18\x03 \x01(\tR\x05scope\x12\x1e\n" +
+	"\n" +
+	"resourceID\x18\x04 \x01(\tR\n" +
+	"resourceID\x12\x18\n" +
+	"\aallowed\x18\x05 \x01(\bR\aallowed\x12\x0e\n" +
+	"\x02ip\x18\x06 \x01(\tR\x02ip\x12\x12\n" +
+	"\x04time\x18\a \x01(\x03R\x04time\"H\n" +
+	"\x14reportApiKeyUsageReq\x120\n" +
+	"\x06usages\x18\x01 \x03(\v2\x18.openim.auth.apiKeyUsageR\x06usages\"\x17\n" +
+	"\x15reportApiKeyUsageResp\"\xa2\x01\n" +
+	"\x11getApiKeyUsageReq\x12\x14\n" +
+	"\x05keyID\x18\x01 \x01(\tR\x05keyID\x12\x1c\n" +
+	"\tstartTime\x18\x02 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x03 \x01(\x03R\aendTime\x12?\n" +
+	"\n" +
+	"pagination\x18\x04 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
+	"pagination\"\\\n" +
+	"\x12getApiKeyUsageResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x120\n" +
+	"\x06usages\x18\x02 \x03(\v2\x18.openim.auth.apiKeyUsageR\x06usages\"U\n" +
+	"\x0frefreshTokenReq\x12\"\n" +
+	"\frefreshToken\x18\x01 \x01(\tR\frefreshToken\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x02 \x01(\x05R\n" +
+	"platformID\"\xd2\x01\n" +
+	"\x10refreshTokenResp\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12,\n" +
+	"\x11expireTimeSeconds\x18\x02 \x01(\x03R\x11expireTimeSeconds\x12\"\n" +
+	"\frefreshToken\x18\x03 \x01(\tR\frefreshToken\x12:\n" +
+	"\x18refreshExpireTimeSeconds\x18\x04 \x01(\x03R\x18refreshExpireTimeSeconds\x12\x1a\n" +
+	"\bfamilyID\x18\x05 \x01(\tR\bfamilyID\"t\n" +
 	"\x12invalidateTokenReq\x12&\n" +
 	"\x0epreservedToken\x18\x01 \x01(\tR\x0epreservedToken\x12\x16\n" +
 	"\x06userID\x18\x02 \x01(\tR\x06userID\x12\x1e\n" +
@@ -759,7 +2392,7 @@ const file_auth_auth_proto_rawDesc = "" +
 	"\vtokenStates\x18\x01 \x03(\v22.openim.auth.getExistingTokenResp.TokenStatesEntryR\vtokenStates\x1a>\n" +
 	"\x10TokenStatesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x012\xaa\x04\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x012\x80\v\n" +
 	"\x04Auth\x12N\n" +
 	"\rgetAdminToken\x12\x1d.openim.auth.getAdminTokenReq\x1a\x1e.openim.auth.getAdminTokenResp\x12K\n" +
 	"\fgetUserToken\x12\x1c.openim.auth.getUserTokenReq\x1a\x1d.openim.auth.getUserTokenResp\x12H\n" +
@@ -769,7 +2402,17 @@ const file_auth_auth_proto_rawDesc = "" +
 	"\x0finvalidateToken\x12\x1f.openim.auth.invalidateTokenReq\x1a .openim.auth.invalidateTokenResp\x12E\n" +
 	"\n" +
 	"kickTokens\x12\x1a.openim.auth.kickTokensReq\x1a\x1b.openim.auth.kickTokensResp\x12W\n" +
-	"\x10getExistingToken\x12 .openim.auth.GetExistingTokenReq\x1a!.openim.auth.getExistingTokenRespB$Z\"github.com/openimsdk/protocol/authb\x06proto3"
+	"\x10getExistingToken\x12 .openim.auth.GetExistingTokenReq\x1a!.openim.auth.getExistingTokenResp\x12K\n" +
+	"\frefreshToken\x12\x1c.openim.auth.refreshTokenReq\x1a\x1d.openim.auth.refreshTokenResp\x12Q\n" +
+	"\x0elistMySessions\x12\x1e.openim.auth.listMySessionsReq\x1a\x1f.openim.auth.listMySessionsResp\x12N\n" +
+	"\rrevokeSession\x12\x1d.openim.auth.revokeSessionReq\x1a\x1e.openim.auth.revokeSessionResp\x12i\n" +
+	"\x16revokeAllOtherSessions\x12&.openim.auth.revokeAllOtherSessionsReq\x1a'.openim.auth.revokeAllOtherSessionsResp\x12f\n" +
+	"\x15reportSessionActivity\x12%.openim.auth.reportSessionActivityReq\x1a&.openim.auth.reportSessionActivityResp\x12K\n" +
+	"\fcreateApiKey\x12\x1c.openim.auth.createApiKeyReq\x1a\x1d.openim.auth.createApiKeyResp\x12H\n" +
+	"\vlistApiKeys\x12\x1b.openim.auth.listApiKeysReq\x1a\x1c.openim.auth.listApiKeysResp\x12K\n" +
+	"\frevokeApiKey\x12\x1c.openim.auth.revokeApiKeyReq\x1a\x1d.openim.auth.revokeApiKeyResp\x12Z\n" +
+	"\x11reportApiKeyUsage\x12!.openim.auth.reportApiKeyUsageReq\x1a\".openim.auth.reportApiKeyUsageResp\x12Q\n" +
+	"\x0egetApiKeyUsage\x12\x1e.openim.auth.getApiKeyUsageReq\x1a\x1f.openim.auth.getApiKeyUsageRespB$Z\"github.com/openimsdk/protocol/authb\x06proto3"
 
 var (
 	file_auth_auth_proto_rawDescOnce sync.Once
@@ -783,45 +2426,102 @@ func file_auth_auth_proto_rawDescGZIP() []byte {
 	return file_auth_auth_proto_rawDescData
 }
 
-var file_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_auth_auth_proto_goTypes = []any{
-	(*GetAdminTokenReq)(nil),     // 0: openim.auth.getAdminTokenReq
-	(*GetAdminTokenResp)(nil),    // 1: openim.auth.getAdminTokenResp
-	(*ForceLogoutReq)(nil),       // 2: openim.auth.forceLogoutReq
-	(*ForceLogoutResp)(nil),      // 3: openim.auth.forceLogoutResp
-	(*ParseTokenReq)(nil),        // 4: openim.auth.parseTokenReq
-	(*ParseTokenResp)(nil),       // 5: openim.auth.parseTokenResp
-	(*GetUserTokenReq)(nil),      // 6: openim.auth.getUserTokenReq
-	(*GetUserTokenResp)(nil),     // 7: openim.auth.getUserTokenResp
-	(*InvalidateTokenReq)(nil),   // 8: openim.auth.invalidateTokenReq
-	(*InvalidateTokenResp)(nil),  // 9: openim.auth.invalidateTokenResp
-	(*KickTokensReq)(nil),        // 10: openim.auth.kickTokensReq
-	(*KickTokensResp)(nil),       // 11: openim.auth.kickTokensResp
-	(*GetExistingTokenReq)(nil),  // 12: openim.auth.GetExistingTokenReq
-	(*GetExistingTokenResp)(nil), // 13: openim.auth.getExistingTokenResp
-	nil,                          // 14: openim.auth.getExistingTokenResp.TokenStatesEntry
+	(*GetAdminTokenReq)(nil),           // 0: openim.auth.getAdminTokenReq
+	(*GetAdminTokenResp)(nil),          // 1: openim.auth.getAdminTokenResp
+	(*ForceLogoutReq)(nil),             // 2: openim.auth.forceLogoutReq
+	(*ForceLogoutResp)(nil),            // 3: openim.auth.forceLogoutResp
+	(*ParseTokenReq)(nil),              // 4: openim.auth.parseTokenReq
+	(*ParseTokenResp)(nil),             // 5: openim.auth.parseTokenResp
+	(*GetUserTokenReq)(nil),            // 6: openim.auth.getUserTokenReq
+	(*SessionDevice)(nil),              // 7: openim.auth.sessionDevice
+	(*GetUserTokenResp)(nil),           // 8: openim.auth.getUserTokenResp
+	(*SessionInfo)(nil),                // 9: openim.auth.sessionInfo
+	(*ListMySessionsReq)(nil),          // 10: openim.auth.listMySessionsReq
+	(*ListMySessionsResp)(nil),         // 11: openim.auth.listMySessionsResp
+	(*RevokeSessionReq)(nil),           // 12: openim.auth.revokeSessionReq
+	(*RevokeSessionResp)(nil),          // 13: openim.auth.revokeSessionResp
+	(*RevokeAllOtherSessionsReq)(nil),  // 14: openim.auth.revokeAllOtherSessionsReq
+	(*RevokeAllOtherSessionsResp)(nil), // 15: openim.auth.revokeAllOtherSessionsResp
+	(*ReportSessionActivityReq)(nil),   // 16: openim.auth.reportSessionActivityReq
+	(*ReportSessionActivityResp)(nil),  // 17: openim.auth.reportSessionActivityResp
+	(*ApiKeyScope)(nil),                // 18: openim.auth.apiKeyScope
+	(*ApiKeyInfo)(nil),                 // 19: openim.auth.apiKeyInfo
+	(*CreateApiKeyReq)(nil),            // 20: openim.auth.createApiKeyReq
+	(*CreateApiKeyResp)(nil),           // 21: openim.auth.createApiKeyResp
+	(*ListApiKeysReq)(nil),             // 22: openim.auth.listApiKeysReq
+	(*ListApiKeysResp)(nil),            // 23: openim.auth.listApiKeysResp
+	(*RevokeApiKeyReq)(nil),            // 24: openim.auth.revokeApiKeyReq
+	(*RevokeApiKeyResp)(nil),           // 25: openim.auth.revokeApiKeyResp
+	(*ApiKeyUsage)(nil),                // 26: openim.auth.apiKeyUsage
+	(*ReportApiKeyUsageReq)(nil),       // 27: openim.auth.reportApiKeyUsageReq
+	(*ReportApiKeyUsageResp)(nil),      // 28: openim.auth.reportApiKeyUsageResp
+	(*GetApiKeyUsageReq)(nil),          // 29: openim.auth.getApiKeyUsageReq
+	(*GetApiKeyUsageResp)(nil),         // 30: openim.auth.getApiKeyUsageResp
+	(*RefreshTokenReq)(nil),            // 31: openim.auth.refreshTokenReq
+	(*RefreshTokenResp)(nil),           // 32: openim.auth.refreshTokenResp
+	(*InvalidateTokenReq)(nil),         // 33: openim.auth.invalidateTokenReq
+	(*InvalidateTokenResp)(nil),        // 34: openim.auth.invalidateTokenResp
+	(*KickTokensReq)(nil),              // 35: openim.auth.kickTokensReq
+	(*KickTokensResp)(nil),             // 36: openim.auth.kickTokensResp
+	(*GetExistingTokenReq)(nil),        // 37: openim.auth.GetExistingTokenReq
+	(*GetExistingTokenResp)(nil),       // 38: openim.auth.getExistingTokenResp
+	nil,                                // 39: openim.auth.getExistingTokenResp.TokenStatesEntry
+	(*sdkws.RequestPagination)(nil),    // 40: openim.sdkws.RequestPagination
 }
 var file_auth_auth_proto_depIdxs = []int32{
-	14, // 0: openim.auth.getExistingTokenResp.tokenStates:type_name -> openim.auth.getExistingTokenResp.TokenStatesEntry
-	0,  // 1: openim.auth.Auth.getAdminToken:input_type -> openim.auth.getAdminTokenReq
-	6,  // 2: openim.auth.Auth.getUserToken:input_type -> openim.auth.getUserTokenReq
-	2,  // 3: openim.auth.Auth.forceLogout:input_type -> openim.auth.forceLogoutReq
-	4,  // 4: openim.auth.Auth.parseToken:input_type -> openim.auth.parseTokenReq
-	8,  // 5: openim.auth.Auth.invalidateToken:input_type -> openim.auth.invalidateTokenReq
-	10, // 6: openim.auth.Auth.kickTokens:input_type -> openim.auth.kickTokensReq
-	12, // 7: openim.auth.Auth.getExistingToken:input_type -> openim.auth.GetExistingTokenReq
-	1,  // 8: openim.auth.Auth.getAdminToken:output_type -> openim.auth.getAdminTokenResp
-	7,  // 9: openim.auth.Auth.getUserToken:output_type -> openim.auth.getUserTokenResp
-	3,  // 10: openim.auth.Auth.forceLogout:output_type -> openim.auth.forceLogoutResp
-	5,  // 11: openim.auth.Auth.parseToken:output_type -> openim.auth.parseTokenResp
-	9,  // 12: openim.auth.Auth.invalidateToken:output_type -> openim.auth.invalidateTokenResp
-	11, // 13: openim.auth.Auth.kickTokens:output_type -> openim.auth.kickTokensResp
-	13, // 14: openim.auth.Auth.getExistingToken:output_type -> openim.auth.getExistingTokenResp
-	8,  // [8:15] is the sub-list for method output_type
-	1,  // [1:8] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	18, // 0: openim.auth.parseTokenResp.scopes:type_name -> openim.auth.apiKeyScope
+	7,  // 1: openim.auth.getUserTokenReq.device:type_name -> openim.auth.sessionDevice
+	9,  // 2: openim.auth.listMySessionsResp.sessions:type_name -> openim.auth.sessionInfo
+	18, // 3: openim.auth.apiKeyInfo.scopes:type_name -> openim.auth.apiKeyScope
+	18, // 4: openim.auth.createApiKeyReq.scopes:type_name -> openim.auth.apiKeyScope
+	19, // 5: openim.auth.createApiKeyResp.info:type_name -> openim.auth.apiKeyInfo
+	40, // 6: openim.auth.listApiKeysReq.pagination:type_name -> openim.sdkws.RequestPagination
+	19, // 7: openim.auth.listApiKeysResp.keys:type_name -> openim.auth.apiKeyInfo
+	26, // 8: openim.auth.reportApiKeyUsageReq.usages:type_name -> openim.auth.apiKeyUsage
+	40, // 9: openim.auth.getApiKeyUsageReq.pagination:type_name -> openim.sdkws.RequestPagination
+	26, // 10: openim.auth.getApiKeyUsageResp.usages:type_name -> openim.auth.apiKeyUsage
+	39, // 11: openim.auth.getExistingTokenResp.tokenStates:type_name -> openim.auth.getExistingTokenResp.TokenStatesEntry
+	0,  // 12: openim.auth.Auth.getAdminToken:input_type -> openim.auth.getAdminTokenReq
+	6,  // 13: openim.auth.Auth.getUserToken:input_type -> openim.auth.getUserTokenReq
+	2,  // 14: openim.auth.Auth.forceLogout:input_type -> openim.auth.forceLogoutReq
+	4,  // 15: openim.auth.Auth.parseToken:input_type -> openim.auth.parseTokenReq
+	33, // 16: openim.auth.Auth.invalidateToken:input_type -> openim.auth.invalidateTokenReq
+	35, // 17: openim.auth.Auth.kickTokens:input_type -> openim.auth.kickTokensReq
+	37, // 18: openim.auth.Auth.getExistingToken:input_type -> openim.auth.GetExistingTokenReq
+	31, // 19: openim.auth.Auth.refreshToken:input_type -> openim.auth.refreshTokenReq
+	10, // 20: openim.auth.Auth.listMySessions:input_type -> openim.auth.listMySessionsReq
+	12, // 21: openim.auth.Auth.revokeSession:input_type -> openim.auth.revokeSessionReq
+	14, // 22: openim.auth.Auth.revokeAllOtherSessions:input_type -> openim.auth.revokeAllOtherSessionsReq
+	16, // 23: openim.auth.Auth.reportSessionActivity:input_type -> openim.auth.reportSessionActivityReq
+	20, // 24: openim.auth.Auth.createApiKey:input_type -> openim.auth.createApiKeyReq
+	22, // 25: openim.auth.Auth.listApiKeys:input_type -> openim.auth.listApiKeysReq
+	24, // 26: openim.auth.Auth.revokeApiKey:input_type -> openim.auth.revokeApiKeyReq
+	27, // 27: openim.auth.Auth.reportApiKeyUsage:input_type -> openim.auth.reportApiKeyUsageReq
+	29, // 28: openim.auth.Auth.getApiKeyUsage:input_type -> openim.auth.getApiKeyUsageReq
+	1,  // 29: openim.auth.Auth.getAdminToken:output_type -> openim.auth.getAdminTokenResp
+	8,  // 30: openim.auth.Auth.getUserToken:output_type -> openim.auth.getUserTokenResp
+	3,  // 31: openim.auth.Auth.forceLogout:output_type -> openim.auth.forceLogoutResp
+	5,  // 32: openim.auth.Auth.parseToken:output_type -> openim.auth.parseTokenResp
+	34, // 33: openim.auth.Auth.invalidateToken:output_type -> openim.auth.invalidateTokenResp
+	36, // 34: openim.auth.Auth.kickTokens:output_type -> openim.auth.kickTokensResp
+	38, // 35: openim.auth.Auth.getExistingToken:output_type -> openim.auth.getExistingTokenResp
+	32, // 36: openim.auth.Auth.refreshToken:output_type -> openim.auth.refreshTokenResp
+	11, // 37: openim.auth.Auth.listMySessions:output_type -> openim.auth.listMySessionsResp
+	13, // 38: openim.auth.Auth.revokeSession:output_type -> openim.auth.revokeSessionResp
+	15, // 39: openim.auth.Auth.revokeAllOtherSessions:output_type -> openim.auth.revokeAllOtherSessionsResp
+	17, // 40: openim.auth.Auth.reportSessionActivity:output_type -> openim.auth.reportSessionActivityResp
+	21, // 41: openim.auth.Auth.createApiKey:output_type -> openim.auth.createApiKeyResp
+	23, // 42: openim.auth.Auth.listApiKeys:output_type -> openim.auth.listApiKeysResp
+	25, // 43: openim.auth.Auth.revokeApiKey:output_type -> openim.auth.revokeApiKeyResp
+	28, // 44: openim.auth.Auth.reportApiKeyUsage:output_type -> openim.auth.reportApiKeyUsageResp
+	30, // 45: openim.auth.Auth.getApiKeyUsage:output_type -> openim.auth.getApiKeyUsageResp
+	29, // [29:46] is the sub-list for method output_type
+	12, // [12:29] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_auth_auth_proto_init() }
@@ -835,7 +2535,7 @@ func file_auth_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_auth_proto_rawDesc), len(file_auth_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
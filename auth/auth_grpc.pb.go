@@ -33,13 +33,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Auth_GetAdminToken_FullMethodName    = "/openim.auth.Auth/getAdminToken"
-	Auth_GetUserToken_FullMethodName     = "/openim.auth.Auth/getUserToken"
-	Auth_ForceLogout_FullMethodName      = "/openim.auth.Auth/forceLogout"
-	Auth_ParseToken_FullMethodName       = "/openim.auth.Auth/parseToken"
-	Auth_InvalidateToken_FullMethodName  = "/openim.auth.Auth/invalidateToken"
-	Auth_KickTokens_FullMethodName       = "/openim.auth.Auth/kickTokens"
-	Auth_GetExistingToken_FullMethodName = "/openim.auth.Auth/getExistingToken"
+	Auth_GetAdminToken_FullMethodName          = "/openim.auth.Auth/getAdminToken"
+	Auth_GetUserToken_FullMethodName           = "/openim.auth.Auth/getUserToken"
+	Auth_ForceLogout_FullMethodName            = "/openim.auth.Auth/forceLogout"
+	Auth_ParseToken_FullMethodName             = "/openim.auth.Auth/parseToken"
+	Auth_InvalidateToken_FullMethodName        = "/openim.auth.Auth/invalidateToken"
+	Auth_KickTokens_FullMethodName             = "/openim.auth.Auth/kickTokens"
+	Auth_GetExistingToken_FullMethodName       = "/openim.auth.Auth/getExistingToken"
+	Auth_RefreshToken_FullMethodName           = "/openim.auth.Auth/refreshToken"
+	Auth_ListMySessions_FullMethodName         = "/openim.auth.Auth/listMySessions"
+	Auth_RevokeSession_FullMethodName          = "/openim.auth.Auth/revokeSession"
+	Auth_RevokeAllOtherSessions_FullMethodName = "/openim.auth.Auth/revokeAllOtherSessions"
+	Auth_ReportSessionActivity_FullMethodName  = "/openim.auth.Auth/reportSessionActivity"
+	Auth_CreateApiKey_FullMethodName           = "/openim.auth.Auth/createApiKey"
+	Auth_ListApiKeys_FullMethodName            = "/openim.auth.Auth/listApiKeys"
+	Auth_RevokeApiKey_FullMethodName           = "/openim.auth.Auth/revokeApiKey"
+	Auth_ReportApiKeyUsage_FullMethodName      = "/openim.auth.Auth/reportApiKeyUsage"
+	Auth_GetApiKeyUsage_FullMethodName         = "/openim.auth.Auth/getApiKeyUsage"
 )
 
 // AuthClient is the client API for Auth service.
@@ -60,6 +70,26 @@ type AuthClient interface {
 	KickTokens(ctx context.Context, in *KickTokensReq, opts ...grpc.CallOption) (*KickTokensResp, error)
 	// Get existing token
 	GetExistingToken(ctx context.Context, in *GetExistingTokenReq, opts ...grpc.CallOption) (*GetExistingTokenResp, error)
+	// Exchange a refresh token for a new token and rotate the refresh token
+	RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error)
+	// List the user's active sessions
+	ListMySessions(ctx context.Context, in *ListMySessionsReq, opts ...grpc.CallOption) (*ListMySessionsResp, error)
+	// Revoke one session, its client receives SessionRevokedNotification
+	RevokeSession(ctx context.Context, in *RevokeSessionReq, opts ...grpc.CallOption) (*RevokeSessionResp, error)
+	// Revoke every session except the current one
+	RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsReq, opts ...grpc.CallOption) (*RevokeAllOtherSessionsResp, error)
+	// Refresh the last active time and device info of a session
+	ReportSessionActivity(ctx context.Context, in *ReportSessionActivityReq, opts ...grpc.CallOption) (*ReportSessionActivityResp, error)
+	// Create a scoped API key bound to a user
+	CreateApiKey(ctx context.Context, in *CreateApiKeyReq, opts ...grpc.CallOption) (*CreateApiKeyResp, error)
+	// List API keys
+	ListApiKeys(ctx context.Context, in *ListApiKeysReq, opts ...grpc.CallOption) (*ListApiKeysResp, error)
+	// Revoke an API key
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyReq, opts ...grpc.CallOption) (*RevokeApiKeyResp, error)
+	// Record API key use
+	ReportApiKeyUsage(ctx context.Context, in *ReportApiKeyUsageReq, opts ...grpc.CallOption) (*ReportApiKeyUsageResp, error)
+	// Get the audit trail of an API key
+	GetApiKeyUsage(ctx context.Context, in *GetApiKeyUsageReq, opts ...grpc.CallOption) (*GetApiKeyUsageResp, error)
 }
 
 type authClient struct {
@@ -140,6 +170,106 @@ func (c *authClient) GetExistingToken(ctx context.Context, in *GetExistingTokenR
 	return out, nil
 }
 
+func (c *authClient) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResp)
+	err := c.cc.Invoke(ctx, Auth_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ListMySessions(ctx context.Context, in *ListMySessionsReq, opts ...grpc.CallOption) (*ListMySessionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMySessionsResp)
+	err := c.cc.Invoke(ctx, Auth_ListMySessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) RevokeSession(ctx context.Context, in *RevokeSessionReq, opts ...grpc.CallOption) (*RevokeSessionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResp)
+	err := c.cc.Invoke(ctx, Auth_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) RevokeAllOtherSessions(ctx context.Context, in *RevokeAllOtherSessionsReq, opts ...grpc.CallOption) (*RevokeAllOtherSessionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllOtherSessionsResp)
+	err := c.cc.Invoke(ctx, Auth_RevokeAllOtherSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ReportSessionActivity(ctx context.Context, in *ReportSessionActivityReq, opts ...grpc.CallOption) (*ReportSessionActivityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportSessionActivityResp)
+	err := c.cc.Invoke(ctx, Auth_ReportSessionActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) CreateApiKey(ctx context.Context, in *CreateApiKeyReq, opts ...grpc.CallOption) (*CreateApiKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResp)
+	err := c.cc.Invoke(ctx, Auth_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ListApiKeys(ctx context.Context, in *ListApiKeysReq, opts ...grpc.CallOption) (*ListApiKeysResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysResp)
+	err := c.cc.Invoke(ctx, Auth_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyReq, opts ...grpc.CallOption) (*RevokeApiKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiKeyResp)
+	err := c.cc.Invoke(ctx, Auth_RevokeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) ReportApiKeyUsage(ctx context.Context, in *ReportApiKeyUsageReq, opts ...grpc.CallOption) (*ReportApiKeyUsageResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportApiKeyUsageResp)
+	err := c.cc.Invoke(ctx, Auth_ReportApiKeyUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) GetApiKeyUsage(ctx context.Context, in *GetApiKeyUsageReq, opts ...grpc.CallOption) (*GetApiKeyUsageResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetApiKeyUsageResp)
+	err := c.cc.Invoke(ctx, Auth_GetApiKeyUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServer is the server API for Auth service.
 // All implementations must embed UnimplementedAuthServer
 // for forward compatibility.
@@ -158,6 +288,26 @@ type AuthServer interface {
 	KickTokens(context.Context, *KickTokensReq) (*KickTokensResp, error)
 	// Get existing token
 	GetExistingToken(context.Context, *GetExistingTokenReq) (*GetExistingTokenResp, error)
+	// Exchange a refresh token for a new token and rotate the refresh token
+	RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error)
+	// List the user's active sessions
+	ListMySessions(context.Context, *ListMySessionsReq) (*ListMySessionsResp, error)
+	// Revoke one session, its client receives SessionRevokedNotification
+	RevokeSession(context.Context, *RevokeSessionReq) (*RevokeSessionResp, error)
+	// Revoke every session except the current one
+	RevokeAllOtherSessions(context.Context, *RevokeAllOtherSessionsReq) (*RevokeAllOtherSessionsResp, error)
+	// Refresh the last active time and device info of a session
+	ReportSessionActivity(context.Context, *ReportSessionActivityReq) (*ReportSessionActivityResp, error)
+	// Create a scoped API key bound to a user
+	CreateApiKey(context.Context, *CreateApiKeyReq) (*CreateApiKeyResp, error)
+	// List API keys
+	ListApiKeys(context.Context, *ListApiKeysReq) (*ListApiKeysResp, error)
+	// Revoke an API key
+	RevokeApiKey(context.Context, *RevokeApiKeyReq) (*RevokeApiKeyResp, error)
+	// Record API key use
+	ReportApiKeyUsage(context.Context, *ReportApiKeyUsageReq) (*ReportApiKeyUsageResp, error)
+	// Get the audit trail of an API key
+	GetApiKeyUsage(context.Context, *GetApiKeyUsageReq) (*GetApiKeyUsageResp, error)
 	mustEmbedUnimplementedAuthServer()
 }
 
@@ -189,6 +339,36 @@ func (UnimplementedAuthServer) KickTokens(context.Context, *KickTokensReq) (*Kic
 func (UnimplementedAuthServer) GetExistingToken(context.Context, *GetExistingTokenReq) (*GetExistingTokenResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetExistingToken not implemented")
 }
+func (UnimplementedAuthServer) RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAuthServer) ListMySessions(context.Context, *ListMySessionsReq) (*ListMySessionsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMySessions not implemented")
+}
+func (UnimplementedAuthServer) RevokeSession(context.Context, *RevokeSessionReq) (*RevokeSessionResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedAuthServer) RevokeAllOtherSessions(context.Context, *RevokeAllOtherSessionsReq) (*RevokeAllOtherSessionsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAllOtherSessions not implemented")
+}
+func (UnimplementedAuthServer) ReportSessionActivity(context.Context, *ReportSessionActivityReq) (*ReportSessionActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportSessionActivity not implemented")
+}
+func (UnimplementedAuthServer) CreateApiKey(context.Context, *CreateApiKeyReq) (*CreateApiKeyResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedAuthServer) ListApiKeys(context.Context, *ListApiKeysReq) (*ListApiKeysResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedAuthServer) RevokeApiKey(context.Context, *RevokeApiKeyReq) (*RevokeApiKeyResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedAuthServer) ReportApiKeyUsage(context.Context, *ReportApiKeyUsageReq) (*ReportApiKeyUsageResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReportApiKeyUsage not implemented")
+}
+func (UnimplementedAuthServer) GetApiKeyUsage(context.Context, *GetApiKeyUsageReq) (*GetApiKeyUsageResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetApiKeyUsage not implemented")
+}
 func (UnimplementedAuthServer) mustEmbedUnimplementedAuthServer() {}
 func (UnimplementedAuthServer) testEmbeddedByValue()              {}
 
@@ -336,6 +516,186 @@ func _Auth_GetExistingToken_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).RefreshToken(ctx, req.(*RefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_ListMySessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMySessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ListMySessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ListMySessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ListMySessions(ctx, req.(*ListMySessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).RevokeSession(ctx, req.(*RevokeSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_RevokeAllOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllOtherSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).RevokeAllOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_RevokeAllOtherSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).RevokeAllOtherSessions(ctx, req.(*RevokeAllOtherSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_ReportSessionActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportSessionActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ReportSessionActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ReportSessionActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ReportSessionActivity(ctx, req.(*ReportSessionActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).CreateApiKey(ctx, req.(*CreateApiKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ListApiKeys(ctx, req.(*ListApiKeysReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_RevokeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).RevokeApiKey(ctx, req.(*RevokeApiKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_ReportApiKeyUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportApiKeyUsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).ReportApiKeyUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_ReportApiKeyUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).ReportApiKeyUsage(ctx, req.(*ReportApiKeyUsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_GetApiKeyUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiKeyUsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).GetApiKeyUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_GetApiKeyUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).GetApiKeyUsage(ctx, req.(*GetApiKeyUsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Auth_ServiceDesc is the grpc.ServiceDesc for Auth service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -371,6 +731,46 @@ var Auth_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "getExistingToken",
 			Handler:    _Auth_GetExistingToken_Handler,
 		},
+		{
+			MethodName: "refreshToken",
+			Handler:    _Auth_RefreshToken_Handler,
+		},
+		{
+			MethodName: "listMySessions",
+			Handler:    _Auth_ListMySessions_Handler,
+		},
+		{
+			MethodName: "revokeSession",
+			Handler:    _Auth_RevokeSession_Handler,
+		},
+		{
+			MethodName: "revokeAllOtherSessions",
+			Handler:    _Auth_RevokeAllOtherSessions_Handler,
+		},
+		{
+			MethodName: "reportSessionActivity",
+			Handler:    _Auth_ReportSessionActivity_Handler,
+		},
+		{
+			MethodName: "createApiKey",
+			Handler:    _Auth_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "listApiKeys",
+			Handler:    _Auth_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "revokeApiKey",
+			Handler:    _Auth_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "reportApiKeyUsage",
+			Handler:    _Auth_ReportApiKeyUsage_Handler,
+		},
+		{
+			MethodName: "getApiKeyUsage",
+			Handler:    _Auth_GetApiKeyUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth/auth.proto",
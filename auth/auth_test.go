@@ -0,0 +1,37 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestRefreshTokenReqCheck(t *testing.T) {
+	for platformID := range constant.PlatformID2Name {
+		if err := (&RefreshTokenReq{RefreshToken: "rt", PlatformID: int32(platformID)}).Check(); err != nil {
+			t.Errorf("platformID %d: %v", platformID, err)
+		}
+	}
+	for _, platformID := range []int32{0, -1, constant.HarmonyOSPlatformID + 1} {
+		if err := (&RefreshTokenReq{RefreshToken: "rt", PlatformID: platformID}).Check(); err == nil {
+			t.Errorf("platformID %d should be rejected", platformID)
+		}
+	}
+	if err := (&RefreshTokenReq{PlatformID: constant.HarmonyOSPlatformID}).Check(); err == nil {
+		t.Error("empty refreshToken should be rejected")
+	}
+}
@@ -0,0 +1,30 @@
+package constant
+
+// 审计操作类型
+const (
+	AuditActionForceLogout        = "auth.forceLogout"         // 强制下线
+	AuditActionKickTokens         = "auth.kickTokens"          // 踢出Token
+	AuditActionRevokeApiKey       = "auth.revokeApiKey"        // 注销API Key
+	AuditActionDismissGroup       = "group.dismiss"            // 解散群组
+	AuditActionMuteGroup          = "group.mute"               // 群组禁言
+	AuditActionCancelMuteGroup    = "group.cancelMute"         // 取消群组禁言
+	AuditActionDeleteMsgPhysical  = "msg.deletePhysical"       // 物理删除消息
+	AuditActionDeleteOutdatedData = "third.deleteOutdatedData" // 删除过期数据
+	AuditActionOASync             = "oa.sync"                  // OA数据同步
+)
+
+// 审计目标类型
+const (
+	AuditTargetUser  = "user"  // 用户
+	AuditTargetToken = "token" // Token
+	AuditTargetGroup = "group" // 群组
+	AuditTargetMsg   = "msg"   // 消息
+	AuditTargetFile  = "file"  // 文件
+	AuditTargetOA    = "oa"    // OA数据
+)
+
+// 审计导出格式
+const (
+	AuditExportFormatJSONL = "jsonl" // 每行一个JSON
+	AuditExportFormatCSV   = "csv"   // CSV
+)
@@ -60,6 +60,7 @@ const (
 	ScheduleGroupPermissionMessage = 211 // 日程分组权限修改消息（显示为：XXX将你对"日历名"的权限修改为了「可管理」）
 	WorkbenchNotifyMessage         = 212 // 工作台通知消息
 	MeetingInviteCardMessage       = 213 // 群会议邀请卡片消息
+	EmojiPackShareMessage          = 214 // 表情包分享卡片消息
 
 	// SysRelated - 系统相关通知类型
 	NotificationBegin = 1000
@@ -75,6 +76,9 @@ const (
 	BlackDeletedNotification              = 1208 // 取消拉黑通知
 	FriendInfoUpdatedNotification         = 1209 // 好友信息更新通知
 	FriendsInfoUpdateNotification         = 1210 // 好友信息批量更新通知
+	FriendCategoryChangedNotification     = 1211 // 好友分组变更通知
+	FriendApplicationExpiredNotification  = 1212 // 好友申请过期通知
+	FriendApplicationRepliedNotification  = 1213 // 好友申请留言通知
 
 	// 会话相关通知
 	ConversationChangeNotification = 1300 // 会话设置变更通知
@@ -98,6 +102,11 @@ const (
 	UserQuickReplyPinNotification      = 1315 // 用户快捷回复置顶/取消置顶通知
 
 	UserSubscribeOnlineStatusNotification = 1308 // 用户在线状态订阅通知
+	SessionRevokedNotification            = 1317 // 登录会话被注销通知
+	UserClientConfigChangedNotification   = 1318 // 用户客户端配置变更通知
+	UserEmojiPackChangedNotification      = 1319 // 用户表情包变更通知
+	UserDeactivatedNotification           = 1320 // 用户账号停用通知
+	UserReactivatedNotification           = 1321 // 用户账号恢复通知
 
 	UserNotificationEnd = 1399
 	OANotification      = 1400 // OA通知
@@ -138,12 +147,13 @@ const (
 	SuperGroupNotificationEnd    = 1699
 
 	// 会话相关通知
-	ConversationPrivateChatNotification = 1701 // 会话私聊通知
-	ConversationUnreadNotification      = 1702 // 会话未读通知
-	ClearConversationNotification       = 1703 // 清空会话通知
-	ConversationDeleteNotification      = 1704 // 删除会话通知
-	ConversationGroupChangeNotification = 1705 // 会话分组变更通知
-	ConversationFoldNotification        = 1706 // 折叠组统一通知（包含创建、更新、删除、会话移入/移出操作，通过action字段区分）
+	ConversationPrivateChatNotification  = 1701 // 会话私聊通知
+	ConversationUnreadNotification       = 1702 // 会话未读通知
+	ClearConversationNotification        = 1703 // 清空会话通知
+	ConversationDeleteNotification       = 1704 // 删除会话通知
+	ConversationGroupChangeNotification  = 1705 // 会话分组变更通知
+	ConversationFoldNotification         = 1706 // 折叠组统一通知（包含创建、更新、删除、会话移入/移出操作，通过action字段区分）
+	ConversationDraftChangedNotification = 1707 // 会话草稿变更通知
 
 	// 业务通知
 	BusinessNotificationBegin = 2000
@@ -229,6 +239,13 @@ const (
 	// 折叠数量限制
 	MaxNormalFoldCount = 5 // 每个用户最多可创建的普通折叠数量（不包括系统默认折叠）
 
+	// 智能折叠条件
+	FoldRuleConditionNotificationAccount = 1 // 通知账号的会话
+	FoldRuleConditionInactiveGroup       = 2 // intValue 天内未打开的群
+	FoldRuleConditionOACompany           = 3 // 对方属于 values 中 OA 企业的单聊
+	FoldRuleConditionMuted               = 4 // 免打扰的会话
+	FoldRuleConditionConversationType    = 5 // 会话类型属于 values
+
 	// 会话折叠分组前缀
 	DefaultFoldPrefix             = "fold_"              // 默认折叠会话ID前缀（普通折叠）
 	NotificationDefaultFoldPrefix = "fold_notification_" // 通知默认折叠会话ID前缀
@@ -253,6 +270,16 @@ const (
 	Online  = 1 // 在线
 	Away    = 2 // 离开
 
+	// 自动状态（由通话、会议、日程产生，数值越大优先级越高）
+	UserActivityNone      = 0 // 无
+	UserActivityInMeeting = 1 // 会议中
+	UserActivityOnCall    = 2 // 通话中
+
+	// 自动状态来源
+	UserActivitySourceCall     = "call"     // 1v1通话
+	UserActivitySourceMeeting  = "meeting"  // LiveKit会议
+	UserActivitySourceSchedule = "schedule" // 已接受的会议类型日程
+
 	// 注册状态
 	Registered   = 1 // 已注册
 	UnRegistered = 0 // 未注册
@@ -410,6 +437,7 @@ const (
 	FriendResponseNotHandle = 0  // 未处理
 	FriendResponseAgree     = 1  // 同意
 	FriendResponseRefuse    = -1 // 拒绝
+	FriendResponseExpired   = -2 // 已过期
 
 	// 性别
 	Male   = 1 // 男性
@@ -444,6 +472,13 @@ const (
 	GroupRPCSendSize = 30 // 群组RPC发送大小
 )
 
+// 批量群成员操作类型
+const (
+	GroupMemberOpInvite  = 1 // 邀请入群
+	GroupMemberOpKick    = 2 // 踢出群
+	GroupMemberOpSetRole = 3 // 修改成员角色
+)
+
 const FriendAcceptTip = "You have successfully become friends, so start chatting" // 好友接受提示
 
 // GroupIsBanChat 检查群组是否被禁言
@@ -475,6 +510,158 @@ const CallbackCommand = "command" // 回调命令
 
 const BatchNum = 100 // 批处理数量
 
+// 好友分组变更通知的操作类型
+const (
+	FriendCategoryActionCreated        = "created"         // 创建
+	FriendCategoryActionUpdated        = "updated"         // 更新
+	FriendCategoryActionDeleted        = "deleted"         // 删除
+	FriendCategoryActionSorted         = "sorted"          // 排序
+	FriendCategoryActionFriendsAdded   = "friends_added"   // 好友加入
+	FriendCategoryActionFriendsRemoved = "friends_removed" // 好友移出
+)
+
+// 用户表情包变更通知的操作类型
+const (
+	EmojiPackActionCreated       = "created"        // 创建
+	EmojiPackActionUpdated       = "updated"        // 更新
+	EmojiPackActionDeleted       = "deleted"        // 删除
+	EmojiPackActionSorted        = "sorted"         // 排序
+	EmojiPackActionEmojisAdded   = "emojis_added"   // 表情加入
+	EmojiPackActionEmojisRemoved = "emojis_removed" // 表情移出
+	EmojiPackActionEmojisSorted  = "emojis_sorted"  // 表情排序
+)
+
+// 连接关闭原因，作为 WebSocket 关闭码下发（4000-4999 为应用自定义区间）
+const (
+	ConnCloseReasonAdmin        = 4001 // 管理员关闭
+	ConnCloseReasonTokenRevoked = 4002 // token 已注销
+	ConnCloseReasonDeactivated  = 4003 // 账号已停用
+	ConnCloseReasonMaintenance  = 4004 // 网关维护，客户端应重连到其他实例
+	ConnCloseReasonUnhealthy    = 4005 // 连接积压过多
+)
+
+// 临时信号类型
+const (
+	EphemeralSignalTyping         = 1 // 正在输入
+	EphemeralSignalRecordingVoice = 2 // 正在录音
+	EphemeralSignalViewing        = 3 // 正在查看
+)
+
+// 会话提醒模式
+const (
+	ConversationNotifyModeAll             = 0 // 所有消息都提醒
+	ConversationNotifyModeMentionKeywords = 1 // 仅@我、@所有人和包含关键词的消息提醒
+)
+
+// 通知摘要模式
+const (
+	NotificationDigestModeOff    = 0 // 关闭，每条消息单独推送
+	NotificationDigestModeDigest = 1 // 摘要，按间隔合并推送
+)
+
+// 账号状态
+const (
+	AccountStatusNormal      = 0 // 正常
+	AccountStatusDeactivated = 1 // 已停用
+	AccountStatusErasing     = 2 // 注销中
+	AccountStatusErased      = 3 // 已注销（资料已匿名化）
+)
+
+// 注销账号时用户为群主的处理方式
+const (
+	EraseOwnerTransferAdminFirst = 0 // 转让给最早加入的管理员，没有管理员时转让给最早加入的成员
+	EraseOwnerTransferDismiss    = 1 // 解散群
+	EraseOwnerTransferReject     = 2 // 不处理，该步骤失败，需人工转让后重试
+)
+
+// 注销任务状态
+const (
+	EraseJobStatusPending   = 0 // 等待执行
+	EraseJobStatusRunning   = 1 // 执行中
+	EraseJobStatusSucceeded = 2 // 成功
+	EraseJobStatusFailed    = 3 // 失败
+)
+
+// 注销任务步骤
+const (
+	EraseStepTokens   = "tokens"    // 注销全部 token
+	EraseStepFriends  = "friends"   // 删除好友
+	EraseStepBlacks   = "blacks"    // 删除黑名单
+	EraseStepGroups   = "groups"    // 退出群（按规则转让群主）
+	EraseStepUserInfo = "user_info" // 匿名化用户资料
+)
+
+// 快捷回复模板变量
+const (
+	QuickReplyVarMyNickname       = "me.nickname"       // 我的昵称
+	QuickReplyVarMyJobTitle       = "me.jobTitle"       // 我的职位（组织架构）
+	QuickReplyVarMyDepartment     = "me.department"     // 我的部门（组织架构）
+	QuickReplyVarFriendNickname   = "friend.nickname"   // 对方昵称
+	QuickReplyVarFriendRemark     = "friend.remark"     // 对方备注，未设置时为昵称
+	QuickReplyVarFriendJobTitle   = "friend.jobTitle"   // 对方职位（组织架构）
+	QuickReplyVarFriendDepartment = "friend.department" // 对方部门（组织架构）
+	QuickReplyVarGroupName        = "group.name"        // 群名称
+	QuickReplyVarDate             = "date"              // 当前日期 YYYY-MM-DD
+	QuickReplyVarTime             = "time"              // 当前时间 HH:mm
+)
+
+// 客户端配置值类型
+const (
+	ClientConfigTypeString = 0 // 字符串
+	ClientConfigTypeBool   = 1 // 布尔值（"true"/"false"）
+	ClientConfigTypeInt    = 2 // 整数
+	ClientConfigTypeJSON   = 3 // JSON
+)
+
+// 客户端配置作用域
+const (
+	ClientConfigScopeGlobal   = 0 // 全局，所有设备共享
+	ClientConfigScopePlatform = 1 // 按平台
+	ClientConfigScopeDevice   = 2 // 按设备
+)
+
+// 隐私设置可见范围
+const (
+	PrivacyAudienceEveryone    = 0 // 所有人
+	PrivacyAudienceFriends     = 1 // 仅好友
+	PrivacyAudienceSameCompany = 2 // 同企业成员
+	PrivacyAudienceNobody      = 3 // 任何人都不可见
+)
+
+// 隐私设置项
+const (
+	PrivacyItemFriendRequest = 1 // 好友申请
+	PrivacyItemOnlineStatus  = 2 // 在线状态
+	PrivacyItemLastSeen      = 3 // 最后在线时间
+	PrivacyItemSignature     = 4 // 签名
+	PrivacyItemAvatar        = 5 // 头像
+	PrivacyItemDiscoverable  = 6 // 出现在好友推荐中
+)
+
+// PrivacyAudienceAllowed 判断查看者是否在可见范围内，本人始终可见
+func PrivacyAudienceAllowed(audience int32, isSelf, isFriend, sameCompany bool) bool {
+	if isSelf {
+		return true
+	}
+	switch audience {
+	case PrivacyAudienceEveryone:
+		return true
+	case PrivacyAudienceFriends:
+		return isFriend
+	case PrivacyAudienceSameCompany:
+		return sameCompany
+	default:
+		return false
+	}
+}
+
+// API Key 权限范围
+const (
+	ApiKeyScopeSendMsg         = "msg.send"          // 向指定会话发送消息
+	ApiKeyScopeReadGroupMember = "group.member.read" // 读取群成员
+	ApiKeyScopeManageSchedule  = "schedule.manage"   // 管理日程
+)
+
 // 用户订阅常量
 const (
 	SubscriberUser = 1 // 订阅用户
@@ -24,3 +24,43 @@ const (
 const (
 	ParamMaxLength = 1000
 )
+
+const (
+	FriendApplyMaxMessageNum        = 20            // 单个好友申请最多留言条数（不含 reqMsg）
+	FriendApplyMessageMaxLength     = 255           // 好友申请留言最大长度
+	FriendApplyDefaultExpireSeconds = 7 * 24 * 3600 // 好友申请默认有效期（秒），服务端未配置有效期时使用
+)
+
+const (
+	UserPresenceTextMaxLength = 100 // 自定义状态文字最大长度
+)
+
+const (
+	EmojiPackMaxEmojiNum     = 300 // 单个表情包最多表情数
+	EmojiPackNameMaxLength   = 50  // 表情包名称最大长度
+	EmojiPackManifestVersion = 1   // 当前表情包清单格式版本
+)
+
+const (
+	NotificationDigestMinInterval = 1    // 通知摘要最小间隔（分钟）
+	NotificationDigestMaxInterval = 1440 // 通知摘要最大间隔（分钟）
+)
+
+const (
+	EphemeralSignalDefaultTTL  = 10   // 临时信号默认有效期（秒）
+	EphemeralSignalMaxTTL      = 60   // 临时信号最大有效期（秒）
+	EphemeralSignalMinInterval = 2000 // 同一发送者在同一会话发送同类开始信号的最小间隔（毫秒）
+)
+
+const (
+	ConversationDraftMaxLength = 10000 // 草稿文本最大长度
+)
+
+const (
+	ConversationNotifyMaxKeywordNum    = 20 // 会话提醒关键词最大数量
+	ConversationNotifyKeywordMaxLength = 50 // 会话提醒关键词最大长度
+)
+
+const (
+	FoldRuleInactiveGroupMaxDays = 3650 // 折叠规则"未打开的群"最大天数
+)
@@ -0,0 +1,46 @@
+package constant
+
+// 推送结果
+const (
+	PushResultSent            = 1 // 已提交给推送厂商
+	PushResultSuppressed      = 2 // 未推送，原因见 PushSuppressReason*
+	PushResultProviderError   = 3 // 推送厂商返回错误
+	PushResultTokenInvalid    = 4 // token 已失效，已删除该平台的推送 token
+	PushResultOnlineDelivered = 5 // 用户在线，已通过长连接送达
+)
+
+// 未推送原因
+const (
+	PushSuppressReasonNotNotify   = "not_notify"   // 会话设置为接收但不提醒（ReceiveNotNotifyMessage）
+	PushSuppressReasonGlobalOpt   = "global_opt"   // 全局免打扰
+	PushSuppressReasonNoToken     = "no_token"     // 该平台没有推送 token
+	PushSuppressReasonOfflinePush = "offline_push" // 消息设置为不离线推送
+	PushSuppressReasonSender      = "sender"       // 发送者本人
+	PushSuppressReasonWebhook     = "webhook"      // 被回调拦截
+	PushSuppressReasonDeactivated = "deactivated"  // 账号已停用
+	PushSuppressReasonDND         = "dnd"          // 用户状态开启了免打扰（doNotDisturb）
+	PushSuppressReasonConvNotify  = "conv_notify"  // 会话提醒设置过滤（临时免打扰，或仅@和关键词时未命中）
+)
+
+// 推送厂商
+const (
+	PushProviderAPNs    = "apns"
+	PushProviderFCM     = "fcm"
+	PushProviderGetui   = "getui"
+	PushProviderJPush   = "jpush"
+	PushProviderWebPush = "webpush"
+)
+
+// 离线推送优先级
+const (
+	OfflinePushPriorityNormal = 0 // 普通
+	OfflinePushPriorityHigh   = 1 // 高优先级，立即唤醒设备（如来电）
+)
+
+// 离线推送通知按钮
+const (
+	OfflinePushActionReply      = "reply"       // 快捷回复
+	OfflinePushActionMarkRead   = "mark_read"   // 标记已读
+	OfflinePushActionAcceptCall = "accept_call" // 接听
+	OfflinePushActionRejectCall = "reject_call" // 拒接
+)
@@ -15,17 +15,25 @@
 package conversation
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
 )
 
 func (x *ConversationReq) Check() error {
 	if x.ConversationID == "" {
 		return errors.New("conversation is empty")
 	}
-	return nil
+	return x.NotifySettings.Check()
 }
 
 func (x *Conversation) Check() error {
@@ -61,7 +69,7 @@ func (x *SetConversationReq) Check() error {
 	if x.Conversation.ConversationID == "" {
 		return errors.New("conversationID is empty")
 	}
-	return nil
+	return x.Conversation.NotifySettings.Check()
 }
 
 //func (x *SetRecvMsgOptReq) Check() error {
@@ -158,7 +166,7 @@ func (x *SetConversationsReq) Check() error {
 	if x.Conversation.ConversationType == constant.ReadGroupChatType && x.Conversation.GroupID == "" {
 		return errors.New("groupID is empty")
 	}
-	return nil
+	return x.Conversation.NotifySettings.Check()
 }
 
 func (x *GetUserConversationIDsHashReq) Check() error {
@@ -315,7 +323,7 @@ func (x *CreateFoldReq) Check() error {
 	if x.FoldType == constant.FoldTypeNotification {
 		return errors.New("notification type fold can only be created by system")
 	}
-	return nil
+	return x.Rule.Check()
 }
 
 // UpdateFoldReq 验证
@@ -332,5 +340,472 @@ func (x *UpdateFoldReq) Check() error {
 			return err
 		}
 	}
+	return x.Rule.Check()
+}
+
+// QuietHours 验证
+func (x *QuietHours) Check() error {
+	if x == nil || !x.Enabled {
+		return nil
+	}
+	if x.StartMinute < 0 || x.StartMinute >= 24*60 || x.EndMinute < 0 || x.EndMinute >= 24*60 {
+		return errors.New("quietHours minute is invalid, should be 0-1439")
+	}
+	if x.Timezone != "" {
+		if _, err := time.LoadLocation(x.Timezone); err != nil {
+			return fmt.Errorf("quietHours timezone %s is invalid", x.Timezone)
+		}
+	}
+	return nil
+}
+
+// quietHoursLocations 缓存已解析的时区，避免每条消息都调用 time.LoadLocation
+var quietHoursLocations sync.Map
+
+// quietHoursLocation 返回 timezone 对应的时区，为空或无效时使用 UTC
+func quietHoursLocation(timezone string) *time.Location {
+	if timezone == "" {
+		return time.UTC
+	}
+	if loc, ok := quietHoursLocations.Load(timezone); ok {
+		return loc.(*time.Location)
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	quietHoursLocations.Store(timezone, loc)
+	return loc
+}
+
+// In 判断 t 是否处于免打扰时段
+func (x *QuietHours) In(t time.Time) bool {
+	if x == nil || !x.Enabled || x.StartMinute == x.EndMinute {
+		return false
+	}
+	t = t.In(quietHoursLocation(x.Timezone))
+	minute := int32(t.Hour()*60 + t.Minute())
+	if x.StartMinute < x.EndMinute {
+		return minute >= x.StartMinute && minute < x.EndMinute
+	}
+	return minute >= x.StartMinute || minute < x.EndMinute
+}
+
+// Interval 返回摘要间隔
+func (x *NotificationDigestSetting) Interval() time.Duration {
+	return time.Duration(x.IntervalMinutes) * time.Minute
+}
+
+// SetNotificationDigestSettingReq 验证
+func (x *SetNotificationDigestSettingReq) Check() error {
+	if x.Setting == nil {
+		return errors.New("setting is empty")
+	}
+	if x.Setting.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	switch x.Setting.Mode {
+	case constant.NotificationDigestModeOff:
+	case constant.NotificationDigestModeDigest:
+		if x.Setting.IntervalMinutes < constant.NotificationDigestMinInterval || x.Setting.IntervalMinutes > constant.NotificationDigestMaxInterval {
+			return fmt.Errorf("intervalMinutes is invalid, should be %d-%d", constant.NotificationDigestMinInterval, constant.NotificationDigestMaxInterval)
+		}
+	default:
+		return errors.New("mode is invalid")
+	}
+	return x.Setting.QuietHours.Check()
+}
+
+// DeleteNotificationDigestSettingReq 验证
+func (x *DeleteNotificationDigestSettingReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	return nil
+}
+
+// GetNotificationDigestSettingsReq 验证
+func (x *GetNotificationDigestSettingsReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	return nil
+}
+
+// GetUsersNotificationDigestSettingsReq 验证
+func (x *GetUsersNotificationDigestSettingsReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	if len(x.UserIDs) > constant.ParamMaxLength {
+		return errors.New("too many userIDs, need to be less than 1000")
+	}
+	return nil
+}
+
+// DraftApplies 判断设备基于 baseUpdateTime 的写入是否生效：
+// 服务端草稿在 baseUpdateTime 之后被其他设备修改过时，本设备的写入已过期
+func DraftApplies(current *ConversationDraft, deviceID string, baseUpdateTime int64) bool {
+	if current == nil || current.UpdateTime <= baseUpdateTime {
+		return true
+	}
+	return current.DeviceID == deviceID
+}
+
+// SetDraftReq 验证
+func (x *SetDraftReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.Draft == nil {
+		return errors.New("draft is empty")
+	}
+	if x.Draft.DeviceID == "" {
+		return errors.New("deviceID is empty")
+	}
+	if utf8.RuneCountInString(x.Draft.Text) > constant.ConversationDraftMaxLength {
+		return fmt.Errorf("draft text too long, need to be less than %d", constant.ConversationDraftMaxLength)
+	}
+	return nil
+}
+
+// ClearDraftReq 验证
+func (x *ClearDraftReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if x.DeviceID == "" {
+		return errors.New("deviceID is empty")
+	}
+	return nil
+}
+
+// Muted 判断 now 时是否处于临时免打扰
+func (x *ConversationNotifySettings) Muted(now time.Time) bool {
+	return x != nil && x.MuteUntil > now.UnixMilli()
+}
+
+// msgText 取出用于关键词匹配的文本
+func msgText(msg *sdkws.MsgData) string {
+	var content struct {
+		Content string `json:"content"`
+		Text    string `json:"text"`
+	}
+	switch msg.ContentType {
+	case constant.Text, constant.AtText, constant.MarkdownText, constant.AdvancedText:
+		if err := json.Unmarshal(msg.Content, &content); err == nil {
+			if content.Text != "" {
+				return content.Text
+			}
+			return content.Content
+		}
+	}
+	return ""
+}
+
+// MatchKeywords 判断消息文本是否包含任一关键词（不区分大小写）
+func (x *ConversationNotifySettings) MatchKeywords(msg *sdkws.MsgData) bool {
+	if x == nil || len(x.Keywords) == 0 {
+		return false
+	}
+	text := strings.ToLower(msgText(msg))
+	if text == "" {
+		return false
+	}
+	for _, keyword := range x.Keywords {
+		if keyword != "" && strings.Contains(text, strings.ToLower(keyword)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ShouldNotify 推送时判断 msg 是否需要提醒会话所有者：
+// recvMsgOpt 不提醒或临时免打扰时不提醒；仅@和关键词模式下只有@我、@所有人或命中关键词时提醒
+func ShouldNotify(conv *Conversation, msg *sdkws.MsgData, now time.Time) bool {
+	if conv == nil {
+		return true
+	}
+	if conv.RecvMsgOpt != constant.ReceiveMessage {
+		return false
+	}
+	settings := conv.NotifySettings
+	if settings == nil {
+		return true
+	}
+	if settings.Muted(now) {
+		return false
+	}
+	if settings.Mode != constant.ConversationNotifyModeMentionKeywords {
+		return true
+	}
+	for _, atUserID := range msg.AtUserIDList {
+		if atUserID == conv.OwnerUserID || atUserID == constant.AtAllString {
+			return true
+		}
+	}
+	return settings.MatchKeywords(msg)
+}
+
+// ConversationNotifySettings 验证
+func (x *ConversationNotifySettings) Check() error {
+	if x == nil {
+		return nil
+	}
+	if x.Mode != constant.ConversationNotifyModeAll && x.Mode != constant.ConversationNotifyModeMentionKeywords {
+		return errors.New("mode is invalid")
+	}
+	if len(x.Keywords) > constant.ConversationNotifyMaxKeywordNum {
+		return fmt.Errorf("too many keywords, need to be less than %d", constant.ConversationNotifyMaxKeywordNum)
+	}
+	for _, keyword := range x.Keywords {
+		if keyword == "" {
+			return errors.New("keyword is empty")
+		}
+		if utf8.RuneCountInString(keyword) > constant.ConversationNotifyKeywordMaxLength {
+			return fmt.Errorf("keyword too long, need to be less than %d", constant.ConversationNotifyKeywordMaxLength)
+		}
+	}
+	if x.MuteUntil < 0 {
+		return errors.New("muteUntil is invalid")
+	}
+	return nil
+}
+
+// SetConversationNotifySettingsReq 验证
+func (x *SetConversationNotifySettingsReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	return x.Settings.Check()
+}
+
+// MuteConversationsReq 验证
+func (x *MuteConversationsReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if len(x.ConversationIDs) == 0 {
+		return errors.New("conversationIDs is empty")
+	}
+	if len(x.ConversationIDs) > constant.ParamMaxLength {
+		return errors.New("too many conversationIDs, need to be less than 1000")
+	}
+	if x.MuteUntil < 0 {
+		return errors.New("muteUntil is invalid")
+	}
+	return nil
+}
+
+// GetUsersConversationNotifySettingsReq 验证
+func (x *GetUsersConversationNotifySettingsReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	if len(x.UserIDs) > constant.ParamMaxLength {
+		return errors.New("too many userIDs, need to be less than 1000")
+	}
+	return nil
+}
+
+// FoldRuleInput 智能折叠求值所需的会话上下文，由服务端填充
+type FoldRuleInput struct {
+	Conversation          *Conversation
+	IsNotificationAccount bool      // 单聊对方为通知账号
+	PeerCompanyID         int32     // 单聊对方的 OA 企业ID
+	LastOpenTime          time.Time // 用户最后一次打开该会话的时间
+}
+
+// matchCondition 判断单个条件是否命中
+func (x *FoldRuleCondition) matchCondition(in *FoldRuleInput, now time.Time) bool {
+	conv := in.Conversation
+	switch x.Type {
+	case constant.FoldRuleConditionNotificationAccount:
+		return in.IsNotificationAccount || conv.ConversationType == constant.NotificationChatType
+	case constant.FoldRuleConditionInactiveGroup:
+		if conv.ConversationType != constant.ReadGroupChatType && conv.ConversationType != constant.WriteGroupChatType {
+			return false
+		}
+		return now.Sub(in.LastOpenTime) >= time.Duration(x.IntValue)*24*time.Hour
+	case constant.FoldRuleConditionOACompany:
+		if conv.ConversationType != constant.SingleChatType || in.PeerCompanyID == 0 {
+			return false
+		}
+		return slices.Contains(x.Values, strconv.Itoa(int(in.PeerCompanyID)))
+	case constant.FoldRuleConditionMuted:
+		return conv.RecvMsgOpt != constant.ReceiveMessage || conv.NotifySettings.Muted(now)
+	case constant.FoldRuleConditionConversationType:
+		return slices.Contains(x.Values, strconv.Itoa(int(conv.ConversationType)))
+	}
+	return false
+}
+
+// Match 判断会话是否属于该智能折叠：手动移出优先，其次手动移入，最后所有条件都命中
+// 折叠会话本身和置顶会话不参与规则折叠
+func (x *FoldRule) Match(in *FoldRuleInput, now time.Time) bool {
+	if x == nil || !x.Enabled || in.Conversation == nil {
+		return false
+	}
+	conv := in.Conversation
+	if slices.Contains(x.ExcludeConversationIDs, conv.ConversationID) {
+		return false
+	}
+	if slices.Contains(x.IncludeConversationIDs, conv.ConversationID) {
+		return true
+	}
+	if len(x.Conditions) == 0 || conv.ConversationType == constant.FoldChatType || conv.IsPinned {
+		return false
+	}
+	for _, condition := range x.Conditions {
+		if !condition.matchCondition(in, now) {
+			return false
+		}
+	}
+	return true
+}
+
+// FoldRule 验证
+func (x *FoldRule) Check() error {
+	if x == nil || !x.Enabled {
+		return nil
+	}
+	if len(x.Conditions) == 0 {
+		return errors.New("rule conditions is empty")
+	}
+	for _, condition := range x.Conditions {
+		switch condition.Type {
+		case constant.FoldRuleConditionNotificationAccount, constant.FoldRuleConditionMuted:
+		case constant.FoldRuleConditionInactiveGroup:
+			if condition.IntValue < 1 || condition.IntValue > constant.FoldRuleInactiveGroupMaxDays {
+				return fmt.Errorf("inactive days is invalid, should be 1-%d", constant.FoldRuleInactiveGroupMaxDays)
+			}
+		case constant.FoldRuleConditionOACompany, constant.FoldRuleConditionConversationType:
+			if len(condition.Values) == 0 {
+				return errors.New("condition values is empty")
+			}
+			for _, value := range condition.Values {
+				if _, err := strconv.Atoi(value); err != nil {
+					return fmt.Errorf("condition value %s is invalid", value)
+				}
+			}
+		default:
+			return errors.New("condition type is invalid")
+		}
+	}
+	if len(x.IncludeConversationIDs)+len(x.ExcludeConversationIDs) > constant.ParamMaxLength {
+		return errors.New("too many override conversationIDs, need to be less than 1000")
+	}
+	return nil
+}
+
+// PreviewFoldRuleReq 验证
+func (x *PreviewFoldRuleReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Rule == nil {
+		return errors.New("rule is empty")
+	}
+	return x.Rule.Check()
+}
+
+// ReevaluateFoldRulesReq 验证
+func (x *ReevaluateFoldRulesReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+// ConversationUnread 单个会话的未读计算输入，由服务端从会话和消息服务组装
+type ConversationUnread struct {
+	Conversation *Conversation
+	MaxSeq       int64
+	HasReadSeq   int64
+	GroupIDs     []string // 会话所属的会话分组
+}
+
+// Unread 返回会话的未读数，手动标记未读在没有未读消息时生效
+func (x *ConversationUnread) Unread() int64 {
+	unread := x.MaxSeq - x.HasReadSeq
+	if unread <= 0 {
+		unread = int64(x.Conversation.UnreadCount)
+	}
+	return max(unread, 0)
+}
+
+// ComputeUnreadSummary 按 UnreadSummary 的规则汇总未读数
+func ComputeUnreadSummary(items []*ConversationUnread, withConversations bool, now time.Time) *UnreadSummary {
+	summary := &UnreadSummary{
+		Groups:      make(map[string]int64),
+		Folds:       make(map[string]int64),
+		ComputeTime: now.UnixMilli(),
+	}
+	if withConversations {
+		summary.Conversations = make(map[string]int64)
+	}
+	for _, item := range items {
+		conv := item.Conversation
+		if conv == nil || conv.IsHidden || conv.ConversationType == constant.FoldChatType || conv.RecvMsgOpt == constant.NotReceiveMessage {
+			continue
+		}
+		unread := item.Unread()
+		if unread == 0 {
+			continue
+		}
+		if withConversations {
+			summary.Conversations[conv.ConversationID] = unread
+		}
+		// 分组和折叠展示的是其中会话的未读数，免打扰会话同样计入，只有角标排除免打扰
+		for _, groupID := range item.GroupIDs {
+			summary.Groups[groupID] += unread
+		}
+		if conv.ParentConversationID != "" {
+			summary.Folds[conv.ParentConversationID] += unread
+		}
+		if conv.RecvMsgOpt == constant.ReceiveNotNotifyMessage || conv.NotifySettings.Muted(now) {
+			summary.MutedTotal += unread
+			continue
+		}
+		summary.Total += unread
+		summary.UnreadConversationCount++
+	}
+	return summary
+}
+
+// GetUnreadSummaryReq 验证
+func (x *GetUnreadSummaryReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+// GetUsersBadgeReq 验证
+func (x *GetUsersBadgeReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	if len(x.UserIDs) > constant.ParamMaxLength {
+		return errors.New("too many userIDs, need to be less than 1000")
+	}
 	return nil
 }
@@ -38,30 +38,32 @@ const (
 )
 
 type Conversation struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	OwnerUserID           string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
-	ConversationID        string                 `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
-	RecvMsgOpt            int32                  `protobuf:"varint,3,opt,name=recvMsgOpt,proto3" json:"recvMsgOpt"`
-	ConversationType      int32                  `protobuf:"varint,4,opt,name=conversationType,proto3" json:"conversationType"` // 会话类型: 1=单聊, 2=可写群聊, 3=只读群聊, 4=通知, 5=折叠会话, 6=日程会话
-	UserID                string                 `protobuf:"bytes,5,opt,name=userID,proto3" json:"userID"`
-	GroupID               string                 `protobuf:"bytes,6,opt,name=groupID,proto3" json:"groupID"`
-	IsPinned              bool                   `protobuf:"varint,7,opt,name=isPinned,proto3" json:"isPinned"`
-	AttachedInfo          string                 `protobuf:"bytes,8,opt,name=attachedInfo,proto3" json:"attachedInfo"`
-	IsPrivateChat         bool                   `protobuf:"varint,9,opt,name=isPrivateChat,proto3" json:"isPrivateChat"`
-	GroupAtType           int32                  `protobuf:"varint,10,opt,name=groupAtType,proto3" json:"groupAtType"`
-	Ex                    string                 `protobuf:"bytes,11,opt,name=ex,proto3" json:"ex"`
-	BurnDuration          int32                  `protobuf:"varint,12,opt,name=burnDuration,proto3" json:"burnDuration"`
-	MinSeq                int64                  `protobuf:"varint,13,opt,name=minSeq,proto3" json:"minSeq"`
-	MaxSeq                int64                  `protobuf:"varint,14,opt,name=maxSeq,proto3" json:"maxSeq"`
-	MsgDestructTime       int64                  `protobuf:"varint,15,opt,name=msgDestructTime,proto3" json:"msgDestructTime"`
-	LatestMsgDestructTime int64                  `protobuf:"varint,16,opt,name=latestMsgDestructTime,proto3" json:"latestMsgDestructTime"`
-	IsMsgDestruct         bool                   `protobuf:"varint,17,opt,name=isMsgDestruct,proto3" json:"isMsgDestruct"`
-	IsMark                bool                   `protobuf:"varint,18,opt,name=isMark,proto3" json:"isMark"`
-	UnreadCount           int32                  `protobuf:"varint,19,opt,name=unreadCount,proto3" json:"unreadCount"`                     // 手动设置的未读数，用于多设备同步
-	UpdateUnreadCountTime int64                  `protobuf:"varint,20,opt,name=updateUnreadCountTime,proto3" json:"updateUnreadCountTime"` // 手动更新未读数的时间戳（毫秒），用于多端同步时判断最新值
-	ParentConversationID  string                 `protobuf:"bytes,21,opt,name=parentConversationID,proto3" json:"parentConversationID"`    // 父折叠会话ID，非空表示该会话被折叠（父会话conversationType=5）
-	IsSystemDefault       bool                   `protobuf:"varint,22,opt,name=isSystemDefault,proto3" json:"isSystemDefault"`             // 是否为系统默认折叠会话（仅折叠会话有效，true=系统默认，false=用户自定义），用于判断是否可以删除和清空
-	IsHidden              bool                   `protobuf:"varint,23,opt,name=isHidden,proto3" json:"isHidden"`                           // 是否隐藏（用户删除/隐藏会话时设置，收到新消息时自动取消隐藏）
+	state                 protoimpl.MessageState      `protogen:"open.v1"`
+	OwnerUserID           string                      `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	ConversationID        string                      `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	RecvMsgOpt            int32                       `protobuf:"varint,3,opt,name=recvMsgOpt,proto3" json:"recvMsgOpt"`
+	ConversationType      int32                       `protobuf:"varint,4,opt,name=conversationType,proto3" json:"conversationType"` // 会话类型: 1=单聊, 2=可写群聊, 3=只读群聊, 4=通知, 5=折叠会话, 6=日程会话
+	UserID                string                      `protobuf:"bytes,5,opt,name=userID,proto3" json:"userID"`
+	GroupID               string                      `protobuf:"bytes,6,opt,name=groupID,proto3" json:"groupID"`
+	IsPinned              bool                        `protobuf:"varint,7,opt,name=isPinned,proto3" json:"isPinned"`
+	AttachedInfo          string                      `protobuf:"bytes,8,opt,name=attachedInfo,proto3" json:"attachedInfo"`
+	IsPrivateChat         bool                        `protobuf:"varint,9,opt,name=isPrivateChat,proto3" json:"isPrivateChat"`
+	GroupAtType           int32                       `protobuf:"varint,10,opt,name=groupAtType,proto3" json:"groupAtType"`
+	Ex                    string                      `protobuf:"bytes,11,opt,name=ex,proto3" json:"ex"`
+	BurnDuration          int32                       `protobuf:"varint,12,opt,name=burnDuration,proto3" json:"burnDuration"`
+	MinSeq                int64                       `protobuf:"varint,13,opt,name=minSeq,proto3" json:"minSeq"`
+	MaxSeq                int64                       `protobuf:"varint,14,opt,name=maxSeq,proto3" json:"maxSeq"`
+	MsgDestructTime       int64                       `protobuf:"varint,15,opt,name=msgDestructTime,proto3" json:"msgDestructTime"`
+	LatestMsgDestructTime int64                       `protobuf:"varint,16,opt,name=latestMsgDestructTime,proto3" json:"latestMsgDestructTime"`
+	IsMsgDestruct         bool                        `protobuf:"varint,17,opt,name=isMsgDestruct,proto3" json:"isMsgDestruct"`
+	IsMark                bool                        `protobuf:"varint,18,opt,name=isMark,proto3" json:"isMark"`
+	UnreadCount           int32                       `protobuf:"varint,19,opt,name=unreadCount,proto3" json:"unreadCount"`                     // 手动设置的未读数，用于多设备同步
+	UpdateUnreadCountTime int64                       `protobuf:"varint,20,opt,name=updateUnreadCountTime,proto3" json:"updateUnreadCountTime"` // 手动更新未读数的时间戳（毫秒），用于多端同步时判断最新值
+	ParentConversationID  string                      `protobuf:"bytes,21,opt,name=parentConversationID,proto3" json:"parentConversationID"`    // 父折叠会话ID，非空表示该会话被折叠（父会话conversationType=5）
+	IsSystemDefault       bool                        `protobuf:"varint,22,opt,name=isSystemDefault,proto3" json:"isSystemDefault"`             // 是否为系统默认折叠会话（仅折叠会话有效，true=系统默认，false=用户自定义），用于判断是否可以删除和清空
+	IsHidden              bool                        `protobuf:"varint,23,opt,name=isHidden,proto3" json:"isHidden"`                           // 是否隐藏（用户删除/隐藏会话时设置，收到新消息时自动取消隐藏）
+	Draft                 *ConversationDraft          `protobuf:"bytes,24,opt,name=draft,proto3" json:"draft"`                                  // 草稿，为空表示没有草稿
+	NotifySettings        *ConversationNotifySettings `protobuf:"bytes,25,opt,name=notifySettings,proto3" json:"notifySettings"`                // 会话提醒设置，为空表示按 recvMsgOpt 提醒
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -257,35 +259,220 @@ func (x *Conversation) GetIsHidden() bool {
 	return false
 }
 
+func (x *Conversation) GetDraft() *ConversationDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+func (x *Conversation) GetNotifySettings() *ConversationNotifySettings {
+	if x != nil {
+		return x.NotifySettings
+	}
+	return nil
+}
+
+// ConversationNotifySettings 会话级提醒设置，在 recvMsgOpt 为 ReceiveMessage 时生效
+type ConversationNotifySettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          int32                  `protobuf:"varint,1,opt,name=mode,proto3" json:"mode"`           // constant.ConversationNotifyMode*
+	Keywords      []string               `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords"`    // mode=仅@和关键词 时，消息包含任一关键词即提醒（不区分大小写）
+	MuteUntil     int64                  `protobuf:"varint,3,opt,name=muteUntil,proto3" json:"muteUntil"` // 临时免打扰截止时间（毫秒），到期自动恢复，0 表示不免打扰
+	Sound         string                 `protobuf:"bytes,4,opt,name=sound,proto3" json:"sound"`          // 自定义提示音，为空使用默认
+	UpdateTime    int64                  `protobuf:"varint,5,opt,name=updateTime,proto3" json:"updateTime"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversationNotifySettings) Reset() {
+	*x = ConversationNotifySettings{}
+	mi := &file_conversation_conversation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationNotifySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationNotifySettings) ProtoMessage() {}
+
+func (x *ConversationNotifySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationNotifySettings.ProtoReflect.Descriptor instead.
+func (*ConversationNotifySettings) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConversationNotifySettings) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *ConversationNotifySettings) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *ConversationNotifySettings) GetMuteUntil() int64 {
+	if x != nil {
+		return x.MuteUntil
+	}
+	return 0
+}
+
+func (x *ConversationNotifySettings) GetSound() string {
+	if x != nil {
+		return x.Sound
+	}
+	return ""
+}
+
+func (x *ConversationNotifySettings) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+// ConversationDraft 会话草稿，多端同步
+type ConversationDraft struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text"`
+	QuoteMsg      *sdkws.MsgData         `protobuf:"bytes,2,opt,name=quoteMsg,proto3" json:"quoteMsg"`   // 引用的消息
+	AtUserIDs     []string               `protobuf:"bytes,3,rep,name=atUserIDs,proto3" json:"atUserIDs"` // @的用户
+	DeviceID      string                 `protobuf:"bytes,4,opt,name=deviceID,proto3" json:"deviceID"`   // 最后编辑的设备
+	PlatformID    int32                  `protobuf:"varint,5,opt,name=platformID,proto3" json:"platformID"`
+	UpdateTime    int64                  `protobuf:"varint,6,opt,name=updateTime,proto3" json:"updateTime"` // 服务端写入时间（毫秒）
+	Ex            string                 `protobuf:"bytes,7,opt,name=ex,proto3" json:"ex"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConversationDraft) Reset() {
+	*x = ConversationDraft{}
+	mi := &file_conversation_conversation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationDraft) ProtoMessage() {}
+
+func (x *ConversationDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationDraft.ProtoReflect.Descriptor instead.
+func (*ConversationDraft) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConversationDraft) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ConversationDraft) GetQuoteMsg() *sdkws.MsgData {
+	if x != nil {
+		return x.QuoteMsg
+	}
+	return nil
+}
+
+func (x *ConversationDraft) GetAtUserIDs() []string {
+	if x != nil {
+		return x.AtUserIDs
+	}
+	return nil
+}
+
+func (x *ConversationDraft) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *ConversationDraft) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *ConversationDraft) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *ConversationDraft) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
 type ConversationReq struct {
-	state                 protoimpl.MessageState  `protogen:"open.v1"`
-	ConversationID        string                  `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
-	ConversationType      int32                   `protobuf:"varint,2,opt,name=conversationType,proto3" json:"conversationType"` // 会话类型: 1=单聊, 2=可写群聊, 3=只读群聊, 4=通知, 5=折叠会话, 6=日程会话
-	UserID                string                  `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
-	GroupID               string                  `protobuf:"bytes,4,opt,name=groupID,proto3" json:"groupID"`
-	RecvMsgOpt            *wrapperspb.Int32Value  `protobuf:"bytes,5,opt,name=recvMsgOpt,proto3" json:"recvMsgOpt"`
-	IsPinned              *wrapperspb.BoolValue   `protobuf:"bytes,6,opt,name=isPinned,proto3" json:"isPinned"`
-	AttachedInfo          *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=attachedInfo,proto3" json:"attachedInfo"`
-	IsPrivateChat         *wrapperspb.BoolValue   `protobuf:"bytes,8,opt,name=isPrivateChat,proto3" json:"isPrivateChat"`
-	Ex                    *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=ex,proto3" json:"ex"`
-	BurnDuration          *wrapperspb.Int32Value  `protobuf:"bytes,10,opt,name=burnDuration,proto3" json:"burnDuration"`
-	MinSeq                *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=minSeq,proto3" json:"minSeq"`
-	MaxSeq                *wrapperspb.Int64Value  `protobuf:"bytes,12,opt,name=maxSeq,proto3" json:"maxSeq"`
-	GroupAtType           *wrapperspb.Int32Value  `protobuf:"bytes,13,opt,name=groupAtType,proto3" json:"groupAtType"`
-	MsgDestructTime       *wrapperspb.Int64Value  `protobuf:"bytes,14,opt,name=msgDestructTime,proto3" json:"msgDestructTime"`
-	IsMsgDestruct         *wrapperspb.BoolValue   `protobuf:"bytes,15,opt,name=isMsgDestruct,proto3" json:"isMsgDestruct"`
-	IsMark                *wrapperspb.BoolValue   `protobuf:"bytes,16,opt,name=isMark,proto3" json:"isMark"`
-	UnreadCount           *wrapperspb.Int32Value  `protobuf:"bytes,17,opt,name=unreadCount,proto3" json:"unreadCount"`                     // 手动设置的未读数
-	UpdateUnreadCountTime *wrapperspb.Int64Value  `protobuf:"bytes,18,opt,name=updateUnreadCountTime,proto3" json:"updateUnreadCountTime"` // 手动更新未读数的时间戳
-	ParentConversationID  *wrapperspb.StringValue `protobuf:"bytes,19,opt,name=parentConversationID,proto3" json:"parentConversationID"`   // 父折叠会话ID
-	IsHidden              *wrapperspb.BoolValue   `protobuf:"bytes,20,opt,name=isHidden,proto3" json:"isHidden"`                           // 是否隐藏会话（用户删除/隐藏会话时设置，收到新消息时自动取消隐藏）
+	state                 protoimpl.MessageState      `protogen:"open.v1"`
+	ConversationID        string                      `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	ConversationType      int32                       `protobuf:"varint,2,opt,name=conversationType,proto3" json:"conversationType"` // 会话类型: 1=单聊, 2=可写群聊, 3=只读群聊, 4=通知, 5=折叠会话, 6=日程会话
+	UserID                string                      `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	GroupID               string                      `protobuf:"bytes,4,opt,name=groupID,proto3" json:"groupID"`
+	RecvMsgOpt            *wrapperspb.Int32Value      `protobuf:"bytes,5,opt,name=recvMsgOpt,proto3" json:"recvMsgOpt"`
+	IsPinned              *wrapperspb.BoolValue       `protobuf:"bytes,6,opt,name=isPinned,proto3" json:"isPinned"`
+	AttachedInfo          *wrapperspb.StringValue     `protobuf:"bytes,7,opt,name=attachedInfo,proto3" json:"attachedInfo"`
+	IsPrivateChat         *wrapperspb.BoolValue       `protobuf:"bytes,8,opt,name=isPrivateChat,proto3" json:"isPrivateChat"`
+	Ex                    *wrapperspb.StringValue     `protobuf:"bytes,9,opt,name=ex,proto3" json:"ex"`
+	BurnDuration          *wrapperspb.Int32Value      `protobuf:"bytes,10,opt,name=burnDuration,proto3" json:"burnDuration"`
+	MinSeq                *wrapperspb.Int64Value      `protobuf:"bytes,11,opt,name=minSeq,proto3" json:"minSeq"`
+	MaxSeq                *wrapperspb.Int64Value      `protobuf:"bytes,12,opt,name=maxSeq,proto3" json:"maxSeq"`
+	GroupAtType           *wrapperspb.Int32Value      `protobuf:"bytes,13,opt,name=groupAtType,proto3" json:"groupAtType"`
+	MsgDestructTime       *wrapperspb.Int64Value      `protobuf:"bytes,14,opt,name=msgDestructTime,proto3" json:"msgDestructTime"`
+	IsMsgDestruct         *wrapperspb.BoolValue       `protobuf:"bytes,15,opt,name=isMsgDestruct,proto3" json:"isMsgDestruct"`
+	IsMark                *wrapperspb.BoolValue       `protobuf:"bytes,16,opt,name=isMark,proto3" json:"isMark"`
+	UnreadCount           *wrapperspb.Int32Value      `protobuf:"bytes,17,opt,name=unreadCount,proto3" json:"unreadCount"`                     // 手动设置的未读数
+	UpdateUnreadCountTime *wrapperspb.Int64Value      `protobuf:"bytes,18,opt,name=updateUnreadCountTime,proto3" json:"updateUnreadCountTime"` // 手动更新未读数的时间戳
+	ParentConversationID  *wrapperspb.StringValue     `protobuf:"bytes,19,opt,name=parentConversationID,proto3" json:"parentConversationID"`   // 父折叠会话ID
+	IsHidden              *wrapperspb.BoolValue       `protobuf:"bytes,20,opt,name=isHidden,proto3" json:"isHidden"`                           // 是否隐藏会话（用户删除/隐藏会话时设置，收到新消息时自动取消隐藏）
+	NotifySettings        *ConversationNotifySettings `protobuf:"bytes,21,opt,name=notifySettings,proto3" json:"notifySettings"`               // 会话提醒设置，整体替换
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *ConversationReq) Reset() {
 	*x = ConversationReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[1]
+	mi := &file_conversation_conversation_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -297,7 +484,7 @@ func (x *ConversationReq) String() string {
 func (*ConversationReq) ProtoMessage() {}
 
 func (x *ConversationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[1]
+	mi := &file_conversation_conversation_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +497,7 @@ func (x *ConversationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversationReq.ProtoReflect.Descriptor instead.
 func (*ConversationReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{1}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ConversationReq) GetConversationID() string {
@@ -453,6 +640,13 @@ func (x *ConversationReq) GetIsHidden() *wrapperspb.BoolValue {
 	return nil
 }
 
+func (x *ConversationReq) GetNotifySettings() *ConversationNotifySettings {
+	if x != nil {
+		return x.NotifySettings
+	}
+	return nil
+}
+
 type SetConversationReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Conversation  *Conversation          `protobuf:"bytes,1,opt,name=conversation,proto3" json:"conversation"`
@@ -462,7 +656,7 @@ type SetConversationReq struct {
 
 func (x *SetConversationReq) Reset() {
 	*x = SetConversationReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[2]
+	mi := &file_conversation_conversation_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -474,7 +668,7 @@ func (x *SetConversationReq) String() string {
 func (*SetConversationReq) ProtoMessage() {}
 
 func (x *SetConversationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[2]
+	mi := &file_conversation_conversation_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -487,7 +681,7 @@ func (x *SetConversationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConversationReq.ProtoReflect.Descriptor instead.
 func (*SetConversationReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{2}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SetConversationReq) GetConversation() *Conversation {
@@ -505,7 +699,7 @@ type SetConversationResp struct {
 
 func (x *SetConversationResp) Reset() {
 	*x = SetConversationResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[3]
+	mi := &file_conversation_conversation_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -517,7 +711,7 @@ func (x *SetConversationResp) String() string {
 func (*SetConversationResp) ProtoMessage() {}
 
 func (x *SetConversationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[3]
+	mi := &file_conversation_conversation_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -530,7 +724,7 @@ func (x *SetConversationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConversationResp.ProtoReflect.Descriptor instead.
 func (*SetConversationResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{3}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{5}
 }
 
 type GetConversationReq struct {
@@ -543,7 +737,7 @@ type GetConversationReq struct {
 
 func (x *GetConversationReq) Reset() {
 	*x = GetConversationReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[4]
+	mi := &file_conversation_conversation_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +749,7 @@ func (x *GetConversationReq) String() string {
 func (*GetConversationReq) ProtoMessage() {}
 
 func (x *GetConversationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[4]
+	mi := &file_conversation_conversation_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +762,7 @@ func (x *GetConversationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationReq.ProtoReflect.Descriptor instead.
 func (*GetConversationReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{4}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetConversationReq) GetConversationID() string {
@@ -594,7 +788,7 @@ type GetConversationResp struct {
 
 func (x *GetConversationResp) Reset() {
 	*x = GetConversationResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[5]
+	mi := &file_conversation_conversation_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -606,7 +800,7 @@ func (x *GetConversationResp) String() string {
 func (*GetConversationResp) ProtoMessage() {}
 
 func (x *GetConversationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[5]
+	mi := &file_conversation_conversation_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +813,7 @@ func (x *GetConversationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationResp.ProtoReflect.Descriptor instead.
 func (*GetConversationResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{5}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetConversationResp) GetConversation() *Conversation {
@@ -640,7 +834,7 @@ type GetSortedConversationListReq struct {
 
 func (x *GetSortedConversationListReq) Reset() {
 	*x = GetSortedConversationListReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[6]
+	mi := &file_conversation_conversation_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -652,7 +846,7 @@ func (x *GetSortedConversationListReq) String() string {
 func (*GetSortedConversationListReq) ProtoMessage() {}
 
 func (x *GetSortedConversationListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[6]
+	mi := &file_conversation_conversation_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -665,7 +859,7 @@ func (x *GetSortedConversationListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSortedConversationListReq.ProtoReflect.Descriptor instead.
 func (*GetSortedConversationListReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{6}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetSortedConversationListReq) GetUserID() string {
@@ -700,7 +894,7 @@ type GetSortedConversationListResp struct {
 
 func (x *GetSortedConversationListResp) Reset() {
 	*x = GetSortedConversationListResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[7]
+	mi := &file_conversation_conversation_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +906,7 @@ func (x *GetSortedConversationListResp) String() string {
 func (*GetSortedConversationListResp) ProtoMessage() {}
 
 func (x *GetSortedConversationListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[7]
+	mi := &file_conversation_conversation_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +919,7 @@ func (x *GetSortedConversationListResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSortedConversationListResp.ProtoReflect.Descriptor instead.
 func (*GetSortedConversationListResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{7}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetSortedConversationListResp) GetConversationTotal() int64 {
@@ -762,7 +956,7 @@ type ConversationElem struct {
 
 func (x *ConversationElem) Reset() {
 	*x = ConversationElem{}
-	mi := &file_conversation_conversation_proto_msgTypes[8]
+	mi := &file_conversation_conversation_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -774,7 +968,7 @@ func (x *ConversationElem) String() string {
 func (*ConversationElem) ProtoMessage() {}
 
 func (x *ConversationElem) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[8]
+	mi := &file_conversation_conversation_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,7 +981,7 @@ func (x *ConversationElem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversationElem.ProtoReflect.Descriptor instead.
 func (*ConversationElem) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{8}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ConversationElem) GetConversationID() string {
@@ -850,7 +1044,7 @@ type MsgInfo struct {
 
 func (x *MsgInfo) Reset() {
 	*x = MsgInfo{}
-	mi := &file_conversation_conversation_proto_msgTypes[9]
+	mi := &file_conversation_conversation_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -862,7 +1056,7 @@ func (x *MsgInfo) String() string {
 func (*MsgInfo) ProtoMessage() {}
 
 func (x *MsgInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[9]
+	mi := &file_conversation_conversation_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +1069,7 @@ func (x *MsgInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgInfo.ProtoReflect.Descriptor instead.
 func (*MsgInfo) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{9}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *MsgInfo) GetServerMsgID() string {
@@ -1007,7 +1201,7 @@ type GetConversationsReq struct {
 
 func (x *GetConversationsReq) Reset() {
 	*x = GetConversationsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[10]
+	mi := &file_conversation_conversation_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1019,7 +1213,7 @@ func (x *GetConversationsReq) String() string {
 func (*GetConversationsReq) ProtoMessage() {}
 
 func (x *GetConversationsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[10]
+	mi := &file_conversation_conversation_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1226,7 @@ func (x *GetConversationsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationsReq.ProtoReflect.Descriptor instead.
 func (*GetConversationsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{10}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetConversationsReq) GetOwnerUserID() string {
@@ -1058,7 +1252,7 @@ type GetConversationsResp struct {
 
 func (x *GetConversationsResp) Reset() {
 	*x = GetConversationsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[11]
+	mi := &file_conversation_conversation_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1070,7 +1264,7 @@ func (x *GetConversationsResp) String() string {
 func (*GetConversationsResp) ProtoMessage() {}
 
 func (x *GetConversationsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[11]
+	mi := &file_conversation_conversation_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1277,7 @@ func (x *GetConversationsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationsResp.ProtoReflect.Descriptor instead.
 func (*GetConversationsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{11}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetConversationsResp) GetConversations() []*Conversation {
@@ -1102,7 +1296,7 @@ type GetAllConversationsReq struct {
 
 func (x *GetAllConversationsReq) Reset() {
 	*x = GetAllConversationsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[12]
+	mi := &file_conversation_conversation_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1114,7 +1308,7 @@ func (x *GetAllConversationsReq) String() string {
 func (*GetAllConversationsReq) ProtoMessage() {}
 
 func (x *GetAllConversationsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[12]
+	mi := &file_conversation_conversation_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,7 +1321,7 @@ func (x *GetAllConversationsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllConversationsReq.ProtoReflect.Descriptor instead.
 func (*GetAllConversationsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{12}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetAllConversationsReq) GetOwnerUserID() string {
@@ -1146,7 +1340,7 @@ type GetAllConversationsResp struct {
 
 func (x *GetAllConversationsResp) Reset() {
 	*x = GetAllConversationsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[13]
+	mi := &file_conversation_conversation_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1158,7 +1352,7 @@ func (x *GetAllConversationsResp) String() string {
 func (*GetAllConversationsResp) ProtoMessage() {}
 
 func (x *GetAllConversationsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[13]
+	mi := &file_conversation_conversation_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1171,7 +1365,7 @@ func (x *GetAllConversationsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllConversationsResp.ProtoReflect.Descriptor instead.
 func (*GetAllConversationsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{13}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetAllConversationsResp) GetConversations() []*Conversation {
@@ -1190,7 +1384,7 @@ type GetRecvMsgNotNotifyUserIDsReq struct {
 
 func (x *GetRecvMsgNotNotifyUserIDsReq) Reset() {
 	*x = GetRecvMsgNotNotifyUserIDsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[14]
+	mi := &file_conversation_conversation_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1202,7 +1396,7 @@ func (x *GetRecvMsgNotNotifyUserIDsReq) String() string {
 func (*GetRecvMsgNotNotifyUserIDsReq) ProtoMessage() {}
 
 func (x *GetRecvMsgNotNotifyUserIDsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[14]
+	mi := &file_conversation_conversation_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1215,7 +1409,7 @@ func (x *GetRecvMsgNotNotifyUserIDsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRecvMsgNotNotifyUserIDsReq.ProtoReflect.Descriptor instead.
 func (*GetRecvMsgNotNotifyUserIDsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{14}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetRecvMsgNotNotifyUserIDsReq) GetGroupID() string {
@@ -1234,7 +1428,7 @@ type GetRecvMsgNotNotifyUserIDsResp struct {
 
 func (x *GetRecvMsgNotNotifyUserIDsResp) Reset() {
 	*x = GetRecvMsgNotNotifyUserIDsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[15]
+	mi := &file_conversation_conversation_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1246,7 +1440,7 @@ func (x *GetRecvMsgNotNotifyUserIDsResp) String() string {
 func (*GetRecvMsgNotNotifyUserIDsResp) ProtoMessage() {}
 
 func (x *GetRecvMsgNotNotifyUserIDsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[15]
+	mi := &file_conversation_conversation_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1259,7 +1453,7 @@ func (x *GetRecvMsgNotNotifyUserIDsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRecvMsgNotNotifyUserIDsResp.ProtoReflect.Descriptor instead.
 func (*GetRecvMsgNotNotifyUserIDsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{15}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetRecvMsgNotNotifyUserIDsResp) GetUserIDs() []string {
@@ -1281,7 +1475,7 @@ type CreateSingleChatConversationsReq struct {
 
 func (x *CreateSingleChatConversationsReq) Reset() {
 	*x = CreateSingleChatConversationsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[16]
+	mi := &file_conversation_conversation_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1293,7 +1487,7 @@ func (x *CreateSingleChatConversationsReq) String() string {
 func (*CreateSingleChatConversationsReq) ProtoMessage() {}
 
 func (x *CreateSingleChatConversationsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[16]
+	mi := &file_conversation_conversation_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1306,7 +1500,7 @@ func (x *CreateSingleChatConversationsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSingleChatConversationsReq.ProtoReflect.Descriptor instead.
 func (*CreateSingleChatConversationsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{16}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CreateSingleChatConversationsReq) GetRecvID() string {
@@ -1345,7 +1539,7 @@ type CreateSingleChatConversationsResp struct {
 
 func (x *CreateSingleChatConversationsResp) Reset() {
 	*x = CreateSingleChatConversationsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[17]
+	mi := &file_conversation_conversation_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1357,7 +1551,7 @@ func (x *CreateSingleChatConversationsResp) String() string {
 func (*CreateSingleChatConversationsResp) ProtoMessage() {}
 
 func (x *CreateSingleChatConversationsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[17]
+	mi := &file_conversation_conversation_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1370,7 +1564,7 @@ func (x *CreateSingleChatConversationsResp) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateSingleChatConversationsResp.ProtoReflect.Descriptor instead.
 func (*CreateSingleChatConversationsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{17}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{19}
 }
 
 type CreateGroupChatConversationsReq struct {
@@ -1383,7 +1577,7 @@ type CreateGroupChatConversationsReq struct {
 
 func (x *CreateGroupChatConversationsReq) Reset() {
 	*x = CreateGroupChatConversationsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[18]
+	mi := &file_conversation_conversation_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1395,7 +1589,7 @@ func (x *CreateGroupChatConversationsReq) String() string {
 func (*CreateGroupChatConversationsReq) ProtoMessage() {}
 
 func (x *CreateGroupChatConversationsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[18]
+	mi := &file_conversation_conversation_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1408,7 +1602,7 @@ func (x *CreateGroupChatConversationsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateGroupChatConversationsReq.ProtoReflect.Descriptor instead.
 func (*CreateGroupChatConversationsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{18}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CreateGroupChatConversationsReq) GetUserIDs() []string {
@@ -1433,7 +1627,7 @@ type CreateGroupChatConversationsResp struct {
 
 func (x *CreateGroupChatConversationsResp) Reset() {
 	*x = CreateGroupChatConversationsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[19]
+	mi := &file_conversation_conversation_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1445,7 +1639,7 @@ func (x *CreateGroupChatConversationsResp) String() string {
 func (*CreateGroupChatConversationsResp) ProtoMessage() {}
 
 func (x *CreateGroupChatConversationsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[19]
+	mi := &file_conversation_conversation_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,7 +1652,7 @@ func (x *CreateGroupChatConversationsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateGroupChatConversationsResp.ProtoReflect.Descriptor instead.
 func (*CreateGroupChatConversationsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{19}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{21}
 }
 
 type SetConversationMaxSeqReq struct {
@@ -1472,7 +1666,7 @@ type SetConversationMaxSeqReq struct {
 
 func (x *SetConversationMaxSeqReq) Reset() {
 	*x = SetConversationMaxSeqReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[20]
+	mi := &file_conversation_conversation_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1484,7 +1678,7 @@ func (x *SetConversationMaxSeqReq) String() string {
 func (*SetConversationMaxSeqReq) ProtoMessage() {}
 
 func (x *SetConversationMaxSeqReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[20]
+	mi := &file_conversation_conversation_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1497,7 +1691,7 @@ func (x *SetConversationMaxSeqReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConversationMaxSeqReq.ProtoReflect.Descriptor instead.
 func (*SetConversationMaxSeqReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{20}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SetConversationMaxSeqReq) GetConversationID() string {
@@ -1529,7 +1723,7 @@ type SetConversationMaxSeqResp struct {
 
 func (x *SetConversationMaxSeqResp) Reset() {
 	*x = SetConversationMaxSeqResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[21]
+	mi := &file_conversation_conversation_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1541,7 +1735,7 @@ func (x *SetConversationMaxSeqResp) String() string {
 func (*SetConversationMaxSeqResp) ProtoMessage() {}
 
 func (x *SetConversationMaxSeqResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[21]
+	mi := &file_conversation_conversation_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1554,7 +1748,7 @@ func (x *SetConversationMaxSeqResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConversationMaxSeqResp.ProtoReflect.Descriptor instead.
 func (*SetConversationMaxSeqResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{21}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{23}
 }
 
 type SetConversationMinSeqReq struct {
@@ -1568,7 +1762,7 @@ type SetConversationMinSeqReq struct {
 
 func (x *SetConversationMinSeqReq) Reset() {
 	*x = SetConversationMinSeqReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[22]
+	mi := &file_conversation_conversation_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1580,7 +1774,7 @@ func (x *SetConversationMinSeqReq) String() string {
 func (*SetConversationMinSeqReq) ProtoMessage() {}
 
 func (x *SetConversationMinSeqReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[22]
+	mi := &file_conversation_conversation_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,7 +1787,7 @@ func (x *SetConversationMinSeqReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConversationMinSeqReq.ProtoReflect.Descriptor instead.
 func (*SetConversationMinSeqReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{22}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SetConversationMinSeqReq) GetConversationID() string {
@@ -1625,7 +1819,7 @@ type SetConversationMinSeqResp struct {
 
 func (x *SetConversationMinSeqResp) Reset() {
 	*x = SetConversationMinSeqResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[23]
+	mi := &file_conversation_conversation_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1637,7 +1831,7 @@ func (x *SetConversationMinSeqResp) String() string {
 func (*SetConversationMinSeqResp) ProtoMessage() {}
 
 func (x *SetConversationMinSeqResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[23]
+	mi := &file_conversation_conversation_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,7 +1844,7 @@ func (x *SetConversationMinSeqResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConversationMinSeqResp.ProtoReflect.Descriptor instead.
 func (*SetConversationMinSeqResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{23}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{25}
 }
 
 type GetConversationIDsReq struct {
@@ -1662,7 +1856,7 @@ type GetConversationIDsReq struct {
 
 func (x *GetConversationIDsReq) Reset() {
 	*x = GetConversationIDsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[24]
+	mi := &file_conversation_conversation_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1674,7 +1868,7 @@ func (x *GetConversationIDsReq) String() string {
 func (*GetConversationIDsReq) ProtoMessage() {}
 
 func (x *GetConversationIDsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[24]
+	mi := &file_conversation_conversation_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1687,7 +1881,7 @@ func (x *GetConversationIDsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationIDsReq.ProtoReflect.Descriptor instead.
 func (*GetConversationIDsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{24}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetConversationIDsReq) GetUserID() string {
@@ -1706,7 +1900,7 @@ type GetConversationIDsResp struct {
 
 func (x *GetConversationIDsResp) Reset() {
 	*x = GetConversationIDsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[25]
+	mi := &file_conversation_conversation_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1718,7 +1912,7 @@ func (x *GetConversationIDsResp) String() string {
 func (*GetConversationIDsResp) ProtoMessage() {}
 
 func (x *GetConversationIDsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[25]
+	mi := &file_conversation_conversation_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1731,7 +1925,7 @@ func (x *GetConversationIDsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationIDsResp.ProtoReflect.Descriptor instead.
 func (*GetConversationIDsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{25}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetConversationIDsResp) GetConversationIDs() []string {
@@ -1751,7 +1945,7 @@ type SetConversationsReq struct {
 
 func (x *SetConversationsReq) Reset() {
 	*x = SetConversationsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[26]
+	mi := &file_conversation_conversation_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1763,7 +1957,7 @@ func (x *SetConversationsReq) String() string {
 func (*SetConversationsReq) ProtoMessage() {}
 
 func (x *SetConversationsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[26]
+	mi := &file_conversation_conversation_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1776,7 +1970,7 @@ func (x *SetConversationsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConversationsReq.ProtoReflect.Descriptor instead.
 func (*SetConversationsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{26}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *SetConversationsReq) GetUserIDs() []string {
@@ -1801,7 +1995,7 @@ type SetConversationsResp struct {
 
 func (x *SetConversationsResp) Reset() {
 	*x = SetConversationsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[27]
+	mi := &file_conversation_conversation_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1813,7 +2007,7 @@ func (x *SetConversationsResp) String() string {
 func (*SetConversationsResp) ProtoMessage() {}
 
 func (x *SetConversationsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[27]
+	mi := &file_conversation_conversation_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1826,7 +2020,7 @@ func (x *SetConversationsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConversationsResp.ProtoReflect.Descriptor instead.
 func (*SetConversationsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{27}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{29}
 }
 
 type GetUserConversationIDsHashReq struct {
@@ -1838,7 +2032,7 @@ type GetUserConversationIDsHashReq struct {
 
 func (x *GetUserConversationIDsHashReq) Reset() {
 	*x = GetUserConversationIDsHashReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[28]
+	mi := &file_conversation_conversation_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1850,7 +2044,7 @@ func (x *GetUserConversationIDsHashReq) String() string {
 func (*GetUserConversationIDsHashReq) ProtoMessage() {}
 
 func (x *GetUserConversationIDsHashReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[28]
+	mi := &file_conversation_conversation_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1863,7 +2057,7 @@ func (x *GetUserConversationIDsHashReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserConversationIDsHashReq.ProtoReflect.Descriptor instead.
 func (*GetUserConversationIDsHashReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{28}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetUserConversationIDsHashReq) GetOwnerUserID() string {
@@ -1882,7 +2076,7 @@ type GetUserConversationIDsHashResp struct {
 
 func (x *GetUserConversationIDsHashResp) Reset() {
 	*x = GetUserConversationIDsHashResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[29]
+	mi := &file_conversation_conversation_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1894,7 +2088,7 @@ func (x *GetUserConversationIDsHashResp) String() string {
 func (*GetUserConversationIDsHashResp) ProtoMessage() {}
 
 func (x *GetUserConversationIDsHashResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[29]
+	mi := &file_conversation_conversation_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1907,7 +2101,7 @@ func (x *GetUserConversationIDsHashResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserConversationIDsHashResp.ProtoReflect.Descriptor instead.
 func (*GetUserConversationIDsHashResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{29}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetUserConversationIDsHashResp) GetHash() uint64 {
@@ -1926,7 +2120,7 @@ type GetConversationsByConversationIDReq struct {
 
 func (x *GetConversationsByConversationIDReq) Reset() {
 	*x = GetConversationsByConversationIDReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[30]
+	mi := &file_conversation_conversation_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1938,7 +2132,7 @@ func (x *GetConversationsByConversationIDReq) String() string {
 func (*GetConversationsByConversationIDReq) ProtoMessage() {}
 
 func (x *GetConversationsByConversationIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[30]
+	mi := &file_conversation_conversation_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1951,7 +2145,7 @@ func (x *GetConversationsByConversationIDReq) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetConversationsByConversationIDReq.ProtoReflect.Descriptor instead.
 func (*GetConversationsByConversationIDReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{30}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetConversationsByConversationIDReq) GetConversationIDs() []string {
@@ -1970,7 +2164,7 @@ type GetConversationsByConversationIDResp struct {
 
 func (x *GetConversationsByConversationIDResp) Reset() {
 	*x = GetConversationsByConversationIDResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[31]
+	mi := &file_conversation_conversation_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1982,7 +2176,7 @@ func (x *GetConversationsByConversationIDResp) String() string {
 func (*GetConversationsByConversationIDResp) ProtoMessage() {}
 
 func (x *GetConversationsByConversationIDResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[31]
+	mi := &file_conversation_conversation_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1995,7 +2189,7 @@ func (x *GetConversationsByConversationIDResp) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetConversationsByConversationIDResp.ProtoReflect.Descriptor instead.
 func (*GetConversationsByConversationIDResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{31}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetConversationsByConversationIDResp) GetConversations() []*Conversation {
@@ -2015,7 +2209,7 @@ type GetConversationOfflinePushUserIDsReq struct {
 
 func (x *GetConversationOfflinePushUserIDsReq) Reset() {
 	*x = GetConversationOfflinePushUserIDsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[32]
+	mi := &file_conversation_conversation_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2027,7 +2221,7 @@ func (x *GetConversationOfflinePushUserIDsReq) String() string {
 func (*GetConversationOfflinePushUserIDsReq) ProtoMessage() {}
 
 func (x *GetConversationOfflinePushUserIDsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[32]
+	mi := &file_conversation_conversation_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2040,7 +2234,7 @@ func (x *GetConversationOfflinePushUserIDsReq) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetConversationOfflinePushUserIDsReq.ProtoReflect.Descriptor instead.
 func (*GetConversationOfflinePushUserIDsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{32}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetConversationOfflinePushUserIDsReq) GetConversationID() string {
@@ -2066,7 +2260,7 @@ type GetConversationOfflinePushUserIDsResp struct {
 
 func (x *GetConversationOfflinePushUserIDsResp) Reset() {
 	*x = GetConversationOfflinePushUserIDsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[33]
+	mi := &file_conversation_conversation_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2078,7 +2272,7 @@ func (x *GetConversationOfflinePushUserIDsResp) String() string {
 func (*GetConversationOfflinePushUserIDsResp) ProtoMessage() {}
 
 func (x *GetConversationOfflinePushUserIDsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[33]
+	mi := &file_conversation_conversation_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2091,7 +2285,7 @@ func (x *GetConversationOfflinePushUserIDsResp) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use GetConversationOfflinePushUserIDsResp.ProtoReflect.Descriptor instead.
 func (*GetConversationOfflinePushUserIDsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{33}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetConversationOfflinePushUserIDsResp) GetUserIDs() []string {
@@ -2110,7 +2304,7 @@ type GetConversationNotReceiveMessageUserIDsReq struct {
 
 func (x *GetConversationNotReceiveMessageUserIDsReq) Reset() {
 	*x = GetConversationNotReceiveMessageUserIDsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[34]
+	mi := &file_conversation_conversation_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2122,7 +2316,7 @@ func (x *GetConversationNotReceiveMessageUserIDsReq) String() string {
 func (*GetConversationNotReceiveMessageUserIDsReq) ProtoMessage() {}
 
 func (x *GetConversationNotReceiveMessageUserIDsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[34]
+	mi := &file_conversation_conversation_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2135,7 +2329,7 @@ func (x *GetConversationNotReceiveMessageUserIDsReq) ProtoReflect() protoreflect
 
 // Deprecated: Use GetConversationNotReceiveMessageUserIDsReq.ProtoReflect.Descriptor instead.
 func (*GetConversationNotReceiveMessageUserIDsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{34}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetConversationNotReceiveMessageUserIDsReq) GetConversationID() string {
@@ -2154,7 +2348,7 @@ type GetConversationNotReceiveMessageUserIDsResp struct {
 
 func (x *GetConversationNotReceiveMessageUserIDsResp) Reset() {
 	*x = GetConversationNotReceiveMessageUserIDsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[35]
+	mi := &file_conversation_conversation_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2166,7 +2360,7 @@ func (x *GetConversationNotReceiveMessageUserIDsResp) String() string {
 func (*GetConversationNotReceiveMessageUserIDsResp) ProtoMessage() {}
 
 func (x *GetConversationNotReceiveMessageUserIDsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[35]
+	mi := &file_conversation_conversation_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2179,7 +2373,7 @@ func (x *GetConversationNotReceiveMessageUserIDsResp) ProtoReflect() protoreflec
 
 // Deprecated: Use GetConversationNotReceiveMessageUserIDsResp.ProtoReflect.Descriptor instead.
 func (*GetConversationNotReceiveMessageUserIDsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{35}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetConversationNotReceiveMessageUserIDsResp) GetUserIDs() []string {
@@ -2214,7 +2408,7 @@ type UpdateConversationReq struct {
 
 func (x *UpdateConversationReq) Reset() {
 	*x = UpdateConversationReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[36]
+	mi := &file_conversation_conversation_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2226,7 +2420,7 @@ func (x *UpdateConversationReq) String() string {
 func (*UpdateConversationReq) ProtoMessage() {}
 
 func (x *UpdateConversationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[36]
+	mi := &file_conversation_conversation_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2239,7 +2433,7 @@ func (x *UpdateConversationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConversationReq.ProtoReflect.Descriptor instead.
 func (*UpdateConversationReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{36}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *UpdateConversationReq) GetConversationID() string {
@@ -2369,7 +2563,7 @@ type UpdateConversationResp struct {
 
 func (x *UpdateConversationResp) Reset() {
 	*x = UpdateConversationResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[37]
+	mi := &file_conversation_conversation_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2381,7 +2575,7 @@ func (x *UpdateConversationResp) String() string {
 func (*UpdateConversationResp) ProtoMessage() {}
 
 func (x *UpdateConversationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[37]
+	mi := &file_conversation_conversation_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2394,7 +2588,7 @@ func (x *UpdateConversationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConversationResp.ProtoReflect.Descriptor instead.
 func (*UpdateConversationResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{37}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{39}
 }
 
 type GetFullOwnerConversationIDsReq struct {
@@ -2407,7 +2601,7 @@ type GetFullOwnerConversationIDsReq struct {
 
 func (x *GetFullOwnerConversationIDsReq) Reset() {
 	*x = GetFullOwnerConversationIDsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[38]
+	mi := &file_conversation_conversation_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2419,7 +2613,7 @@ func (x *GetFullOwnerConversationIDsReq) String() string {
 func (*GetFullOwnerConversationIDsReq) ProtoMessage() {}
 
 func (x *GetFullOwnerConversationIDsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[38]
+	mi := &file_conversation_conversation_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2432,7 +2626,7 @@ func (x *GetFullOwnerConversationIDsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFullOwnerConversationIDsReq.ProtoReflect.Descriptor instead.
 func (*GetFullOwnerConversationIDsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{38}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetFullOwnerConversationIDsReq) GetIdHash() uint64 {
@@ -2461,7 +2655,7 @@ type GetFullOwnerConversationIDsResp struct {
 
 func (x *GetFullOwnerConversationIDsResp) Reset() {
 	*x = GetFullOwnerConversationIDsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[39]
+	mi := &file_conversation_conversation_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2473,7 +2667,7 @@ func (x *GetFullOwnerConversationIDsResp) String() string {
 func (*GetFullOwnerConversationIDsResp) ProtoMessage() {}
 
 func (x *GetFullOwnerConversationIDsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[39]
+	mi := &file_conversation_conversation_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2486,7 +2680,7 @@ func (x *GetFullOwnerConversationIDsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFullOwnerConversationIDsResp.ProtoReflect.Descriptor instead.
 func (*GetFullOwnerConversationIDsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{39}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetFullOwnerConversationIDsResp) GetVersion() uint64 {
@@ -2528,7 +2722,7 @@ type GetIncrementalConversationReq struct {
 
 func (x *GetIncrementalConversationReq) Reset() {
 	*x = GetIncrementalConversationReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[40]
+	mi := &file_conversation_conversation_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2540,7 +2734,7 @@ func (x *GetIncrementalConversationReq) String() string {
 func (*GetIncrementalConversationReq) ProtoMessage() {}
 
 func (x *GetIncrementalConversationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[40]
+	mi := &file_conversation_conversation_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2553,7 +2747,7 @@ func (x *GetIncrementalConversationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIncrementalConversationReq.ProtoReflect.Descriptor instead.
 func (*GetIncrementalConversationReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{40}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetIncrementalConversationReq) GetUserID() string {
@@ -2591,7 +2785,7 @@ type GetIncrementalConversationResp struct {
 
 func (x *GetIncrementalConversationResp) Reset() {
 	*x = GetIncrementalConversationResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[41]
+	mi := &file_conversation_conversation_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2603,7 +2797,7 @@ func (x *GetIncrementalConversationResp) String() string {
 func (*GetIncrementalConversationResp) ProtoMessage() {}
 
 func (x *GetIncrementalConversationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[41]
+	mi := &file_conversation_conversation_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2616,7 +2810,7 @@ func (x *GetIncrementalConversationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIncrementalConversationResp.ProtoReflect.Descriptor instead.
 func (*GetIncrementalConversationResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{41}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetIncrementalConversationResp) GetVersion() uint64 {
@@ -2671,7 +2865,7 @@ type GetOwnerConversationReq struct {
 
 func (x *GetOwnerConversationReq) Reset() {
 	*x = GetOwnerConversationReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[42]
+	mi := &file_conversation_conversation_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2683,7 +2877,7 @@ func (x *GetOwnerConversationReq) String() string {
 func (*GetOwnerConversationReq) ProtoMessage() {}
 
 func (x *GetOwnerConversationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[42]
+	mi := &file_conversation_conversation_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2696,7 +2890,7 @@ func (x *GetOwnerConversationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOwnerConversationReq.ProtoReflect.Descriptor instead.
 func (*GetOwnerConversationReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{42}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetOwnerConversationReq) GetUserID() string {
@@ -2723,7 +2917,7 @@ type GetOwnerConversationResp struct {
 
 func (x *GetOwnerConversationResp) Reset() {
 	*x = GetOwnerConversationResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[43]
+	mi := &file_conversation_conversation_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2735,7 +2929,7 @@ func (x *GetOwnerConversationResp) String() string {
 func (*GetOwnerConversationResp) ProtoMessage() {}
 
 func (x *GetOwnerConversationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[43]
+	mi := &file_conversation_conversation_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2748,7 +2942,7 @@ func (x *GetOwnerConversationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOwnerConversationResp.ProtoReflect.Descriptor instead.
 func (*GetOwnerConversationResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{43}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetOwnerConversationResp) GetTotal() int64 {
@@ -2773,7 +2967,7 @@ type GetConversationsNeedClearMsgReq struct {
 
 func (x *GetConversationsNeedClearMsgReq) Reset() {
 	*x = GetConversationsNeedClearMsgReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[44]
+	mi := &file_conversation_conversation_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2785,7 +2979,7 @@ func (x *GetConversationsNeedClearMsgReq) String() string {
 func (*GetConversationsNeedClearMsgReq) ProtoMessage() {}
 
 func (x *GetConversationsNeedClearMsgReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[44]
+	mi := &file_conversation_conversation_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2798,7 +2992,7 @@ func (x *GetConversationsNeedClearMsgReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationsNeedClearMsgReq.ProtoReflect.Descriptor instead.
 func (*GetConversationsNeedClearMsgReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{44}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{46}
 }
 
 type GetConversationsNeedClearMsgResp struct {
@@ -2810,7 +3004,7 @@ type GetConversationsNeedClearMsgResp struct {
 
 func (x *GetConversationsNeedClearMsgResp) Reset() {
 	*x = GetConversationsNeedClearMsgResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[45]
+	mi := &file_conversation_conversation_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2822,7 +3016,7 @@ func (x *GetConversationsNeedClearMsgResp) String() string {
 func (*GetConversationsNeedClearMsgResp) ProtoMessage() {}
 
 func (x *GetConversationsNeedClearMsgResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[45]
+	mi := &file_conversation_conversation_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2835,7 +3029,7 @@ func (x *GetConversationsNeedClearMsgResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationsNeedClearMsgResp.ProtoReflect.Descriptor instead.
 func (*GetConversationsNeedClearMsgResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{45}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetConversationsNeedClearMsgResp) GetConversations() []*Conversation {
@@ -2854,7 +3048,7 @@ type GetNotNotifyConversationIDsReq struct {
 
 func (x *GetNotNotifyConversationIDsReq) Reset() {
 	*x = GetNotNotifyConversationIDsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[46]
+	mi := &file_conversation_conversation_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2866,7 +3060,7 @@ func (x *GetNotNotifyConversationIDsReq) String() string {
 func (*GetNotNotifyConversationIDsReq) ProtoMessage() {}
 
 func (x *GetNotNotifyConversationIDsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[46]
+	mi := &file_conversation_conversation_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2879,7 +3073,7 @@ func (x *GetNotNotifyConversationIDsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotNotifyConversationIDsReq.ProtoReflect.Descriptor instead.
 func (*GetNotNotifyConversationIDsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{46}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetNotNotifyConversationIDsReq) GetUserID() string {
@@ -2898,7 +3092,7 @@ type GetNotNotifyConversationIDsResp struct {
 
 func (x *GetNotNotifyConversationIDsResp) Reset() {
 	*x = GetNotNotifyConversationIDsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[47]
+	mi := &file_conversation_conversation_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2910,7 +3104,7 @@ func (x *GetNotNotifyConversationIDsResp) String() string {
 func (*GetNotNotifyConversationIDsResp) ProtoMessage() {}
 
 func (x *GetNotNotifyConversationIDsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[47]
+	mi := &file_conversation_conversation_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2923,7 +3117,7 @@ func (x *GetNotNotifyConversationIDsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNotNotifyConversationIDsResp.ProtoReflect.Descriptor instead.
 func (*GetNotNotifyConversationIDsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{47}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetNotNotifyConversationIDsResp) GetConversationIDs() []string {
@@ -2942,7 +3136,7 @@ type GetPinnedConversationIDsReq struct {
 
 func (x *GetPinnedConversationIDsReq) Reset() {
 	*x = GetPinnedConversationIDsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[48]
+	mi := &file_conversation_conversation_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2954,7 +3148,7 @@ func (x *GetPinnedConversationIDsReq) String() string {
 func (*GetPinnedConversationIDsReq) ProtoMessage() {}
 
 func (x *GetPinnedConversationIDsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[48]
+	mi := &file_conversation_conversation_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2967,7 +3161,7 @@ func (x *GetPinnedConversationIDsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPinnedConversationIDsReq.ProtoReflect.Descriptor instead.
 func (*GetPinnedConversationIDsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{48}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetPinnedConversationIDsReq) GetUserID() string {
@@ -2986,7 +3180,7 @@ type GetPinnedConversationIDsResp struct {
 
 func (x *GetPinnedConversationIDsResp) Reset() {
 	*x = GetPinnedConversationIDsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[49]
+	mi := &file_conversation_conversation_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2998,7 +3192,7 @@ func (x *GetPinnedConversationIDsResp) String() string {
 func (*GetPinnedConversationIDsResp) ProtoMessage() {}
 
 func (x *GetPinnedConversationIDsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[49]
+	mi := &file_conversation_conversation_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3011,7 +3205,7 @@ func (x *GetPinnedConversationIDsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPinnedConversationIDsResp.ProtoReflect.Descriptor instead.
 func (*GetPinnedConversationIDsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{49}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetPinnedConversationIDsResp) GetConversationIDs() []string {
@@ -3032,7 +3226,7 @@ type MarkConversationReq struct {
 
 func (x *MarkConversationReq) Reset() {
 	*x = MarkConversationReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[50]
+	mi := &file_conversation_conversation_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3044,7 +3238,7 @@ func (x *MarkConversationReq) String() string {
 func (*MarkConversationReq) ProtoMessage() {}
 
 func (x *MarkConversationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[50]
+	mi := &file_conversation_conversation_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3057,7 +3251,7 @@ func (x *MarkConversationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkConversationReq.ProtoReflect.Descriptor instead.
 func (*MarkConversationReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{50}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *MarkConversationReq) GetUserID() string {
@@ -3091,7 +3285,7 @@ type MarkConversationResp struct {
 
 func (x *MarkConversationResp) Reset() {
 	*x = MarkConversationResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[51]
+	mi := &file_conversation_conversation_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3103,7 +3297,7 @@ func (x *MarkConversationResp) String() string {
 func (*MarkConversationResp) ProtoMessage() {}
 
 func (x *MarkConversationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[51]
+	mi := &file_conversation_conversation_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3116,7 +3310,7 @@ func (x *MarkConversationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkConversationResp.ProtoReflect.Descriptor instead.
 func (*MarkConversationResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{51}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *MarkConversationResp) GetSuccess() bool {
@@ -3144,7 +3338,7 @@ type MarkConversationAsUnreadReq struct {
 
 func (x *MarkConversationAsUnreadReq) Reset() {
 	*x = MarkConversationAsUnreadReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[52]
+	mi := &file_conversation_conversation_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3156,7 +3350,7 @@ func (x *MarkConversationAsUnreadReq) String() string {
 func (*MarkConversationAsUnreadReq) ProtoMessage() {}
 
 func (x *MarkConversationAsUnreadReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[52]
+	mi := &file_conversation_conversation_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3169,7 +3363,7 @@ func (x *MarkConversationAsUnreadReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkConversationAsUnreadReq.ProtoReflect.Descriptor instead.
 func (*MarkConversationAsUnreadReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{52}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *MarkConversationAsUnreadReq) GetUserID() string {
@@ -3201,7 +3395,7 @@ type MarkConversationAsUnreadResp struct {
 
 func (x *MarkConversationAsUnreadResp) Reset() {
 	*x = MarkConversationAsUnreadResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[53]
+	mi := &file_conversation_conversation_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3213,7 +3407,7 @@ func (x *MarkConversationAsUnreadResp) String() string {
 func (*MarkConversationAsUnreadResp) ProtoMessage() {}
 
 func (x *MarkConversationAsUnreadResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[53]
+	mi := &file_conversation_conversation_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3226,7 +3420,7 @@ func (x *MarkConversationAsUnreadResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkConversationAsUnreadResp.ProtoReflect.Descriptor instead.
 func (*MarkConversationAsUnreadResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{53}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{55}
 }
 
 type ClearUserConversationMsgReq struct {
@@ -3239,7 +3433,7 @@ type ClearUserConversationMsgReq struct {
 
 func (x *ClearUserConversationMsgReq) Reset() {
 	*x = ClearUserConversationMsgReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[54]
+	mi := &file_conversation_conversation_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3251,7 +3445,7 @@ func (x *ClearUserConversationMsgReq) String() string {
 func (*ClearUserConversationMsgReq) ProtoMessage() {}
 
 func (x *ClearUserConversationMsgReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[54]
+	mi := &file_conversation_conversation_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3264,7 +3458,7 @@ func (x *ClearUserConversationMsgReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearUserConversationMsgReq.ProtoReflect.Descriptor instead.
 func (*ClearUserConversationMsgReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{54}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ClearUserConversationMsgReq) GetTimestamp() int64 {
@@ -3290,7 +3484,7 @@ type ClearUserConversationMsgResp struct {
 
 func (x *ClearUserConversationMsgResp) Reset() {
 	*x = ClearUserConversationMsgResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[55]
+	mi := &file_conversation_conversation_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3302,7 +3496,7 @@ func (x *ClearUserConversationMsgResp) String() string {
 func (*ClearUserConversationMsgResp) ProtoMessage() {}
 
 func (x *ClearUserConversationMsgResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[55]
+	mi := &file_conversation_conversation_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3315,7 +3509,7 @@ func (x *ClearUserConversationMsgResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearUserConversationMsgResp.ProtoReflect.Descriptor instead.
 func (*ClearUserConversationMsgResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{55}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ClearUserConversationMsgResp) GetCount() int32 {
@@ -3335,7 +3529,7 @@ type UpdateConversationsByUserReq struct {
 
 func (x *UpdateConversationsByUserReq) Reset() {
 	*x = UpdateConversationsByUserReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[56]
+	mi := &file_conversation_conversation_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3347,7 +3541,7 @@ func (x *UpdateConversationsByUserReq) String() string {
 func (*UpdateConversationsByUserReq) ProtoMessage() {}
 
 func (x *UpdateConversationsByUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[56]
+	mi := &file_conversation_conversation_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3360,7 +3554,7 @@ func (x *UpdateConversationsByUserReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConversationsByUserReq.ProtoReflect.Descriptor instead.
 func (*UpdateConversationsByUserReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{56}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *UpdateConversationsByUserReq) GetUserID() string {
@@ -3385,7 +3579,7 @@ type UpdateConversationsByUserResp struct {
 
 func (x *UpdateConversationsByUserResp) Reset() {
 	*x = UpdateConversationsByUserResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[57]
+	mi := &file_conversation_conversation_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3397,7 +3591,7 @@ func (x *UpdateConversationsByUserResp) String() string {
 func (*UpdateConversationsByUserResp) ProtoMessage() {}
 
 func (x *UpdateConversationsByUserResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[57]
+	mi := &file_conversation_conversation_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3410,7 +3604,7 @@ func (x *UpdateConversationsByUserResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConversationsByUserResp.ProtoReflect.Descriptor instead.
 func (*UpdateConversationsByUserResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{57}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{59}
 }
 
 type DeleteConversationsReq struct {
@@ -3424,7 +3618,7 @@ type DeleteConversationsReq struct {
 
 func (x *DeleteConversationsReq) Reset() {
 	*x = DeleteConversationsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[58]
+	mi := &file_conversation_conversation_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3436,7 +3630,7 @@ func (x *DeleteConversationsReq) String() string {
 func (*DeleteConversationsReq) ProtoMessage() {}
 
 func (x *DeleteConversationsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[58]
+	mi := &file_conversation_conversation_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3449,7 +3643,7 @@ func (x *DeleteConversationsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteConversationsReq.ProtoReflect.Descriptor instead.
 func (*DeleteConversationsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{58}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *DeleteConversationsReq) GetOwnerUserID() string {
@@ -3481,7 +3675,7 @@ type DeleteConversationsResp struct {
 
 func (x *DeleteConversationsResp) Reset() {
 	*x = DeleteConversationsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[59]
+	mi := &file_conversation_conversation_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3493,7 +3687,7 @@ func (x *DeleteConversationsResp) String() string {
 func (*DeleteConversationsResp) ProtoMessage() {}
 
 func (x *DeleteConversationsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[59]
+	mi := &file_conversation_conversation_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3506,7 +3700,7 @@ func (x *DeleteConversationsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteConversationsResp.ProtoReflect.Descriptor instead.
 func (*DeleteConversationsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{59}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{61}
 }
 
 // UnhideConversationsIfNeeded 取消隐藏会话（如果消息 seq > hiddenSeq）
@@ -3522,7 +3716,7 @@ type UnhideConversationsIfNeededReq struct {
 
 func (x *UnhideConversationsIfNeededReq) Reset() {
 	*x = UnhideConversationsIfNeededReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[60]
+	mi := &file_conversation_conversation_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3534,7 +3728,7 @@ func (x *UnhideConversationsIfNeededReq) String() string {
 func (*UnhideConversationsIfNeededReq) ProtoMessage() {}
 
 func (x *UnhideConversationsIfNeededReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[60]
+	mi := &file_conversation_conversation_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3547,7 +3741,7 @@ func (x *UnhideConversationsIfNeededReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnhideConversationsIfNeededReq.ProtoReflect.Descriptor instead.
 func (*UnhideConversationsIfNeededReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{60}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *UnhideConversationsIfNeededReq) GetConversationID() string {
@@ -3580,7 +3774,7 @@ type UnhideConversationsIfNeededResp struct {
 
 func (x *UnhideConversationsIfNeededResp) Reset() {
 	*x = UnhideConversationsIfNeededResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[61]
+	mi := &file_conversation_conversation_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3592,7 +3786,7 @@ func (x *UnhideConversationsIfNeededResp) String() string {
 func (*UnhideConversationsIfNeededResp) ProtoMessage() {}
 
 func (x *UnhideConversationsIfNeededResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[61]
+	mi := &file_conversation_conversation_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3605,7 +3799,7 @@ func (x *UnhideConversationsIfNeededResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnhideConversationsIfNeededResp.ProtoReflect.Descriptor instead.
 func (*UnhideConversationsIfNeededResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{61}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *UnhideConversationsIfNeededResp) GetUnhiddenUserIDs() []string {
@@ -3633,7 +3827,7 @@ type ConversationGroup struct {
 
 func (x *ConversationGroup) Reset() {
 	*x = ConversationGroup{}
-	mi := &file_conversation_conversation_proto_msgTypes[62]
+	mi := &file_conversation_conversation_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3645,7 +3839,7 @@ func (x *ConversationGroup) String() string {
 func (*ConversationGroup) ProtoMessage() {}
 
 func (x *ConversationGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[62]
+	mi := &file_conversation_conversation_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3658,7 +3852,7 @@ func (x *ConversationGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversationGroup.ProtoReflect.Descriptor instead.
 func (*ConversationGroup) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{62}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *ConversationGroup) GetGroupID() string {
@@ -3734,7 +3928,7 @@ type GroupOrder struct {
 
 func (x *GroupOrder) Reset() {
 	*x = GroupOrder{}
-	mi := &file_conversation_conversation_proto_msgTypes[63]
+	mi := &file_conversation_conversation_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3746,7 +3940,7 @@ func (x *GroupOrder) String() string {
 func (*GroupOrder) ProtoMessage() {}
 
 func (x *GroupOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[63]
+	mi := &file_conversation_conversation_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3759,7 +3953,7 @@ func (x *GroupOrder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupOrder.ProtoReflect.Descriptor instead.
 func (*GroupOrder) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{63}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *GroupOrder) GetGroupID() string {
@@ -3786,7 +3980,7 @@ type InitConversationGroupsReq struct {
 
 func (x *InitConversationGroupsReq) Reset() {
 	*x = InitConversationGroupsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[64]
+	mi := &file_conversation_conversation_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3798,7 +3992,7 @@ func (x *InitConversationGroupsReq) String() string {
 func (*InitConversationGroupsReq) ProtoMessage() {}
 
 func (x *InitConversationGroupsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[64]
+	mi := &file_conversation_conversation_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3811,7 +4005,7 @@ func (x *InitConversationGroupsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitConversationGroupsReq.ProtoReflect.Descriptor instead.
 func (*InitConversationGroupsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{64}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *InitConversationGroupsReq) GetOwnerUserID() string {
@@ -3829,7 +4023,7 @@ type InitConversationGroupsResp struct {
 
 func (x *InitConversationGroupsResp) Reset() {
 	*x = InitConversationGroupsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[65]
+	mi := &file_conversation_conversation_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3841,7 +4035,7 @@ func (x *InitConversationGroupsResp) String() string {
 func (*InitConversationGroupsResp) ProtoMessage() {}
 
 func (x *InitConversationGroupsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[65]
+	mi := &file_conversation_conversation_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3854,7 +4048,7 @@ func (x *InitConversationGroupsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitConversationGroupsResp.ProtoReflect.Descriptor instead.
 func (*InitConversationGroupsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{65}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{67}
 }
 
 type GetAllConversationGroupsReq struct {
@@ -3866,7 +4060,7 @@ type GetAllConversationGroupsReq struct {
 
 func (x *GetAllConversationGroupsReq) Reset() {
 	*x = GetAllConversationGroupsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[66]
+	mi := &file_conversation_conversation_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3878,7 +4072,7 @@ func (x *GetAllConversationGroupsReq) String() string {
 func (*GetAllConversationGroupsReq) ProtoMessage() {}
 
 func (x *GetAllConversationGroupsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[66]
+	mi := &file_conversation_conversation_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3891,7 +4085,7 @@ func (x *GetAllConversationGroupsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllConversationGroupsReq.ProtoReflect.Descriptor instead.
 func (*GetAllConversationGroupsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{66}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *GetAllConversationGroupsReq) GetOwnerUserID() string {
@@ -3910,7 +4104,7 @@ type GetAllConversationGroupsResp struct {
 
 func (x *GetAllConversationGroupsResp) Reset() {
 	*x = GetAllConversationGroupsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[67]
+	mi := &file_conversation_conversation_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3922,7 +4116,7 @@ func (x *GetAllConversationGroupsResp) String() string {
 func (*GetAllConversationGroupsResp) ProtoMessage() {}
 
 func (x *GetAllConversationGroupsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[67]
+	mi := &file_conversation_conversation_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3935,7 +4129,7 @@ func (x *GetAllConversationGroupsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllConversationGroupsResp.ProtoReflect.Descriptor instead.
 func (*GetAllConversationGroupsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{67}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *GetAllConversationGroupsResp) GetGroups() []*ConversationGroup {
@@ -3954,7 +4148,7 @@ type GetVisibleConversationGroupsReq struct {
 
 func (x *GetVisibleConversationGroupsReq) Reset() {
 	*x = GetVisibleConversationGroupsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[68]
+	mi := &file_conversation_conversation_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3966,7 +4160,7 @@ func (x *GetVisibleConversationGroupsReq) String() string {
 func (*GetVisibleConversationGroupsReq) ProtoMessage() {}
 
 func (x *GetVisibleConversationGroupsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[68]
+	mi := &file_conversation_conversation_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3979,7 +4173,7 @@ func (x *GetVisibleConversationGroupsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVisibleConversationGroupsReq.ProtoReflect.Descriptor instead.
 func (*GetVisibleConversationGroupsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{68}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *GetVisibleConversationGroupsReq) GetOwnerUserID() string {
@@ -3998,7 +4192,7 @@ type GetVisibleConversationGroupsResp struct {
 
 func (x *GetVisibleConversationGroupsResp) Reset() {
 	*x = GetVisibleConversationGroupsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[69]
+	mi := &file_conversation_conversation_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4010,7 +4204,7 @@ func (x *GetVisibleConversationGroupsResp) String() string {
 func (*GetVisibleConversationGroupsResp) ProtoMessage() {}
 
 func (x *GetVisibleConversationGroupsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[69]
+	mi := &file_conversation_conversation_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4023,7 +4217,7 @@ func (x *GetVisibleConversationGroupsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVisibleConversationGroupsResp.ProtoReflect.Descriptor instead.
 func (*GetVisibleConversationGroupsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{69}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *GetVisibleConversationGroupsResp) GetGroups() []*ConversationGroup {
@@ -4045,7 +4239,7 @@ type CreateConversationGroupReq struct {
 
 func (x *CreateConversationGroupReq) Reset() {
 	*x = CreateConversationGroupReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[70]
+	mi := &file_conversation_conversation_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4057,7 +4251,7 @@ func (x *CreateConversationGroupReq) String() string {
 func (*CreateConversationGroupReq) ProtoMessage() {}
 
 func (x *CreateConversationGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[70]
+	mi := &file_conversation_conversation_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4070,7 +4264,7 @@ func (x *CreateConversationGroupReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateConversationGroupReq.ProtoReflect.Descriptor instead.
 func (*CreateConversationGroupReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{70}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *CreateConversationGroupReq) GetOwnerUserID() string {
@@ -4110,7 +4304,7 @@ type CreateConversationGroupResp struct {
 
 func (x *CreateConversationGroupResp) Reset() {
 	*x = CreateConversationGroupResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[71]
+	mi := &file_conversation_conversation_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4122,7 +4316,7 @@ func (x *CreateConversationGroupResp) String() string {
 func (*CreateConversationGroupResp) ProtoMessage() {}
 
 func (x *CreateConversationGroupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[71]
+	mi := &file_conversation_conversation_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4135,7 +4329,7 @@ func (x *CreateConversationGroupResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateConversationGroupResp.ProtoReflect.Descriptor instead.
 func (*CreateConversationGroupResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{71}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *CreateConversationGroupResp) GetGroup() *ConversationGroup {
@@ -4157,7 +4351,7 @@ type UpdateConversationGroupReq struct {
 
 func (x *UpdateConversationGroupReq) Reset() {
 	*x = UpdateConversationGroupReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[72]
+	mi := &file_conversation_conversation_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4169,7 +4363,7 @@ func (x *UpdateConversationGroupReq) String() string {
 func (*UpdateConversationGroupReq) ProtoMessage() {}
 
 func (x *UpdateConversationGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[72]
+	mi := &file_conversation_conversation_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4182,7 +4376,7 @@ func (x *UpdateConversationGroupReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConversationGroupReq.ProtoReflect.Descriptor instead.
 func (*UpdateConversationGroupReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{72}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *UpdateConversationGroupReq) GetGroupID() string {
@@ -4221,7 +4415,7 @@ type UpdateConversationGroupResp struct {
 
 func (x *UpdateConversationGroupResp) Reset() {
 	*x = UpdateConversationGroupResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[73]
+	mi := &file_conversation_conversation_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4233,7 +4427,7 @@ func (x *UpdateConversationGroupResp) String() string {
 func (*UpdateConversationGroupResp) ProtoMessage() {}
 
 func (x *UpdateConversationGroupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[73]
+	mi := &file_conversation_conversation_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4246,7 +4440,7 @@ func (x *UpdateConversationGroupResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConversationGroupResp.ProtoReflect.Descriptor instead.
 func (*UpdateConversationGroupResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{73}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{75}
 }
 
 type DeleteConversationGroupReq struct {
@@ -4258,7 +4452,7 @@ type DeleteConversationGroupReq struct {
 
 func (x *DeleteConversationGroupReq) Reset() {
 	*x = DeleteConversationGroupReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[74]
+	mi := &file_conversation_conversation_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4270,7 +4464,7 @@ func (x *DeleteConversationGroupReq) String() string {
 func (*DeleteConversationGroupReq) ProtoMessage() {}
 
 func (x *DeleteConversationGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[74]
+	mi := &file_conversation_conversation_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4283,7 +4477,7 @@ func (x *DeleteConversationGroupReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteConversationGroupReq.ProtoReflect.Descriptor instead.
 func (*DeleteConversationGroupReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{74}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *DeleteConversationGroupReq) GetGroupID() string {
@@ -4301,7 +4495,7 @@ type DeleteConversationGroupResp struct {
 
 func (x *DeleteConversationGroupResp) Reset() {
 	*x = DeleteConversationGroupResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[75]
+	mi := &file_conversation_conversation_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4313,7 +4507,7 @@ func (x *DeleteConversationGroupResp) String() string {
 func (*DeleteConversationGroupResp) ProtoMessage() {}
 
 func (x *DeleteConversationGroupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[75]
+	mi := &file_conversation_conversation_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4326,7 +4520,7 @@ func (x *DeleteConversationGroupResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteConversationGroupResp.ProtoReflect.Descriptor instead.
 func (*DeleteConversationGroupResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{75}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{77}
 }
 
 type UpdateConversationGroupSortReq struct {
@@ -4339,7 +4533,7 @@ type UpdateConversationGroupSortReq struct {
 
 func (x *UpdateConversationGroupSortReq) Reset() {
 	*x = UpdateConversationGroupSortReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[76]
+	mi := &file_conversation_conversation_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4351,7 +4545,7 @@ func (x *UpdateConversationGroupSortReq) String() string {
 func (*UpdateConversationGroupSortReq) ProtoMessage() {}
 
 func (x *UpdateConversationGroupSortReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[76]
+	mi := &file_conversation_conversation_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4364,7 +4558,7 @@ func (x *UpdateConversationGroupSortReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConversationGroupSortReq.ProtoReflect.Descriptor instead.
 func (*UpdateConversationGroupSortReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{76}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *UpdateConversationGroupSortReq) GetOwnerUserID() string {
@@ -4389,7 +4583,7 @@ type UpdateConversationGroupSortResp struct {
 
 func (x *UpdateConversationGroupSortResp) Reset() {
 	*x = UpdateConversationGroupSortResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[77]
+	mi := &file_conversation_conversation_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4401,7 +4595,7 @@ func (x *UpdateConversationGroupSortResp) String() string {
 func (*UpdateConversationGroupSortResp) ProtoMessage() {}
 
 func (x *UpdateConversationGroupSortResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[77]
+	mi := &file_conversation_conversation_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4414,7 +4608,7 @@ func (x *UpdateConversationGroupSortResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConversationGroupSortResp.ProtoReflect.Descriptor instead.
 func (*UpdateConversationGroupSortResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{77}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{79}
 }
 
 type SetConversationGroupVisibilityReq struct {
@@ -4427,7 +4621,7 @@ type SetConversationGroupVisibilityReq struct {
 
 func (x *SetConversationGroupVisibilityReq) Reset() {
 	*x = SetConversationGroupVisibilityReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[78]
+	mi := &file_conversation_conversation_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4439,7 +4633,7 @@ func (x *SetConversationGroupVisibilityReq) String() string {
 func (*SetConversationGroupVisibilityReq) ProtoMessage() {}
 
 func (x *SetConversationGroupVisibilityReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[78]
+	mi := &file_conversation_conversation_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4452,7 +4646,7 @@ func (x *SetConversationGroupVisibilityReq) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SetConversationGroupVisibilityReq.ProtoReflect.Descriptor instead.
 func (*SetConversationGroupVisibilityReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{78}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *SetConversationGroupVisibilityReq) GetGroupID() string {
@@ -4477,7 +4671,7 @@ type SetConversationGroupVisibilityResp struct {
 
 func (x *SetConversationGroupVisibilityResp) Reset() {
 	*x = SetConversationGroupVisibilityResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[79]
+	mi := &file_conversation_conversation_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4489,7 +4683,7 @@ func (x *SetConversationGroupVisibilityResp) String() string {
 func (*SetConversationGroupVisibilityResp) ProtoMessage() {}
 
 func (x *SetConversationGroupVisibilityResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[79]
+	mi := &file_conversation_conversation_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4502,7 +4696,7 @@ func (x *SetConversationGroupVisibilityResp) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SetConversationGroupVisibilityResp.ProtoReflect.Descriptor instead.
 func (*SetConversationGroupVisibilityResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{79}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{81}
 }
 
 type AddConversationsToGroupReq struct {
@@ -4516,7 +4710,7 @@ type AddConversationsToGroupReq struct {
 
 func (x *AddConversationsToGroupReq) Reset() {
 	*x = AddConversationsToGroupReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[80]
+	mi := &file_conversation_conversation_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4528,7 +4722,7 @@ func (x *AddConversationsToGroupReq) String() string {
 func (*AddConversationsToGroupReq) ProtoMessage() {}
 
 func (x *AddConversationsToGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[80]
+	mi := &file_conversation_conversation_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4541,7 +4735,7 @@ func (x *AddConversationsToGroupReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddConversationsToGroupReq.ProtoReflect.Descriptor instead.
 func (*AddConversationsToGroupReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{80}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *AddConversationsToGroupReq) GetOwnerUserID() string {
@@ -4573,7 +4767,7 @@ type AddConversationsToGroupResp struct {
 
 func (x *AddConversationsToGroupResp) Reset() {
 	*x = AddConversationsToGroupResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[81]
+	mi := &file_conversation_conversation_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4585,7 +4779,7 @@ func (x *AddConversationsToGroupResp) String() string {
 func (*AddConversationsToGroupResp) ProtoMessage() {}
 
 func (x *AddConversationsToGroupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[81]
+	mi := &file_conversation_conversation_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4598,7 +4792,7 @@ func (x *AddConversationsToGroupResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddConversationsToGroupResp.ProtoReflect.Descriptor instead.
 func (*AddConversationsToGroupResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{81}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{83}
 }
 
 type RemoveConversationsFromGroupReq struct {
@@ -4611,7 +4805,7 @@ type RemoveConversationsFromGroupReq struct {
 
 func (x *RemoveConversationsFromGroupReq) Reset() {
 	*x = RemoveConversationsFromGroupReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[82]
+	mi := &file_conversation_conversation_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4623,7 +4817,7 @@ func (x *RemoveConversationsFromGroupReq) String() string {
 func (*RemoveConversationsFromGroupReq) ProtoMessage() {}
 
 func (x *RemoveConversationsFromGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[82]
+	mi := &file_conversation_conversation_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4636,7 +4830,7 @@ func (x *RemoveConversationsFromGroupReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveConversationsFromGroupReq.ProtoReflect.Descriptor instead.
 func (*RemoveConversationsFromGroupReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{82}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *RemoveConversationsFromGroupReq) GetGroupID() string {
@@ -4661,7 +4855,7 @@ type RemoveConversationsFromGroupResp struct {
 
 func (x *RemoveConversationsFromGroupResp) Reset() {
 	*x = RemoveConversationsFromGroupResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[83]
+	mi := &file_conversation_conversation_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4673,7 +4867,7 @@ func (x *RemoveConversationsFromGroupResp) String() string {
 func (*RemoveConversationsFromGroupResp) ProtoMessage() {}
 
 func (x *RemoveConversationsFromGroupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[83]
+	mi := &file_conversation_conversation_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4686,7 +4880,7 @@ func (x *RemoveConversationsFromGroupResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveConversationsFromGroupResp.ProtoReflect.Descriptor instead.
 func (*RemoveConversationsFromGroupResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{83}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{85}
 }
 
 type GetConversationIDsByGroupIDReq struct {
@@ -4698,7 +4892,7 @@ type GetConversationIDsByGroupIDReq struct {
 
 func (x *GetConversationIDsByGroupIDReq) Reset() {
 	*x = GetConversationIDsByGroupIDReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[84]
+	mi := &file_conversation_conversation_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4710,7 +4904,7 @@ func (x *GetConversationIDsByGroupIDReq) String() string {
 func (*GetConversationIDsByGroupIDReq) ProtoMessage() {}
 
 func (x *GetConversationIDsByGroupIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[84]
+	mi := &file_conversation_conversation_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4723,7 +4917,7 @@ func (x *GetConversationIDsByGroupIDReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationIDsByGroupIDReq.ProtoReflect.Descriptor instead.
 func (*GetConversationIDsByGroupIDReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{84}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *GetConversationIDsByGroupIDReq) GetGroupID() string {
@@ -4742,7 +4936,7 @@ type GetConversationIDsByGroupIDResp struct {
 
 func (x *GetConversationIDsByGroupIDResp) Reset() {
 	*x = GetConversationIDsByGroupIDResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[85]
+	mi := &file_conversation_conversation_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4754,7 +4948,7 @@ func (x *GetConversationIDsByGroupIDResp) String() string {
 func (*GetConversationIDsByGroupIDResp) ProtoMessage() {}
 
 func (x *GetConversationIDsByGroupIDResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[85]
+	mi := &file_conversation_conversation_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4767,7 +4961,7 @@ func (x *GetConversationIDsByGroupIDResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConversationIDsByGroupIDResp.ProtoReflect.Descriptor instead.
 func (*GetConversationIDsByGroupIDResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{85}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GetConversationIDsByGroupIDResp) GetConversationIDs() []string {
@@ -4790,7 +4984,7 @@ type SetConversationFoldReq struct {
 
 func (x *SetConversationFoldReq) Reset() {
 	*x = SetConversationFoldReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[86]
+	mi := &file_conversation_conversation_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4802,7 +4996,7 @@ func (x *SetConversationFoldReq) String() string {
 func (*SetConversationFoldReq) ProtoMessage() {}
 
 func (x *SetConversationFoldReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[86]
+	mi := &file_conversation_conversation_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4815,7 +5009,7 @@ func (x *SetConversationFoldReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConversationFoldReq.ProtoReflect.Descriptor instead.
 func (*SetConversationFoldReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{86}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *SetConversationFoldReq) GetConversationID() string {
@@ -4840,7 +5034,7 @@ type SetConversationFoldResp struct {
 
 func (x *SetConversationFoldResp) Reset() {
 	*x = SetConversationFoldResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[87]
+	mi := &file_conversation_conversation_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4852,7 +5046,7 @@ func (x *SetConversationFoldResp) String() string {
 func (*SetConversationFoldResp) ProtoMessage() {}
 
 func (x *SetConversationFoldResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[87]
+	mi := &file_conversation_conversation_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4865,7 +5059,7 @@ func (x *SetConversationFoldResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetConversationFoldResp.ProtoReflect.Descriptor instead.
 func (*SetConversationFoldResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{87}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{89}
 }
 
 // GetFoldConversationList 获取折叠内的会话列表
@@ -4882,7 +5076,7 @@ type GetFoldConversationListReq struct {
 
 func (x *GetFoldConversationListReq) Reset() {
 	*x = GetFoldConversationListReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[88]
+	mi := &file_conversation_conversation_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4894,7 +5088,7 @@ func (x *GetFoldConversationListReq) String() string {
 func (*GetFoldConversationListReq) ProtoMessage() {}
 
 func (x *GetFoldConversationListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[88]
+	mi := &file_conversation_conversation_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4907,7 +5101,7 @@ func (x *GetFoldConversationListReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFoldConversationListReq.ProtoReflect.Descriptor instead.
 func (*GetFoldConversationListReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{88}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *GetFoldConversationListReq) GetUserID() string {
@@ -4948,7 +5142,7 @@ type GetFoldConversationListResp struct {
 
 func (x *GetFoldConversationListResp) Reset() {
 	*x = GetFoldConversationListResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[89]
+	mi := &file_conversation_conversation_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4960,7 +5154,7 @@ func (x *GetFoldConversationListResp) String() string {
 func (*GetFoldConversationListResp) ProtoMessage() {}
 
 func (x *GetFoldConversationListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[89]
+	mi := &file_conversation_conversation_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4973,7 +5167,7 @@ func (x *GetFoldConversationListResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFoldConversationListResp.ProtoReflect.Descriptor instead.
 func (*GetFoldConversationListResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{89}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *GetFoldConversationListResp) GetTotal() int64 {
@@ -5000,7 +5194,7 @@ type GetAllFoldsReq struct {
 
 func (x *GetAllFoldsReq) Reset() {
 	*x = GetAllFoldsReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[90]
+	mi := &file_conversation_conversation_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5012,7 +5206,7 @@ func (x *GetAllFoldsReq) String() string {
 func (*GetAllFoldsReq) ProtoMessage() {}
 
 func (x *GetAllFoldsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[90]
+	mi := &file_conversation_conversation_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5025,7 +5219,7 @@ func (x *GetAllFoldsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllFoldsReq.ProtoReflect.Descriptor instead.
 func (*GetAllFoldsReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{90}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *GetAllFoldsReq) GetUserID() string {
@@ -5056,13 +5250,14 @@ type ConversationFold struct {
 	CreateTime         int64                  `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`                // 创建时间
 	UpdateTime         int64                  `protobuf:"varint,9,opt,name=updateTime,proto3" json:"updateTime"`                // 更新时间
 	Ex                 string                 `protobuf:"bytes,10,opt,name=ex,proto3" json:"ex"`                                // 扩展字段
+	Rule               *FoldRule              `protobuf:"bytes,11,opt,name=rule,proto3" json:"rule"`                            // 智能折叠规则，为空表示手动折叠
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ConversationFold) Reset() {
 	*x = ConversationFold{}
-	mi := &file_conversation_conversation_proto_msgTypes[91]
+	mi := &file_conversation_conversation_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5074,7 +5269,7 @@ func (x *ConversationFold) String() string {
 func (*ConversationFold) ProtoMessage() {}
 
 func (x *ConversationFold) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[91]
+	mi := &file_conversation_conversation_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5087,7 +5282,7 @@ func (x *ConversationFold) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversationFold.ProtoReflect.Descriptor instead.
 func (*ConversationFold) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{91}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *ConversationFold) GetFoldConversationID() string {
@@ -5160,39 +5355,38 @@ func (x *ConversationFold) GetEx() string {
 	return ""
 }
 
-// FoldInfo 折叠信息（包含 Conversation_fold 表的字段和是否系统默认）
-type FoldInfo struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	FoldConversationID string                 `protobuf:"bytes,1,opt,name=foldConversationID,proto3" json:"foldConversationID"` // 折叠会话ID
-	OwnerUserID        string                 `protobuf:"bytes,2,opt,name=ownerUserID,proto3" json:"ownerUserID"`               // 用户ID（折叠所属用户）
-	FoldName           string                 `protobuf:"bytes,3,opt,name=foldName,proto3" json:"foldName"`                     // 折叠名称
-	FaceURL            string                 `protobuf:"bytes,4,opt,name=faceURL,proto3" json:"faceURL"`                       // 头像URL
-	Description        string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description"`               // 描述
-	FoldType           int32                  `protobuf:"varint,6,opt,name=foldType,proto3" json:"foldType"`                    // 折叠类型: 1=普通折叠, 2=通知折叠
-	SortOrder          int32                  `protobuf:"varint,7,opt,name=sortOrder,proto3" json:"sortOrder"`                  // 排序顺序
-	CreateTime         int64                  `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`                // 创建时间
-	UpdateTime         int64                  `protobuf:"varint,9,opt,name=updateTime,proto3" json:"updateTime"`                // 更新时间
-	Ex                 string                 `protobuf:"bytes,10,opt,name=ex,proto3" json:"ex"`                                // 扩展字段
-	IsSystemDefault    bool                   `protobuf:"varint,11,opt,name=isSystemDefault,proto3" json:"isSystemDefault"`     // 是否为系统默认折叠
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *ConversationFold) GetRule() *FoldRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
 }
 
-func (x *FoldInfo) Reset() {
-	*x = FoldInfo{}
-	mi := &file_conversation_conversation_proto_msgTypes[92]
+// FoldRuleCondition 智能折叠条件
+type FoldRuleCondition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type"`         // constant.FoldRuleCondition*
+	IntValue      int64                  `protobuf:"varint,2,opt,name=intValue,proto3" json:"intValue"` // 未打开的群：天数（1-3650）
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values"`      // OA企业：企业ID；会话类型：conversationType
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FoldRuleCondition) Reset() {
+	*x = FoldRuleCondition{}
+	mi := &file_conversation_conversation_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FoldInfo) String() string {
+func (x *FoldRuleCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FoldInfo) ProtoMessage() {}
+func (*FoldRuleCondition) ProtoMessage() {}
 
-func (x *FoldInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[92]
+func (x *FoldRuleCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5203,61 +5397,209 @@ func (x *FoldInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FoldInfo.ProtoReflect.Descriptor instead.
-func (*FoldInfo) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use FoldRuleCondition.ProtoReflect.Descriptor instead.
+func (*FoldRuleCondition) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *FoldInfo) GetFoldConversationID() string {
+func (x *FoldRuleCondition) GetType() int32 {
 	if x != nil {
-		return x.FoldConversationID
+		return x.Type
 	}
-	return ""
+	return 0
 }
 
-func (x *FoldInfo) GetOwnerUserID() string {
+func (x *FoldRuleCondition) GetIntValue() int64 {
 	if x != nil {
-		return x.OwnerUserID
+		return x.IntValue
 	}
-	return ""
+	return 0
 }
 
-func (x *FoldInfo) GetFoldName() string {
+func (x *FoldRuleCondition) GetValues() []string {
 	if x != nil {
-		return x.FoldName
+		return x.Values
 	}
-	return ""
+	return nil
 }
 
-func (x *FoldInfo) GetFaceURL() string {
+// FoldRule 智能折叠规则，条件之间为“且”关系，由服务端在会话变更时重新求值
+// 用户手动移入/移出的会话记录在 include/exclude 中，优先于条件
+type FoldRule struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Enabled                bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled"`
+	Conditions             []*FoldRuleCondition   `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions"`
+	IncludeConversationIDs []string               `protobuf:"bytes,3,rep,name=includeConversationIDs,proto3" json:"includeConversationIDs"` // 手动移入
+	ExcludeConversationIDs []string               `protobuf:"bytes,4,rep,name=excludeConversationIDs,proto3" json:"excludeConversationIDs"` // 手动移出
+	UpdateTime             int64                  `protobuf:"varint,5,opt,name=updateTime,proto3" json:"updateTime"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *FoldRule) Reset() {
+	*x = FoldRule{}
+	mi := &file_conversation_conversation_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FoldRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoldRule) ProtoMessage() {}
+
+func (x *FoldRule) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[95]
 	if x != nil {
-		return x.FaceURL
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FoldInfo) GetDescription() string {
+// Deprecated: Use FoldRule.ProtoReflect.Descriptor instead.
+func (*FoldRule) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *FoldRule) GetEnabled() bool {
 	if x != nil {
-		return x.Description
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *FoldInfo) GetFoldType() int32 {
+func (x *FoldRule) GetConditions() []*FoldRuleCondition {
 	if x != nil {
-		return x.FoldType
+		return x.Conditions
 	}
-	return 0
+	return nil
 }
 
-func (x *FoldInfo) GetSortOrder() int32 {
+func (x *FoldRule) GetIncludeConversationIDs() []string {
 	if x != nil {
-		return x.SortOrder
+		return x.IncludeConversationIDs
 	}
-	return 0
+	return nil
 }
 
-func (x *FoldInfo) GetCreateTime() int64 {
+func (x *FoldRule) GetExcludeConversationIDs() []string {
+	if x != nil {
+		return x.ExcludeConversationIDs
+	}
+	return nil
+}
+
+func (x *FoldRule) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+// FoldInfo 折叠信息（包含 Conversation_fold 表的字段和是否系统默认）
+type FoldInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FoldConversationID string                 `protobuf:"bytes,1,opt,name=foldConversationID,proto3" json:"foldConversationID"` // 折叠会话ID
+	OwnerUserID        string                 `protobuf:"bytes,2,opt,name=ownerUserID,proto3" json:"ownerUserID"`               // 用户ID（折叠所属用户）
+	FoldName           string                 `protobuf:"bytes,3,opt,name=foldName,proto3" json:"foldName"`                     // 折叠名称
+	FaceURL            string                 `protobuf:"bytes,4,opt,name=faceURL,proto3" json:"faceURL"`                       // 头像URL
+	Description        string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description"`               // 描述
+	FoldType           int32                  `protobuf:"varint,6,opt,name=foldType,proto3" json:"foldType"`                    // 折叠类型: 1=普通折叠, 2=通知折叠
+	SortOrder          int32                  `protobuf:"varint,7,opt,name=sortOrder,proto3" json:"sortOrder"`                  // 排序顺序
+	CreateTime         int64                  `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`                // 创建时间
+	UpdateTime         int64                  `protobuf:"varint,9,opt,name=updateTime,proto3" json:"updateTime"`                // 更新时间
+	Ex                 string                 `protobuf:"bytes,10,opt,name=ex,proto3" json:"ex"`                                // 扩展字段
+	IsSystemDefault    bool                   `protobuf:"varint,11,opt,name=isSystemDefault,proto3" json:"isSystemDefault"`     // 是否为系统默认折叠
+	Rule               *FoldRule              `protobuf:"bytes,12,opt,name=rule,proto3" json:"rule"`                            // 智能折叠规则，为空表示手动折叠
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *FoldInfo) Reset() {
+	*x = FoldInfo{}
+	mi := &file_conversation_conversation_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FoldInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoldInfo) ProtoMessage() {}
+
+func (x *FoldInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FoldInfo.ProtoReflect.Descriptor instead.
+func (*FoldInfo) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *FoldInfo) GetFoldConversationID() string {
+	if x != nil {
+		return x.FoldConversationID
+	}
+	return ""
+}
+
+func (x *FoldInfo) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *FoldInfo) GetFoldName() string {
+	if x != nil {
+		return x.FoldName
+	}
+	return ""
+}
+
+func (x *FoldInfo) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *FoldInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FoldInfo) GetFoldType() int32 {
+	if x != nil {
+		return x.FoldType
+	}
+	return 0
+}
+
+func (x *FoldInfo) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *FoldInfo) GetCreateTime() int64 {
 	if x != nil {
 		return x.CreateTime
 	}
@@ -5285,6 +5627,13 @@ func (x *FoldInfo) GetIsSystemDefault() bool {
 	return false
 }
 
+func (x *FoldInfo) GetRule() *FoldRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
 type GetAllFoldsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Groups        []*FoldInfo            `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
@@ -5294,7 +5643,7 @@ type GetAllFoldsResp struct {
 
 func (x *GetAllFoldsResp) Reset() {
 	*x = GetAllFoldsResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[93]
+	mi := &file_conversation_conversation_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5306,7 +5655,7 @@ func (x *GetAllFoldsResp) String() string {
 func (*GetAllFoldsResp) ProtoMessage() {}
 
 func (x *GetAllFoldsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[93]
+	mi := &file_conversation_conversation_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5319,7 +5668,7 @@ func (x *GetAllFoldsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllFoldsResp.ProtoReflect.Descriptor instead.
 func (*GetAllFoldsResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{93}
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *GetAllFoldsResp) GetGroups() []*FoldInfo {
@@ -5337,21 +5686,1625 @@ type RemoveFoldReq struct {
 	sizeCache          protoimpl.SizeCache
 }
 
-func (x *RemoveFoldReq) Reset() {
-	*x = RemoveFoldReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[94]
+func (x *RemoveFoldReq) Reset() {
+	*x = RemoveFoldReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFoldReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFoldReq) ProtoMessage() {}
+
+func (x *RemoveFoldReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFoldReq.ProtoReflect.Descriptor instead.
+func (*RemoveFoldReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RemoveFoldReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RemoveFoldReq) GetFoldConversationID() string {
+	if x != nil {
+		return x.FoldConversationID
+	}
+	return ""
+}
+
+type RemoveFoldResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MovedCount    int32                  `protobuf:"varint,1,opt,name=movedCount,proto3" json:"movedCount"` // 移回的会话数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFoldResp) Reset() {
+	*x = RemoveFoldResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFoldResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFoldResp) ProtoMessage() {}
+
+func (x *RemoveFoldResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFoldResp.ProtoReflect.Descriptor instead.
+func (*RemoveFoldResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RemoveFoldResp) GetMovedCount() int32 {
+	if x != nil {
+		return x.MovedCount
+	}
+	return 0
+}
+
+// CreateFold 创建折叠会话（同时创建折叠会话和折叠属性）
+type CreateFoldReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`           // 用户ID
+	FoldName      string                 `protobuf:"bytes,2,opt,name=foldName,proto3" json:"foldName"`       // 折叠名称
+	FaceURL       string                 `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`         // 头像URL
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description"` // 描述
+	FoldType      int32                  `protobuf:"varint,5,opt,name=foldType,proto3" json:"foldType"`      // 折叠类型: 1=普通折叠, 2=通知折叠
+	Rule          *FoldRule              `protobuf:"bytes,6,opt,name=rule,proto3" json:"rule"`               // 智能折叠规则（可选）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFoldReq) Reset() {
+	*x = CreateFoldReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFoldReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFoldReq) ProtoMessage() {}
+
+func (x *CreateFoldReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFoldReq.ProtoReflect.Descriptor instead.
+func (*CreateFoldReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CreateFoldReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreateFoldReq) GetFoldName() string {
+	if x != nil {
+		return x.FoldName
+	}
+	return ""
+}
+
+func (x *CreateFoldReq) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *CreateFoldReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateFoldReq) GetFoldType() int32 {
+	if x != nil {
+		return x.FoldType
+	}
+	return 0
+}
+
+func (x *CreateFoldReq) GetRule() *FoldRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type CreateFoldResp struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FoldConversationID string                 `protobuf:"bytes,1,opt,name=foldConversationID,proto3" json:"foldConversationID"` // 创建的折叠会话ID（同时也是fold表的关联ID）
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateFoldResp) Reset() {
+	*x = CreateFoldResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFoldResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFoldResp) ProtoMessage() {}
+
+func (x *CreateFoldResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFoldResp.ProtoReflect.Descriptor instead.
+func (*CreateFoldResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CreateFoldResp) GetFoldConversationID() string {
+	if x != nil {
+		return x.FoldConversationID
+	}
+	return ""
+}
+
+// UpdateFold 更新折叠属性（不更新会话本身）
+type UpdateFoldReq struct {
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	UserID             string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                         // 用户ID
+	FoldConversationID string                  `protobuf:"bytes,2,opt,name=foldConversationID,proto3" json:"foldConversationID"` // 折叠会话ID
+	FoldName           *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=foldName,proto3" json:"foldName"`                     // 折叠名称（可选）
+	FaceURL            *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=faceURL,proto3" json:"faceURL"`                       // 头像URL（可选）
+	Description        *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description"`               // 描述（可选）
+	Rule               *FoldRule               `protobuf:"bytes,6,opt,name=rule,proto3" json:"rule"`                             // 智能折叠规则（可选，整体替换，enabled=false 表示停用）
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateFoldReq) Reset() {
+	*x = UpdateFoldReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFoldReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFoldReq) ProtoMessage() {}
+
+func (x *UpdateFoldReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFoldReq.ProtoReflect.Descriptor instead.
+func (*UpdateFoldReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UpdateFoldReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UpdateFoldReq) GetFoldConversationID() string {
+	if x != nil {
+		return x.FoldConversationID
+	}
+	return ""
+}
+
+func (x *UpdateFoldReq) GetFoldName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.FoldName
+	}
+	return nil
+}
+
+func (x *UpdateFoldReq) GetFaceURL() *wrapperspb.StringValue {
+	if x != nil {
+		return x.FaceURL
+	}
+	return nil
+}
+
+func (x *UpdateFoldReq) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateFoldReq) GetRule() *FoldRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type UpdateFoldResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFoldResp) Reset() {
+	*x = UpdateFoldResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFoldResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFoldResp) ProtoMessage() {}
+
+func (x *UpdateFoldResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFoldResp.ProtoReflect.Descriptor instead.
+func (*UpdateFoldResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{103}
+}
+
+// ClearFold 清空折叠会话（将子会话设为非免打扰、移出折叠、删除折叠会话）
+type ClearFoldReq struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserID             string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                         // 用户ID
+	FoldConversationID string                 `protobuf:"bytes,2,opt,name=foldConversationID,proto3" json:"foldConversationID"` // 要清空的折叠会话ID
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ClearFoldReq) Reset() {
+	*x = ClearFoldReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearFoldReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearFoldReq) ProtoMessage() {}
+
+func (x *ClearFoldReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearFoldReq.ProtoReflect.Descriptor instead.
+func (*ClearFoldReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ClearFoldReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ClearFoldReq) GetFoldConversationID() string {
+	if x != nil {
+		return x.FoldConversationID
+	}
+	return ""
+}
+
+type ClearFoldResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClearedCount  int32                  `protobuf:"varint,1,opt,name=clearedCount,proto3" json:"clearedCount"` // 清空的子会话数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearFoldResp) Reset() {
+	*x = ClearFoldResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearFoldResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearFoldResp) ProtoMessage() {}
+
+func (x *ClearFoldResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearFoldResp.ProtoReflect.Descriptor instead.
+func (*ClearFoldResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ClearFoldResp) GetClearedCount() int32 {
+	if x != nil {
+		return x.ClearedCount
+	}
+	return 0
+}
+
+// 通知摘要相关消息定义
+// 摘要模式下同一会话的推送在 intervalMinutes 内合并为一条（如"#ops 有 12 条新消息"），
+// @我、@所有人 和音视频通话始终立即推送，免打扰时段内只累积不推送
+type QuietHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled"`
+	StartMinute   int32                  `protobuf:"varint,2,opt,name=startMinute,proto3" json:"startMinute"` // 开始时间，当天第几分钟（0-1439）
+	EndMinute     int32                  `protobuf:"varint,3,opt,name=endMinute,proto3" json:"endMinute"`     // 结束时间，小于 startMinute 表示跨天
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone"`        // IANA 时区，如 Asia/Shanghai，为空使用 UTC
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuietHours) Reset() {
+	*x = QuietHours{}
+	mi := &file_conversation_conversation_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuietHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietHours) ProtoMessage() {}
+
+func (x *QuietHours) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietHours.ProtoReflect.Descriptor instead.
+func (*QuietHours) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *QuietHours) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *QuietHours) GetStartMinute() int32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *QuietHours) GetEndMinute() int32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+func (x *QuietHours) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// NotificationDigestSetting conversationID 为空表示全局设置
+type NotificationDigestSetting struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID     string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	ConversationID  string                 `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Mode            int32                  `protobuf:"varint,3,opt,name=mode,proto3" json:"mode"`                       // constant.NotificationDigestMode*
+	IntervalMinutes int32                  `protobuf:"varint,4,opt,name=intervalMinutes,proto3" json:"intervalMinutes"` // 摘要间隔（分钟）
+	QuietHours      *QuietHours            `protobuf:"bytes,5,opt,name=quietHours,proto3" json:"quietHours"`
+	UpdateTime      int64                  `protobuf:"varint,6,opt,name=updateTime,proto3" json:"updateTime"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotificationDigestSetting) Reset() {
+	*x = NotificationDigestSetting{}
+	mi := &file_conversation_conversation_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationDigestSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationDigestSetting) ProtoMessage() {}
+
+func (x *NotificationDigestSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationDigestSetting.ProtoReflect.Descriptor instead.
+func (*NotificationDigestSetting) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *NotificationDigestSetting) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *NotificationDigestSetting) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *NotificationDigestSetting) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *NotificationDigestSetting) GetIntervalMinutes() int32 {
+	if x != nil {
+		return x.IntervalMinutes
+	}
+	return 0
+}
+
+func (x *NotificationDigestSetting) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *NotificationDigestSetting) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type SetNotificationDigestSettingReq struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Setting       *NotificationDigestSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationDigestSettingReq) Reset() {
+	*x = SetNotificationDigestSettingReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationDigestSettingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationDigestSettingReq) ProtoMessage() {}
+
+func (x *SetNotificationDigestSettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationDigestSettingReq.ProtoReflect.Descriptor instead.
+func (*SetNotificationDigestSettingReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SetNotificationDigestSettingReq) GetSetting() *NotificationDigestSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+type SetNotificationDigestSettingResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationDigestSettingResp) Reset() {
+	*x = SetNotificationDigestSettingResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationDigestSettingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationDigestSettingResp) ProtoMessage() {}
+
+func (x *SetNotificationDigestSettingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationDigestSettingResp.ProtoReflect.Descriptor instead.
+func (*SetNotificationDigestSettingResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{109}
+}
+
+type DeleteNotificationDigestSettingReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID    string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	ConversationID string                 `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationDigestSettingReq) Reset() {
+	*x = DeleteNotificationDigestSettingReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationDigestSettingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationDigestSettingReq) ProtoMessage() {}
+
+func (x *DeleteNotificationDigestSettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationDigestSettingReq.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationDigestSettingReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *DeleteNotificationDigestSettingReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *DeleteNotificationDigestSettingReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+type DeleteNotificationDigestSettingResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationDigestSettingResp) Reset() {
+	*x = DeleteNotificationDigestSettingResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationDigestSettingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationDigestSettingResp) ProtoMessage() {}
+
+func (x *DeleteNotificationDigestSettingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationDigestSettingResp.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationDigestSettingResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{111}
+}
+
+type GetNotificationDigestSettingsReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID     string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	ConversationIDs []string               `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs"` // 为空时返回全部会话设置
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetNotificationDigestSettingsReq) Reset() {
+	*x = GetNotificationDigestSettingsReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationDigestSettingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationDigestSettingsReq) ProtoMessage() {}
+
+func (x *GetNotificationDigestSettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationDigestSettingsReq.ProtoReflect.Descriptor instead.
+func (*GetNotificationDigestSettingsReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetNotificationDigestSettingsReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *GetNotificationDigestSettingsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type GetNotificationDigestSettingsResp struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Global        *NotificationDigestSetting   `protobuf:"bytes,1,opt,name=global,proto3" json:"global"` // 全局设置，未设置时为空
+	Conversations []*NotificationDigestSetting `protobuf:"bytes,2,rep,name=conversations,proto3" json:"conversations"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationDigestSettingsResp) Reset() {
+	*x = GetNotificationDigestSettingsResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationDigestSettingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationDigestSettingsResp) ProtoMessage() {}
+
+func (x *GetNotificationDigestSettingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationDigestSettingsResp.ProtoReflect.Descriptor instead.
+func (*GetNotificationDigestSettingsResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetNotificationDigestSettingsResp) GetGlobal() *NotificationDigestSetting {
+	if x != nil {
+		return x.Global
+	}
+	return nil
+}
+
+func (x *GetNotificationDigestSettingsResp) GetConversations() []*NotificationDigestSetting {
+	if x != nil {
+		return x.Conversations
+	}
+	return nil
+}
+
+type GetUsersNotificationDigestSettingsReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	UserIDs        []string               `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUsersNotificationDigestSettingsReq) Reset() {
+	*x = GetUsersNotificationDigestSettingsReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersNotificationDigestSettingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersNotificationDigestSettingsReq) ProtoMessage() {}
+
+func (x *GetUsersNotificationDigestSettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersNotificationDigestSettingsReq.ProtoReflect.Descriptor instead.
+func (*GetUsersNotificationDigestSettingsReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetUsersNotificationDigestSettingsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetUsersNotificationDigestSettingsReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetUsersNotificationDigestSettingsResp struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Settings      map[string]*NotificationDigestSetting `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // key 为 userID，只包含开启摘要的用户（会话设置优先于全局设置）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersNotificationDigestSettingsResp) Reset() {
+	*x = GetUsersNotificationDigestSettingsResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersNotificationDigestSettingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersNotificationDigestSettingsResp) ProtoMessage() {}
+
+func (x *GetUsersNotificationDigestSettingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersNotificationDigestSettingsResp.ProtoReflect.Descriptor instead.
+func (*GetUsersNotificationDigestSettingsResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetUsersNotificationDigestSettingsResp) GetSettings() map[string]*NotificationDigestSetting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// PreviewFoldRule 预览规则命中的会话（不修改数据）
+type PreviewFoldRuleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Rule          *FoldRule              `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewFoldRuleReq) Reset() {
+	*x = PreviewFoldRuleReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewFoldRuleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewFoldRuleReq) ProtoMessage() {}
+
+func (x *PreviewFoldRuleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewFoldRuleReq.ProtoReflect.Descriptor instead.
+func (*PreviewFoldRuleReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *PreviewFoldRuleReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *PreviewFoldRuleReq) GetRule() *FoldRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type PreviewFoldRuleResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConversationIDs []string               `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PreviewFoldRuleResp) Reset() {
+	*x = PreviewFoldRuleResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewFoldRuleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewFoldRuleResp) ProtoMessage() {}
+
+func (x *PreviewFoldRuleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewFoldRuleResp.ProtoReflect.Descriptor instead.
+func (*PreviewFoldRuleResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *PreviewFoldRuleResp) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type ReevaluateFoldRulesReq struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserID             string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	FoldConversationID string                 `protobuf:"bytes,2,opt,name=foldConversationID,proto3" json:"foldConversationID"` // 为空时重新求值该用户全部智能折叠
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReevaluateFoldRulesReq) Reset() {
+	*x = ReevaluateFoldRulesReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReevaluateFoldRulesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReevaluateFoldRulesReq) ProtoMessage() {}
+
+func (x *ReevaluateFoldRulesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReevaluateFoldRulesReq.ProtoReflect.Descriptor instead.
+func (*ReevaluateFoldRulesReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ReevaluateFoldRulesReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ReevaluateFoldRulesReq) GetFoldConversationID() string {
+	if x != nil {
+		return x.FoldConversationID
+	}
+	return ""
+}
+
+type ReevaluateFoldRulesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddedCount    int32                  `protobuf:"varint,1,opt,name=addedCount,proto3" json:"addedCount"`     // 移入的会话数量
+	RemovedCount  int32                  `protobuf:"varint,2,opt,name=removedCount,proto3" json:"removedCount"` // 移出的会话数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReevaluateFoldRulesResp) Reset() {
+	*x = ReevaluateFoldRulesResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReevaluateFoldRulesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReevaluateFoldRulesResp) ProtoMessage() {}
+
+func (x *ReevaluateFoldRulesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReevaluateFoldRulesResp.ProtoReflect.Descriptor instead.
+func (*ReevaluateFoldRulesResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ReevaluateFoldRulesResp) GetAddedCount() int32 {
+	if x != nil {
+		return x.AddedCount
+	}
+	return 0
+}
+
+func (x *ReevaluateFoldRulesResp) GetRemovedCount() int32 {
+	if x != nil {
+		return x.RemovedCount
+	}
+	return 0
+}
+
+// 草稿相关消息定义
+// 冲突处理：baseUpdateTime 为设备最后一次看到的草稿 updateTime，
+// 如果服务端草稿由其他设备在此之后更新过，则不写入并返回当前草稿
+type SetDraftReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID    string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	ConversationID string                 `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Draft          *ConversationDraft     `protobuf:"bytes,3,opt,name=draft,proto3" json:"draft"`
+	BaseUpdateTime int64                  `protobuf:"varint,4,opt,name=baseUpdateTime,proto3" json:"baseUpdateTime"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetDraftReq) Reset() {
+	*x = SetDraftReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDraftReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDraftReq) ProtoMessage() {}
+
+func (x *SetDraftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDraftReq.ProtoReflect.Descriptor instead.
+func (*SetDraftReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *SetDraftReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *SetDraftReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SetDraftReq) GetDraft() *ConversationDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+func (x *SetDraftReq) GetBaseUpdateTime() int64 {
+	if x != nil {
+		return x.BaseUpdateTime
+	}
+	return 0
+}
+
+type SetDraftResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied"` // 是否写入，false 表示本设备的草稿已过期
+	Draft         *ConversationDraft     `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft"`      // 服务端当前草稿
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDraftResp) Reset() {
+	*x = SetDraftResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDraftResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDraftResp) ProtoMessage() {}
+
+func (x *SetDraftResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDraftResp.ProtoReflect.Descriptor instead.
+func (*SetDraftResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *SetDraftResp) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *SetDraftResp) GetDraft() *ConversationDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type ClearDraftReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID    string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	ConversationID string                 `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	DeviceID       string                 `protobuf:"bytes,3,opt,name=deviceID,proto3" json:"deviceID"`
+	BaseUpdateTime int64                  `protobuf:"varint,4,opt,name=baseUpdateTime,proto3" json:"baseUpdateTime"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClearDraftReq) Reset() {
+	*x = ClearDraftReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearDraftReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearDraftReq) ProtoMessage() {}
+
+func (x *ClearDraftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearDraftReq.ProtoReflect.Descriptor instead.
+func (*ClearDraftReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ClearDraftReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *ClearDraftReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *ClearDraftReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *ClearDraftReq) GetBaseUpdateTime() int64 {
+	if x != nil {
+		return x.BaseUpdateTime
+	}
+	return 0
+}
+
+type ClearDraftResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied"`
+	Draft         *ConversationDraft     `protobuf:"bytes,2,opt,name=draft,proto3" json:"draft"` // 未清除时返回服务端当前草稿
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearDraftResp) Reset() {
+	*x = ClearDraftResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearDraftResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearDraftResp) ProtoMessage() {}
+
+func (x *ClearDraftResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearDraftResp.ProtoReflect.Descriptor instead.
+func (*ClearDraftResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ClearDraftResp) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ClearDraftResp) GetDraft() *ConversationDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+// 会话提醒设置相关消息定义
+type SetConversationNotifySettingsReq struct {
+	state          protoimpl.MessageState      `protogen:"open.v1"`
+	OwnerUserID    string                      `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	ConversationID string                      `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Settings       *ConversationNotifySettings `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings"` // 整体替换，为空表示清除
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetConversationNotifySettingsReq) Reset() {
+	*x = SetConversationNotifySettingsReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConversationNotifySettingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationNotifySettingsReq) ProtoMessage() {}
+
+func (x *SetConversationNotifySettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationNotifySettingsReq.ProtoReflect.Descriptor instead.
+func (*SetConversationNotifySettingsReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SetConversationNotifySettingsReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *SetConversationNotifySettingsReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SetConversationNotifySettingsReq) GetSettings() *ConversationNotifySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SetConversationNotifySettingsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetConversationNotifySettingsResp) Reset() {
+	*x = SetConversationNotifySettingsResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetConversationNotifySettingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConversationNotifySettingsResp) ProtoMessage() {}
+
+func (x *SetConversationNotifySettingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConversationNotifySettingsResp.ProtoReflect.Descriptor instead.
+func (*SetConversationNotifySettingsResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{125}
+}
+
+type MuteConversationsReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID     string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	ConversationIDs []string               `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs"`
+	MuteUntil       int64                  `protobuf:"varint,3,opt,name=muteUntil,proto3" json:"muteUntil"` // 截止时间（毫秒），0 表示取消临时免打扰
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MuteConversationsReq) Reset() {
+	*x = MuteConversationsReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteConversationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteConversationsReq) ProtoMessage() {}
+
+func (x *MuteConversationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteConversationsReq.ProtoReflect.Descriptor instead.
+func (*MuteConversationsReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *MuteConversationsReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *MuteConversationsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+func (x *MuteConversationsReq) GetMuteUntil() int64 {
+	if x != nil {
+		return x.MuteUntil
+	}
+	return 0
+}
+
+type MuteConversationsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteConversationsResp) Reset() {
+	*x = MuteConversationsResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteConversationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteConversationsResp) ProtoMessage() {}
+
+func (x *MuteConversationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteConversationsResp.ProtoReflect.Descriptor instead.
+func (*MuteConversationsResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{127}
+}
+
+type GetUsersConversationNotifySettingsReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	UserIDs        []string               `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetUsersConversationNotifySettingsReq) Reset() {
+	*x = GetUsersConversationNotifySettingsReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveFoldReq) String() string {
+func (x *GetUsersConversationNotifySettingsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFoldReq) ProtoMessage() {}
+func (*GetUsersConversationNotifySettingsReq) ProtoMessage() {}
 
-func (x *RemoveFoldReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[94]
+func (x *GetUsersConversationNotifySettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5362,47 +7315,47 @@ func (x *RemoveFoldReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFoldReq.ProtoReflect.Descriptor instead.
-func (*RemoveFoldReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use GetUsersConversationNotifySettingsReq.ProtoReflect.Descriptor instead.
+func (*GetUsersConversationNotifySettingsReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *RemoveFoldReq) GetUserID() string {
+func (x *GetUsersConversationNotifySettingsReq) GetConversationID() string {
 	if x != nil {
-		return x.UserID
+		return x.ConversationID
 	}
 	return ""
 }
 
-func (x *RemoveFoldReq) GetFoldConversationID() string {
+func (x *GetUsersConversationNotifySettingsReq) GetUserIDs() []string {
 	if x != nil {
-		return x.FoldConversationID
+		return x.UserIDs
 	}
-	return ""
+	return nil
 }
 
-type RemoveFoldResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MovedCount    int32                  `protobuf:"varint,1,opt,name=movedCount,proto3" json:"movedCount"` // 移回的会话数量
+type GetUsersConversationNotifySettingsResp struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Settings      map[string]*ConversationNotifySettings `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // key 为 userID，只包含有设置的用户
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveFoldResp) Reset() {
-	*x = RemoveFoldResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[95]
+func (x *GetUsersConversationNotifySettingsResp) Reset() {
+	*x = GetUsersConversationNotifySettingsResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveFoldResp) String() string {
+func (x *GetUsersConversationNotifySettingsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFoldResp) ProtoMessage() {}
+func (*GetUsersConversationNotifySettingsResp) ProtoMessage() {}
 
-func (x *RemoveFoldResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[95]
+func (x *GetUsersConversationNotifySettingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5413,45 +7366,49 @@ func (x *RemoveFoldResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFoldResp.ProtoReflect.Descriptor instead.
-func (*RemoveFoldResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use GetUsersConversationNotifySettingsResp.ProtoReflect.Descriptor instead.
+func (*GetUsersConversationNotifySettingsResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *RemoveFoldResp) GetMovedCount() int32 {
+func (x *GetUsersConversationNotifySettingsResp) GetSettings() map[string]*ConversationNotifySettings {
 	if x != nil {
-		return x.MovedCount
+		return x.Settings
 	}
-	return 0
-}
-
-// CreateFold 创建折叠会话（同时创建折叠会话和折叠属性）
-type CreateFoldReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`           // 用户ID
-	FoldName      string                 `protobuf:"bytes,2,opt,name=foldName,proto3" json:"foldName"`       // 折叠名称
-	FaceURL       string                 `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`         // 头像URL
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description"` // 描述
-	FoldType      int32                  `protobuf:"varint,5,opt,name=foldType,proto3" json:"foldType"`      // 折叠类型: 1=普通折叠, 2=通知折叠
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *CreateFoldReq) Reset() {
-	*x = CreateFoldReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[96]
+// 未读数相关消息定义
+// 计算规则：不接收消息和隐藏的会话不计；接收但不提醒、临时免打扰的会话计入 mutedTotal，不计入 total 和 unreadConversationCount；
+// groups、folds 包含免打扰会话，折叠内的会话同时计入所属折叠；手动标记未读（unreadCount）在没有未读消息时计入
+type UnreadSummary struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Total                   int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`                                                                                           // 角标值
+	MutedTotal              int64                  `protobuf:"varint,2,opt,name=mutedTotal,proto3" json:"mutedTotal"`                                                                                 // 免打扰会话的未读数
+	UnreadConversationCount int32                  `protobuf:"varint,3,opt,name=unreadConversationCount,proto3" json:"unreadConversationCount"`                                                       // 计入角标的有未读的会话数
+	Groups                  map[string]int64       `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`               // key 为会话分组ID，含免打扰会话
+	Folds                   map[string]int64       `protobuf:"bytes,5,rep,name=folds,proto3" json:"folds" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`                 // key 为折叠会话ID，含免打扰会话
+	Conversations           map[string]int64       `protobuf:"bytes,6,rep,name=conversations,proto3" json:"conversations" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // key 为会话ID，withConversations 时返回
+	ComputeTime             int64                  `protobuf:"varint,7,opt,name=computeTime,proto3" json:"computeTime"`                                                                               // 计算时间（毫秒）
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UnreadSummary) Reset() {
+	*x = UnreadSummary{}
+	mi := &file_conversation_conversation_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateFoldReq) String() string {
+func (x *UnreadSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFoldReq) ProtoMessage() {}
+func (*UnreadSummary) ProtoMessage() {}
 
-func (x *CreateFoldReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[96]
+func (x *UnreadSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5462,117 +7419,83 @@ func (x *CreateFoldReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFoldReq.ProtoReflect.Descriptor instead.
-func (*CreateFoldReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use UnreadSummary.ProtoReflect.Descriptor instead.
+func (*UnreadSummary) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *CreateFoldReq) GetUserID() string {
+func (x *UnreadSummary) GetTotal() int64 {
 	if x != nil {
-		return x.UserID
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateFoldReq) GetFoldName() string {
+func (x *UnreadSummary) GetMutedTotal() int64 {
 	if x != nil {
-		return x.FoldName
+		return x.MutedTotal
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateFoldReq) GetFaceURL() string {
+func (x *UnreadSummary) GetUnreadConversationCount() int32 {
 	if x != nil {
-		return x.FaceURL
+		return x.UnreadConversationCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateFoldReq) GetDescription() string {
+func (x *UnreadSummary) GetGroups() map[string]int64 {
 	if x != nil {
-		return x.Description
+		return x.Groups
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateFoldReq) GetFoldType() int32 {
+func (x *UnreadSummary) GetFolds() map[string]int64 {
 	if x != nil {
-		return x.FoldType
+		return x.Folds
 	}
-	return 0
-}
-
-type CreateFoldResp struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	FoldConversationID string                 `protobuf:"bytes,1,opt,name=foldConversationID,proto3" json:"foldConversationID"` // 创建的折叠会话ID（同时也是fold表的关联ID）
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *CreateFoldResp) Reset() {
-	*x = CreateFoldResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[97]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateFoldResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*CreateFoldResp) ProtoMessage() {}
-
-func (x *CreateFoldResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[97]
+func (x *UnreadSummary) GetConversations() map[string]int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Conversations
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateFoldResp.ProtoReflect.Descriptor instead.
-func (*CreateFoldResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{97}
+	return nil
 }
 
-func (x *CreateFoldResp) GetFoldConversationID() string {
+func (x *UnreadSummary) GetComputeTime() int64 {
 	if x != nil {
-		return x.FoldConversationID
+		return x.ComputeTime
 	}
-	return ""
+	return 0
 }
 
-// UpdateFold 更新折叠属性（不更新会话本身）
-type UpdateFoldReq struct {
-	state              protoimpl.MessageState  `protogen:"open.v1"`
-	UserID             string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                         // 用户ID
-	FoldConversationID string                  `protobuf:"bytes,2,opt,name=foldConversationID,proto3" json:"foldConversationID"` // 折叠会话ID
-	FoldName           *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=foldName,proto3" json:"foldName"`                     // 折叠名称（可选）
-	FaceURL            *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=faceURL,proto3" json:"faceURL"`                       // 头像URL（可选）
-	Description        *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description"`               // 描述（可选）
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetUnreadSummaryReq struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserID            string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	WithConversations bool                   `protobuf:"varint,2,opt,name=withConversations,proto3" json:"withConversations"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *UpdateFoldReq) Reset() {
-	*x = UpdateFoldReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[98]
+func (x *GetUnreadSummaryReq) Reset() {
+	*x = GetUnreadSummaryReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateFoldReq) String() string {
+func (x *GetUnreadSummaryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFoldReq) ProtoMessage() {}
+func (*GetUnreadSummaryReq) ProtoMessage() {}
 
-func (x *UpdateFoldReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[98]
+func (x *GetUnreadSummaryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5583,67 +7506,47 @@ func (x *UpdateFoldReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFoldReq.ProtoReflect.Descriptor instead.
-func (*UpdateFoldReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use GetUnreadSummaryReq.ProtoReflect.Descriptor instead.
+func (*GetUnreadSummaryReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *UpdateFoldReq) GetUserID() string {
+func (x *GetUnreadSummaryReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *UpdateFoldReq) GetFoldConversationID() string {
-	if x != nil {
-		return x.FoldConversationID
-	}
-	return ""
-}
-
-func (x *UpdateFoldReq) GetFoldName() *wrapperspb.StringValue {
-	if x != nil {
-		return x.FoldName
-	}
-	return nil
-}
-
-func (x *UpdateFoldReq) GetFaceURL() *wrapperspb.StringValue {
-	if x != nil {
-		return x.FaceURL
-	}
-	return nil
-}
-
-func (x *UpdateFoldReq) GetDescription() *wrapperspb.StringValue {
+func (x *GetUnreadSummaryReq) GetWithConversations() bool {
 	if x != nil {
-		return x.Description
+		return x.WithConversations
 	}
-	return nil
+	return false
 }
 
-type UpdateFoldResp struct {
+type GetUnreadSummaryResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *UnreadSummary         `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateFoldResp) Reset() {
-	*x = UpdateFoldResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[99]
+func (x *GetUnreadSummaryResp) Reset() {
+	*x = GetUnreadSummaryResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateFoldResp) String() string {
+func (x *GetUnreadSummaryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFoldResp) ProtoMessage() {}
+func (*GetUnreadSummaryResp) ProtoMessage() {}
 
-func (x *UpdateFoldResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[99]
+func (x *GetUnreadSummaryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5654,35 +7557,40 @@ func (x *UpdateFoldResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFoldResp.ProtoReflect.Descriptor instead.
-func (*UpdateFoldResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use GetUnreadSummaryResp.ProtoReflect.Descriptor instead.
+func (*GetUnreadSummaryResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{132}
 }
 
-// ClearFold 清空折叠会话（将子会话设为非免打扰、移出折叠、删除折叠会话）
-type ClearFoldReq struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	UserID             string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`                         // 用户ID
-	FoldConversationID string                 `protobuf:"bytes,2,opt,name=foldConversationID,proto3" json:"foldConversationID"` // 要清空的折叠会话ID
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *GetUnreadSummaryResp) GetSummary() *UnreadSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
 }
 
-func (x *ClearFoldReq) Reset() {
-	*x = ClearFoldReq{}
-	mi := &file_conversation_conversation_proto_msgTypes[100]
+type GetUsersBadgeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIDs       []string               `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersBadgeReq) Reset() {
+	*x = GetUsersBadgeReq{}
+	mi := &file_conversation_conversation_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearFoldReq) String() string {
+func (x *GetUsersBadgeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearFoldReq) ProtoMessage() {}
+func (*GetUsersBadgeReq) ProtoMessage() {}
 
-func (x *ClearFoldReq) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[100]
+func (x *GetUsersBadgeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5693,47 +7601,40 @@ func (x *ClearFoldReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearFoldReq.ProtoReflect.Descriptor instead.
-func (*ClearFoldReq) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{100}
-}
-
-func (x *ClearFoldReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
+// Deprecated: Use GetUsersBadgeReq.ProtoReflect.Descriptor instead.
+func (*GetUsersBadgeReq) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *ClearFoldReq) GetFoldConversationID() string {
+func (x *GetUsersBadgeReq) GetUserIDs() []string {
 	if x != nil {
-		return x.FoldConversationID
+		return x.UserIDs
 	}
-	return ""
+	return nil
 }
 
-type ClearFoldResp struct {
+type GetUsersBadgeResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClearedCount  int32                  `protobuf:"varint,1,opt,name=clearedCount,proto3" json:"clearedCount"` // 清空的子会话数量
+	Badges        map[string]int64       `protobuf:"bytes,1,rep,name=badges,proto3" json:"badges" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // key 为 userID，值为 UnreadSummary.total
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClearFoldResp) Reset() {
-	*x = ClearFoldResp{}
-	mi := &file_conversation_conversation_proto_msgTypes[101]
+func (x *GetUsersBadgeResp) Reset() {
+	*x = GetUsersBadgeResp{}
+	mi := &file_conversation_conversation_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearFoldResp) String() string {
+func (x *GetUsersBadgeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearFoldResp) ProtoMessage() {}
+func (*GetUsersBadgeResp) ProtoMessage() {}
 
-func (x *ClearFoldResp) ProtoReflect() protoreflect.Message {
-	mi := &file_conversation_conversation_proto_msgTypes[101]
+func (x *GetUsersBadgeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_conversation_conversation_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5744,23 +7645,23 @@ func (x *ClearFoldResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearFoldResp.ProtoReflect.Descriptor instead.
-func (*ClearFoldResp) Descriptor() ([]byte, []int) {
-	return file_conversation_conversation_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use GetUsersBadgeResp.ProtoReflect.Descriptor instead.
+func (*GetUsersBadgeResp) Descriptor() ([]byte, []int) {
+	return file_conversation_conversation_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *ClearFoldResp) GetClearedCount() int32 {
+func (x *GetUsersBadgeResp) GetBadges() map[string]int64 {
 	if x != nil {
-		return x.ClearedCount
+		return x.Badges
 	}
-	return 0
+	return nil
 }
 
 var File_conversation_conversation_proto protoreflect.FileDescriptor
 
 const file_conversation_conversation_proto_rawDesc = "" +
 	"\n" +
-	"\x1fconversation/conversation.proto\x12\x13openim.conversation\x1a\x11sdkws/sdkws.proto\x1a\x1bwrapperspb/wrapperspb.proto\"\xb2\x06\n" +
+	"\x1fconversation/conversation.proto\x12\x13openim.conversation\x1a\x11sdkws/sdkws.proto\x1a\x1bwrapperspb/wrapperspb.proto\"\xc9\a\n" +
 	"\fConversation\x12 \n" +
 	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12&\n" +
 	"\x0econversationID\x18\x02 \x01(\tR\x0econversationID\x12\x1e\n" +
@@ -5787,7 +7688,29 @@ const file_conversation_conversation_proto_rawDesc = "" +
 	"\x15updateUnreadCountTime\x18\x14 \x01(\x03R\x15updateUnreadCountTime\x122\n" +
 	"\x14parentConversationID\x18\x15 \x01(\tR\x14parentConversationID\x12(\n" +
 	"\x0fisSystemDefault\x18\x16 \x01(\bR\x0fisSystemDefault\x12\x1a\n" +
-	"\bisHidden\x18\x17 \x01(\bR\bisHidden\"\x81\t\n" +
+	"\bisHidden\x18\x17 \x01(\bR\bisHidden\x12<\n" +
+	"\x05draft\x18\x18 \x01(\v2&.openim.conversation.ConversationDraftR\x05draft\x12W\n" +
+	"\x0enotifySettings\x18\x19 \x01(\v2/.openim.conversation.ConversationNotifySettingsR\x0enotifySettings\"\xa0\x01\n" +
+	"\x1aConversationNotifySettings\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\x05R\x04mode\x12\x1a\n" +
+	"\bkeywords\x18\x02 \x03(\tR\bkeywords\x12\x1c\n" +
+	"\tmuteUntil\x18\x03 \x01(\x03R\tmuteUntil\x12\x14\n" +
+	"\x05sound\x18\x04 \x01(\tR\x05sound\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\x05 \x01(\x03R\n" +
+	"updateTime\"\xe4\x01\n" +
+	"\x11ConversationDraft\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x121\n" +
+	"\bquoteMsg\x18\x02 \x01(\v2\x15.openim.sdkws.MsgDataR\bquoteMsg\x12\x1c\n" +
+	"\tatUserIDs\x18\x03 \x03(\tR\tatUserIDs\x12\x1a\n" +
+	"\bdeviceID\x18\x04 \x01(\tR\bdeviceID\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x05 \x01(\x05R\n" +
+	"platformID\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\x06 \x01(\x03R\n" +
+	"updateTime\x12\x0e\n" +
+	"\x02ex\x18\a \x01(\tR\x02ex\"\xda\t\n" +
 	"\x0fConversationReq\x12&\n" +
 	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12*\n" +
 	"\x10conversationType\x18\x02 \x01(\x05R\x10conversationType\x12\x16\n" +
@@ -5811,7 +7734,8 @@ const file_conversation_conversation_proto_rawDesc = "" +
 	"\vunreadCount\x18\x11 \x01(\v2\x1b.openim.protobuf.Int32ValueR\vunreadCount\x12Q\n" +
 	"\x15updateUnreadCountTime\x18\x12 \x01(\v2\x1b.openim.protobuf.Int64ValueR\x15updateUnreadCountTime\x12P\n" +
 	"\x14parentConversationID\x18\x13 \x01(\v2\x1c.openim.protobuf.StringValueR\x14parentConversationID\x126\n" +
-	"\bisHidden\x18\x14 \x01(\v2\x1a.openim.protobuf.BoolValueR\bisHidden\"[\n" +
+	"\bisHidden\x18\x14 \x01(\v2\x1a.openim.protobuf.BoolValueR\bisHidden\x12W\n" +
+	"\x0enotifySettings\x18\x15 \x01(\v2/.openim.conversation.ConversationNotifySettingsR\x0enotifySettings\"[\n" +
 	"\x12SetConversationReq\x12E\n" +
 	"\fconversation\x18\x01 \x01(\v2!.openim.conversation.ConversationR\fconversation\"\x15\n" +
 	"\x13SetConversationResp\"^\n" +
@@ -6091,7 +8015,7 @@ const file_conversation_conversation_proto_rawDesc = "" +
 	"\rconversations\x18\x02 \x03(\v2!.openim.conversation.ConversationR\rconversations\"D\n" +
 	"\x0eGetAllFoldsReq\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1a\n" +
-	"\bfoldType\x18\x02 \x01(\x05R\bfoldType\"\xc6\x02\n" +
+	"\bfoldType\x18\x02 \x01(\x05R\bfoldType\"\xf9\x02\n" +
 	"\x10ConversationFold\x12.\n" +
 	"\x12foldConversationID\x18\x01 \x01(\tR\x12foldConversationID\x12 \n" +
 	"\vownerUserID\x18\x02 \x01(\tR\vownerUserID\x12\x1a\n" +
@@ -6107,7 +8031,22 @@ const file_conversation_conversation_proto_rawDesc = "" +
 	"updateTime\x18\t \x01(\x03R\n" +
 	"updateTime\x12\x0e\n" +
 	"\x02ex\x18\n" +
-	" \x01(\tR\x02ex\"\xe8\x02\n" +
+	" \x01(\tR\x02ex\x121\n" +
+	"\x04rule\x18\v \x01(\v2\x1d.openim.conversation.FoldRuleR\x04rule\"[\n" +
+	"\x11FoldRuleCondition\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x1a\n" +
+	"\bintValue\x18\x02 \x01(\x03R\bintValue\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"\xfc\x01\n" +
+	"\bFoldRule\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12F\n" +
+	"\n" +
+	"conditions\x18\x02 \x03(\v2&.openim.conversation.FoldRuleConditionR\n" +
+	"conditions\x126\n" +
+	"\x16includeConversationIDs\x18\x03 \x03(\tR\x16includeConversationIDs\x126\n" +
+	"\x16excludeConversationIDs\x18\x04 \x03(\tR\x16excludeConversationIDs\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\x05 \x01(\x03R\n" +
+	"updateTime\"\x9b\x03\n" +
 	"\bFoldInfo\x12.\n" +
 	"\x12foldConversationID\x18\x01 \x01(\tR\x12foldConversationID\x12 \n" +
 	"\vownerUserID\x18\x02 \x01(\tR\vownerUserID\x12\x1a\n" +
@@ -6124,7 +8063,8 @@ const file_conversation_conversation_proto_rawDesc = "" +
 	"updateTime\x12\x0e\n" +
 	"\x02ex\x18\n" +
 	" \x01(\tR\x02ex\x12(\n" +
-	"\x0fisSystemDefault\x18\v \x01(\bR\x0fisSystemDefault\"H\n" +
+	"\x0fisSystemDefault\x18\v \x01(\bR\x0fisSystemDefault\x121\n" +
+	"\x04rule\x18\f \x01(\v2\x1d.openim.conversation.FoldRuleR\x04rule\"H\n" +
 	"\x0fGetAllFoldsResp\x125\n" +
 	"\x06groups\x18\x01 \x03(\v2\x1d.openim.conversation.FoldInfoR\x06groups\"W\n" +
 	"\rRemoveFoldReq\x12\x16\n" +
@@ -6133,27 +8073,146 @@ const file_conversation_conversation_proto_rawDesc = "" +
 	"\x0eRemoveFoldResp\x12\x1e\n" +
 	"\n" +
 	"movedCount\x18\x01 \x01(\x05R\n" +
-	"movedCount\"\x9b\x01\n" +
+	"movedCount\"\xce\x01\n" +
 	"\rCreateFoldReq\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1a\n" +
 	"\bfoldName\x18\x02 \x01(\tR\bfoldName\x12\x18\n" +
 	"\afaceURL\x18\x03 \x01(\tR\afaceURL\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
-	"\bfoldType\x18\x05 \x01(\x05R\bfoldType\"@\n" +
+	"\bfoldType\x18\x05 \x01(\x05R\bfoldType\x121\n" +
+	"\x04rule\x18\x06 \x01(\v2\x1d.openim.conversation.FoldRuleR\x04rule\"@\n" +
 	"\x0eCreateFoldResp\x12.\n" +
-	"\x12foldConversationID\x18\x01 \x01(\tR\x12foldConversationID\"\x89\x02\n" +
+	"\x12foldConversationID\x18\x01 \x01(\tR\x12foldConversationID\"\xbc\x02\n" +
 	"\rUpdateFoldReq\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12.\n" +
 	"\x12foldConversationID\x18\x02 \x01(\tR\x12foldConversationID\x128\n" +
 	"\bfoldName\x18\x03 \x01(\v2\x1c.openim.protobuf.StringValueR\bfoldName\x126\n" +
 	"\afaceURL\x18\x04 \x01(\v2\x1c.openim.protobuf.StringValueR\afaceURL\x12>\n" +
-	"\vdescription\x18\x05 \x01(\v2\x1c.openim.protobuf.StringValueR\vdescription\"\x10\n" +
+	"\vdescription\x18\x05 \x01(\v2\x1c.openim.protobuf.StringValueR\vdescription\x121\n" +
+	"\x04rule\x18\x06 \x01(\v2\x1d.openim.conversation.FoldRuleR\x04rule\"\x10\n" +
 	"\x0eUpdateFoldResp\"V\n" +
 	"\fClearFoldReq\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12.\n" +
 	"\x12foldConversationID\x18\x02 \x01(\tR\x12foldConversationID\"3\n" +
 	"\rClearFoldResp\x12\"\n" +
-	"\fclearedCount\x18\x01 \x01(\x05R\fclearedCount2\xf2-\n" +
+	"\fclearedCount\x18\x01 \x01(\x05R\fclearedCount\"\x82\x01\n" +
+	"\n" +
+	"QuietHours\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12 \n" +
+	"\vstartMinute\x18\x02 \x01(\x05R\vstartMinute\x12\x1c\n" +
+	"\tendMinute\x18\x03 \x01(\x05R\tendMinute\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\"\x84\x02\n" +
+	"\x19NotificationDigestSetting\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12&\n" +
+	"\x0econversationID\x18\x02 \x01(\tR\x0econversationID\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\x05R\x04mode\x12(\n" +
+	"\x0fintervalMinutes\x18\x04 \x01(\x05R\x0fintervalMinutes\x12?\n" +
+	"\n" +
+	"quietHours\x18\x05 \x01(\v2\x1f.openim.conversation.QuietHoursR\n" +
+	"quietHours\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\x06 \x01(\x03R\n" +
+	"updateTime\"k\n" +
+	"\x1fSetNotificationDigestSettingReq\x12H\n" +
+	"\asetting\x18\x01 \x01(\v2..openim.conversation.NotificationDigestSettingR\asetting\"\"\n" +
+	" SetNotificationDigestSettingResp\"n\n" +
+	"\"DeleteNotificationDigestSettingReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12&\n" +
+	"\x0econversationID\x18\x02 \x01(\tR\x0econversationID\"%\n" +
+	"#DeleteNotificationDigestSettingResp\"n\n" +
+	" GetNotificationDigestSettingsReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12(\n" +
+	"\x0fconversationIDs\x18\x02 \x03(\tR\x0fconversationIDs\"\xc1\x01\n" +
+	"!GetNotificationDigestSettingsResp\x12F\n" +
+	"\x06global\x18\x01 \x01(\v2..openim.conversation.NotificationDigestSettingR\x06global\x12T\n" +
+	"\rconversations\x18\x02 \x03(\v2..openim.conversation.NotificationDigestSettingR\rconversations\"i\n" +
+	"%GetUsersNotificationDigestSettingsReq\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12\x18\n" +
+	"\auserIDs\x18\x02 \x03(\tR\auserIDs\"\xfc\x01\n" +
+	"&GetUsersNotificationDigestSettingsResp\x12e\n" +
+	"\bsettings\x18\x01 \x03(\v2I.openim.conversation.GetUsersNotificationDigestSettingsResp.SettingsEntryR\bsettings\x1ak\n" +
+	"\rSettingsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12D\n" +
+	"\x05value\x18\x02 \x01(\v2..openim.conversation.NotificationDigestSettingR\x05value:\x028\x01\"_\n" +
+	"\x12PreviewFoldRuleReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x121\n" +
+	"\x04rule\x18\x02 \x01(\v2\x1d.openim.conversation.FoldRuleR\x04rule\"?\n" +
+	"\x13PreviewFoldRuleResp\x12(\n" +
+	"\x0fconversationIDs\x18\x01 \x03(\tR\x0fconversationIDs\"`\n" +
+	"\x16ReevaluateFoldRulesReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12.\n" +
+	"\x12foldConversationID\x18\x02 \x01(\tR\x12foldConversationID\"]\n" +
+	"\x17ReevaluateFoldRulesResp\x12\x1e\n" +
+	"\n" +
+	"addedCount\x18\x01 \x01(\x05R\n" +
+	"addedCount\x12\"\n" +
+	"\fremovedCount\x18\x02 \x01(\x05R\fremovedCount\"\xbd\x01\n" +
+	"\vSetDraftReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12&\n" +
+	"\x0econversationID\x18\x02 \x01(\tR\x0econversationID\x12<\n" +
+	"\x05draft\x18\x03 \x01(\v2&.openim.conversation.ConversationDraftR\x05draft\x12&\n" +
+	"\x0ebaseUpdateTime\x18\x04 \x01(\x03R\x0ebaseUpdateTime\"f\n" +
+	"\fSetDraftResp\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x12<\n" +
+	"\x05draft\x18\x02 \x01(\v2&.openim.conversation.ConversationDraftR\x05draft\"\x9d\x01\n" +
+	"\rClearDraftReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12&\n" +
+	"\x0econversationID\x18\x02 \x01(\tR\x0econversationID\x12\x1a\n" +
+	"\bdeviceID\x18\x03 \x01(\tR\bdeviceID\x12&\n" +
+	"\x0ebaseUpdateTime\x18\x04 \x01(\x03R\x0ebaseUpdateTime\"h\n" +
+	"\x0eClearDraftResp\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x12<\n" +
+	"\x05draft\x18\x02 \x01(\v2&.openim.conversation.ConversationDraftR\x05draft\"\xb9\x01\n" +
+	" SetConversationNotifySettingsReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12&\n" +
+	"\x0econversationID\x18\x02 \x01(\tR\x0econversationID\x12K\n" +
+	"\bsettings\x18\x03 \x01(\v2/.openim.conversation.ConversationNotifySettingsR\bsettings\"#\n" +
+	"!SetConversationNotifySettingsResp\"\x80\x01\n" +
+	"\x14MuteConversationsReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12(\n" +
+	"\x0fconversationIDs\x18\x02 \x03(\tR\x0fconversationIDs\x12\x1c\n" +
+	"\tmuteUntil\x18\x03 \x01(\x03R\tmuteUntil\"\x17\n" +
+	"\x15MuteConversationsResp\"i\n" +
+	"%GetUsersConversationNotifySettingsReq\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12\x18\n" +
+	"\auserIDs\x18\x02 \x03(\tR\auserIDs\"\xfd\x01\n" +
+	"&GetUsersConversationNotifySettingsResp\x12e\n" +
+	"\bsettings\x18\x01 \x03(\v2I.openim.conversation.GetUsersConversationNotifySettingsResp.SettingsEntryR\bsettings\x1al\n" +
+	"\rSettingsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12E\n" +
+	"\x05value\x18\x02 \x01(\v2/.openim.conversation.ConversationNotifySettingsR\x05value:\x028\x01\"\xc2\x04\n" +
+	"\rUnreadSummary\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x1e\n" +
+	"\n" +
+	"mutedTotal\x18\x02 \x01(\x03R\n" +
+	"mutedTotal\x128\n" +
+	"\x17unreadConversationCount\x18\x03 \x01(\x05R\x17unreadConversationCount\x12F\n" +
+	"\x06groups\x18\x04 \x03(\v2..openim.conversation.UnreadSummary.GroupsEntryR\x06groups\x12C\n" +
+	"\x05folds\x18\x05 \x03(\v2-.openim.conversation.UnreadSummary.FoldsEntryR\x05folds\x12[\n" +
+	"\rconversations\x18\x06 \x03(\v25.openim.conversation.UnreadSummary.ConversationsEntryR\rconversations\x12 \n" +
+	"\vcomputeTime\x18\a \x01(\x03R\vcomputeTime\x1a9\n" +
+	"\vGroupsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"FoldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1a@\n" +
+	"\x12ConversationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"[\n" +
+	"\x13GetUnreadSummaryReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12,\n" +
+	"\x11withConversations\x18\x02 \x01(\bR\x11withConversations\"T\n" +
+	"\x14GetUnreadSummaryResp\x12<\n" +
+	"\asummary\x18\x01 \x01(\v2\".openim.conversation.UnreadSummaryR\asummary\",\n" +
+	"\x10GetUsersBadgeReq\x12\x18\n" +
+	"\auserIDs\x18\x01 \x03(\tR\auserIDs\"\x9a\x01\n" +
+	"\x11GetUsersBadgeResp\x12J\n" +
+	"\x06badges\x18\x01 \x03(\v22.openim.conversation.GetUsersBadgeResp.BadgesEntryR\x06badges\x1a9\n" +
+	"\vBadgesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x012\xae:\n" +
 	"\fconversation\x12d\n" +
 	"\x0fGetConversation\x12'.openim.conversation.GetConversationReq\x1a(.openim.conversation.GetConversationResp\x12\x82\x01\n" +
 	"\x19GetSortedConversationList\x121.openim.conversation.GetSortedConversationListReq\x1a2.openim.conversation.GetSortedConversationListResp\x12p\n" +
@@ -6204,7 +8263,21 @@ const file_conversation_conversation_proto_rawDesc = "" +
 	"\vGetAllFolds\x12#.openim.conversation.GetAllFoldsReq\x1a$.openim.conversation.GetAllFoldsResp\x12U\n" +
 	"\n" +
 	"RemoveFold\x12\".openim.conversation.RemoveFoldReq\x1a#.openim.conversation.RemoveFoldResp\x12R\n" +
-	"\tClearFold\x12!.openim.conversation.ClearFoldReq\x1a\".openim.conversation.ClearFoldRespB,Z*github.com/openimsdk/protocol/conversationb\x06proto3"
+	"\tClearFold\x12!.openim.conversation.ClearFoldReq\x1a\".openim.conversation.ClearFoldResp\x12d\n" +
+	"\x0fPreviewFoldRule\x12'.openim.conversation.PreviewFoldRuleReq\x1a(.openim.conversation.PreviewFoldRuleResp\x12p\n" +
+	"\x13ReevaluateFoldRules\x12+.openim.conversation.ReevaluateFoldRulesReq\x1a,.openim.conversation.ReevaluateFoldRulesResp\x12O\n" +
+	"\bSetDraft\x12 .openim.conversation.SetDraftReq\x1a!.openim.conversation.SetDraftResp\x12U\n" +
+	"\n" +
+	"ClearDraft\x12\".openim.conversation.ClearDraftReq\x1a#.openim.conversation.ClearDraftResp\x12\x8e\x01\n" +
+	"\x1dSetConversationNotifySettings\x125.openim.conversation.SetConversationNotifySettingsReq\x1a6.openim.conversation.SetConversationNotifySettingsResp\x12j\n" +
+	"\x11MuteConversations\x12).openim.conversation.MuteConversationsReq\x1a*.openim.conversation.MuteConversationsResp\x12\x9d\x01\n" +
+	"\"GetUsersConversationNotifySettings\x12:.openim.conversation.GetUsersConversationNotifySettingsReq\x1a;.openim.conversation.GetUsersConversationNotifySettingsResp\x12g\n" +
+	"\x10GetUnreadSummary\x12(.openim.conversation.GetUnreadSummaryReq\x1a).openim.conversation.GetUnreadSummaryResp\x12^\n" +
+	"\rGetUsersBadge\x12%.openim.conversation.GetUsersBadgeReq\x1a&.openim.conversation.GetUsersBadgeResp\x12\x8b\x01\n" +
+	"\x1cSetNotificationDigestSetting\x124.openim.conversation.SetNotificationDigestSettingReq\x1a5.openim.conversation.SetNotificationDigestSettingResp\x12\x94\x01\n" +
+	"\x1fDeleteNotificationDigestSetting\x127.openim.conversation.DeleteNotificationDigestSettingReq\x1a8.openim.conversation.DeleteNotificationDigestSettingResp\x12\x8e\x01\n" +
+	"\x1dGetNotificationDigestSettings\x125.openim.conversation.GetNotificationDigestSettingsReq\x1a6.openim.conversation.GetNotificationDigestSettingsResp\x12\x9d\x01\n" +
+	"\"GetUsersNotificationDigestSettings\x12:.openim.conversation.GetUsersNotificationDigestSettingsReq\x1a;.openim.conversation.GetUsersNotificationDigestSettingsRespB,Z*github.com/openimsdk/protocol/conversationb\x06proto3"
 
 var (
 	file_conversation_conversation_proto_rawDescOnce sync.Once
@@ -6218,272 +8291,365 @@ func file_conversation_conversation_proto_rawDescGZIP() []byte {
 	return file_conversation_conversation_proto_rawDescData
 }
 
-var file_conversation_conversation_proto_msgTypes = make([]protoimpl.MessageInfo, 102)
+var file_conversation_conversation_proto_msgTypes = make([]protoimpl.MessageInfo, 141)
 var file_conversation_conversation_proto_goTypes = []any{
 	(*Conversation)(nil),                                // 0: openim.conversation.Conversation
-	(*ConversationReq)(nil),                             // 1: openim.conversation.ConversationReq
-	(*SetConversationReq)(nil),                          // 2: openim.conversation.SetConversationReq
-	(*SetConversationResp)(nil),                         // 3: openim.conversation.SetConversationResp
-	(*GetConversationReq)(nil),                          // 4: openim.conversation.GetConversationReq
-	(*GetConversationResp)(nil),                         // 5: openim.conversation.GetConversationResp
-	(*GetSortedConversationListReq)(nil),                // 6: openim.conversation.GetSortedConversationListReq
-	(*GetSortedConversationListResp)(nil),               // 7: openim.conversation.GetSortedConversationListResp
-	(*ConversationElem)(nil),                            // 8: openim.conversation.ConversationElem
-	(*MsgInfo)(nil),                                     // 9: openim.conversation.MsgInfo
-	(*GetConversationsReq)(nil),                         // 10: openim.conversation.GetConversationsReq
-	(*GetConversationsResp)(nil),                        // 11: openim.conversation.GetConversationsResp
-	(*GetAllConversationsReq)(nil),                      // 12: openim.conversation.GetAllConversationsReq
-	(*GetAllConversationsResp)(nil),                     // 13: openim.conversation.GetAllConversationsResp
-	(*GetRecvMsgNotNotifyUserIDsReq)(nil),               // 14: openim.conversation.GetRecvMsgNotNotifyUserIDsReq
-	(*GetRecvMsgNotNotifyUserIDsResp)(nil),              // 15: openim.conversation.GetRecvMsgNotNotifyUserIDsResp
-	(*CreateSingleChatConversationsReq)(nil),            // 16: openim.conversation.CreateSingleChatConversationsReq
-	(*CreateSingleChatConversationsResp)(nil),           // 17: openim.conversation.CreateSingleChatConversationsResp
-	(*CreateGroupChatConversationsReq)(nil),             // 18: openim.conversation.CreateGroupChatConversationsReq
-	(*CreateGroupChatConversationsResp)(nil),            // 19: openim.conversation.CreateGroupChatConversationsResp
-	(*SetConversationMaxSeqReq)(nil),                    // 20: openim.conversation.SetConversationMaxSeqReq
-	(*SetConversationMaxSeqResp)(nil),                   // 21: openim.conversation.SetConversationMaxSeqResp
-	(*SetConversationMinSeqReq)(nil),                    // 22: openim.conversation.SetConversationMinSeqReq
-	(*SetConversationMinSeqResp)(nil),                   // 23: openim.conversation.SetConversationMinSeqResp
-	(*GetConversationIDsReq)(nil),                       // 24: openim.conversation.GetConversationIDsReq
-	(*GetConversationIDsResp)(nil),                      // 25: openim.conversation.GetConversationIDsResp
-	(*SetConversationsReq)(nil),                         // 26: openim.conversation.SetConversationsReq
-	(*SetConversationsResp)(nil),                        // 27: openim.conversation.SetConversationsResp
-	(*GetUserConversationIDsHashReq)(nil),               // 28: openim.conversation.GetUserConversationIDsHashReq
-	(*GetUserConversationIDsHashResp)(nil),              // 29: openim.conversation.GetUserConversationIDsHashResp
-	(*GetConversationsByConversationIDReq)(nil),         // 30: openim.conversation.GetConversationsByConversationIDReq
-	(*GetConversationsByConversationIDResp)(nil),        // 31: openim.conversation.GetConversationsByConversationIDResp
-	(*GetConversationOfflinePushUserIDsReq)(nil),        // 32: openim.conversation.GetConversationOfflinePushUserIDsReq
-	(*GetConversationOfflinePushUserIDsResp)(nil),       // 33: openim.conversation.GetConversationOfflinePushUserIDsResp
-	(*GetConversationNotReceiveMessageUserIDsReq)(nil),  // 34: openim.conversation.GetConversationNotReceiveMessageUserIDsReq
-	(*GetConversationNotReceiveMessageUserIDsResp)(nil), // 35: openim.conversation.GetConversationNotReceiveMessageUserIDsResp
-	(*UpdateConversationReq)(nil),                       // 36: openim.conversation.UpdateConversationReq
-	(*UpdateConversationResp)(nil),                      // 37: openim.conversation.UpdateConversationResp
-	(*GetFullOwnerConversationIDsReq)(nil),              // 38: openim.conversation.GetFullOwnerConversationIDsReq
-	(*GetFullOwnerConversationIDsResp)(nil),             // 39: openim.conversation.GetFullOwnerConversationIDsResp
-	(*GetIncrementalConversationReq)(nil),               // 40: openim.conversation.GetIncrementalConversationReq
-	(*GetIncrementalConversationResp)(nil),              // 41: openim.conversation.GetIncrementalConversationResp
-	(*GetOwnerConversationReq)(nil),                     // 42: openim.conversation.GetOwnerConversationReq
-	(*GetOwnerConversationResp)(nil),                    // 43: openim.conversation.GetOwnerConversationResp
-	(*GetConversationsNeedClearMsgReq)(nil),             // 44: openim.conversation.GetConversationsNeedClearMsgReq
-	(*GetConversationsNeedClearMsgResp)(nil),            // 45: openim.conversation.GetConversationsNeedClearMsgResp
-	(*GetNotNotifyConversationIDsReq)(nil),              // 46: openim.conversation.GetNotNotifyConversationIDsReq
-	(*GetNotNotifyConversationIDsResp)(nil),             // 47: openim.conversation.GetNotNotifyConversationIDsResp
-	(*GetPinnedConversationIDsReq)(nil),                 // 48: openim.conversation.GetPinnedConversationIDsReq
-	(*GetPinnedConversationIDsResp)(nil),                // 49: openim.conversation.GetPinnedConversationIDsResp
-	(*MarkConversationReq)(nil),                         // 50: openim.conversation.MarkConversationReq
-	(*MarkConversationResp)(nil),                        // 51: openim.conversation.MarkConversationResp
-	(*MarkConversationAsUnreadReq)(nil),                 // 52: openim.conversation.MarkConversationAsUnreadReq
-	(*MarkConversationAsUnreadResp)(nil),                // 53: openim.conversation.MarkConversationAsUnreadResp
-	(*ClearUserConversationMsgReq)(nil),                 // 54: openim.conversation.ClearUserConversationMsgReq
-	(*ClearUserConversationMsgResp)(nil),                // 55: openim.conversation.ClearUserConversationMsgResp
-	(*UpdateConversationsByUserReq)(nil),                // 56: openim.conversation.UpdateConversationsByUserReq
-	(*UpdateConversationsByUserResp)(nil),               // 57: openim.conversation.UpdateConversationsByUserResp
-	(*DeleteConversationsReq)(nil),                      // 58: openim.conversation.DeleteConversationsReq
-	(*DeleteConversationsResp)(nil),                     // 59: openim.conversation.DeleteConversationsResp
-	(*UnhideConversationsIfNeededReq)(nil),              // 60: openim.conversation.UnhideConversationsIfNeededReq
-	(*UnhideConversationsIfNeededResp)(nil),             // 61: openim.conversation.UnhideConversationsIfNeededResp
-	(*ConversationGroup)(nil),                           // 62: openim.conversation.ConversationGroup
-	(*GroupOrder)(nil),                                  // 63: openim.conversation.GroupOrder
-	(*InitConversationGroupsReq)(nil),                   // 64: openim.conversation.InitConversationGroupsReq
-	(*InitConversationGroupsResp)(nil),                  // 65: openim.conversation.InitConversationGroupsResp
-	(*GetAllConversationGroupsReq)(nil),                 // 66: openim.conversation.GetAllConversationGroupsReq
-	(*GetAllConversationGroupsResp)(nil),                // 67: openim.conversation.GetAllConversationGroupsResp
-	(*GetVisibleConversationGroupsReq)(nil),             // 68: openim.conversation.GetVisibleConversationGroupsReq
-	(*GetVisibleConversationGroupsResp)(nil),            // 69: openim.conversation.GetVisibleConversationGroupsResp
-	(*CreateConversationGroupReq)(nil),                  // 70: openim.conversation.CreateConversationGroupReq
-	(*CreateConversationGroupResp)(nil),                 // 71: openim.conversation.CreateConversationGroupResp
-	(*UpdateConversationGroupReq)(nil),                  // 72: openim.conversation.UpdateConversationGroupReq
-	(*UpdateConversationGroupResp)(nil),                 // 73: openim.conversation.UpdateConversationGroupResp
-	(*DeleteConversationGroupReq)(nil),                  // 74: openim.conversation.DeleteConversationGroupReq
-	(*DeleteConversationGroupResp)(nil),                 // 75: openim.conversation.DeleteConversationGroupResp
-	(*UpdateConversationGroupSortReq)(nil),              // 76: openim.conversation.UpdateConversationGroupSortReq
-	(*UpdateConversationGroupSortResp)(nil),             // 77: openim.conversation.UpdateConversationGroupSortResp
-	(*SetConversationGroupVisibilityReq)(nil),           // 78: openim.conversation.SetConversationGroupVisibilityReq
-	(*SetConversationGroupVisibilityResp)(nil),          // 79: openim.conversation.SetConversationGroupVisibilityResp
-	(*AddConversationsToGroupReq)(nil),                  // 80: openim.conversation.AddConversationsToGroupReq
-	(*AddConversationsToGroupResp)(nil),                 // 81: openim.conversation.AddConversationsToGroupResp
-	(*RemoveConversationsFromGroupReq)(nil),             // 82: openim.conversation.RemoveConversationsFromGroupReq
-	(*RemoveConversationsFromGroupResp)(nil),            // 83: openim.conversation.RemoveConversationsFromGroupResp
-	(*GetConversationIDsByGroupIDReq)(nil),              // 84: openim.conversation.GetConversationIDsByGroupIDReq
-	(*GetConversationIDsByGroupIDResp)(nil),             // 85: openim.conversation.GetConversationIDsByGroupIDResp
-	(*SetConversationFoldReq)(nil),                      // 86: openim.conversation.SetConversationFoldReq
-	(*SetConversationFoldResp)(nil),                     // 87: openim.conversation.SetConversationFoldResp
-	(*GetFoldConversationListReq)(nil),                  // 88: openim.conversation.GetFoldConversationListReq
-	(*GetFoldConversationListResp)(nil),                 // 89: openim.conversation.GetFoldConversationListResp
-	(*GetAllFoldsReq)(nil),                              // 90: openim.conversation.GetAllFoldsReq
-	(*ConversationFold)(nil),                            // 91: openim.conversation.ConversationFold
-	(*FoldInfo)(nil),                                    // 92: openim.conversation.FoldInfo
-	(*GetAllFoldsResp)(nil),                             // 93: openim.conversation.GetAllFoldsResp
-	(*RemoveFoldReq)(nil),                               // 94: openim.conversation.RemoveFoldReq
-	(*RemoveFoldResp)(nil),                              // 95: openim.conversation.RemoveFoldResp
-	(*CreateFoldReq)(nil),                               // 96: openim.conversation.CreateFoldReq
-	(*CreateFoldResp)(nil),                              // 97: openim.conversation.CreateFoldResp
-	(*UpdateFoldReq)(nil),                               // 98: openim.conversation.UpdateFoldReq
-	(*UpdateFoldResp)(nil),                              // 99: openim.conversation.UpdateFoldResp
-	(*ClearFoldReq)(nil),                                // 100: openim.conversation.ClearFoldReq
-	(*ClearFoldResp)(nil),                               // 101: openim.conversation.ClearFoldResp
-	(*wrapperspb.Int32Value)(nil),                       // 102: openim.protobuf.Int32Value
-	(*wrapperspb.BoolValue)(nil),                        // 103: openim.protobuf.BoolValue
-	(*wrapperspb.StringValue)(nil),                      // 104: openim.protobuf.StringValue
-	(*wrapperspb.Int64Value)(nil),                       // 105: openim.protobuf.Int64Value
-	(*sdkws.RequestPagination)(nil),                     // 106: openim.sdkws.RequestPagination
+	(*ConversationNotifySettings)(nil),                  // 1: openim.conversation.ConversationNotifySettings
+	(*ConversationDraft)(nil),                           // 2: openim.conversation.ConversationDraft
+	(*ConversationReq)(nil),                             // 3: openim.conversation.ConversationReq
+	(*SetConversationReq)(nil),                          // 4: openim.conversation.SetConversationReq
+	(*SetConversationResp)(nil),                         // 5: openim.conversation.SetConversationResp
+	(*GetConversationReq)(nil),                          // 6: openim.conversation.GetConversationReq
+	(*GetConversationResp)(nil),                         // 7: openim.conversation.GetConversationResp
+	(*GetSortedConversationListReq)(nil),                // 8: openim.conversation.GetSortedConversationListReq
+	(*GetSortedConversationListResp)(nil),               // 9: openim.conversation.GetSortedConversationListResp
+	(*ConversationElem)(nil),                            // 10: openim.conversation.ConversationElem
+	(*MsgInfo)(nil),                                     // 11: openim.conversation.MsgInfo
+	(*GetConversationsReq)(nil),                         // 12: openim.conversation.GetConversationsReq
+	(*GetConversationsResp)(nil),                        // 13: openim.conversation.GetConversationsResp
+	(*GetAllConversationsReq)(nil),                      // 14: openim.conversation.GetAllConversationsReq
+	(*GetAllConversationsResp)(nil),                     // 15: openim.conversation.GetAllConversationsResp
+	(*GetRecvMsgNotNotifyUserIDsReq)(nil),               // 16: openim.conversation.GetRecvMsgNotNotifyUserIDsReq
+	(*GetRecvMsgNotNotifyUserIDsResp)(nil),              // 17: openim.conversation.GetRecvMsgNotNotifyUserIDsResp
+	(*CreateSingleChatConversationsReq)(nil),            // 18: openim.conversation.CreateSingleChatConversationsReq
+	(*CreateSingleChatConversationsResp)(nil),           // 19: openim.conversation.CreateSingleChatConversationsResp
+	(*CreateGroupChatConversationsReq)(nil),             // 20: openim.conversation.CreateGroupChatConversationsReq
+	(*CreateGroupChatConversationsResp)(nil),            // 21: openim.conversation.CreateGroupChatConversationsResp
+	(*SetConversationMaxSeqReq)(nil),                    // 22: openim.conversation.SetConversationMaxSeqReq
+	(*SetConversationMaxSeqResp)(nil),                   // 23: openim.conversation.SetConversationMaxSeqResp
+	(*SetConversationMinSeqReq)(nil),                    // 24: openim.conversation.SetConversationMinSeqReq
+	(*SetConversationMinSeqResp)(nil),                   // 25: openim.conversation.SetConversationMinSeqResp
+	(*GetConversationIDsReq)(nil),                       // 26: openim.conversation.GetConversationIDsReq
+	(*GetConversationIDsResp)(nil),                      // 27: openim.conversation.GetConversationIDsResp
+	(*SetConversationsReq)(nil),                         // 28: openim.conversation.SetConversationsReq
+	(*SetConversationsResp)(nil),                        // 29: openim.conversation.SetConversationsResp
+	(*GetUserConversationIDsHashReq)(nil),               // 30: openim.conversation.GetUserConversationIDsHashReq
+	(*GetUserConversationIDsHashResp)(nil),              // 31: openim.conversation.GetUserConversationIDsHashResp
+	(*GetConversationsByConversationIDReq)(nil),         // 32: openim.conversation.GetConversationsByConversationIDReq
+	(*GetConversationsByConversationIDResp)(nil),        // 33: openim.conversation.GetConversationsByConversationIDResp
+	(*GetConversationOfflinePushUserIDsReq)(nil),        // 34: openim.conversation.GetConversationOfflinePushUserIDsReq
+	(*GetConversationOfflinePushUserIDsResp)(nil),       // 35: openim.conversation.GetConversationOfflinePushUserIDsResp
+	(*GetConversationNotReceiveMessageUserIDsReq)(nil),  // 36: openim.conversation.GetConversationNotReceiveMessageUserIDsReq
+	(*GetConversationNotReceiveMessageUserIDsResp)(nil), // 37: openim.conversation.GetConversationNotReceiveMessageUserIDsResp
+	(*UpdateConversationReq)(nil),                       // 38: openim.conversation.UpdateConversationReq
+	(*UpdateConversationResp)(nil),                      // 39: openim.conversation.UpdateConversationResp
+	(*GetFullOwnerConversationIDsReq)(nil),              // 40: openim.conversation.GetFullOwnerConversationIDsReq
+	(*GetFullOwnerConversationIDsResp)(nil),             // 41: openim.conversation.GetFullOwnerConversationIDsResp
+	(*GetIncrementalConversationReq)(nil),               // 42: openim.conversation.GetIncrementalConversationReq
+	(*GetIncrementalConversationResp)(nil),              // 43: openim.conversation.GetIncrementalConversationResp
+	(*GetOwnerConversationReq)(nil),                     // 44: openim.conversation.GetOwnerConversationReq
+	(*GetOwnerConversationResp)(nil),                    // 45: openim.conversation.GetOwnerConversationResp
+	(*GetConversationsNeedClearMsgReq)(nil),             // 46: openim.conversation.GetConversationsNeedClearMsgReq
+	(*GetConversationsNeedClearMsgResp)(nil),            // 47: openim.conversation.GetConversationsNeedClearMsgResp
+	(*GetNotNotifyConversationIDsReq)(nil),              // 48: openim.conversation.GetNotNotifyConversationIDsReq
+	(*GetNotNotifyConversationIDsResp)(nil),             // 49: openim.conversation.GetNotNotifyConversationIDsResp
+	(*GetPinnedConversationIDsReq)(nil),                 // 50: openim.conversation.GetPinnedConversationIDsReq
+	(*GetPinnedConversationIDsResp)(nil),                // 51: openim.conversation.GetPinnedConversationIDsResp
+	(*MarkConversationReq)(nil),                         // 52: openim.conversation.MarkConversationReq
+	(*MarkConversationResp)(nil),                        // 53: openim.conversation.MarkConversationResp
+	(*MarkConversationAsUnreadReq)(nil),                 // 54: openim.conversation.MarkConversationAsUnreadReq
+	(*MarkConversationAsUnreadResp)(nil),                // 55: openim.conversation.MarkConversationAsUnreadResp
+	(*ClearUserConversationMsgReq)(nil),                 // 56: openim.conversation.ClearUserConversationMsgReq
+	(*ClearUserConversationMsgResp)(nil),                // 57: openim.conversation.ClearUserConversationMsgResp
+	(*UpdateConversationsByUserReq)(nil),                // 58: openim.conversation.UpdateConversationsByUserReq
+	(*UpdateConversationsByUserResp)(nil),               // 59: openim.conversation.UpdateConversationsByUserResp
+	(*DeleteConversationsReq)(nil),                      // 60: openim.conversation.DeleteConversationsReq
+	(*DeleteConversationsResp)(nil),                     // 61: openim.conversation.DeleteConversationsResp
+	(*UnhideConversationsIfNeededReq)(nil),              // 62: openim.conversation.UnhideConversationsIfNeededReq
+	(*UnhideConversationsIfNeededResp)(nil),             // 63: openim.conversation.UnhideConversationsIfNeededResp
+	(*ConversationGroup)(nil),                           // 64: openim.conversation.ConversationGroup
+	(*GroupOrder)(nil),                                  // 65: openim.conversation.GroupOrder
+	(*InitConversationGroupsReq)(nil),                   // 66: openim.conversation.InitConversationGroupsReq
+	(*InitConversationGroupsResp)(nil),                  // 67: openim.conversation.InitConversationGroupsResp
+	(*GetAllConversationGroupsReq)(nil),                 // 68: openim.conversation.GetAllConversationGroupsReq
+	(*GetAllConversationGroupsResp)(nil),                // 69: openim.conversation.GetAllConversationGroupsResp
+	(*GetVisibleConversationGroupsReq)(nil),             // 70: openim.conversation.GetVisibleConversationGroupsReq
+	(*GetVisibleConversationGroupsResp)(nil),            // 71: openim.conversation.GetVisibleConversationGroupsResp
+	(*CreateConversationGroupReq)(nil),                  // 72: openim.conversation.CreateConversationGroupReq
+	(*CreateConversationGroupResp)(nil),                 // 73: openim.conversation.CreateConversationGroupResp
+	(*UpdateConversationGroupReq)(nil),                  // 74: openim.conversation.UpdateConversationGroupReq
+	(*UpdateConversationGroupResp)(nil),                 // 75: openim.conversation.UpdateConversationGroupResp
+	(*DeleteConversationGroupReq)(nil),                  // 76: openim.conversation.DeleteConversationGroupReq
+	(*DeleteConversationGroupResp)(nil),                 // 77: openim.conversation.DeleteConversationGroupResp
+	(*UpdateConversationGroupSortReq)(nil),              // 78: openim.conversation.UpdateConversationGroupSortReq
+	(*UpdateConversationGroupSortResp)(nil),             // 79: openim.conversation.UpdateConversationGroupSortResp
+	(*SetConversationGroupVisibilityReq)(nil),           // 80: openim.conversation.SetConversationGroupVisibilityReq
+	(*SetConversationGroupVisibilityResp)(nil),          // 81: openim.conversation.SetConversationGroupVisibilityResp
+	(*AddConversationsToGroupReq)(nil),                  // 82: openim.conversation.AddConversationsToGroupReq
+	(*AddConversationsToGroupResp)(nil),                 // 83: openim.conversation.AddConversationsToGroupResp
+	(*RemoveConversationsFromGroupReq)(nil),             // 84: openim.conversation.RemoveConversationsFromGroupReq
+	(*RemoveConversationsFromGroupResp)(nil),            // 85: openim.conversation.RemoveConversationsFromGroupResp
+	(*GetConversationIDsByGroupIDReq)(nil),              // 86: openim.conversation.GetConversationIDsByGroupIDReq
+	(*GetConversationIDsByGroupIDResp)(nil),             // 87: openim.conversation.GetConversationIDsByGroupIDResp
+	(*SetConversationFoldReq)(nil),                      // 88: openim.conversation.SetConversationFoldReq
+	(*SetConversationFoldResp)(nil),                     // 89: openim.conversation.SetConversationFoldResp
+	(*GetFoldConversationListReq)(nil),                  // 90: openim.conversation.GetFoldConversationListReq
+	(*GetFoldConversationListResp)(nil),                 // 91: openim.conversation.GetFoldConversationListResp
+	(*GetAllFoldsReq)(nil),                              // 92: openim.conversation.GetAllFoldsReq
+	(*ConversationFold)(nil),                            // 93: openim.conversation.ConversationFold
+	(*FoldRuleCondition)(nil),                           // 94: openim.conversation.FoldRuleCondition
+	(*FoldRule)(nil),                                    // 95: openim.conversation.FoldRule
+	(*FoldInfo)(nil),                                    // 96: openim.conversation.FoldInfo
+	(*GetAllFoldsResp)(nil),                             // 97: openim.conversation.GetAllFoldsResp
+	(*RemoveFoldReq)(nil),                               // 98: openim.conversation.RemoveFoldReq
+	(*RemoveFoldResp)(nil),                              // 99: openim.conversation.RemoveFoldResp
+	(*CreateFoldReq)(nil),                               // 100: openim.conversation.CreateFoldReq
+	(*CreateFoldResp)(nil),                              // 101: openim.conversation.CreateFoldResp
+	(*UpdateFoldReq)(nil),                               // 102: openim.conversation.UpdateFoldReq
+	(*UpdateFoldResp)(nil),                              // 103: openim.conversation.UpdateFoldResp
+	(*ClearFoldReq)(nil),                                // 104: openim.conversation.ClearFoldReq
+	(*ClearFoldResp)(nil),                               // 105: openim.conversation.ClearFoldResp
+	(*QuietHours)(nil),                                  // 106: openim.conversation.QuietHours
+	(*NotificationDigestSetting)(nil),                   // 107: openim.conversation.NotificationDigestSetting
+	(*SetNotificationDigestSettingReq)(nil),             // 108: openim.conversation.SetNotificationDigestSettingReq
+	(*SetNotificationDigestSettingResp)(nil),            // 109: openim.conversation.SetNotificationDigestSettingResp
+	(*DeleteNotificationDigestSettingReq)(nil),          // 110: openim.conversation.DeleteNotificationDigestSettingReq
+	(*DeleteNotificationDigestSettingResp)(nil),         // 111: openim.conversation.DeleteNotificationDigestSettingResp
+	(*GetNotificationDigestSettingsReq)(nil),            // 112: openim.conversation.GetNotificationDigestSettingsReq
+	(*GetNotificationDigestSettingsResp)(nil),           // 113: openim.conversation.GetNotificationDigestSettingsResp
+	(*GetUsersNotificationDigestSettingsReq)(nil),       // 114: openim.conversation.GetUsersNotificationDigestSettingsReq
+	(*GetUsersNotificationDigestSettingsResp)(nil),      // 115: openim.conversation.GetUsersNotificationDigestSettingsResp
+	(*PreviewFoldRuleReq)(nil),                          // 116: openim.conversation.PreviewFoldRuleReq
+	(*PreviewFoldRuleResp)(nil),                         // 117: openim.conversation.PreviewFoldRuleResp
+	(*ReevaluateFoldRulesReq)(nil),                      // 118: openim.conversation.ReevaluateFoldRulesReq
+	(*ReevaluateFoldRulesResp)(nil),                     // 119: openim.conversation.ReevaluateFoldRulesResp
+	(*SetDraftReq)(nil),                                 // 120: openim.conversation.SetDraftReq
+	(*SetDraftResp)(nil),                                // 121: openim.conversation.SetDraftResp
+	(*ClearDraftReq)(nil),                               // 122: openim.conversation.ClearDraftReq
+	(*ClearDraftResp)(nil),                              // 123: openim.conversation.ClearDraftResp
+	(*SetConversationNotifySettingsReq)(nil),            // 124: openim.conversation.SetConversationNotifySettingsReq
+	(*SetConversationNotifySettingsResp)(nil),           // 125: openim.conversation.SetConversationNotifySettingsResp
+	(*MuteConversationsReq)(nil),                        // 126: openim.conversation.MuteConversationsReq
+	(*MuteConversationsResp)(nil),                       // 127: openim.conversation.MuteConversationsResp
+	(*GetUsersConversationNotifySettingsReq)(nil),       // 128: openim.conversation.GetUsersConversationNotifySettingsReq
+	(*GetUsersConversationNotifySettingsResp)(nil),      // 129: openim.conversation.GetUsersConversationNotifySettingsResp
+	(*UnreadSummary)(nil),                               // 130: openim.conversation.UnreadSummary
+	(*GetUnreadSummaryReq)(nil),                         // 131: openim.conversation.GetUnreadSummaryReq
+	(*GetUnreadSummaryResp)(nil),                        // 132: openim.conversation.GetUnreadSummaryResp
+	(*GetUsersBadgeReq)(nil),                            // 133: openim.conversation.GetUsersBadgeReq
+	(*GetUsersBadgeResp)(nil),                           // 134: openim.conversation.GetUsersBadgeResp
+	nil,                                                 // 135: openim.conversation.GetUsersNotificationDigestSettingsResp.SettingsEntry
+	nil,                                                 // 136: openim.conversation.GetUsersConversationNotifySettingsResp.SettingsEntry
+	nil,                                                 // 137: openim.conversation.UnreadSummary.GroupsEntry
+	nil,                                                 // 138: openim.conversation.UnreadSummary.FoldsEntry
+	nil,                                                 // 139: openim.conversation.UnreadSummary.ConversationsEntry
+	nil,                                                 // 140: openim.conversation.GetUsersBadgeResp.BadgesEntry
+	(*sdkws.MsgData)(nil),                               // 141: openim.sdkws.MsgData
+	(*wrapperspb.Int32Value)(nil),                       // 142: openim.protobuf.Int32Value
+	(*wrapperspb.BoolValue)(nil),                        // 143: openim.protobuf.BoolValue
+	(*wrapperspb.StringValue)(nil),                      // 144: openim.protobuf.StringValue
+	(*wrapperspb.Int64Value)(nil),                       // 145: openim.protobuf.Int64Value
+	(*sdkws.RequestPagination)(nil),                     // 146: openim.sdkws.RequestPagination
 }
 var file_conversation_conversation_proto_depIdxs = []int32{
-	102, // 0: openim.conversation.ConversationReq.recvMsgOpt:type_name -> openim.protobuf.Int32Value
-	103, // 1: openim.conversation.ConversationReq.isPinned:type_name -> openim.protobuf.BoolValue
-	104, // 2: openim.conversation.ConversationReq.attachedInfo:type_name -> openim.protobuf.StringValue
-	103, // 3: openim.conversation.ConversationReq.isPrivateChat:type_name -> openim.protobuf.BoolValue
-	104, // 4: openim.conversation.ConversationReq.ex:type_name -> openim.protobuf.StringValue
-	102, // 5: openim.conversation.ConversationReq.burnDuration:type_name -> openim.protobuf.Int32Value
-	105, // 6: openim.conversation.ConversationReq.minSeq:type_name -> openim.protobuf.Int64Value
-	105, // 7: openim.conversation.ConversationReq.maxSeq:type_name -> openim.protobuf.Int64Value
-	102, // 8: openim.conversation.ConversationReq.groupAtType:type_name -> openim.protobuf.Int32Value
-	105, // 9: openim.conversation.ConversationReq.msgDestructTime:type_name -> openim.protobuf.Int64Value
-	103, // 10: openim.conversation.ConversationReq.isMsgDestruct:type_name -> openim.protobuf.BoolValue
-	103, // 11: openim.conversation.ConversationReq.isMark:type_name -> openim.protobuf.BoolValue
-	102, // 12: openim.conversation.ConversationReq.unreadCount:type_name -> openim.protobuf.Int32Value
-	105, // 13: openim.conversation.ConversationReq.updateUnreadCountTime:type_name -> openim.protobuf.Int64Value
-	104, // 14: openim.conversation.ConversationReq.parentConversationID:type_name -> openim.protobuf.StringValue
-	103, // 15: openim.conversation.ConversationReq.isHidden:type_name -> openim.protobuf.BoolValue
-	0,   // 16: openim.conversation.SetConversationReq.conversation:type_name -> openim.conversation.Conversation
-	0,   // 17: openim.conversation.GetConversationResp.conversation:type_name -> openim.conversation.Conversation
-	106, // 18: openim.conversation.GetSortedConversationListReq.pagination:type_name -> openim.sdkws.RequestPagination
-	8,   // 19: openim.conversation.GetSortedConversationListResp.conversationElems:type_name -> openim.conversation.ConversationElem
-	9,   // 20: openim.conversation.ConversationElem.msgInfo:type_name -> openim.conversation.MsgInfo
-	0,   // 21: openim.conversation.GetConversationsResp.conversations:type_name -> openim.conversation.Conversation
-	0,   // 22: openim.conversation.GetAllConversationsResp.conversations:type_name -> openim.conversation.Conversation
-	1,   // 23: openim.conversation.SetConversationsReq.conversation:type_name -> openim.conversation.ConversationReq
-	0,   // 24: openim.conversation.GetConversationsByConversationIDResp.conversations:type_name -> openim.conversation.Conversation
-	102, // 25: openim.conversation.UpdateConversationReq.recvMsgOpt:type_name -> openim.protobuf.Int32Value
-	103, // 26: openim.conversation.UpdateConversationReq.isPinned:type_name -> openim.protobuf.BoolValue
-	104, // 27: openim.conversation.UpdateConversationReq.attachedInfo:type_name -> openim.protobuf.StringValue
-	103, // 28: openim.conversation.UpdateConversationReq.isPrivateChat:type_name -> openim.protobuf.BoolValue
-	104, // 29: openim.conversation.UpdateConversationReq.ex:type_name -> openim.protobuf.StringValue
-	102, // 30: openim.conversation.UpdateConversationReq.burnDuration:type_name -> openim.protobuf.Int32Value
-	105, // 31: openim.conversation.UpdateConversationReq.minSeq:type_name -> openim.protobuf.Int64Value
-	105, // 32: openim.conversation.UpdateConversationReq.maxSeq:type_name -> openim.protobuf.Int64Value
-	102, // 33: openim.conversation.UpdateConversationReq.groupAtType:type_name -> openim.protobuf.Int32Value
-	105, // 34: openim.conversation.UpdateConversationReq.msgDestructTime:type_name -> openim.protobuf.Int64Value
-	103, // 35: openim.conversation.UpdateConversationReq.isMsgDestruct:type_name -> openim.protobuf.BoolValue
-	105, // 36: openim.conversation.UpdateConversationReq.latestMsgDestructTime:type_name -> openim.protobuf.Int64Value
-	103, // 37: openim.conversation.UpdateConversationReq.isMark:type_name -> openim.protobuf.BoolValue
-	104, // 38: openim.conversation.UpdateConversationReq.parentConversationID:type_name -> openim.protobuf.StringValue
-	103, // 39: openim.conversation.UpdateConversationReq.isHidden:type_name -> openim.protobuf.BoolValue
-	0,   // 40: openim.conversation.GetIncrementalConversationResp.insert:type_name -> openim.conversation.Conversation
-	0,   // 41: openim.conversation.GetIncrementalConversationResp.update:type_name -> openim.conversation.Conversation
-	106, // 42: openim.conversation.GetOwnerConversationReq.pagination:type_name -> openim.sdkws.RequestPagination
-	0,   // 43: openim.conversation.GetOwnerConversationResp.conversations:type_name -> openim.conversation.Conversation
-	0,   // 44: openim.conversation.GetConversationsNeedClearMsgResp.conversations:type_name -> openim.conversation.Conversation
-	104, // 45: openim.conversation.UpdateConversationsByUserReq.ex:type_name -> openim.protobuf.StringValue
-	62,  // 46: openim.conversation.GetAllConversationGroupsResp.groups:type_name -> openim.conversation.ConversationGroup
-	62,  // 47: openim.conversation.GetVisibleConversationGroupsResp.groups:type_name -> openim.conversation.ConversationGroup
-	62,  // 48: openim.conversation.CreateConversationGroupResp.group:type_name -> openim.conversation.ConversationGroup
-	63,  // 49: openim.conversation.UpdateConversationGroupSortReq.groupOrders:type_name -> openim.conversation.GroupOrder
-	106, // 50: openim.conversation.GetFoldConversationListReq.pagination:type_name -> openim.sdkws.RequestPagination
-	0,   // 51: openim.conversation.GetFoldConversationListResp.conversations:type_name -> openim.conversation.Conversation
-	92,  // 52: openim.conversation.GetAllFoldsResp.groups:type_name -> openim.conversation.FoldInfo
-	104, // 53: openim.conversation.UpdateFoldReq.foldName:type_name -> openim.protobuf.StringValue
-	104, // 54: openim.conversation.UpdateFoldReq.faceURL:type_name -> openim.protobuf.StringValue
-	104, // 55: openim.conversation.UpdateFoldReq.description:type_name -> openim.protobuf.StringValue
-	4,   // 56: openim.conversation.conversation.GetConversation:input_type -> openim.conversation.GetConversationReq
-	6,   // 57: openim.conversation.conversation.GetSortedConversationList:input_type -> openim.conversation.GetSortedConversationListReq
-	12,  // 58: openim.conversation.conversation.GetAllConversations:input_type -> openim.conversation.GetAllConversationsReq
-	10,  // 59: openim.conversation.conversation.GetConversations:input_type -> openim.conversation.GetConversationsReq
-	2,   // 60: openim.conversation.conversation.SetConversation:input_type -> openim.conversation.SetConversationReq
-	14,  // 61: openim.conversation.conversation.GetRecvMsgNotNotifyUserIDs:input_type -> openim.conversation.GetRecvMsgNotNotifyUserIDsReq
-	16,  // 62: openim.conversation.conversation.CreateSingleChatConversations:input_type -> openim.conversation.CreateSingleChatConversationsReq
-	18,  // 63: openim.conversation.conversation.CreateGroupChatConversations:input_type -> openim.conversation.CreateGroupChatConversationsReq
-	20,  // 64: openim.conversation.conversation.SetConversationMaxSeq:input_type -> openim.conversation.SetConversationMaxSeqReq
-	22,  // 65: openim.conversation.conversation.SetConversationMinSeq:input_type -> openim.conversation.SetConversationMinSeqReq
-	24,  // 66: openim.conversation.conversation.GetConversationIDs:input_type -> openim.conversation.GetConversationIDsReq
-	26,  // 67: openim.conversation.conversation.SetConversations:input_type -> openim.conversation.SetConversationsReq
-	28,  // 68: openim.conversation.conversation.GetUserConversationIDsHash:input_type -> openim.conversation.GetUserConversationIDsHashReq
-	30,  // 69: openim.conversation.conversation.GetConversationsByConversationID:input_type -> openim.conversation.GetConversationsByConversationIDReq
-	32,  // 70: openim.conversation.conversation.GetConversationOfflinePushUserIDs:input_type -> openim.conversation.GetConversationOfflinePushUserIDsReq
-	34,  // 71: openim.conversation.conversation.GetConversationNotReceiveMessageUserIDs:input_type -> openim.conversation.GetConversationNotReceiveMessageUserIDsReq
-	36,  // 72: openim.conversation.conversation.UpdateConversation:input_type -> openim.conversation.UpdateConversationReq
-	38,  // 73: openim.conversation.conversation.GetFullOwnerConversationIDs:input_type -> openim.conversation.GetFullOwnerConversationIDsReq
-	40,  // 74: openim.conversation.conversation.GetIncrementalConversation:input_type -> openim.conversation.GetIncrementalConversationReq
-	42,  // 75: openim.conversation.conversation.GetOwnerConversation:input_type -> openim.conversation.GetOwnerConversationReq
-	44,  // 76: openim.conversation.conversation.GetConversationsNeedClearMsg:input_type -> openim.conversation.GetConversationsNeedClearMsgReq
-	46,  // 77: openim.conversation.conversation.GetNotNotifyConversationIDs:input_type -> openim.conversation.GetNotNotifyConversationIDsReq
-	48,  // 78: openim.conversation.conversation.GetPinnedConversationIDs:input_type -> openim.conversation.GetPinnedConversationIDsReq
-	50,  // 79: openim.conversation.conversation.MarkConversation:input_type -> openim.conversation.MarkConversationReq
-	52,  // 80: openim.conversation.conversation.MarkConversationAsUnread:input_type -> openim.conversation.MarkConversationAsUnreadReq
-	54,  // 81: openim.conversation.conversation.ClearUserConversationMsg:input_type -> openim.conversation.ClearUserConversationMsgReq
-	56,  // 82: openim.conversation.conversation.UpdateConversationsByUser:input_type -> openim.conversation.UpdateConversationsByUserReq
-	58,  // 83: openim.conversation.conversation.DeleteConversations:input_type -> openim.conversation.DeleteConversationsReq
-	60,  // 84: openim.conversation.conversation.UnhideConversationsIfNeeded:input_type -> openim.conversation.UnhideConversationsIfNeededReq
-	64,  // 85: openim.conversation.conversation.InitConversationGroups:input_type -> openim.conversation.InitConversationGroupsReq
-	66,  // 86: openim.conversation.conversation.GetAllConversationGroups:input_type -> openim.conversation.GetAllConversationGroupsReq
-	68,  // 87: openim.conversation.conversation.GetVisibleConversationGroups:input_type -> openim.conversation.GetVisibleConversationGroupsReq
-	70,  // 88: openim.conversation.conversation.CreateConversationGroup:input_type -> openim.conversation.CreateConversationGroupReq
-	72,  // 89: openim.conversation.conversation.UpdateConversationGroup:input_type -> openim.conversation.UpdateConversationGroupReq
-	74,  // 90: openim.conversation.conversation.DeleteConversationGroup:input_type -> openim.conversation.DeleteConversationGroupReq
-	76,  // 91: openim.conversation.conversation.UpdateConversationGroupSort:input_type -> openim.conversation.UpdateConversationGroupSortReq
-	78,  // 92: openim.conversation.conversation.SetConversationGroupVisibility:input_type -> openim.conversation.SetConversationGroupVisibilityReq
-	80,  // 93: openim.conversation.conversation.AddConversationsToGroup:input_type -> openim.conversation.AddConversationsToGroupReq
-	82,  // 94: openim.conversation.conversation.RemoveConversationsFromGroup:input_type -> openim.conversation.RemoveConversationsFromGroupReq
-	84,  // 95: openim.conversation.conversation.GetConversationIDsByGroupID:input_type -> openim.conversation.GetConversationIDsByGroupIDReq
-	96,  // 96: openim.conversation.conversation.CreateFold:input_type -> openim.conversation.CreateFoldReq
-	98,  // 97: openim.conversation.conversation.UpdateFold:input_type -> openim.conversation.UpdateFoldReq
-	86,  // 98: openim.conversation.conversation.SetConversationFold:input_type -> openim.conversation.SetConversationFoldReq
-	88,  // 99: openim.conversation.conversation.GetFoldConversationList:input_type -> openim.conversation.GetFoldConversationListReq
-	90,  // 100: openim.conversation.conversation.GetAllFolds:input_type -> openim.conversation.GetAllFoldsReq
-	94,  // 101: openim.conversation.conversation.RemoveFold:input_type -> openim.conversation.RemoveFoldReq
-	100, // 102: openim.conversation.conversation.ClearFold:input_type -> openim.conversation.ClearFoldReq
-	5,   // 103: openim.conversation.conversation.GetConversation:output_type -> openim.conversation.GetConversationResp
-	7,   // 104: openim.conversation.conversation.GetSortedConversationList:output_type -> openim.conversation.GetSortedConversationListResp
-	13,  // 105: openim.conversation.conversation.GetAllConversations:output_type -> openim.conversation.GetAllConversationsResp
-	11,  // 106: openim.conversation.conversation.GetConversations:output_type -> openim.conversation.GetConversationsResp
-	3,   // 107: openim.conversation.conversation.SetConversation:output_type -> openim.conversation.SetConversationResp
-	15,  // 108: openim.conversation.conversation.GetRecvMsgNotNotifyUserIDs:output_type -> openim.conversation.GetRecvMsgNotNotifyUserIDsResp
-	17,  // 109: openim.conversation.conversation.CreateSingleChatConversations:output_type -> openim.conversation.CreateSingleChatConversationsResp
-	19,  // 110: openim.conversation.conversation.CreateGroupChatConversations:output_type -> openim.conversation.CreateGroupChatConversationsResp
-	21,  // 111: openim.conversation.conversation.SetConversationMaxSeq:output_type -> openim.conversation.SetConversationMaxSeqResp
-	23,  // 112: openim.conversation.conversation.SetConversationMinSeq:output_type -> openim.conversation.SetConversationMinSeqResp
-	25,  // 113: openim.conversation.conversation.GetConversationIDs:output_type -> openim.conversation.GetConversationIDsResp
-	27,  // 114: openim.conversation.conversation.SetConversations:output_type -> openim.conversation.SetConversationsResp
-	29,  // 115: openim.conversation.conversation.GetUserConversationIDsHash:output_type -> openim.conversation.GetUserConversationIDsHashResp
-	31,  // 116: openim.conversation.conversation.GetConversationsByConversationID:output_type -> openim.conversation.GetConversationsByConversationIDResp
-	33,  // 117: openim.conversation.conversation.GetConversationOfflinePushUserIDs:output_type -> openim.conversation.GetConversationOfflinePushUserIDsResp
-	35,  // 118: openim.conversation.conversation.GetConversationNotReceiveMessageUserIDs:output_type -> openim.conversation.GetConversationNotReceiveMessageUserIDsResp
-	37,  // 119: openim.conversation.conversation.UpdateConversation:output_type -> openim.conversation.UpdateConversationResp
-	39,  // 120: openim.conversation.conversation.GetFullOwnerConversationIDs:output_type -> openim.conversation.GetFullOwnerConversationIDsResp
-	41,  // 121: openim.conversation.conversation.GetIncrementalConversation:output_type -> openim.conversation.GetIncrementalConversationResp
-	43,  // 122: openim.conversation.conversation.GetOwnerConversation:output_type -> openim.conversation.GetOwnerConversationResp
-	45,  // 123: openim.conversation.conversation.GetConversationsNeedClearMsg:output_type -> openim.conversation.GetConversationsNeedClearMsgResp
-	47,  // 124: openim.conversation.conversation.GetNotNotifyConversationIDs:output_type -> openim.conversation.GetNotNotifyConversationIDsResp
-	49,  // 125: openim.conversation.conversation.GetPinnedConversationIDs:output_type -> openim.conversation.GetPinnedConversationIDsResp
-	51,  // 126: openim.conversation.conversation.MarkConversation:output_type -> openim.conversation.MarkConversationResp
-	53,  // 127: openim.conversation.conversation.MarkConversationAsUnread:output_type -> openim.conversation.MarkConversationAsUnreadResp
-	55,  // 128: openim.conversation.conversation.ClearUserConversationMsg:output_type -> openim.conversation.ClearUserConversationMsgResp
-	57,  // 129: openim.conversation.conversation.UpdateConversationsByUser:output_type -> openim.conversation.UpdateConversationsByUserResp
-	59,  // 130: openim.conversation.conversation.DeleteConversations:output_type -> openim.conversation.DeleteConversationsResp
-	61,  // 131: openim.conversation.conversation.UnhideConversationsIfNeeded:output_type -> openim.conversation.UnhideConversationsIfNeededResp
-	65,  // 132: openim.conversation.conversation.InitConversationGroups:output_type -> openim.conversation.InitConversationGroupsResp
-	67,  // 133: openim.conversation.conversation.GetAllConversationGroups:output_type -> openim.conversation.GetAllConversationGroupsResp
-	69,  // 134: openim.conversation.conversation.GetVisibleConversationGroups:output_type -> openim.conversation.GetVisibleConversationGroupsResp
-	71,  // 135: openim.conversation.conversation.CreateConversationGroup:output_type -> openim.conversation.CreateConversationGroupResp
-	73,  // 136: openim.conversation.conversation.UpdateConversationGroup:output_type -> openim.conversation.UpdateConversationGroupResp
-	75,  // 137: openim.conversation.conversation.DeleteConversationGroup:output_type -> openim.conversation.DeleteConversationGroupResp
-	77,  // 138: openim.conversation.conversation.UpdateConversationGroupSort:output_type -> openim.conversation.UpdateConversationGroupSortResp
-	79,  // 139: openim.conversation.conversation.SetConversationGroupVisibility:output_type -> openim.conversation.SetConversationGroupVisibilityResp
-	81,  // 140: openim.conversation.conversation.AddConversationsToGroup:output_type -> openim.conversation.AddConversationsToGroupResp
-	83,  // 141: openim.conversation.conversation.RemoveConversationsFromGroup:output_type -> openim.conversation.RemoveConversationsFromGroupResp
-	85,  // 142: openim.conversation.conversation.GetConversationIDsByGroupID:output_type -> openim.conversation.GetConversationIDsByGroupIDResp
-	97,  // 143: openim.conversation.conversation.CreateFold:output_type -> openim.conversation.CreateFoldResp
-	99,  // 144: openim.conversation.conversation.UpdateFold:output_type -> openim.conversation.UpdateFoldResp
-	87,  // 145: openim.conversation.conversation.SetConversationFold:output_type -> openim.conversation.SetConversationFoldResp
-	89,  // 146: openim.conversation.conversation.GetFoldConversationList:output_type -> openim.conversation.GetFoldConversationListResp
-	93,  // 147: openim.conversation.conversation.GetAllFolds:output_type -> openim.conversation.GetAllFoldsResp
-	95,  // 148: openim.conversation.conversation.RemoveFold:output_type -> openim.conversation.RemoveFoldResp
-	101, // 149: openim.conversation.conversation.ClearFold:output_type -> openim.conversation.ClearFoldResp
-	103, // [103:150] is the sub-list for method output_type
-	56,  // [56:103] is the sub-list for method input_type
-	56,  // [56:56] is the sub-list for extension type_name
-	56,  // [56:56] is the sub-list for extension extendee
-	0,   // [0:56] is the sub-list for field type_name
+	2,   // 0: openim.conversation.Conversation.draft:type_name -> openim.conversation.ConversationDraft
+	1,   // 1: openim.conversation.Conversation.notifySettings:type_name -> openim.conversation.ConversationNotifySettings
+	141, // 2: openim.conversation.ConversationDraft.quoteMsg:type_name -> openim.sdkws.MsgData
+	142, // 3: openim.conversation.ConversationReq.recvMsgOpt:type_name -> openim.protobuf.Int32Value
+	143, // 4: openim.conversation.ConversationReq.isPinned:type_name -> openim.protobuf.BoolValue
+	144, // 5: openim.conversation.ConversationReq.attachedInfo:type_name -> openim.protobuf.StringValue
+	143, // 6: openim.conversation.ConversationReq.isPrivateChat:type_name -> openim.protobuf.BoolValue
+	144, // 7: openim.conversation.ConversationReq.ex:type_name -> openim.protobuf.StringValue
+	142, // 8: openim.conversation.ConversationReq.burnDuration:type_name -> openim.protobuf.Int32Value
+	145, // 9: openim.conversation.ConversationReq.minSeq:type_name -> openim.protobuf.Int64Value
+	145, // 10: openim.conversation.ConversationReq.maxSeq:type_name -> openim.protobuf.Int64Value
+	142, // 11: openim.conversation.ConversationReq.groupAtType:type_name -> openim.protobuf.Int32Value
+	145, // 12: openim.conversation.ConversationReq.msgDestructTime:type_name -> openim.protobuf.Int64Value
+	143, // 13: openim.conversation.ConversationReq.isMsgDestruct:type_name -> openim.protobuf.BoolValue
+	143, // 14: openim.conversation.ConversationReq.isMark:type_name -> openim.protobuf.BoolValue
+	142, // 15: openim.conversation.ConversationReq.unreadCount:type_name -> openim.protobuf.Int32Value
+	145, // 16: openim.conversation.ConversationReq.updateUnreadCountTime:type_name -> openim.protobuf.Int64Value
+	144, // 17: openim.conversation.ConversationReq.parentConversationID:type_name -> openim.protobuf.StringValue
+	143, // 18: openim.conversation.ConversationReq.isHidden:type_name -> openim.protobuf.BoolValue
+	1,   // 19: openim.conversation.ConversationReq.notifySettings:type_name -> openim.conversation.ConversationNotifySettings
+	0,   // 20: openim.conversation.SetConversationReq.conversation:type_name -> openim.conversation.Conversation
+	0,   // 21: openim.conversation.GetConversationResp.conversation:type_name -> openim.conversation.Conversation
+	146, // 22: openim.conversation.GetSortedConversationListReq.pagination:type_name -> openim.sdkws.RequestPagination
+	10,  // 23: openim.conversation.GetSortedConversationListResp.conversationElems:type_name -> openim.conversation.ConversationElem
+	11,  // 24: openim.conversation.ConversationElem.msgInfo:type_name -> openim.conversation.MsgInfo
+	0,   // 25: openim.conversation.GetConversationsResp.conversations:type_name -> openim.conversation.Conversation
+	0,   // 26: openim.conversation.GetAllConversationsResp.conversations:type_name -> openim.conversation.Conversation
+	3,   // 27: openim.conversation.SetConversationsReq.conversation:type_name -> openim.conversation.ConversationReq
+	0,   // 28: openim.conversation.GetConversationsByConversationIDResp.conversations:type_name -> openim.conversation.Conversation
+	142, // 29: openim.conversation.UpdateConversationReq.recvMsgOpt:type_name -> openim.protobuf.Int32Value
+	143, // 30: openim.conversation.UpdateConversationReq.isPinned:type_name -> openim.protobuf.BoolValue
+	144, // 31: openim.conversation.UpdateConversationReq.attachedInfo:type_name -> openim.protobuf.StringValue
+	143, // 32: openim.conversation.UpdateConversationReq.isPrivateChat:type_name -> openim.protobuf.BoolValue
+	144, // 33: openim.conversation.UpdateConversationReq.ex:type_name -> openim.protobuf.StringValue
+	142, // 34: openim.conversation.UpdateConversationReq.burnDuration:type_name -> openim.protobuf.Int32Value
+	145, // 35: openim.conversation.UpdateConversationReq.minSeq:type_name -> openim.protobuf.Int64Value
+	145, // 36: openim.conversation.UpdateConversationReq.maxSeq:type_name -> openim.protobuf.Int64Value
+	142, // 37: openim.conversation.UpdateConversationReq.groupAtType:type_name -> openim.protobuf.Int32Value
+	145, // 38: openim.conversation.UpdateConversationReq.msgDestructTime:type_name -> openim.protobuf.Int64Value
+	143, // 39: openim.conversation.UpdateConversationReq.isMsgDestruct:type_name -> openim.protobuf.BoolValue
+	145, // 40: openim.conversation.UpdateConversationReq.latestMsgDestructTime:type_name -> openim.protobuf.Int64Value
+	143, // 41: openim.conversation.UpdateConversationReq.isMark:type_name -> openim.protobuf.BoolValue
+	144, // 42: openim.conversation.UpdateConversationReq.parentConversationID:type_name -> openim.protobuf.StringValue
+	143, // 43: openim.conversation.UpdateConversationReq.isHidden:type_name -> openim.protobuf.BoolValue
+	0,   // 44: openim.conversation.GetIncrementalConversationResp.insert:type_name -> openim.conversation.Conversation
+	0,   // 45: openim.conversation.GetIncrementalConversationResp.update:type_name -> openim.conversation.Conversation
+	146, // 46: openim.conversation.GetOwnerConversationReq.pagination:type_name -> openim.sdkws.RequestPagination
+	0,   // 47: openim.conversation.GetOwnerConversationResp.conversations:type_name -> openim.conversation.Conversation
+	0,   // 48: openim.conversation.GetConversationsNeedClearMsgResp.conversations:type_name -> openim.conversation.Conversation
+	144, // 49: openim.conversation.UpdateConversationsByUserReq.ex:type_name -> openim.protobuf.StringValue
+	64,  // 50: openim.conversation.GetAllConversationGroupsResp.groups:type_name -> openim.conversation.ConversationGroup
+	64,  // 51: openim.conversation.GetVisibleConversationGroupsResp.groups:type_name -> openim.conversation.ConversationGroup
+	64,  // 52: openim.conversation.CreateConversationGroupResp.group:type_name -> openim.conversation.ConversationGroup
+	65,  // 53: openim.conversation.UpdateConversationGroupSortReq.groupOrders:type_name -> openim.conversation.GroupOrder
+	146, // 54: openim.conversation.GetFoldConversationListReq.pagination:type_name -> openim.sdkws.RequestPagination
+	0,   // 55: openim.conversation.GetFoldConversationListResp.conversations:type_name -> openim.conversation.Conversation
+	95,  // 56: openim.conversation.ConversationFold.rule:type_name -> openim.conversation.FoldRule
+	94,  // 57: openim.conversation.FoldRule.conditions:type_name -> openim.conversation.FoldRuleCondition
+	95,  // 58: openim.conversation.FoldInfo.rule:type_name -> openim.conversation.FoldRule
+	96,  // 59: openim.conversation.GetAllFoldsResp.groups:type_name -> openim.conversation.FoldInfo
+	95,  // 60: openim.conversation.CreateFoldReq.rule:type_name -> openim.conversation.FoldRule
+	144, // 61: openim.conversation.UpdateFoldReq.foldName:type_name -> openim.protobuf.StringValue
+	144, // 62: openim.conversation.UpdateFoldReq.faceURL:type_name -> openim.protobuf.StringValue
+	144, // 63: openim.conversation.UpdateFoldReq.description:type_name -> openim.protobuf.StringValue
+	95,  // 64: openim.conversation.UpdateFoldReq.rule:type_name -> openim.conversation.FoldRule
+	106, // 65: openim.conversation.NotificationDigestSetting.quietHours:type_name -> openim.conversation.QuietHours
+	107, // 66: openim.conversation.SetNotificationDigestSettingReq.setting:type_name -> openim.conversation.NotificationDigestSetting
+	107, // 67: openim.conversation.GetNotificationDigestSettingsResp.global:type_name -> openim.conversation.NotificationDigestSetting
+	107, // 68: openim.conversation.GetNotificationDigestSettingsResp.conversations:type_name -> openim.conversation.NotificationDigestSetting
+	135, // 69: openim.conversation.GetUsersNotificationDigestSettingsResp.settings:type_name -> openim.conversation.GetUsersNotificationDigestSettingsResp.SettingsEntry
+	95,  // 70: openim.conversation.PreviewFoldRuleReq.rule:type_name -> openim.conversation.FoldRule
+	2,   // 71: openim.conversation.SetDraftReq.draft:type_name -> openim.conversation.ConversationDraft
+	2,   // 72: openim.conversation.SetDraftResp.draft:type_name -> openim.conversation.ConversationDraft
+	2,   // 73: openim.conversation.ClearDraftResp.draft:type_name -> openim.conversation.ConversationDraft
+	1,   // 74: openim.conversation.SetConversationNotifySettingsReq.settings:type_name -> openim.conversation.ConversationNotifySettings
+	136, // 75: openim.conversation.GetUsersConversationNotifySettingsResp.settings:type_name -> openim.conversation.GetUsersConversationNotifySettingsResp.SettingsEntry
+	137, // 76: openim.conversation.UnreadSummary.groups:type_name -> openim.conversation.UnreadSummary.GroupsEntry
+	138, // 77: openim.conversation.UnreadSummary.folds:type_name -> openim.conversation.UnreadSummary.FoldsEntry
+	139, // 78: openim.conversation.UnreadSummary.conversations:type_name -> openim.conversation.UnreadSummary.ConversationsEntry
+	130, // 79: openim.conversation.GetUnreadSummaryResp.summary:type_name -> openim.conversation.UnreadSummary
+	140, // 80: openim.conversation.GetUsersBadgeResp.badges:type_name -> openim.conversation.GetUsersBadgeResp.BadgesEntry
+	107, // 81: openim.conversation.GetUsersNotificationDigestSettingsResp.SettingsEntry.value:type_name -> openim.conversation.NotificationDigestSetting
+	1,   // 82: openim.conversation.GetUsersConversationNotifySettingsResp.SettingsEntry.value:type_name -> openim.conversation.ConversationNotifySettings
+	6,   // 83: openim.conversation.conversation.GetConversation:input_type -> openim.conversation.GetConversationReq
+	8,   // 84: openim.conversation.conversation.GetSortedConversationList:input_type -> openim.conversation.GetSortedConversationListReq
+	14,  // 85: openim.conversation.conversation.GetAllConversations:input_type -> openim.conversation.GetAllConversationsReq
+	12,  // 86: openim.conversation.conversation.GetConversations:input_type -> openim.conversation.GetConversationsReq
+	4,   // 87: openim.conversation.conversation.SetConversation:input_type -> openim.conversation.SetConversationReq
+	16,  // 88: openim.conversation.conversation.GetRecvMsgNotNotifyUserIDs:input_type -> openim.conversation.GetRecvMsgNotNotifyUserIDsReq
+	18,  // 89: openim.conversation.conversation.CreateSingleChatConversations:input_type -> openim.conversation.CreateSingleChatConversationsReq
+	20,  // 90: openim.conversation.conversation.CreateGroupChatConversations:input_type -> openim.conversation.CreateGroupChatConversationsReq
+	22,  // 91: openim.conversation.conversation.SetConversationMaxSeq:input_type -> openim.conversation.SetConversationMaxSeqReq
+	24,  // 92: openim.conversation.conversation.SetConversationMinSeq:input_type -> openim.conversation.SetConversationMinSeqReq
+	26,  // 93: openim.conversation.conversation.GetConversationIDs:input_type -> openim.conversation.GetConversationIDsReq
+	28,  // 94: openim.conversation.conversation.SetConversations:input_type -> openim.conversation.SetConversationsReq
+	30,  // 95: openim.conversation.conversation.GetUserConversationIDsHash:input_type -> openim.conversation.GetUserConversationIDsHashReq
+	32,  // 96: openim.conversation.conversation.GetConversationsByConversationID:input_type -> openim.conversation.GetConversationsByConversationIDReq
+	34,  // 97: openim.conversation.conversation.GetConversationOfflinePushUserIDs:input_type -> openim.conversation.GetConversationOfflinePushUserIDsReq
+	36,  // 98: openim.conversation.conversation.GetConversationNotReceiveMessageUserIDs:input_type -> openim.conversation.GetConversationNotReceiveMessageUserIDsReq
+	38,  // 99: openim.conversation.conversation.UpdateConversation:input_type -> openim.conversation.UpdateConversationReq
+	40,  // 100: openim.conversation.conversation.GetFullOwnerConversationIDs:input_type -> openim.conversation.GetFullOwnerConversationIDsReq
+	42,  // 101: openim.conversation.conversation.GetIncrementalConversation:input_type -> openim.conversation.GetIncrementalConversationReq
+	44,  // 102: openim.conversation.conversation.GetOwnerConversation:input_type -> openim.conversation.GetOwnerConversationReq
+	46,  // 103: openim.conversation.conversation.GetConversationsNeedClearMsg:input_type -> openim.conversation.GetConversationsNeedClearMsgReq
+	48,  // 104: openim.conversation.conversation.GetNotNotifyConversationIDs:input_type -> openim.conversation.GetNotNotifyConversationIDsReq
+	50,  // 105: openim.conversation.conversation.GetPinnedConversationIDs:input_type -> openim.conversation.GetPinnedConversationIDsReq
+	52,  // 106: openim.conversation.conversation.MarkConversation:input_type -> openim.conversation.MarkConversationReq
+	54,  // 107: openim.conversation.conversation.MarkConversationAsUnread:input_type -> openim.conversation.MarkConversationAsUnreadReq
+	56,  // 108: openim.conversation.conversation.ClearUserConversationMsg:input_type -> openim.conversation.ClearUserConversationMsgReq
+	58,  // 109: openim.conversation.conversation.UpdateConversationsByUser:input_type -> openim.conversation.UpdateConversationsByUserReq
+	60,  // 110: openim.conversation.conversation.DeleteConversations:input_type -> openim.conversation.DeleteConversationsReq
+	62,  // 111: openim.conversation.conversation.UnhideConversationsIfNeeded:input_type -> openim.conversation.UnhideConversationsIfNeededReq
+	66,  // 112: openim.conversation.conversation.InitConversationGroups:input_type -> openim.conversation.InitConversationGroupsReq
+	68,  // 113: openim.conversation.conversation.GetAllConversationGroups:input_type -> openim.conversation.GetAllConversationGroupsReq
+	70,  // 114: openim.conversation.conversation.GetVisibleConversationGroups:input_type -> openim.conversation.GetVisibleConversationGroupsReq
+	72,  // 115: openim.conversation.conversation.CreateConversationGroup:input_type -> openim.conversation.CreateConversationGroupReq
+	74,  // 116: openim.conversation.conversation.UpdateConversationGroup:input_type -> openim.conversation.UpdateConversationGroupReq
+	76,  // 117: openim.conversation.conversation.DeleteConversationGroup:input_type -> openim.conversation.DeleteConversationGroupReq
+	78,  // 118: openim.conversation.conversation.UpdateConversationGroupSort:input_type -> openim.conversation.UpdateConversationGroupSortReq
+	80,  // 119: openim.conversation.conversation.SetConversationGroupVisibility:input_type -> openim.conversation.SetConversationGroupVisibilityReq
+	82,  // 120: openim.conversation.conversation.AddConversationsToGroup:input_type -> openim.conversation.AddConversationsToGroupReq
+	84,  // 121: openim.conversation.conversation.RemoveConversationsFromGroup:input_type -> openim.conversation.RemoveConversationsFromGroupReq
+	86,  // 122: openim.conversation.conversation.GetConversationIDsByGroupID:input_type -> openim.conversation.GetConversationIDsByGroupIDReq
+	100, // 123: openim.conversation.conversation.CreateFold:input_type -> openim.conversation.CreateFoldReq
+	102, // 124: openim.conversation.conversation.UpdateFold:input_type -> openim.conversation.UpdateFoldReq
+	88,  // 125: openim.conversation.conversation.SetConversationFold:input_type -> openim.conversation.SetConversationFoldReq
+	90,  // 126: openim.conversation.conversation.GetFoldConversationList:input_type -> openim.conversation.GetFoldConversationListReq
+	92,  // 127: openim.conversation.conversation.GetAllFolds:input_type -> openim.conversation.GetAllFoldsReq
+	98,  // 128: openim.conversation.conversation.RemoveFold:input_type -> openim.conversation.RemoveFoldReq
+	104, // 129: openim.conversation.conversation.ClearFold:input_type -> openim.conversation.ClearFoldReq
+	116, // 130: openim.conversation.conversation.PreviewFoldRule:input_type -> openim.conversation.PreviewFoldRuleReq
+	118, // 131: openim.conversation.conversation.ReevaluateFoldRules:input_type -> openim.conversation.ReevaluateFoldRulesReq
+	120, // 132: openim.conversation.conversation.SetDraft:input_type -> openim.conversation.SetDraftReq
+	122, // 133: openim.conversation.conversation.ClearDraft:input_type -> openim.conversation.ClearDraftReq
+	124, // 134: openim.conversation.conversation.SetConversationNotifySettings:input_type -> openim.conversation.SetConversationNotifySettingsReq
+	126, // 135: openim.conversation.conversation.MuteConversations:input_type -> openim.conversation.MuteConversationsReq
+	128, // 136: openim.conversation.conversation.GetUsersConversationNotifySettings:input_type -> openim.conversation.GetUsersConversationNotifySettingsReq
+	131, // 137: openim.conversation.conversation.GetUnreadSummary:input_type -> openim.conversation.GetUnreadSummaryReq
+	133, // 138: openim.conversation.conversation.GetUsersBadge:input_type -> openim.conversation.GetUsersBadgeReq
+	108, // 139: openim.conversation.conversation.SetNotificationDigestSetting:input_type -> openim.conversation.SetNotificationDigestSettingReq
+	110, // 140: openim.conversation.conversation.DeleteNotificationDigestSetting:input_type -> openim.conversation.DeleteNotificationDigestSettingReq
+	112, // 141: openim.conversation.conversation.GetNotificationDigestSettings:input_type -> openim.conversation.GetNotificationDigestSettingsReq
+	114, // 142: openim.conversation.conversation.GetUsersNotificationDigestSettings:input_type -> openim.conversation.GetUsersNotificationDigestSettingsReq
+	7,   // 143: openim.conversation.conversation.GetConversation:output_type -> openim.conversation.GetConversationResp
+	9,   // 144: openim.conversation.conversation.GetSortedConversationList:output_type -> openim.conversation.GetSortedConversationListResp
+	15,  // 145: openim.conversation.conversation.GetAllConversations:output_type -> openim.conversation.GetAllConversationsResp
+	13,  // 146: openim.conversation.conversation.GetConversations:output_type -> openim.conversation.GetConversationsResp
+	5,   // 147: openim.conversation.conversation.SetConversation:output_type -> openim.conversation.SetConversationResp
+	17,  // 148: openim.conversation.conversation.GetRecvMsgNotNotifyUserIDs:output_type -> openim.conversation.GetRecvMsgNotNotifyUserIDsResp
+	19,  // 149: openim.conversation.conversation.CreateSingleChatConversations:output_type -> openim.conversation.CreateSingleChatConversationsResp
+	21,  // 150: openim.conversation.conversation.CreateGroupChatConversations:output_type -> openim.conversation.CreateGroupChatConversationsResp
+	23,  // 151: openim.conversation.conversation.SetConversationMaxSeq:output_type -> openim.conversation.SetConversationMaxSeqResp
+	25,  // 152: openim.conversation.conversation.SetConversationMinSeq:output_type -> openim.conversation.SetConversationMinSeqResp
+	27,  // 153: openim.conversation.conversation.GetConversationIDs:output_type -> openim.conversation.GetConversationIDsResp
+	29,  // 154: openim.conversation.conversation.SetConversations:output_type -> openim.conversation.SetConversationsResp
+	31,  // 155: openim.conversation.conversation.GetUserConversationIDsHash:output_type -> openim.conversation.GetUserConversationIDsHashResp
+	33,  // 156: openim.conversation.conversation.GetConversationsByConversationID:output_type -> openim.conversation.GetConversationsByConversationIDResp
+	35,  // 157: openim.conversation.conversation.GetConversationOfflinePushUserIDs:output_type -> openim.conversation.GetConversationOfflinePushUserIDsResp
+	37,  // 158: openim.conversation.conversation.GetConversationNotReceiveMessageUserIDs:output_type -> openim.conversation.GetConversationNotReceiveMessageUserIDsResp
+	39,  // 159: openim.conversation.conversation.UpdateConversation:output_type -> openim.conversation.UpdateConversationResp
+	41,  // 160: openim.conversation.conversation.GetFullOwnerConversationIDs:output_type -> openim.conversation.GetFullOwnerConversationIDsResp
+	43,  // 161: openim.conversation.conversation.GetIncrementalConversation:output_type -> openim.conversation.GetIncrementalConversationResp
+	45,  // 162: openim.conversation.conversation.GetOwnerConversation:output_type -> openim.conversation.GetOwnerConversationResp
+	47,  // 163: openim.conversation.conversation.GetConversationsNeedClearMsg:output_type -> openim.conversation.GetConversationsNeedClearMsgResp
+	49,  // 164: openim.conversation.conversation.GetNotNotifyConversationIDs:output_type -> openim.conversation.GetNotNotifyConversationIDsResp
+	51,  // 165: openim.conversation.conversation.GetPinnedConversationIDs:output_type -> openim.conversation.GetPinnedConversationIDsResp
+	53,  // 166: openim.conversation.conversation.MarkConversation:output_type -> openim.conversation.MarkConversationResp
+	55,  // 167: openim.conversation.conversation.MarkConversationAsUnread:output_type -> openim.conversation.MarkConversationAsUnreadResp
+	57,  // 168: openim.conversation.conversation.ClearUserConversationMsg:output_type -> openim.conversation.ClearUserConversationMsgResp
+	59,  // 169: openim.conversation.conversation.UpdateConversationsByUser:output_type -> openim.conversation.UpdateConversationsByUserResp
+	61,  // 170: openim.conversation.conversation.DeleteConversations:output_type -> openim.conversation.DeleteConversationsResp
+	63,  // 171: openim.conversation.conversation.UnhideConversationsIfNeeded:output_type -> openim.conversation.UnhideConversationsIfNeededResp
+	67,  // 172: openim.conversation.conversation.InitConversationGroups:output_type -> openim.conversation.InitConversationGroupsResp
+	69,  // 173: openim.conversation.conversation.GetAllConversationGroups:output_type -> openim.conversation.GetAllConversationGroupsResp
+	71,  // 174: openim.conversation.conversation.GetVisibleConversationGroups:output_type -> openim.conversation.GetVisibleConversationGroupsResp
+	73,  // 175: openim.conversation.conversation.CreateConversationGroup:output_type -> openim.conversation.CreateConversationGroupResp
+	75,  // 176: openim.conversation.conversation.UpdateConversationGroup:output_type -> openim.conversation.UpdateConversationGroupResp
+	77,  // 177: openim.conversation.conversation.DeleteConversationGroup:output_type -> openim.conversation.DeleteConversationGroupResp
+	79,  // 178: openim.conversation.conversation.UpdateConversationGroupSort:output_type -> openim.conversation.UpdateConversationGroupSortResp
+	81,  // 179: openim.conversation.conversation.SetConversationGroupVisibility:output_type -> openim.conversation.SetConversationGroupVisibilityResp
+	83,  // 180: openim.conversation.conversation.AddConversationsToGroup:output_type -> openim.conversation.AddConversationsToGroupResp
+	85,  // 181: openim.conversation.conversation.RemoveConversationsFromGroup:output_type -> openim.conversation.RemoveConversationsFromGroupResp
+	87,  // 182: openim.conversation.conversation.GetConversationIDsByGroupID:output_type -> openim.conversation.GetConversationIDsByGroupIDResp
+	101, // 183: openim.conversation.conversation.CreateFold:output_type -> openim.conversation.CreateFoldResp
+	103, // 184: openim.conversation.conversation.UpdateFold:output_type -> openim.conversation.UpdateFoldResp
+	89,  // 185: openim.conversation.conversation.SetConversationFold:output_type -> openim.conversation.SetConversationFoldResp
+	91,  // 186: openim.conversation.conversation.GetFoldConversationList:output_type -> openim.conversation.GetFoldConversationListResp
+	97,  // 187: openim.conversation.conversation.GetAllFolds:output_type -> openim.conversation.GetAllFoldsResp
+	99,  // 188: openim.conversation.conversation.RemoveFold:output_type -> openim.conversation.RemoveFoldResp
+	105, // 189: openim.conversation.conversation.ClearFold:output_type -> openim.conversation.ClearFoldResp
+	117, // 190: openim.conversation.conversation.PreviewFoldRule:output_type -> openim.conversation.PreviewFoldRuleResp
+	119, // 191: openim.conversation.conversation.ReevaluateFoldRules:output_type -> openim.conversation.ReevaluateFoldRulesResp
+	121, // 192: openim.conversation.conversation.SetDraft:output_type -> openim.conversation.SetDraftResp
+	123, // 193: openim.conversation.conversation.ClearDraft:output_type -> openim.conversation.ClearDraftResp
+	125, // 194: openim.conversation.conversation.SetConversationNotifySettings:output_type -> openim.conversation.SetConversationNotifySettingsResp
+	127, // 195: openim.conversation.conversation.MuteConversations:output_type -> openim.conversation.MuteConversationsResp
+	129, // 196: openim.conversation.conversation.GetUsersConversationNotifySettings:output_type -> openim.conversation.GetUsersConversationNotifySettingsResp
+	132, // 197: openim.conversation.conversation.GetUnreadSummary:output_type -> openim.conversation.GetUnreadSummaryResp
+	134, // 198: openim.conversation.conversation.GetUsersBadge:output_type -> openim.conversation.GetUsersBadgeResp
+	109, // 199: openim.conversation.conversation.SetNotificationDigestSetting:output_type -> openim.conversation.SetNotificationDigestSettingResp
+	111, // 200: openim.conversation.conversation.DeleteNotificationDigestSetting:output_type -> openim.conversation.DeleteNotificationDigestSettingResp
+	113, // 201: openim.conversation.conversation.GetNotificationDigestSettings:output_type -> openim.conversation.GetNotificationDigestSettingsResp
+	115, // 202: openim.conversation.conversation.GetUsersNotificationDigestSettings:output_type -> openim.conversation.GetUsersNotificationDigestSettingsResp
+	143, // [143:203] is the sub-list for method output_type
+	83,  // [83:143] is the sub-list for method input_type
+	83,  // [83:83] is the sub-list for extension type_name
+	83,  // [83:83] is the sub-list for extension extendee
+	0,   // [0:83] is the sub-list for field type_name
 }
 
 func init() { file_conversation_conversation_proto_init() }
@@ -6497,7 +8663,7 @@ func file_conversation_conversation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conversation_conversation_proto_rawDesc), len(file_conversation_conversation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   102,
+			NumMessages:   141,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -80,6 +80,19 @@ const (
 	Conversation_GetAllFolds_FullMethodName                             = "/openim.conversation.conversation/GetAllFolds"
 	Conversation_RemoveFold_FullMethodName                              = "/openim.conversation.conversation/RemoveFold"
 	Conversation_ClearFold_FullMethodName                               = "/openim.conversation.conversation/ClearFold"
+	Conversation_PreviewFoldRule_FullMethodName                         = "/openim.conversation.conversation/PreviewFoldRule"
+	Conversation_ReevaluateFoldRules_FullMethodName                     = "/openim.conversation.conversation/ReevaluateFoldRules"
+	Conversation_SetDraft_FullMethodName                                = "/openim.conversation.conversation/SetDraft"
+	Conversation_ClearDraft_FullMethodName                              = "/openim.conversation.conversation/ClearDraft"
+	Conversation_SetConversationNotifySettings_FullMethodName           = "/openim.conversation.conversation/SetConversationNotifySettings"
+	Conversation_MuteConversations_FullMethodName                       = "/openim.conversation.conversation/MuteConversations"
+	Conversation_GetUsersConversationNotifySettings_FullMethodName      = "/openim.conversation.conversation/GetUsersConversationNotifySettings"
+	Conversation_GetUnreadSummary_FullMethodName                        = "/openim.conversation.conversation/GetUnreadSummary"
+	Conversation_GetUsersBadge_FullMethodName                           = "/openim.conversation.conversation/GetUsersBadge"
+	Conversation_SetNotificationDigestSetting_FullMethodName            = "/openim.conversation.conversation/SetNotificationDigestSetting"
+	Conversation_DeleteNotificationDigestSetting_FullMethodName         = "/openim.conversation.conversation/DeleteNotificationDigestSetting"
+	Conversation_GetNotificationDigestSettings_FullMethodName           = "/openim.conversation.conversation/GetNotificationDigestSettings"
+	Conversation_GetUsersNotificationDigestSettings_FullMethodName      = "/openim.conversation.conversation/GetUsersNotificationDigestSettings"
 )
 
 // ConversationClient is the client API for Conversation service.
@@ -134,6 +147,23 @@ type ConversationClient interface {
 	GetAllFolds(ctx context.Context, in *GetAllFoldsReq, opts ...grpc.CallOption) (*GetAllFoldsResp, error)
 	RemoveFold(ctx context.Context, in *RemoveFoldReq, opts ...grpc.CallOption) (*RemoveFoldResp, error)
 	ClearFold(ctx context.Context, in *ClearFoldReq, opts ...grpc.CallOption) (*ClearFoldResp, error)
+	PreviewFoldRule(ctx context.Context, in *PreviewFoldRuleReq, opts ...grpc.CallOption) (*PreviewFoldRuleResp, error)
+	ReevaluateFoldRules(ctx context.Context, in *ReevaluateFoldRulesReq, opts ...grpc.CallOption) (*ReevaluateFoldRulesResp, error)
+	// 草稿相关接口
+	SetDraft(ctx context.Context, in *SetDraftReq, opts ...grpc.CallOption) (*SetDraftResp, error)
+	ClearDraft(ctx context.Context, in *ClearDraftReq, opts ...grpc.CallOption) (*ClearDraftResp, error)
+	// 会话提醒设置相关接口
+	SetConversationNotifySettings(ctx context.Context, in *SetConversationNotifySettingsReq, opts ...grpc.CallOption) (*SetConversationNotifySettingsResp, error)
+	MuteConversations(ctx context.Context, in *MuteConversationsReq, opts ...grpc.CallOption) (*MuteConversationsResp, error)
+	GetUsersConversationNotifySettings(ctx context.Context, in *GetUsersConversationNotifySettingsReq, opts ...grpc.CallOption) (*GetUsersConversationNotifySettingsResp, error)
+	// 未读数相关接口
+	GetUnreadSummary(ctx context.Context, in *GetUnreadSummaryReq, opts ...grpc.CallOption) (*GetUnreadSummaryResp, error)
+	GetUsersBadge(ctx context.Context, in *GetUsersBadgeReq, opts ...grpc.CallOption) (*GetUsersBadgeResp, error)
+	// 通知摘要相关接口
+	SetNotificationDigestSetting(ctx context.Context, in *SetNotificationDigestSettingReq, opts ...grpc.CallOption) (*SetNotificationDigestSettingResp, error)
+	DeleteNotificationDigestSetting(ctx context.Context, in *DeleteNotificationDigestSettingReq, opts ...grpc.CallOption) (*DeleteNotificationDigestSettingResp, error)
+	GetNotificationDigestSettings(ctx context.Context, in *GetNotificationDigestSettingsReq, opts ...grpc.CallOption) (*GetNotificationDigestSettingsResp, error)
+	GetUsersNotificationDigestSettings(ctx context.Context, in *GetUsersNotificationDigestSettingsReq, opts ...grpc.CallOption) (*GetUsersNotificationDigestSettingsResp, error)
 }
 
 type conversationClient struct {
@@ -614,6 +644,136 @@ func (c *conversationClient) ClearFold(ctx context.Context, in *ClearFoldReq, op
 	return out, nil
 }
 
+func (c *conversationClient) PreviewFoldRule(ctx context.Context, in *PreviewFoldRuleReq, opts ...grpc.CallOption) (*PreviewFoldRuleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewFoldRuleResp)
+	err := c.cc.Invoke(ctx, Conversation_PreviewFoldRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) ReevaluateFoldRules(ctx context.Context, in *ReevaluateFoldRulesReq, opts ...grpc.CallOption) (*ReevaluateFoldRulesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReevaluateFoldRulesResp)
+	err := c.cc.Invoke(ctx, Conversation_ReevaluateFoldRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) SetDraft(ctx context.Context, in *SetDraftReq, opts ...grpc.CallOption) (*SetDraftResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDraftResp)
+	err := c.cc.Invoke(ctx, Conversation_SetDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) ClearDraft(ctx context.Context, in *ClearDraftReq, opts ...grpc.CallOption) (*ClearDraftResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearDraftResp)
+	err := c.cc.Invoke(ctx, Conversation_ClearDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) SetConversationNotifySettings(ctx context.Context, in *SetConversationNotifySettingsReq, opts ...grpc.CallOption) (*SetConversationNotifySettingsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetConversationNotifySettingsResp)
+	err := c.cc.Invoke(ctx, Conversation_SetConversationNotifySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) MuteConversations(ctx context.Context, in *MuteConversationsReq, opts ...grpc.CallOption) (*MuteConversationsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteConversationsResp)
+	err := c.cc.Invoke(ctx, Conversation_MuteConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) GetUsersConversationNotifySettings(ctx context.Context, in *GetUsersConversationNotifySettingsReq, opts ...grpc.CallOption) (*GetUsersConversationNotifySettingsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersConversationNotifySettingsResp)
+	err := c.cc.Invoke(ctx, Conversation_GetUsersConversationNotifySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) GetUnreadSummary(ctx context.Context, in *GetUnreadSummaryReq, opts ...grpc.CallOption) (*GetUnreadSummaryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnreadSummaryResp)
+	err := c.cc.Invoke(ctx, Conversation_GetUnreadSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) GetUsersBadge(ctx context.Context, in *GetUsersBadgeReq, opts ...grpc.CallOption) (*GetUsersBadgeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersBadgeResp)
+	err := c.cc.Invoke(ctx, Conversation_GetUsersBadge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) SetNotificationDigestSetting(ctx context.Context, in *SetNotificationDigestSettingReq, opts ...grpc.CallOption) (*SetNotificationDigestSettingResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNotificationDigestSettingResp)
+	err := c.cc.Invoke(ctx, Conversation_SetNotificationDigestSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) DeleteNotificationDigestSetting(ctx context.Context, in *DeleteNotificationDigestSettingReq, opts ...grpc.CallOption) (*DeleteNotificationDigestSettingResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNotificationDigestSettingResp)
+	err := c.cc.Invoke(ctx, Conversation_DeleteNotificationDigestSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) GetNotificationDigestSettings(ctx context.Context, in *GetNotificationDigestSettingsReq, opts ...grpc.CallOption) (*GetNotificationDigestSettingsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationDigestSettingsResp)
+	err := c.cc.Invoke(ctx, Conversation_GetNotificationDigestSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *conversationClient) GetUsersNotificationDigestSettings(ctx context.Context, in *GetUsersNotificationDigestSettingsReq, opts ...grpc.CallOption) (*GetUsersNotificationDigestSettingsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersNotificationDigestSettingsResp)
+	err := c.cc.Invoke(ctx, Conversation_GetUsersNotificationDigestSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConversationServer is the server API for Conversation service.
 // All implementations must embed UnimplementedConversationServer
 // for forward compatibility.
@@ -666,6 +826,23 @@ type ConversationServer interface {
 	GetAllFolds(context.Context, *GetAllFoldsReq) (*GetAllFoldsResp, error)
 	RemoveFold(context.Context, *RemoveFoldReq) (*RemoveFoldResp, error)
 	ClearFold(context.Context, *ClearFoldReq) (*ClearFoldResp, error)
+	PreviewFoldRule(context.Context, *PreviewFoldRuleReq) (*PreviewFoldRuleResp, error)
+	ReevaluateFoldRules(context.Context, *ReevaluateFoldRulesReq) (*ReevaluateFoldRulesResp, error)
+	// 草稿相关接口
+	SetDraft(context.Context, *SetDraftReq) (*SetDraftResp, error)
+	ClearDraft(context.Context, *ClearDraftReq) (*ClearDraftResp, error)
+	// 会话提醒设置相关接口
+	SetConversationNotifySettings(context.Context, *SetConversationNotifySettingsReq) (*SetConversationNotifySettingsResp, error)
+	MuteConversations(context.Context, *MuteConversationsReq) (*MuteConversationsResp, error)
+	GetUsersConversationNotifySettings(context.Context, *GetUsersConversationNotifySettingsReq) (*GetUsersConversationNotifySettingsResp, error)
+	// 未读数相关接口
+	GetUnreadSummary(context.Context, *GetUnreadSummaryReq) (*GetUnreadSummaryResp, error)
+	GetUsersBadge(context.Context, *GetUsersBadgeReq) (*GetUsersBadgeResp, error)
+	// 通知摘要相关接口
+	SetNotificationDigestSetting(context.Context, *SetNotificationDigestSettingReq) (*SetNotificationDigestSettingResp, error)
+	DeleteNotificationDigestSetting(context.Context, *DeleteNotificationDigestSettingReq) (*DeleteNotificationDigestSettingResp, error)
+	GetNotificationDigestSettings(context.Context, *GetNotificationDigestSettingsReq) (*GetNotificationDigestSettingsResp, error)
+	GetUsersNotificationDigestSettings(context.Context, *GetUsersNotificationDigestSettingsReq) (*GetUsersNotificationDigestSettingsResp, error)
 	mustEmbedUnimplementedConversationServer()
 }
 
@@ -817,6 +994,45 @@ func (UnimplementedConversationServer) RemoveFold(context.Context, *RemoveFoldRe
 func (UnimplementedConversationServer) ClearFold(context.Context, *ClearFoldReq) (*ClearFoldResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method ClearFold not implemented")
 }
+func (UnimplementedConversationServer) PreviewFoldRule(context.Context, *PreviewFoldRuleReq) (*PreviewFoldRuleResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method PreviewFoldRule not implemented")
+}
+func (UnimplementedConversationServer) ReevaluateFoldRules(context.Context, *ReevaluateFoldRulesReq) (*ReevaluateFoldRulesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReevaluateFoldRules not implemented")
+}
+func (UnimplementedConversationServer) SetDraft(context.Context, *SetDraftReq) (*SetDraftResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDraft not implemented")
+}
+func (UnimplementedConversationServer) ClearDraft(context.Context, *ClearDraftReq) (*ClearDraftResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearDraft not implemented")
+}
+func (UnimplementedConversationServer) SetConversationNotifySettings(context.Context, *SetConversationNotifySettingsReq) (*SetConversationNotifySettingsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetConversationNotifySettings not implemented")
+}
+func (UnimplementedConversationServer) MuteConversations(context.Context, *MuteConversationsReq) (*MuteConversationsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method MuteConversations not implemented")
+}
+func (UnimplementedConversationServer) GetUsersConversationNotifySettings(context.Context, *GetUsersConversationNotifySettingsReq) (*GetUsersConversationNotifySettingsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsersConversationNotifySettings not implemented")
+}
+func (UnimplementedConversationServer) GetUnreadSummary(context.Context, *GetUnreadSummaryReq) (*GetUnreadSummaryResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUnreadSummary not implemented")
+}
+func (UnimplementedConversationServer) GetUsersBadge(context.Context, *GetUsersBadgeReq) (*GetUsersBadgeResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsersBadge not implemented")
+}
+func (UnimplementedConversationServer) SetNotificationDigestSetting(context.Context, *SetNotificationDigestSettingReq) (*SetNotificationDigestSettingResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetNotificationDigestSetting not implemented")
+}
+func (UnimplementedConversationServer) DeleteNotificationDigestSetting(context.Context, *DeleteNotificationDigestSettingReq) (*DeleteNotificationDigestSettingResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNotificationDigestSetting not implemented")
+}
+func (UnimplementedConversationServer) GetNotificationDigestSettings(context.Context, *GetNotificationDigestSettingsReq) (*GetNotificationDigestSettingsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNotificationDigestSettings not implemented")
+}
+func (UnimplementedConversationServer) GetUsersNotificationDigestSettings(context.Context, *GetUsersNotificationDigestSettingsReq) (*GetUsersNotificationDigestSettingsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUsersNotificationDigestSettings not implemented")
+}
 func (UnimplementedConversationServer) mustEmbedUnimplementedConversationServer() {}
 func (UnimplementedConversationServer) testEmbeddedByValue()                      {}
 
@@ -1684,6 +1900,240 @@ func _Conversation_ClearFold_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Conversation_PreviewFoldRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewFoldRuleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).PreviewFoldRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_PreviewFoldRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).PreviewFoldRule(ctx, req.(*PreviewFoldRuleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_ReevaluateFoldRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReevaluateFoldRulesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).ReevaluateFoldRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_ReevaluateFoldRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).ReevaluateFoldRules(ctx, req.(*ReevaluateFoldRulesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_SetDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).SetDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_SetDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).SetDraft(ctx, req.(*SetDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_ClearDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).ClearDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_ClearDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).ClearDraft(ctx, req.(*ClearDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_SetConversationNotifySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConversationNotifySettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).SetConversationNotifySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_SetConversationNotifySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).SetConversationNotifySettings(ctx, req.(*SetConversationNotifySettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_MuteConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteConversationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).MuteConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_MuteConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).MuteConversations(ctx, req.(*MuteConversationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_GetUsersConversationNotifySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersConversationNotifySettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).GetUsersConversationNotifySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_GetUsersConversationNotifySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).GetUsersConversationNotifySettings(ctx, req.(*GetUsersConversationNotifySettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_GetUnreadSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadSummaryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).GetUnreadSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_GetUnreadSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).GetUnreadSummary(ctx, req.(*GetUnreadSummaryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_GetUsersBadge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersBadgeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).GetUsersBadge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_GetUsersBadge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).GetUsersBadge(ctx, req.(*GetUsersBadgeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_SetNotificationDigestSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationDigestSettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).SetNotificationDigestSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_SetNotificationDigestSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).SetNotificationDigestSetting(ctx, req.(*SetNotificationDigestSettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_DeleteNotificationDigestSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationDigestSettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).DeleteNotificationDigestSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_DeleteNotificationDigestSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).DeleteNotificationDigestSetting(ctx, req.(*DeleteNotificationDigestSettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_GetNotificationDigestSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationDigestSettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).GetNotificationDigestSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_GetNotificationDigestSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).GetNotificationDigestSettings(ctx, req.(*GetNotificationDigestSettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Conversation_GetUsersNotificationDigestSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersNotificationDigestSettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConversationServer).GetUsersNotificationDigestSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Conversation_GetUsersNotificationDigestSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConversationServer).GetUsersNotificationDigestSettings(ctx, req.(*GetUsersNotificationDigestSettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Conversation_ServiceDesc is the grpc.ServiceDesc for Conversation service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1879,6 +2329,58 @@ var Conversation_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearFold",
 			Handler:    _Conversation_ClearFold_Handler,
 		},
+		{
+			MethodName: "PreviewFoldRule",
+			Handler:    _Conversation_PreviewFoldRule_Handler,
+		},
+		{
+			MethodName: "ReevaluateFoldRules",
+			Handler:    _Conversation_ReevaluateFoldRules_Handler,
+		},
+		{
+			MethodName: "SetDraft",
+			Handler:    _Conversation_SetDraft_Handler,
+		},
+		{
+			MethodName: "ClearDraft",
+			Handler:    _Conversation_ClearDraft_Handler,
+		},
+		{
+			MethodName: "SetConversationNotifySettings",
+			Handler:    _Conversation_SetConversationNotifySettings_Handler,
+		},
+		{
+			MethodName: "MuteConversations",
+			Handler:    _Conversation_MuteConversations_Handler,
+		},
+		{
+			MethodName: "GetUsersConversationNotifySettings",
+			Handler:    _Conversation_GetUsersConversationNotifySettings_Handler,
+		},
+		{
+			MethodName: "GetUnreadSummary",
+			Handler:    _Conversation_GetUnreadSummary_Handler,
+		},
+		{
+			MethodName: "GetUsersBadge",
+			Handler:    _Conversation_GetUsersBadge_Handler,
+		},
+		{
+			MethodName: "SetNotificationDigestSetting",
+			Handler:    _Conversation_SetNotificationDigestSetting_Handler,
+		},
+		{
+			MethodName: "DeleteNotificationDigestSetting",
+			Handler:    _Conversation_DeleteNotificationDigestSetting_Handler,
+		},
+		{
+			MethodName: "GetNotificationDigestSettings",
+			Handler:    _Conversation_GetNotificationDigestSettings_Handler,
+		},
+		{
+			MethodName: "GetUsersNotificationDigestSettings",
+			Handler:    _Conversation_GetUsersNotificationDigestSettings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "conversation/conversation.proto",
@@ -0,0 +1,310 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversation
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+func TestQuietHoursIn(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 3, 1, hour, minute, 0, 0, time.UTC)
+	}
+	sameDay := &QuietHours{Enabled: true, StartMinute: 13 * 60, EndMinute: 14 * 60}
+	crossMidnight := &QuietHours{Enabled: true, StartMinute: 22 * 60, EndMinute: 7 * 60}
+	// 22:00-07:00 in Asia/Shanghai is 14:00-23:00 UTC.
+	shanghai := &QuietHours{Enabled: true, StartMinute: 22 * 60, EndMinute: 7 * 60, Timezone: "Asia/Shanghai"}
+	tests := []struct {
+		name string
+		q    *QuietHours
+		t    time.Time
+		want bool
+	}{
+		{"nil", nil, at(23, 0), false},
+		{"disabled", &QuietHours{StartMinute: 22 * 60, EndMinute: 7 * 60}, at(23, 0), false},
+		{"empty window", &QuietHours{Enabled: true, StartMinute: 60, EndMinute: 60}, at(1, 0), false},
+		{"same day start inclusive", sameDay, at(13, 0), true},
+		{"same day end exclusive", sameDay, at(14, 0), false},
+		{"same day before", sameDay, at(12, 59), false},
+		{"cross midnight start", crossMidnight, at(22, 0), true},
+		{"cross midnight before midnight", crossMidnight, at(23, 59), true},
+		{"cross midnight after midnight", crossMidnight, at(0, 0), true},
+		{"cross midnight last minute", crossMidnight, at(6, 59), true},
+		{"cross midnight end exclusive", crossMidnight, at(7, 0), false},
+		{"cross midnight daytime", crossMidnight, at(12, 0), false},
+		{"timezone inside", shanghai, at(15, 0), true},
+		{"timezone outside", shanghai, at(23, 0), false},
+		{"invalid timezone falls back to utc", &QuietHours{Enabled: true, StartMinute: 22 * 60, EndMinute: 7 * 60, Timezone: "Mars/Base"}, at(23, 0), true},
+	}
+	for _, tt := range tests {
+		if got := tt.q.In(tt.t); got != tt.want {
+			t.Errorf("%s: In(%s) = %v, want %v", tt.name, tt.t.Format(time.TimeOnly), got, tt.want)
+		}
+	}
+}
+
+func TestQuietHoursCheck(t *testing.T) {
+	tests := []struct {
+		name  string
+		q     *QuietHours
+		valid bool
+	}{
+		{"nil", nil, true},
+		{"cross midnight", &QuietHours{Enabled: true, StartMinute: 1380, EndMinute: 420, Timezone: "Asia/Shanghai"}, true},
+		{"minute out of range", &QuietHours{Enabled: true, StartMinute: 1440}, false},
+		{"invalid timezone", &QuietHours{Enabled: true, Timezone: "Mars/Base"}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.q.Check(); (err == nil) != tt.valid {
+			t.Errorf("%s: Check() = %v, want valid %v", tt.name, err, tt.valid)
+		}
+	}
+}
+
+func TestFoldRuleCheckInactiveGroup(t *testing.T) {
+	for _, tt := range []struct {
+		days  int64
+		valid bool
+	}{
+		{0, false},
+		{1, true},
+		{constant.FoldRuleInactiveGroupMaxDays, true},
+		{constant.FoldRuleInactiveGroupMaxDays + 1, false},
+		{1 << 40, false},
+	} {
+		rule := &FoldRule{Enabled: true, Conditions: []*FoldRuleCondition{{Type: constant.FoldRuleConditionInactiveGroup, IntValue: tt.days}}}
+		if err := rule.Check(); (err == nil) != tt.valid {
+			t.Errorf("inactive days %d: Check() = %v, want valid %v", tt.days, err, tt.valid)
+		}
+	}
+}
+
+func TestComputeUnreadSummary(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	item := func(conv *Conversation, maxSeq, hasReadSeq int64, groupIDs ...string) *ConversationUnread {
+		return &ConversationUnread{Conversation: conv, MaxSeq: maxSeq, HasReadSeq: hasReadSeq, GroupIDs: groupIDs}
+	}
+	items := []*ConversationUnread{
+		item(&Conversation{ConversationID: "normal", ConversationType: constant.SingleChatType}, 10, 7, "work"),
+		item(&Conversation{ConversationID: "hidden", IsHidden: true}, 10, 0, "work"),
+		item(&Conversation{ConversationID: "not_receive", RecvMsgOpt: constant.NotReceiveMessage}, 10, 0, "work"),
+		item(&Conversation{ConversationID: "not_notify", RecvMsgOpt: constant.ReceiveNotNotifyMessage, ParentConversationID: "fold1"}, 5, 0, "work"),
+		item(&Conversation{ConversationID: "muted", NotifySettings: &ConversationNotifySettings{MuteUntil: now.UnixMilli() + 1}}, 4, 0, "work"),
+		item(&Conversation{ConversationID: "mute_expired", NotifySettings: &ConversationNotifySettings{MuteUntil: now.UnixMilli()}}, 2, 0),
+		item(&Conversation{ConversationID: "manual", UnreadCount: 1}, 3, 3, "work"),
+		item(&Conversation{ConversationID: "read"}, 3, 3),
+		item(&Conversation{ConversationID: "folded", ParentConversationID: "fold1"}, 6, 0),
+		item(&Conversation{ConversationID: "fold1", ConversationType: constant.FoldChatType}, 100, 0),
+		item(nil, 10, 0),
+	}
+	summary := ComputeUnreadSummary(items, true, now)
+	tests := []struct {
+		name string
+		got  int64
+		want int64
+	}{
+		// normal 3 + mute_expired 2 + manual 1 + folded 6
+		{"total", summary.Total, 12},
+		{"unreadConversationCount", int64(summary.UnreadConversationCount), 4},
+		// not_notify 5 + muted 4
+		{"mutedTotal", summary.MutedTotal, 9},
+		// normal 3 + not_notify 5 + muted 4 + manual 1
+		{"groups[work]", summary.Groups["work"], 13},
+		// not_notify 5 + folded 6
+		{"folds[fold1]", summary.Folds["fold1"], 11},
+		{"conversations[muted]", summary.Conversations["muted"], 4},
+		{"conversations[manual]", summary.Conversations["manual"], 1},
+		{"computeTime", summary.ComputeTime, now.UnixMilli()},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %d, want %d", tt.name, tt.got, tt.want)
+		}
+	}
+	for _, conversationID := range []string{"hidden", "not_receive", "read", "fold1"} {
+		if _, ok := summary.Conversations[conversationID]; ok {
+			t.Errorf("conversations[%s] should be absent", conversationID)
+		}
+	}
+	if without := ComputeUnreadSummary(items, false, now); without.Conversations != nil || without.Total != summary.Total {
+		t.Errorf("withConversations=false: conversations = %v, total = %d", without.Conversations, without.Total)
+	}
+}
+
+func TestDraftApplies(t *testing.T) {
+	current := &ConversationDraft{Text: "hi", DeviceID: "phone", UpdateTime: 2000}
+	tests := []struct {
+		name           string
+		current        *ConversationDraft
+		deviceID       string
+		baseUpdateTime int64
+		want           bool
+	}{
+		{"no current draft", nil, "pc", 0, true},
+		{"stale write from another device", current, "pc", 1000, false},
+		{"stale write from the same device", current, "phone", 1000, true},
+		{"equal updateTime", current, "pc", 2000, true},
+		{"base newer than current", current, "pc", 3000, true},
+		{"first write from another device", current, "pc", 0, false},
+	}
+	for _, tt := range tests {
+		if got := DraftApplies(tt.current, tt.deviceID, tt.baseUpdateTime); got != tt.want {
+			t.Errorf("%s: DraftApplies() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestShouldNotify(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	text := func(content string) *sdkws.MsgData {
+		return &sdkws.MsgData{ContentType: constant.Text, Content: []byte(`{"content":"` + content + `"}`)}
+	}
+	atText := func(text string, atUserIDs ...string) *sdkws.MsgData {
+		return &sdkws.MsgData{ContentType: constant.AtText, Content: []byte(`{"text":"` + text + `"}`), AtUserIDList: atUserIDs}
+	}
+	conv := func(recvMsgOpt int32, settings *ConversationNotifySettings) *Conversation {
+		return &Conversation{OwnerUserID: "me", ConversationID: "sg_g1", RecvMsgOpt: recvMsgOpt, NotifySettings: settings}
+	}
+	mentionKeywords := &ConversationNotifySettings{Mode: constant.ConversationNotifyModeMentionKeywords, Keywords: []string{"Release", "上线"}}
+	tests := []struct {
+		name string
+		conv *Conversation
+		msg  *sdkws.MsgData
+		want bool
+	}{
+		{"nil conversation", nil, text("hi"), true},
+		{"receive without settings", conv(constant.ReceiveMessage, nil), text("hi"), true},
+		{"receive not notify", conv(constant.ReceiveNotNotifyMessage, nil), text("hi"), false},
+		{"not receive", conv(constant.NotReceiveMessage, nil), text("hi"), false},
+		{"muted", conv(constant.ReceiveMessage, &ConversationNotifySettings{MuteUntil: now.UnixMilli() + 1}), text("hi"), false},
+		{"mute expired", conv(constant.ReceiveMessage, &ConversationNotifySettings{MuteUntil: now.UnixMilli()}), text("hi"), true},
+		{"muted mention", conv(constant.ReceiveMessage, &ConversationNotifySettings{MuteUntil: now.UnixMilli() + 1}), atText("hi", "me"), false},
+		{"mode all", conv(constant.ReceiveMessage, &ConversationNotifySettings{Keywords: []string{"release"}}), text("hi"), true},
+		{"mention keywords miss", conv(constant.ReceiveMessage, mentionKeywords), text("hi"), false},
+		{"at me", conv(constant.ReceiveMessage, mentionKeywords), atText("hi", "me"), true},
+		{"at all", conv(constant.ReceiveMessage, mentionKeywords), atText("hi", constant.AtAllString), true},
+		{"at other", conv(constant.ReceiveMessage, mentionKeywords), atText("hi", "other"), false},
+		{"keyword in text case insensitive", conv(constant.ReceiveMessage, mentionKeywords), text("new RELEASE today"), true},
+		{"keyword in at text", conv(constant.ReceiveMessage, mentionKeywords), atText("@other 今晚上线", "other"), true},
+		{"keyword in non text message", conv(constant.ReceiveMessage, mentionKeywords), &sdkws.MsgData{ContentType: constant.Picture, Content: []byte(`{"content":"release"}`)}, false},
+		{"keyword in invalid content", conv(constant.ReceiveMessage, mentionKeywords), &sdkws.MsgData{ContentType: constant.Text, Content: []byte("release")}, false},
+	}
+	for _, tt := range tests {
+		if got := ShouldNotify(tt.conv, tt.msg, now); got != tt.want {
+			t.Errorf("%s: ShouldNotify() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNotifySettingsValidatedOnSetConversations(t *testing.T) {
+	tooMany := make([]string, constant.ConversationNotifyMaxKeywordNum+1)
+	for i := range tooMany {
+		tooMany[i] = "k"
+	}
+	invalid := []*ConversationNotifySettings{
+		{Mode: 9},
+		{Keywords: tooMany},
+		{Keywords: []string{""}},
+		{Keywords: []string{string(make([]rune, constant.ConversationNotifyKeywordMaxLength+1))}},
+	}
+	for _, settings := range invalid {
+		req := &SetConversationsReq{
+			UserIDs:      []string{"u1"},
+			Conversation: &ConversationReq{ConversationID: "sg_g1", ConversationType: constant.ReadGroupChatType, GroupID: "g1", NotifySettings: settings},
+		}
+		if err := req.Check(); err == nil {
+			t.Errorf("SetConversationsReq with settings %v should be rejected", settings)
+		}
+		if err := req.Conversation.Check(); err == nil {
+			t.Errorf("ConversationReq with settings %v should be rejected", settings)
+		}
+		if err := (&SetConversationReq{Conversation: &Conversation{ConversationID: "sg_g1", NotifySettings: settings}}).Check(); err == nil {
+			t.Errorf("SetConversationReq with settings %v should be rejected", settings)
+		}
+	}
+	if err := (&GetUsersConversationNotifySettingsReq{ConversationID: "sg_g1", UserIDs: make([]string, constant.ParamMaxLength+1)}).Check(); err == nil {
+		t.Error("too many userIDs should be rejected")
+	}
+}
+
+func TestFoldRuleMatch(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	group := func(id string) *Conversation {
+		return &Conversation{ConversationID: id, ConversationType: constant.ReadGroupChatType, RecvMsgOpt: constant.ReceiveMessage}
+	}
+	inactive := &FoldRule{
+		Enabled:                true,
+		Conditions:             []*FoldRuleCondition{{Type: constant.FoldRuleConditionInactiveGroup, IntValue: 7}},
+		IncludeConversationIDs: []string{"sg_included", "sg_both"},
+		ExcludeConversationIDs: []string{"sg_excluded", "sg_both"},
+	}
+	oa := &FoldRule{Enabled: true, Conditions: []*FoldRuleCondition{{Type: constant.FoldRuleConditionOACompany, Values: []string{"100", "200"}}}}
+	muted := &FoldRule{Enabled: true, Conditions: []*FoldRuleCondition{{Type: constant.FoldRuleConditionMuted}}}
+	mutedGroups := &FoldRule{Enabled: true, Conditions: []*FoldRuleCondition{
+		{Type: constant.FoldRuleConditionMuted},
+		{Type: constant.FoldRuleConditionConversationType, Values: []string{strconv.Itoa(constant.ReadGroupChatType)}},
+	}}
+	notification := &FoldRule{Enabled: true, Conditions: []*FoldRuleCondition{{Type: constant.FoldRuleConditionNotificationAccount}}}
+	single := &Conversation{ConversationID: "si_me_peer", ConversationType: constant.SingleChatType}
+	pinned := group("sg_pinned")
+	pinned.IsPinned = true
+	notReceiveNotify := group("sg_not_notify")
+	notReceiveNotify.RecvMsgOpt = constant.ReceiveNotNotifyMessage
+	tempMuted := group("sg_temp_muted")
+	tempMuted.NotifySettings = &ConversationNotifySettings{MuteUntil: now.UnixMilli() + 1}
+	muteExpired := group("sg_mute_expired")
+	muteExpired.NotifySettings = &ConversationNotifySettings{MuteUntil: now.UnixMilli()}
+	tests := []struct {
+		name string
+		rule *FoldRule
+		in   *FoldRuleInput
+		want bool
+	}{
+		{"nil rule", nil, &FoldRuleInput{Conversation: group("sg_1")}, false},
+		{"disabled rule", &FoldRule{Conditions: inactive.Conditions}, &FoldRuleInput{Conversation: group("sg_1")}, false},
+		{"nil conversation", inactive, &FoldRuleInput{}, false},
+		{"inactive group never opened", inactive, &FoldRuleInput{Conversation: group("sg_1")}, true},
+		{"inactive group opened long ago", inactive, &FoldRuleInput{Conversation: group("sg_1"), LastOpenTime: now.Add(-7 * 24 * time.Hour)}, true},
+		{"active group", inactive, &FoldRuleInput{Conversation: group("sg_1"), LastOpenTime: now.Add(-6 * 24 * time.Hour)}, false},
+		{"inactive single chat", inactive, &FoldRuleInput{Conversation: single}, false},
+		{"include override", inactive, &FoldRuleInput{Conversation: group("sg_included"), LastOpenTime: now}, true},
+		{"exclude override", inactive, &FoldRuleInput{Conversation: group("sg_excluded")}, false},
+		{"exclude wins over include", inactive, &FoldRuleInput{Conversation: group("sg_both")}, false},
+		{"pinned not folded by rule", inactive, &FoldRuleInput{Conversation: pinned}, false},
+		{"fold conversation itself", inactive, &FoldRuleInput{Conversation: &Conversation{ConversationID: "fold", ConversationType: constant.FoldChatType}}, false},
+		{"oa company hit", oa, &FoldRuleInput{Conversation: single, PeerCompanyID: 200}, true},
+		{"oa company miss", oa, &FoldRuleInput{Conversation: single, PeerCompanyID: 300}, false},
+		{"oa company unknown", oa, &FoldRuleInput{Conversation: single}, false},
+		{"oa company group", oa, &FoldRuleInput{Conversation: group("sg_1"), PeerCompanyID: 100}, false},
+		{"muted by recvMsgOpt", muted, &FoldRuleInput{Conversation: notReceiveNotify}, true},
+		{"muted temporarily", muted, &FoldRuleInput{Conversation: tempMuted}, true},
+		{"mute expired", muted, &FoldRuleInput{Conversation: muteExpired}, false},
+		{"all conditions hit", mutedGroups, &FoldRuleInput{Conversation: tempMuted}, true},
+		{"one condition misses", mutedGroups, &FoldRuleInput{Conversation: &Conversation{ConversationID: "si_x", ConversationType: constant.SingleChatType, RecvMsgOpt: constant.ReceiveNotNotifyMessage}}, false},
+		{"notification account", notification, &FoldRuleInput{Conversation: single, IsNotificationAccount: true}, true},
+		{"notification conversation", notification, &FoldRuleInput{Conversation: &Conversation{ConversationID: "sn_x", ConversationType: constant.NotificationChatType}}, true},
+		{"not notification", notification, &FoldRuleInput{Conversation: single}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.rule.Match(tt.in, now); got != tt.want {
+			t.Errorf("%s: Match() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
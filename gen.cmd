@@ -2,7 +2,7 @@
 setlocal
 
 rem Define array elements
-set "PROTO_NAMES=auth conversation errinfo relation group jssdk msg msggateway push rtc sdkws third user statistics wrapperspb oa livekit_meeting"
+set "PROTO_NAMES=auth conversation errinfo relation group jssdk msg msggateway push rtc sdkws third user statistics wrapperspb oa livekit_meeting audit"
 
 rem Loop through each element in the array
 for %%i in (%PROTO_NAMES%) do (
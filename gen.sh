@@ -20,6 +20,7 @@ PROTO_NAMES=(
     "schedule"
     "egress"
     "call"
+    "audit"
 )
 
 for name in "${PROTO_NAMES[@]}"; do
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/errs"
 )
 
 func (x *CreateGroupReq) Check() error {
@@ -402,6 +403,72 @@ func (x *GetFullJoinGroupIDsReq) Check() error {
 	return nil
 }
 
+func (x *GroupMemberOperation) Check() error {
+	if x.GroupID == "" {
+		return errors.New("groupID is empty")
+	}
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	switch x.Action {
+	case constant.GroupMemberOpInvite, constant.GroupMemberOpKick:
+	case constant.GroupMemberOpSetRole:
+		if x.RoleLevel != constant.GroupAdmin && x.RoleLevel != constant.GroupOrdinaryUsers {
+			return errors.New("roleLevel is invalid")
+		}
+	default:
+		return errors.New("action is invalid")
+	}
+	return nil
+}
+
+func (x *BatchOperateGroupMembersReq) Check() error {
+	if len(x.Operations) == 0 {
+		return errors.New("operations is empty")
+	}
+	if len(x.Operations) > constant.ParamMaxLength {
+		return errors.New("too many Operations, need to be less than 1000")
+	}
+	type opKey struct {
+		groupID string
+		userID  string
+		action  int32
+	}
+	seen := make(map[opKey]struct{}, len(x.Operations))
+	for _, op := range x.Operations {
+		if op == nil {
+			return errors.New("operation is nil")
+		}
+		key := opKey{groupID: op.GroupID, userID: op.UserID, action: op.Action}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate operation, groupID %s userID %s action %d", op.GroupID, op.UserID, op.Action)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// SplitOperations separates the operations that pass GroupMemberOperation.Check from those that don't.
+// Invalid operations are returned as failed results with errs.ArgsError so that the rest of the batch still runs.
+func (x *BatchOperateGroupMembersReq) SplitOperations() ([]*GroupMemberOperation, []*GroupMemberOperationResult) {
+	valid := make([]*GroupMemberOperation, 0, len(x.Operations))
+	var invalid []*GroupMemberOperationResult
+	for _, op := range x.Operations {
+		if err := op.Check(); err != nil {
+			invalid = append(invalid, &GroupMemberOperationResult{
+				GroupID: op.GroupID,
+				UserID:  op.UserID,
+				Action:  op.Action,
+				ErrCode: errs.ArgsError,
+				ErrMsg:  err.Error(),
+			})
+			continue
+		}
+		valid = append(valid, op)
+	}
+	return valid, invalid
+}
+
 func (x *BatchGetIncrementalGroupMemberResp) Format() any {
 	if len(x.RespList) > 50 {
 		return fmt.Sprintf("len is %v", len(x.RespList))
@@ -442,3 +509,10 @@ func (x *GetUserReqApplicationListResp) Format() any {
 	}
 	return x
 }
+
+func (x *BatchOperateGroupMembersResp) Format() any {
+	if len(x.Results) > 50 {
+		return fmt.Sprintf("len is %v, success is %v, failed is %v", len(x.Results), x.SuccessCount, x.FailedCount)
+	}
+	return x
+}
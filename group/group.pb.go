@@ -4437,6 +4437,270 @@ func (x *BatchGetIncrementalGroupMemberResp) GetRespList() map[string]*GetIncrem
 	return nil
 }
 
+type GroupMemberOperation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       string                 `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	UserID        string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Action        int32                  `protobuf:"varint,3,opt,name=action,proto3" json:"action"`       // constant.GroupMemberOpInvite/GroupMemberOpKick/GroupMemberOpSetRole
+	RoleLevel     int32                  `protobuf:"varint,4,opt,name=roleLevel,proto3" json:"roleLevel"` // target role level, only used by GroupMemberOpSetRole
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMemberOperation) Reset() {
+	*x = GroupMemberOperation{}
+	mi := &file_group_group_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMemberOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberOperation) ProtoMessage() {}
+
+func (x *GroupMemberOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberOperation.ProtoReflect.Descriptor instead.
+func (*GroupMemberOperation) Descriptor() ([]byte, []int) {
+	return file_group_group_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GroupMemberOperation) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupMemberOperation) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GroupMemberOperation) GetAction() int32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *GroupMemberOperation) GetRoleLevel() int32 {
+	if x != nil {
+		return x.RoleLevel
+	}
+	return 0
+}
+
+type BatchOperateGroupMembersReq struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Operations    []*GroupMemberOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations"`
+	Reason        string                  `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	SendMessage   *bool                   `protobuf:"varint,3,opt,name=sendMessage,proto3,oneof" json:"sendMessage"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOperateGroupMembersReq) Reset() {
+	*x = BatchOperateGroupMembersReq{}
+	mi := &file_group_group_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperateGroupMembersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperateGroupMembersReq) ProtoMessage() {}
+
+func (x *BatchOperateGroupMembersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperateGroupMembersReq.ProtoReflect.Descriptor instead.
+func (*BatchOperateGroupMembersReq) Descriptor() ([]byte, []int) {
+	return file_group_group_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *BatchOperateGroupMembersReq) GetOperations() []*GroupMemberOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *BatchOperateGroupMembersReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BatchOperateGroupMembersReq) GetSendMessage() bool {
+	if x != nil && x.SendMessage != nil {
+		return *x.SendMessage
+	}
+	return false
+}
+
+type GroupMemberOperationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupID       string                 `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	UserID        string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Action        int32                  `protobuf:"varint,3,opt,name=action,proto3" json:"action"`
+	ErrCode       int32                  `protobuf:"varint,4,opt,name=errCode,proto3" json:"errCode"` // 0 means success, errs.ArgsError for operations rejected by validation
+	ErrMsg        string                 `protobuf:"bytes,5,opt,name=errMsg,proto3" json:"errMsg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMemberOperationResult) Reset() {
+	*x = GroupMemberOperationResult{}
+	mi := &file_group_group_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMemberOperationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberOperationResult) ProtoMessage() {}
+
+func (x *GroupMemberOperationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberOperationResult.ProtoReflect.Descriptor instead.
+func (*GroupMemberOperationResult) Descriptor() ([]byte, []int) {
+	return file_group_group_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GroupMemberOperationResult) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupMemberOperationResult) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GroupMemberOperationResult) GetAction() int32 {
+	if x != nil {
+		return x.Action
+	}
+	return 0
+}
+
+func (x *GroupMemberOperationResult) GetErrCode() int32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *GroupMemberOperationResult) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type BatchOperateGroupMembersResp struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Results       []*GroupMemberOperationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	SuccessCount  uint32                        `protobuf:"varint,2,opt,name=successCount,proto3" json:"successCount"`
+	FailedCount   uint32                        `protobuf:"varint,3,opt,name=failedCount,proto3" json:"failedCount"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOperateGroupMembersResp) Reset() {
+	*x = BatchOperateGroupMembersResp{}
+	mi := &file_group_group_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperateGroupMembersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperateGroupMembersResp) ProtoMessage() {}
+
+func (x *BatchOperateGroupMembersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_group_group_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperateGroupMembersResp.ProtoReflect.Descriptor instead.
+func (*BatchOperateGroupMembersResp) Descriptor() ([]byte, []int) {
+	return file_group_group_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *BatchOperateGroupMembersResp) GetResults() []*GroupMemberOperationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchOperateGroupMembersResp) GetSuccessCount() uint32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BatchOperateGroupMembersResp) GetFailedCount() uint32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
 var File_group_group_proto protoreflect.FileDescriptor
 
 const file_group_group_proto_rawDesc = "" +
@@ -4738,7 +5002,29 @@ const file_group_group_proto_rawDesc = "" +
 	"\brespList\x18\x01 \x03(\v2>.openim.group.BatchGetIncrementalGroupMemberResp.RespListEntryR\brespList\x1ah\n" +
 	"\rRespListEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12A\n" +
-	"\x05value\x18\x02 \x01(\v2+.openim.group.getIncrementalGroupMemberRespR\x05value:\x028\x012\xcd\x1e\n" +
+	"\x05value\x18\x02 \x01(\v2+.openim.group.getIncrementalGroupMemberRespR\x05value:\x028\x01\"~\n" +
+	"\x14GroupMemberOperation\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\tR\agroupID\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\x05R\x06action\x12\x1c\n" +
+	"\troleLevel\x18\x04 \x01(\x05R\troleLevel\"\xb0\x01\n" +
+	"\x1bBatchOperateGroupMembersReq\x12B\n" +
+	"\n" +
+	"operations\x18\x01 \x03(\v2\".openim.group.GroupMemberOperationR\n" +
+	"operations\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12%\n" +
+	"\vsendMessage\x18\x03 \x01(\bH\x00R\vsendMessage\x88\x01\x01B\x0e\n" +
+	"\f_sendMessage\"\x98\x01\n" +
+	"\x1aGroupMemberOperationResult\x12\x18\n" +
+	"\agroupID\x18\x01 \x01(\tR\agroupID\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\x05R\x06action\x12\x18\n" +
+	"\aerrCode\x18\x04 \x01(\x05R\aerrCode\x12\x16\n" +
+	"\x06errMsg\x18\x05 \x01(\tR\x06errMsg\"\xa8\x01\n" +
+	"\x1cBatchOperateGroupMembersResp\x12B\n" +
+	"\aresults\x18\x01 \x03(\v2(.openim.group.GroupMemberOperationResultR\aresults\x12\"\n" +
+	"\fsuccessCount\x18\x02 \x01(\rR\fsuccessCount\x12 \n" +
+	"\vfailedCount\x18\x03 \x01(\rR\vfailedCount2\xc0\x1f\n" +
 	"\x05group\x12J\n" +
 	"\vcreateGroup\x12\x1c.openim.group.CreateGroupReq\x1a\x1d.openim.group.CreateGroupResp\x12D\n" +
 	"\tjoinGroup\x12\x1a.openim.group.JoinGroupReq\x1a\x1b.openim.group.JoinGroupResp\x12D\n" +
@@ -4778,7 +5064,8 @@ const file_group_group_proto_rawDesc = "" +
 	"\x1eBatchGetIncrementalGroupMember\x12/.openim.group.BatchGetIncrementalGroupMemberReq\x1a0.openim.group.BatchGetIncrementalGroupMemberResp\x12n\n" +
 	"\x17getIncrementalJoinGroup\x12(.openim.group.getIncrementalJoinGroupReq\x1a).openim.group.getIncrementalJoinGroupResp\x12t\n" +
 	"\x19GetFullGroupMemberUserIDs\x12*.openim.group.GetFullGroupMemberUserIDsReq\x1a+.openim.group.GetFullGroupMemberUserIDsResp\x12b\n" +
-	"\x13GetFullJoinGroupIDs\x12$.openim.group.GetFullJoinGroupIDsReq\x1a%.openim.group.GetFullJoinGroupIDsRespB%Z#github.com/openimsdk/protocol/groupb\x06proto3"
+	"\x13GetFullJoinGroupIDs\x12$.openim.group.GetFullJoinGroupIDsReq\x1a%.openim.group.GetFullJoinGroupIDsResp\x12q\n" +
+	"\x18BatchOperateGroupMembers\x12).openim.group.BatchOperateGroupMembersReq\x1a*.openim.group.BatchOperateGroupMembersRespB%Z#github.com/openimsdk/protocol/groupb\x06proto3"
 
 var (
 	file_group_group_proto_rawDescOnce sync.Once
@@ -4792,7 +5079,7 @@ func file_group_group_proto_rawDescGZIP() []byte {
 	return file_group_group_proto_rawDescData
 }
 
-var file_group_group_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
+var file_group_group_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_group_group_proto_goTypes = []any{
 	(*CreateGroupReq)(nil),                        // 0: openim.group.CreateGroupReq
 	(*CreateGroupResp)(nil),                       // 1: openim.group.CreateGroupResp
@@ -4878,152 +5165,160 @@ var file_group_group_proto_goTypes = []any{
 	(*GetFullJoinGroupIDsResp)(nil),               // 81: openim.group.GetFullJoinGroupIDsResp
 	(*BatchGetIncrementalGroupMemberReq)(nil),     // 82: openim.group.BatchGetIncrementalGroupMemberReq
 	(*BatchGetIncrementalGroupMemberResp)(nil),    // 83: openim.group.BatchGetIncrementalGroupMemberResp
-	nil,                               // 84: openim.group.GroupCreateCountResp.CountEntry
-	nil,                               // 85: openim.group.BatchGetIncrementalGroupMemberResp.RespListEntry
-	(*sdkws.GroupInfo)(nil),           // 86: openim.sdkws.GroupInfo
-	(*sdkws.GroupInfoForSet)(nil),     // 87: openim.sdkws.GroupInfoForSet
-	(*wrapperspb.StringValue)(nil),    // 88: openim.protobuf.StringValue
-	(*wrapperspb.Int32Value)(nil),     // 89: openim.protobuf.Int32Value
-	(*sdkws.RequestPagination)(nil),   // 90: openim.sdkws.RequestPagination
-	(*sdkws.GroupRequest)(nil),        // 91: openim.sdkws.GroupRequest
-	(*sdkws.GroupMemberFullInfo)(nil), // 92: openim.sdkws.GroupMemberFullInfo
-	(*sdkws.UserInfo)(nil),            // 93: openim.sdkws.UserInfo
+	(*GroupMemberOperation)(nil),                  // 84: openim.group.GroupMemberOperation
+	(*BatchOperateGroupMembersReq)(nil),           // 85: openim.group.BatchOperateGroupMembersReq
+	(*GroupMemberOperationResult)(nil),            // 86: openim.group.GroupMemberOperationResult
+	(*BatchOperateGroupMembersResp)(nil),          // 87: openim.group.BatchOperateGroupMembersResp
+	nil,                                           // 88: openim.group.GroupCreateCountResp.CountEntry
+	nil,                                           // 89: openim.group.BatchGetIncrementalGroupMemberResp.RespListEntry
+	(*sdkws.GroupInfo)(nil),                       // 90: openim.sdkws.GroupInfo
+	(*sdkws.GroupInfoForSet)(nil),                 // 91: openim.sdkws.GroupInfoForSet
+	(*wrapperspb.StringValue)(nil),                // 92: openim.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),                 // 93: openim.protobuf.Int32Value
+	(*sdkws.RequestPagination)(nil),               // 94: openim.sdkws.RequestPagination
+	(*sdkws.GroupRequest)(nil),                    // 95: openim.sdkws.GroupRequest
+	(*sdkws.GroupMemberFullInfo)(nil),             // 96: openim.sdkws.GroupMemberFullInfo
+	(*sdkws.UserInfo)(nil),                        // 97: openim.sdkws.UserInfo
 }
 var file_group_group_proto_depIdxs = []int32{
-	86, // 0: openim.group.CreateGroupReq.groupInfo:type_name -> openim.sdkws.GroupInfo
-	86, // 1: openim.group.CreateGroupResp.groupInfo:type_name -> openim.sdkws.GroupInfo
-	86, // 2: openim.group.GetGroupsInfoResp.groupInfos:type_name -> openim.sdkws.GroupInfo
-	87, // 3: openim.group.SetGroupInfoReq.groupInfoForSet:type_name -> openim.sdkws.GroupInfoForSet
-	88, // 4: openim.group.SetGroupInfoExReq.groupName:type_name -> openim.protobuf.StringValue
-	88, // 5: openim.group.SetGroupInfoExReq.notification:type_name -> openim.protobuf.StringValue
-	88, // 6: openim.group.SetGroupInfoExReq.introduction:type_name -> openim.protobuf.StringValue
-	88, // 7: openim.group.SetGroupInfoExReq.faceURL:type_name -> openim.protobuf.StringValue
-	88, // 8: openim.group.SetGroupInfoExReq.ex:type_name -> openim.protobuf.StringValue
-	89, // 9: openim.group.SetGroupInfoExReq.needVerification:type_name -> openim.protobuf.Int32Value
-	89, // 10: openim.group.SetGroupInfoExReq.lookMemberInfo:type_name -> openim.protobuf.Int32Value
-	89, // 11: openim.group.SetGroupInfoExReq.applyMemberFriend:type_name -> openim.protobuf.Int32Value
-	90, // 12: openim.group.GetGroupApplicationListReq.pagination:type_name -> openim.sdkws.RequestPagination
-	91, // 13: openim.group.GetGroupApplicationListResp.groupRequests:type_name -> openim.sdkws.GroupRequest
-	90, // 14: openim.group.GetUserReqApplicationListReq.pagination:type_name -> openim.sdkws.RequestPagination
-	91, // 15: openim.group.GetUserReqApplicationListResp.groupRequests:type_name -> openim.sdkws.GroupRequest
-	91, // 16: openim.group.GetSpecifiedUserGroupRequestInfoResp.groupRequests:type_name -> openim.sdkws.GroupRequest
-	90, // 17: openim.group.GetGroupMemberListReq.pagination:type_name -> openim.sdkws.RequestPagination
-	92, // 18: openim.group.GetGroupMemberListResp.members:type_name -> openim.sdkws.GroupMemberFullInfo
-	92, // 19: openim.group.GetGroupMembersInfoResp.members:type_name -> openim.sdkws.GroupMemberFullInfo
-	90, // 20: openim.group.GetJoinedGroupListReq.pagination:type_name -> openim.sdkws.RequestPagination
-	86, // 21: openim.group.GetJoinedGroupListResp.groups:type_name -> openim.sdkws.GroupInfo
-	90, // 22: openim.group.GetGroupAllMemberReq.pagination:type_name -> openim.sdkws.RequestPagination
-	92, // 23: openim.group.GetGroupAllMemberResp.members:type_name -> openim.sdkws.GroupMemberFullInfo
-	86, // 24: openim.group.CMSGroup.groupInfo:type_name -> openim.sdkws.GroupInfo
-	90, // 25: openim.group.GetGroupsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	90, // 0: openim.group.CreateGroupReq.groupInfo:type_name -> openim.sdkws.GroupInfo
+	90, // 1: openim.group.CreateGroupResp.groupInfo:type_name -> openim.sdkws.GroupInfo
+	90, // 2: openim.group.GetGroupsInfoResp.groupInfos:type_name -> openim.sdkws.GroupInfo
+	91, // 3: openim.group.SetGroupInfoReq.groupInfoForSet:type_name -> openim.sdkws.GroupInfoForSet
+	92, // 4: openim.group.SetGroupInfoExReq.groupName:type_name -> openim.protobuf.StringValue
+	92, // 5: openim.group.SetGroupInfoExReq.notification:type_name -> openim.protobuf.StringValue
+	92, // 6: openim.group.SetGroupInfoExReq.introduction:type_name -> openim.protobuf.StringValue
+	92, // 7: openim.group.SetGroupInfoExReq.faceURL:type_name -> openim.protobuf.StringValue
+	92, // 8: openim.group.SetGroupInfoExReq.ex:type_name -> openim.protobuf.StringValue
+	93, // 9: openim.group.SetGroupInfoExReq.needVerification:type_name -> openim.protobuf.Int32Value
+	93, // 10: openim.group.SetGroupInfoExReq.lookMemberInfo:type_name -> openim.protobuf.Int32Value
+	93, // 11: openim.group.SetGroupInfoExReq.applyMemberFriend:type_name -> openim.protobuf.Int32Value
+	94, // 12: openim.group.GetGroupApplicationListReq.pagination:type_name -> openim.sdkws.RequestPagination
+	95, // 13: openim.group.GetGroupApplicationListResp.groupRequests:type_name -> openim.sdkws.GroupRequest
+	94, // 14: openim.group.GetUserReqApplicationListReq.pagination:type_name -> openim.sdkws.RequestPagination
+	95, // 15: openim.group.GetUserReqApplicationListResp.groupRequests:type_name -> openim.sdkws.GroupRequest
+	95, // 16: openim.group.GetSpecifiedUserGroupRequestInfoResp.groupRequests:type_name -> openim.sdkws.GroupRequest
+	94, // 17: openim.group.GetGroupMemberListReq.pagination:type_name -> openim.sdkws.RequestPagination
+	96, // 18: openim.group.GetGroupMemberListResp.members:type_name -> openim.sdkws.GroupMemberFullInfo
+	96, // 19: openim.group.GetGroupMembersInfoResp.members:type_name -> openim.sdkws.GroupMemberFullInfo
+	94, // 20: openim.group.GetJoinedGroupListReq.pagination:type_name -> openim.sdkws.RequestPagination
+	90, // 21: openim.group.GetJoinedGroupListResp.groups:type_name -> openim.sdkws.GroupInfo
+	94, // 22: openim.group.GetGroupAllMemberReq.pagination:type_name -> openim.sdkws.RequestPagination
+	96, // 23: openim.group.GetGroupAllMemberResp.members:type_name -> openim.sdkws.GroupMemberFullInfo
+	90, // 24: openim.group.CMSGroup.groupInfo:type_name -> openim.sdkws.GroupInfo
+	94, // 25: openim.group.GetGroupsReq.pagination:type_name -> openim.sdkws.RequestPagination
 	36, // 26: openim.group.GetGroupsResp.groups:type_name -> openim.group.CMSGroup
-	90, // 27: openim.group.GetGroupMembersCMSReq.pagination:type_name -> openim.sdkws.RequestPagination
-	92, // 28: openim.group.GetGroupMembersCMSResp.members:type_name -> openim.sdkws.GroupMemberFullInfo
-	88, // 29: openim.group.SetGroupMemberInfo.nickname:type_name -> openim.protobuf.StringValue
-	88, // 30: openim.group.SetGroupMemberInfo.faceURL:type_name -> openim.protobuf.StringValue
-	89, // 31: openim.group.SetGroupMemberInfo.roleLevel:type_name -> openim.protobuf.Int32Value
-	88, // 32: openim.group.SetGroupMemberInfo.ex:type_name -> openim.protobuf.StringValue
+	94, // 27: openim.group.GetGroupMembersCMSReq.pagination:type_name -> openim.sdkws.RequestPagination
+	96, // 28: openim.group.GetGroupMembersCMSResp.members:type_name -> openim.sdkws.GroupMemberFullInfo
+	92, // 29: openim.group.SetGroupMemberInfo.nickname:type_name -> openim.protobuf.StringValue
+	92, // 30: openim.group.SetGroupMemberInfo.faceURL:type_name -> openim.protobuf.StringValue
+	93, // 31: openim.group.SetGroupMemberInfo.roleLevel:type_name -> openim.protobuf.Int32Value
+	92, // 32: openim.group.SetGroupMemberInfo.ex:type_name -> openim.protobuf.StringValue
 	52, // 33: openim.group.SetGroupMemberInfoReq.members:type_name -> openim.group.SetGroupMemberInfo
 	56, // 34: openim.group.GetGroupAbstractInfoResp.groupAbstractInfos:type_name -> openim.group.GroupAbstractInfo
-	92, // 35: openim.group.GetUserInGroupMembersResp.members:type_name -> openim.sdkws.GroupMemberFullInfo
-	92, // 36: openim.group.GetGroupMemberRoleLevelResp.members:type_name -> openim.sdkws.GroupMemberFullInfo
-	86, // 37: openim.group.GetGroupInfoCacheResp.groupInfo:type_name -> openim.sdkws.GroupInfo
-	92, // 38: openim.group.GetGroupMemberCacheResp.member:type_name -> openim.sdkws.GroupMemberFullInfo
-	84, // 39: openim.group.GroupCreateCountResp.count:type_name -> openim.group.GroupCreateCountResp.CountEntry
-	91, // 40: openim.group.getGroupUsersReqApplicationListResp.groupRequests:type_name -> openim.sdkws.GroupRequest
-	93, // 41: openim.group.notificationUserInfoUpdateReq.oldUserInfo:type_name -> openim.sdkws.UserInfo
-	93, // 42: openim.group.notificationUserInfoUpdateReq.newUserInfo:type_name -> openim.sdkws.UserInfo
-	92, // 43: openim.group.getIncrementalGroupMemberResp.insert:type_name -> openim.sdkws.GroupMemberFullInfo
-	92, // 44: openim.group.getIncrementalGroupMemberResp.update:type_name -> openim.sdkws.GroupMemberFullInfo
-	86, // 45: openim.group.getIncrementalGroupMemberResp.group:type_name -> openim.sdkws.GroupInfo
-	86, // 46: openim.group.getIncrementalJoinGroupResp.insert:type_name -> openim.sdkws.GroupInfo
-	86, // 47: openim.group.getIncrementalJoinGroupResp.update:type_name -> openim.sdkws.GroupInfo
+	96, // 35: openim.group.GetUserInGroupMembersResp.members:type_name -> openim.sdkws.GroupMemberFullInfo
+	96, // 36: openim.group.GetGroupMemberRoleLevelResp.members:type_name -> openim.sdkws.GroupMemberFullInfo
+	90, // 37: openim.group.GetGroupInfoCacheResp.groupInfo:type_name -> openim.sdkws.GroupInfo
+	96, // 38: openim.group.GetGroupMemberCacheResp.member:type_name -> openim.sdkws.GroupMemberFullInfo
+	88, // 39: openim.group.GroupCreateCountResp.count:type_name -> openim.group.GroupCreateCountResp.CountEntry
+	95, // 40: openim.group.getGroupUsersReqApplicationListResp.groupRequests:type_name -> openim.sdkws.GroupRequest
+	97, // 41: openim.group.notificationUserInfoUpdateReq.oldUserInfo:type_name -> openim.sdkws.UserInfo
+	97, // 42: openim.group.notificationUserInfoUpdateReq.newUserInfo:type_name -> openim.sdkws.UserInfo
+	96, // 43: openim.group.getIncrementalGroupMemberResp.insert:type_name -> openim.sdkws.GroupMemberFullInfo
+	96, // 44: openim.group.getIncrementalGroupMemberResp.update:type_name -> openim.sdkws.GroupMemberFullInfo
+	90, // 45: openim.group.getIncrementalGroupMemberResp.group:type_name -> openim.sdkws.GroupInfo
+	90, // 46: openim.group.getIncrementalJoinGroupResp.insert:type_name -> openim.sdkws.GroupInfo
+	90, // 47: openim.group.getIncrementalJoinGroupResp.update:type_name -> openim.sdkws.GroupInfo
 	74, // 48: openim.group.BatchGetIncrementalGroupMemberReq.reqList:type_name -> openim.group.getIncrementalGroupMemberReq
-	85, // 49: openim.group.BatchGetIncrementalGroupMemberResp.respList:type_name -> openim.group.BatchGetIncrementalGroupMemberResp.RespListEntry
-	75, // 50: openim.group.BatchGetIncrementalGroupMemberResp.RespListEntry.value:type_name -> openim.group.getIncrementalGroupMemberResp
-	0,  // 51: openim.group.group.createGroup:input_type -> openim.group.CreateGroupReq
-	18, // 52: openim.group.group.joinGroup:input_type -> openim.group.JoinGroupReq
-	22, // 53: openim.group.group.quitGroup:input_type -> openim.group.QuitGroupReq
-	2,  // 54: openim.group.group.getGroupsInfo:input_type -> openim.group.GetGroupsInfoReq
-	4,  // 55: openim.group.group.setGroupInfo:input_type -> openim.group.SetGroupInfoReq
-	6,  // 56: openim.group.group.setGroupInfoEx:input_type -> openim.group.SetGroupInfoExReq
-	8,  // 57: openim.group.group.getGroupApplicationList:input_type -> openim.group.GetGroupApplicationListReq
-	10, // 58: openim.group.group.getGroupApplicationUnhandledCount:input_type -> openim.group.GetGroupApplicationUnhandledCountReq
-	12, // 59: openim.group.group.getUserReqApplicationList:input_type -> openim.group.GetUserReqApplicationListReq
-	70, // 60: openim.group.group.getGroupUsersReqApplicationList:input_type -> openim.group.getGroupUsersReqApplicationListReq
-	14, // 61: openim.group.group.getSpecifiedUserGroupRequestInfo:input_type -> openim.group.GetSpecifiedUserGroupRequestInfoReq
-	16, // 62: openim.group.group.transferGroupOwner:input_type -> openim.group.TransferGroupOwnerReq
-	20, // 63: openim.group.group.groupApplicationResponse:input_type -> openim.group.GroupApplicationResponseReq
-	24, // 64: openim.group.group.getGroupMemberList:input_type -> openim.group.GetGroupMemberListReq
-	26, // 65: openim.group.group.getGroupMembersInfo:input_type -> openim.group.GetGroupMembersInfoReq
-	28, // 66: openim.group.group.kickGroupMember:input_type -> openim.group.KickGroupMemberReq
-	30, // 67: openim.group.group.getJoinedGroupList:input_type -> openim.group.GetJoinedGroupListReq
-	32, // 68: openim.group.group.inviteUserToGroup:input_type -> openim.group.InviteUserToGroupReq
-	37, // 69: openim.group.group.getGroups:input_type -> openim.group.GetGroupsReq
-	40, // 70: openim.group.group.getGroupMembersCMS:input_type -> openim.group.GetGroupMembersCMSReq
-	42, // 71: openim.group.group.dismissGroup:input_type -> openim.group.DismissGroupReq
-	44, // 72: openim.group.group.muteGroupMember:input_type -> openim.group.MuteGroupMemberReq
-	46, // 73: openim.group.group.cancelMuteGroupMember:input_type -> openim.group.CancelMuteGroupMemberReq
-	48, // 74: openim.group.group.muteGroup:input_type -> openim.group.MuteGroupReq
-	50, // 75: openim.group.group.cancelMuteGroup:input_type -> openim.group.CancelMuteGroupReq
-	53, // 76: openim.group.group.setGroupMemberInfo:input_type -> openim.group.SetGroupMemberInfoReq
-	55, // 77: openim.group.group.getGroupAbstractInfo:input_type -> openim.group.GetGroupAbstractInfoReq
-	58, // 78: openim.group.group.getUserInGroupMembers:input_type -> openim.group.GetUserInGroupMembersReq
-	60, // 79: openim.group.group.getGroupMemberUserIDs:input_type -> openim.group.GetGroupMemberUserIDsReq
-	62, // 80: openim.group.group.GetGroupMemberRoleLevel:input_type -> openim.group.GetGroupMemberRoleLevelReq
-	64, // 81: openim.group.group.GetGroupInfoCache:input_type -> openim.group.GetGroupInfoCacheReq
-	66, // 82: openim.group.group.GetGroupMemberCache:input_type -> openim.group.GetGroupMemberCacheReq
-	68, // 83: openim.group.group.GroupCreateCount:input_type -> openim.group.GroupCreateCountReq
-	72, // 84: openim.group.group.NotificationUserInfoUpdate:input_type -> openim.group.notificationUserInfoUpdateReq
-	74, // 85: openim.group.group.getIncrementalGroupMember:input_type -> openim.group.getIncrementalGroupMemberReq
-	82, // 86: openim.group.group.BatchGetIncrementalGroupMember:input_type -> openim.group.BatchGetIncrementalGroupMemberReq
-	76, // 87: openim.group.group.getIncrementalJoinGroup:input_type -> openim.group.getIncrementalJoinGroupReq
-	78, // 88: openim.group.group.GetFullGroupMemberUserIDs:input_type -> openim.group.GetFullGroupMemberUserIDsReq
-	80, // 89: openim.group.group.GetFullJoinGroupIDs:input_type -> openim.group.GetFullJoinGroupIDsReq
-	1,  // 90: openim.group.group.createGroup:output_type -> openim.group.CreateGroupResp
-	19, // 91: openim.group.group.joinGroup:output_type -> openim.group.JoinGroupResp
-	23, // 92: openim.group.group.quitGroup:output_type -> openim.group.QuitGroupResp
-	3,  // 93: openim.group.group.getGroupsInfo:output_type -> openim.group.GetGroupsInfoResp
-	5,  // 94: openim.group.group.setGroupInfo:output_type -> openim.group.SetGroupInfoResp
-	7,  // 95: openim.group.group.setGroupInfoEx:output_type -> openim.group.SetGroupInfoExResp
-	9,  // 96: openim.group.group.getGroupApplicationList:output_type -> openim.group.GetGroupApplicationListResp
-	11, // 97: openim.group.group.getGroupApplicationUnhandledCount:output_type -> openim.group.GetGroupApplicationUnhandledCountResp
-	13, // 98: openim.group.group.getUserReqApplicationList:output_type -> openim.group.GetUserReqApplicationListResp
-	71, // 99: openim.group.group.getGroupUsersReqApplicationList:output_type -> openim.group.getGroupUsersReqApplicationListResp
-	15, // 100: openim.group.group.getSpecifiedUserGroupRequestInfo:output_type -> openim.group.GetSpecifiedUserGroupRequestInfoResp
-	17, // 101: openim.group.group.transferGroupOwner:output_type -> openim.group.TransferGroupOwnerResp
-	21, // 102: openim.group.group.groupApplicationResponse:output_type -> openim.group.GroupApplicationResponseResp
-	25, // 103: openim.group.group.getGroupMemberList:output_type -> openim.group.GetGroupMemberListResp
-	27, // 104: openim.group.group.getGroupMembersInfo:output_type -> openim.group.GetGroupMembersInfoResp
-	29, // 105: openim.group.group.kickGroupMember:output_type -> openim.group.KickGroupMemberResp
-	31, // 106: openim.group.group.getJoinedGroupList:output_type -> openim.group.GetJoinedGroupListResp
-	33, // 107: openim.group.group.inviteUserToGroup:output_type -> openim.group.InviteUserToGroupResp
-	38, // 108: openim.group.group.getGroups:output_type -> openim.group.GetGroupsResp
-	41, // 109: openim.group.group.getGroupMembersCMS:output_type -> openim.group.GetGroupMembersCMSResp
-	43, // 110: openim.group.group.dismissGroup:output_type -> openim.group.DismissGroupResp
-	45, // 111: openim.group.group.muteGroupMember:output_type -> openim.group.MuteGroupMemberResp
-	47, // 112: openim.group.group.cancelMuteGroupMember:output_type -> openim.group.CancelMuteGroupMemberResp
-	49, // 113: openim.group.group.muteGroup:output_type -> openim.group.MuteGroupResp
-	51, // 114: openim.group.group.cancelMuteGroup:output_type -> openim.group.CancelMuteGroupResp
-	54, // 115: openim.group.group.setGroupMemberInfo:output_type -> openim.group.SetGroupMemberInfoResp
-	57, // 116: openim.group.group.getGroupAbstractInfo:output_type -> openim.group.GetGroupAbstractInfoResp
-	59, // 117: openim.group.group.getUserInGroupMembers:output_type -> openim.group.GetUserInGroupMembersResp
-	61, // 118: openim.group.group.getGroupMemberUserIDs:output_type -> openim.group.GetGroupMemberUserIDsResp
-	63, // 119: openim.group.group.GetGroupMemberRoleLevel:output_type -> openim.group.GetGroupMemberRoleLevelResp
-	65, // 120: openim.group.group.GetGroupInfoCache:output_type -> openim.group.GetGroupInfoCacheResp
-	67, // 121: openim.group.group.GetGroupMemberCache:output_type -> openim.group.GetGroupMemberCacheResp
-	69, // 122: openim.group.group.GroupCreateCount:output_type -> openim.group.GroupCreateCountResp
-	73, // 123: openim.group.group.NotificationUserInfoUpdate:output_type -> openim.group.notificationUserInfoUpdateResp
-	75, // 124: openim.group.group.getIncrementalGroupMember:output_type -> openim.group.getIncrementalGroupMemberResp
-	83, // 125: openim.group.group.BatchGetIncrementalGroupMember:output_type -> openim.group.BatchGetIncrementalGroupMemberResp
-	77, // 126: openim.group.group.getIncrementalJoinGroup:output_type -> openim.group.getIncrementalJoinGroupResp
-	79, // 127: openim.group.group.GetFullGroupMemberUserIDs:output_type -> openim.group.GetFullGroupMemberUserIDsResp
-	81, // 128: openim.group.group.GetFullJoinGroupIDs:output_type -> openim.group.GetFullJoinGroupIDsResp
-	90, // [90:129] is the sub-list for method output_type
-	51, // [51:90] is the sub-list for method input_type
-	51, // [51:51] is the sub-list for extension type_name
-	51, // [51:51] is the sub-list for extension extendee
-	0,  // [0:51] is the sub-list for field type_name
+	89, // 49: openim.group.BatchGetIncrementalGroupMemberResp.respList:type_name -> openim.group.BatchGetIncrementalGroupMemberResp.RespListEntry
+	84, // 50: openim.group.BatchOperateGroupMembersReq.operations:type_name -> openim.group.GroupMemberOperation
+	86, // 51: openim.group.BatchOperateGroupMembersResp.results:type_name -> openim.group.GroupMemberOperationResult
+	75, // 52: openim.group.BatchGetIncrementalGroupMemberResp.RespListEntry.value:type_name -> openim.group.getIncrementalGroupMemberResp
+	0,  // 53: openim.group.group.createGroup:input_type -> openim.group.CreateGroupReq
+	18, // 54: openim.group.group.joinGroup:input_type -> openim.group.JoinGroupReq
+	22, // 55: openim.group.group.quitGroup:input_type -> openim.group.QuitGroupReq
+	2,  // 56: openim.group.group.getGroupsInfo:input_type -> openim.group.GetGroupsInfoReq
+	4,  // 57: openim.group.group.setGroupInfo:input_type -> openim.group.SetGroupInfoReq
+	6,  // 58: openim.group.group.setGroupInfoEx:input_type -> openim.group.SetGroupInfoExReq
+	8,  // 59: openim.group.group.getGroupApplicationList:input_type -> openim.group.GetGroupApplicationListReq
+	10, // 60: openim.group.group.getGroupApplicationUnhandledCount:input_type -> openim.group.GetGroupApplicationUnhandledCountReq
+	12, // 61: openim.group.group.getUserReqApplicationList:input_type -> openim.group.GetUserReqApplicationListReq
+	70, // 62: openim.group.group.getGroupUsersReqApplicationList:input_type -> openim.group.getGroupUsersReqApplicationListReq
+	14, // 63: openim.group.group.getSpecifiedUserGroupRequestInfo:input_type -> openim.group.GetSpecifiedUserGroupRequestInfoReq
+	16, // 64: openim.group.group.transferGroupOwner:input_type -> openim.group.TransferGroupOwnerReq
+	20, // 65: openim.group.group.groupApplicationResponse:input_type -> openim.group.GroupApplicationResponseReq
+	24, // 66: openim.group.group.getGroupMemberList:input_type -> openim.group.GetGroupMemberListReq
+	26, // 67: openim.group.group.getGroupMembersInfo:input_type -> openim.group.GetGroupMembersInfoReq
+	28, // 68: openim.group.group.kickGroupMember:input_type -> openim.group.KickGroupMemberReq
+	30, // 69: openim.group.group.getJoinedGroupList:input_type -> openim.group.GetJoinedGroupListReq
+	32, // 70: openim.group.group.inviteUserToGroup:input_type -> openim.group.InviteUserToGroupReq
+	37, // 71: openim.group.group.getGroups:input_type -> openim.group.GetGroupsReq
+	40, // 72: openim.group.group.getGroupMembersCMS:input_type -> openim.group.GetGroupMembersCMSReq
+	42, // 73: openim.group.group.dismissGroup:input_type -> openim.group.DismissGroupReq
+	44, // 74: openim.group.group.muteGroupMember:input_type -> openim.group.MuteGroupMemberReq
+	46, // 75: openim.group.group.cancelMuteGroupMember:input_type -> openim.group.CancelMuteGroupMemberReq
+	48, // 76: openim.group.group.muteGroup:input_type -> openim.group.MuteGroupReq
+	50, // 77: openim.group.group.cancelMuteGroup:input_type -> openim.group.CancelMuteGroupReq
+	53, // 78: openim.group.group.setGroupMemberInfo:input_type -> openim.group.SetGroupMemberInfoReq
+	55, // 79: openim.group.group.getGroupAbstractInfo:input_type -> openim.group.GetGroupAbstractInfoReq
+	58, // 80: openim.group.group.getUserInGroupMembers:input_type -> openim.group.GetUserInGroupMembersReq
+	60, // 81: openim.group.group.getGroupMemberUserIDs:input_type -> openim.group.GetGroupMemberUserIDsReq
+	62, // 82: openim.group.group.GetGroupMemberRoleLevel:input_type -> openim.group.GetGroupMemberRoleLevelReq
+	64, // 83: openim.group.group.GetGroupInfoCache:input_type -> openim.group.GetGroupInfoCacheReq
+	66, // 84: openim.group.group.GetGroupMemberCache:input_type -> openim.group.GetGroupMemberCacheReq
+	68, // 85: openim.group.group.GroupCreateCount:input_type -> openim.group.GroupCreateCountReq
+	72, // 86: openim.group.group.NotificationUserInfoUpdate:input_type -> openim.group.notificationUserInfoUpdateReq
+	74, // 87: openim.group.group.getIncrementalGroupMember:input_type -> openim.group.getIncrementalGroupMemberReq
+	82, // 88: openim.group.group.BatchGetIncrementalGroupMember:input_type -> openim.group.BatchGetIncrementalGroupMemberReq
+	76, // 89: openim.group.group.getIncrementalJoinGroup:input_type -> openim.group.getIncrementalJoinGroupReq
+	78, // 90: openim.group.group.GetFullGroupMemberUserIDs:input_type -> openim.group.GetFullGroupMemberUserIDsReq
+	80, // 91: openim.group.group.GetFullJoinGroupIDs:input_type -> openim.group.GetFullJoinGroupIDsReq
+	85, // 92: openim.group.group.BatchOperateGroupMembers:input_type -> openim.group.BatchOperateGroupMembersReq
+	1,  // 93: openim.group.group.createGroup:output_type -> openim.group.CreateGroupResp
+	19, // 94: openim.group.group.joinGroup:output_type -> openim.group.JoinGroupResp
+	23, // 95: openim.group.group.quitGroup:output_type -> openim.group.QuitGroupResp
+	3,  // 96: openim.group.group.getGroupsInfo:output_type -> openim.group.GetGroupsInfoResp
+	5,  // 97: openim.group.group.setGroupInfo:output_type -> openim.group.SetGroupInfoResp
+	7,  // 98: openim.group.group.setGroupInfoEx:output_type -> openim.group.SetGroupInfoExResp
+	9,  // 99: openim.group.group.getGroupApplicationList:output_type -> openim.group.GetGroupApplicationListResp
+	11, // 100: openim.group.group.getGroupApplicationUnhandledCount:output_type -> openim.group.GetGroupApplicationUnhandledCountResp
+	13, // 101: openim.group.group.getUserReqApplicationList:output_type -> openim.group.GetUserReqApplicationListResp
+	71, // 102: openim.group.group.getGroupUsersReqApplicationList:output_type -> openim.group.getGroupUsersReqApplicationListResp
+	15, // 103: openim.group.group.getSpecifiedUserGroupRequestInfo:output_type -> openim.group.GetSpecifiedUserGroupRequestInfoResp
+	17, // 104: openim.group.group.transferGroupOwner:output_type -> openim.group.TransferGroupOwnerResp
+	21, // 105: openim.group.group.groupApplicationResponse:output_type -> openim.group.GroupApplicationResponseResp
+	25, // 106: openim.group.group.getGroupMemberList:output_type -> openim.group.GetGroupMemberListResp
+	27, // 107: openim.group.group.getGroupMembersInfo:output_type -> openim.group.GetGroupMembersInfoResp
+	29, // 108: openim.group.group.kickGroupMember:output_type -> openim.group.KickGroupMemberResp
+	31, // 109: openim.group.group.getJoinedGroupList:output_type -> openim.group.GetJoinedGroupListResp
+	33, // 110: openim.group.group.inviteUserToGroup:output_type -> openim.group.InviteUserToGroupResp
+	38, // 111: openim.group.group.getGroups:output_type -> openim.group.GetGroupsResp
+	41, // 112: openim.group.group.getGroupMembersCMS:output_type -> openim.group.GetGroupMembersCMSResp
+	43, // 113: openim.group.group.dismissGroup:output_type -> openim.group.DismissGroupResp
+	45, // 114: openim.group.group.muteGroupMember:output_type -> openim.group.MuteGroupMemberResp
+	47, // 115: openim.group.group.cancelMuteGroupMember:output_type -> openim.group.CancelMuteGroupMemberResp
+	49, // 116: openim.group.group.muteGroup:output_type -> openim.group.MuteGroupResp
+	51, // 117: openim.group.group.cancelMuteGroup:output_type -> openim.group.CancelMuteGroupResp
+	54, // 118: openim.group.group.setGroupMemberInfo:output_type -> openim.group.SetGroupMemberInfoResp
+	57, // 119: openim.group.group.getGroupAbstractInfo:output_type -> openim.group.GetGroupAbstractInfoResp
+	59, // 120: openim.group.group.getUserInGroupMembers:output_type -> openim.group.GetUserInGroupMembersResp
+	61, // 121: openim.group.group.getGroupMemberUserIDs:output_type -> openim.group.GetGroupMemberUserIDsResp
+	63, // 122: openim.group.group.GetGroupMemberRoleLevel:output_type -> openim.group.GetGroupMemberRoleLevelResp
+	65, // 123: openim.group.group.GetGroupInfoCache:output_type -> openim.group.GetGroupInfoCacheResp
+	67, // 124: openim.group.group.GetGroupMemberCache:output_type -> openim.group.GetGroupMemberCacheResp
+	69, // 125: openim.group.group.GroupCreateCount:output_type -> openim.group.GroupCreateCountResp
+	73, // 126: openim.group.group.NotificationUserInfoUpdate:output_type -> openim.group.notificationUserInfoUpdateResp
+	75, // 127: openim.group.group.getIncrementalGroupMember:output_type -> openim.group.getIncrementalGroupMemberResp
+	83, // 128: openim.group.group.BatchGetIncrementalGroupMember:output_type -> openim.group.BatchGetIncrementalGroupMemberResp
+	77, // 129: openim.group.group.getIncrementalJoinGroup:output_type -> openim.group.getIncrementalJoinGroupResp
+	79, // 130: openim.group.group.GetFullGroupMemberUserIDs:output_type -> openim.group.GetFullGroupMemberUserIDsResp
+	81, // 131: openim.group.group.GetFullJoinGroupIDs:output_type -> openim.group.GetFullJoinGroupIDsResp
+	87, // 132: openim.group.group.BatchOperateGroupMembers:output_type -> openim.group.BatchOperateGroupMembersResp
+	93, // [93:133] is the sub-list for method output_type
+	53, // [53:93] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_group_group_proto_init() }
@@ -5035,13 +5330,14 @@ func file_group_group_proto_init() {
 	file_group_group_proto_msgTypes[28].OneofWrappers = []any{}
 	file_group_group_proto_msgTypes[32].OneofWrappers = []any{}
 	file_group_group_proto_msgTypes[42].OneofWrappers = []any{}
+	file_group_group_proto_msgTypes[85].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_group_group_proto_rawDesc), len(file_group_group_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   86,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
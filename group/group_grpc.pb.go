@@ -72,6 +72,7 @@ const (
 	Group_GetIncrementalJoinGroup_FullMethodName           = "/openim.group.group/getIncrementalJoinGroup"
 	Group_GetFullGroupMemberUserIDs_FullMethodName         = "/openim.group.group/GetFullGroupMemberUserIDs"
 	Group_GetFullJoinGroupIDs_FullMethodName               = "/openim.group.group/GetFullJoinGroupIDs"
+	Group_BatchOperateGroupMembers_FullMethodName          = "/openim.group.group/BatchOperateGroupMembers"
 )
 
 // GroupClient is the client API for Group service.
@@ -142,6 +143,10 @@ type GroupClient interface {
 	GetIncrementalJoinGroup(ctx context.Context, in *GetIncrementalJoinGroupReq, opts ...grpc.CallOption) (*GetIncrementalJoinGroupResp, error)
 	GetFullGroupMemberUserIDs(ctx context.Context, in *GetFullGroupMemberUserIDsReq, opts ...grpc.CallOption) (*GetFullGroupMemberUserIDsResp, error)
 	GetFullJoinGroupIDs(ctx context.Context, in *GetFullJoinGroupIDsReq, opts ...grpc.CallOption) (*GetFullJoinGroupIDsResp, error)
+	// Invite, kick or change the role of members across many groups in one call.
+	// Each (groupID, userID) operation reports its own result, and notifications
+	// are coalesced per group and action.
+	BatchOperateGroupMembers(ctx context.Context, in *BatchOperateGroupMembersReq, opts ...grpc.CallOption) (*BatchOperateGroupMembersResp, error)
 }
 
 type groupClient struct {
@@ -542,6 +547,16 @@ func (c *groupClient) GetFullJoinGroupIDs(ctx context.Context, in *GetFullJoinGr
 	return out, nil
 }
 
+func (c *groupClient) BatchOperateGroupMembers(ctx context.Context, in *BatchOperateGroupMembersReq, opts ...grpc.CallOption) (*BatchOperateGroupMembersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchOperateGroupMembersResp)
+	err := c.cc.Invoke(ctx, Group_BatchOperateGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupServer is the server API for Group service.
 // All implementations must embed UnimplementedGroupServer
 // for forward compatibility.
@@ -610,6 +625,10 @@ type GroupServer interface {
 	GetIncrementalJoinGroup(context.Context, *GetIncrementalJoinGroupReq) (*GetIncrementalJoinGroupResp, error)
 	GetFullGroupMemberUserIDs(context.Context, *GetFullGroupMemberUserIDsReq) (*GetFullGroupMemberUserIDsResp, error)
 	GetFullJoinGroupIDs(context.Context, *GetFullJoinGroupIDsReq) (*GetFullJoinGroupIDsResp, error)
+	// Invite, kick or change the role of members across many groups in one call.
+	// Each (groupID, userID) operation reports its own result, and notifications
+	// are coalesced per group and action.
+	BatchOperateGroupMembers(context.Context, *BatchOperateGroupMembersReq) (*BatchOperateGroupMembersResp, error)
 	mustEmbedUnimplementedGroupServer()
 }
 
@@ -737,6 +756,9 @@ func (UnimplementedGroupServer) GetFullGroupMemberUserIDs(context.Context, *GetF
 func (UnimplementedGroupServer) GetFullJoinGroupIDs(context.Context, *GetFullJoinGroupIDsReq) (*GetFullJoinGroupIDsResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFullJoinGroupIDs not implemented")
 }
+func (UnimplementedGroupServer) BatchOperateGroupMembers(context.Context, *BatchOperateGroupMembersReq) (*BatchOperateGroupMembersResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchOperateGroupMembers not implemented")
+}
 func (UnimplementedGroupServer) mustEmbedUnimplementedGroupServer() {}
 func (UnimplementedGroupServer) testEmbeddedByValue()               {}
 
@@ -1460,6 +1482,24 @@ func _Group_GetFullJoinGroupIDs_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Group_BatchOperateGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchOperateGroupMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).BatchOperateGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Group_BatchOperateGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).BatchOperateGroupMembers(ctx, req.(*BatchOperateGroupMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Group_ServiceDesc is the grpc.ServiceDesc for Group service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1623,6 +1663,10 @@ var Group_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFullJoinGroupIDs",
 			Handler:    _Group_GetFullJoinGroupIDs_Handler,
 		},
+		{
+			MethodName: "BatchOperateGroupMembers",
+			Handler:    _Group_BatchOperateGroupMembers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "group/group.proto",
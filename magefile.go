@@ -51,6 +51,7 @@ const (
 )
 
 var protoModules = []string{
+	"audit",
 	"auth",
 	"call",
 	"conversation",
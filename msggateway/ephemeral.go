@@ -0,0 +1,88 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+// TTLDuration returns the signal's TTL, falling back to the default when unset.
+func (x *EphemeralSignal) TTLDuration() time.Duration {
+	if x.Ttl <= 0 {
+		return constant.EphemeralSignalDefaultTTL * time.Second
+	}
+	return time.Duration(x.Ttl) * time.Second
+}
+
+type ephemeralKey struct {
+	sendID         string
+	conversationID string
+	signalType     int32
+}
+
+// ephemeralState is the last start signal passed for an ephemeralKey.
+type ephemeralState struct {
+	start   time.Time // when the start passed
+	expire  time.Time // when receivers clear the start by themselves
+	stopped bool      // a stop already passed for this start
+}
+
+// EphemeralLimiter drops repeated start signals from the same sender in the same conversation.
+// A stop passes once per start, and only while that start has not expired on the receivers,
+// so stops cannot be used to flood the fan-out.
+type EphemeralLimiter struct {
+	interval time.Duration
+	mu       sync.Mutex
+	last     map[ephemeralKey]*ephemeralState
+}
+
+func NewEphemeralLimiter(interval time.Duration) *EphemeralLimiter {
+	return &EphemeralLimiter{interval: interval, last: make(map[ephemeralKey]*ephemeralState)}
+}
+
+// Allow reports whether signal may be fanned out at now.
+func (l *EphemeralLimiter) Allow(signal *EphemeralSignal, now time.Time) bool {
+	key := ephemeralKey{sendID: signal.SendID, conversationID: signal.ConversationID, signalType: signal.SignalType}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	state, ok := l.last[key]
+	if !signal.Active {
+		// The start time is kept so that start/stop pairs do not bypass the interval.
+		if !ok || state.stopped || !now.Before(state.expire) {
+			return false
+		}
+		state.stopped = true
+		return true
+	}
+	if ok && now.Sub(state.start) < l.interval {
+		return false
+	}
+	l.last[key] = &ephemeralState{start: now, expire: now.Add(signal.TTLDuration())}
+	return true
+}
+
+// Expire removes entries that no longer limit anything, call it periodically to bound memory.
+func (l *EphemeralLimiter) Expire(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key, state := range l.last {
+		if now.Sub(state.start) >= l.interval && (state.stopped || !now.Before(state.expire)) {
+			delete(l.last, key)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestEphemeralLimiterAllow(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewEphemeralLimiter(3 * time.Second)
+	start := &EphemeralSignal{SendID: "u1", ConversationID: "si_u1_u2", SignalType: constant.EphemeralSignalTyping, Active: true, Ttl: 10}
+	stop := &EphemeralSignal{SendID: "u1", ConversationID: "si_u1_u2", SignalType: constant.EphemeralSignalTyping}
+	other := &EphemeralSignal{SendID: "u1", ConversationID: "si_u1_u2", SignalType: constant.EphemeralSignalViewing, Active: true}
+	steps := []struct {
+		name   string
+		signal *EphemeralSignal
+		at     time.Duration
+		want   bool
+	}{
+		{"stop without start", stop, 0, false},
+		{"first start", start, 0, true},
+		{"repeated start", start, time.Second, false},
+		{"other type", other, time.Second, true},
+		{"stop passes", stop, 2 * time.Second, true},
+		{"start after stop within interval", start, 2 * time.Second, false},
+		{"second stop", stop, 2 * time.Second, false},
+		{"start after interval", start, 3 * time.Second, true},
+		{"stop after start expired", stop, 13 * time.Second, false},
+	}
+	for _, step := range steps {
+		if got := l.Allow(step.signal, now.Add(step.at)); got != step.want {
+			t.Fatalf("%s: Allow() = %v, want %v", step.name, got, step.want)
+		}
+	}
+}
+
+func TestEphemeralLimiterStopSpam(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewEphemeralLimiter(3 * time.Second)
+	start := &EphemeralSignal{SendID: "u1", ConversationID: "c1", SignalType: constant.EphemeralSignalTyping, Active: true}
+	stop := &EphemeralSignal{SendID: "u1", ConversationID: "c1", SignalType: constant.EphemeralSignalTyping}
+	passed := 0
+	for i := 0; i < 1000; i++ {
+		at := now.Add(time.Duration(i) * 10 * time.Millisecond)
+		if i%2 == 0 {
+			l.Allow(start, at)
+		} else if l.Allow(stop, at) {
+			passed++
+		}
+	}
+	// 10s of alternating start/stop every 10ms: one start and one stop per 3s interval.
+	if passed != 4 {
+		t.Fatalf("%d stops passed, want 4", passed)
+	}
+}
+
+func TestEphemeralLimiterExpire(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewEphemeralLimiter(3 * time.Second)
+	stopped := &EphemeralSignal{SendID: "u1", ConversationID: "c1", Active: true}
+	l.Allow(stopped, now)
+	l.Allow(&EphemeralSignal{SendID: "u1", ConversationID: "c1"}, now.Add(time.Second))
+	l.Allow(&EphemeralSignal{SendID: "u2", ConversationID: "c1", Active: true}, now)
+	l.Allow(&EphemeralSignal{SendID: "u3", ConversationID: "c1", Active: true}, now.Add(2*time.Second))
+	// u1 was stopped, u2 is past the interval but its start is still shown, u3 is within the interval.
+	l.Expire(now.Add(3 * time.Second))
+	if len(l.last) != 2 {
+		t.Fatalf("%d entries left, want 2", len(l.last))
+	}
+	l.Expire(now.Add(constant.EphemeralSignalDefaultTTL*time.Second + 2*time.Second))
+	if len(l.last) != 0 {
+		t.Fatalf("%d entries left, want 0", len(l.last))
+	}
+}
+
+func TestSendEphemeralSignalReqCheck(t *testing.T) {
+	signal := &EphemeralSignal{SendID: "u1", ConversationID: "c1", SignalType: constant.EphemeralSignalTyping, Active: true}
+	if err := (&SendEphemeralSignalReq{Signal: signal, RecvUserIDs: []string{"u2"}}).Check(); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&SendEphemeralSignalReq{Signal: signal, RecvUserIDs: make([]string, constant.ParamMaxLength+1)}).Check(); err == nil {
+		t.Fatal("too many RecvUserIDs should be rejected")
+	}
+}
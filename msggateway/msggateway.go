@@ -14,7 +14,11 @@
 
 package msggateway
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/openimsdk/protocol/constant"
+)
 
 func (x *OnlinePushMsgReq) Check() error {
 	if x.MsgData == nil {
@@ -71,3 +75,53 @@ func (x *MultiTerminalLoginCheckReq) Check() error {
 	}
 	return nil
 }
+
+func (x *SendEphemeralSignalReq) Check() error {
+	if x.Signal == nil {
+		return errors.New("Signal is empty")
+	}
+	if x.Signal.ConversationID == "" {
+		return errors.New("ConversationID is empty")
+	}
+	if x.Signal.SendID == "" {
+		return errors.New("SendID is empty")
+	}
+	switch x.Signal.SignalType {
+	case constant.EphemeralSignalTyping, constant.EphemeralSignalRecordingVoice, constant.EphemeralSignalViewing:
+	default:
+		return errors.New("SignalType is invalid")
+	}
+	if x.Signal.Ttl < 0 || x.Signal.Ttl > constant.EphemeralSignalMaxTTL {
+		return errors.New("Ttl is invalid")
+	}
+	if len(x.RecvUserIDs) == 0 {
+		return errors.New("RecvUserIDs is empty")
+	}
+	if len(x.RecvUserIDs) > constant.ParamMaxLength {
+		return errors.New("too many RecvUserIDs, need to be less than 1000")
+	}
+	return nil
+}
+
+func (x *ListUserConnectionsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("UserID is empty")
+	}
+	if x.PlatformID < 0 || x.PlatformID > constant.HarmonyOSPlatformID {
+		return errors.New("PlatformID is invalid")
+	}
+	return nil
+}
+
+func (x *CloseConnectionReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("UserID is empty")
+	}
+	if x.ConnID == "" {
+		return errors.New("ConnID is empty")
+	}
+	if x.ReasonCode < 4000 || x.ReasonCode > 4999 {
+		return errors.New("ReasonCode is invalid")
+	}
+	return nil
+}
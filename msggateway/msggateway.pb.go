@@ -748,6 +748,733 @@ func (*MultiTerminalLoginCheckResp) Descriptor() ([]byte, []int) {
 	return file_msggateway_msggateway_proto_rawDescGZIP(), []int{13}
 }
 
+// ConnectionInfo 单个长连接的详细信息
+type ConnectionInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserID          string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	PlatformID      int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID"`
+	ConnID          string                 `protobuf:"bytes,3,opt,name=connID,proto3" json:"connID"`
+	IsBackground    bool                   `protobuf:"varint,4,opt,name=isBackground,proto3" json:"isBackground"`
+	AppVersion      string                 `protobuf:"bytes,5,opt,name=appVersion,proto3" json:"appVersion"`
+	SdkVersion      string                 `protobuf:"bytes,6,opt,name=sdkVersion,proto3" json:"sdkVersion"`
+	Ip              string                 `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip"`
+	ConnectTime     int64                  `protobuf:"varint,8,opt,name=connectTime,proto3" json:"connectTime"`          // 建立连接时间（毫秒）
+	LastActiveTime  int64                  `protobuf:"varint,9,opt,name=lastActiveTime,proto3" json:"lastActiveTime"`    // 最近一次收到客户端数据的时间（毫秒）
+	PendingMsgCount int32                  `protobuf:"varint,10,opt,name=pendingMsgCount,proto3" json:"pendingMsgCount"` // 待写入连接的消息数
+	PushLag         int64                  `protobuf:"varint,11,opt,name=pushLag,proto3" json:"pushLag"`                 // 最近一条消息从发送到写入连接的延迟（毫秒）
+	GatewayID       string                 `protobuf:"bytes,12,opt,name=gatewayID,proto3" json:"gatewayID"`              // 所在网关实例
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConnectionInfo) Reset() {
+	*x = ConnectionInfo{}
+	mi := &file_msggateway_msggateway_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionInfo) ProtoMessage() {}
+
+func (x *ConnectionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_msggateway_msggateway_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionInfo.ProtoReflect.Descriptor instead.
+func (*ConnectionInfo) Descriptor() ([]byte, []int) {
+	return file_msggateway_msggateway_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ConnectionInfo) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *ConnectionInfo) GetConnID() string {
+	if x != nil {
+		return x.ConnID
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetIsBackground() bool {
+	if x != nil {
+		return x.IsBackground
+	}
+	return false
+}
+
+func (x *ConnectionInfo) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetSdkVersion() string {
+	if x != nil {
+		return x.SdkVersion
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetConnectTime() int64 {
+	if x != nil {
+		return x.ConnectTime
+	}
+	return 0
+}
+
+func (x *ConnectionInfo) GetLastActiveTime() int64 {
+	if x != nil {
+		return x.LastActiveTime
+	}
+	return 0
+}
+
+func (x *ConnectionInfo) GetPendingMsgCount() int32 {
+	if x != nil {
+		return x.PendingMsgCount
+	}
+	return 0
+}
+
+func (x *ConnectionInfo) GetPushLag() int64 {
+	if x != nil {
+		return x.PushLag
+	}
+	return 0
+}
+
+func (x *ConnectionInfo) GetGatewayID() string {
+	if x != nil {
+		return x.GatewayID
+	}
+	return ""
+}
+
+type ListUserConnectionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	PlatformID    int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID"` // 0 表示全部平台
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserConnectionsReq) Reset() {
+	*x = ListUserConnectionsReq{}
+	mi := &file_msggateway_msggateway_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserConnectionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserConnectionsReq) ProtoMessage() {}
+
+func (x *ListUserConnectionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msggateway_msggateway_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserConnectionsReq.ProtoReflect.Descriptor instead.
+func (*ListUserConnectionsReq) Descriptor() ([]byte, []int) {
+	return file_msggateway_msggateway_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListUserConnectionsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ListUserConnectionsReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+type ListUserConnectionsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connections   []*ConnectionInfo      `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserConnectionsResp) Reset() {
+	*x = ListUserConnectionsResp{}
+	mi := &file_msggateway_msggateway_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserConnectionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserConnectionsResp) ProtoMessage() {}
+
+func (x *ListUserConnectionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msggateway_msggateway_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserConnectionsResp.ProtoReflect.Descriptor instead.
+func (*ListUserConnectionsResp) Descriptor() ([]byte, []int) {
+	return file_msggateway_msggateway_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListUserConnectionsResp) GetConnections() []*ConnectionInfo {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+// CloseConnectionReq 关闭指定连接，reasonCode 作为 WebSocket 关闭码下发给客户端
+type CloseConnectionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConnID        string                 `protobuf:"bytes,2,opt,name=connID,proto3" json:"connID"`
+	ReasonCode    int32                  `protobuf:"varint,3,opt,name=reasonCode,proto3" json:"reasonCode"` // constant.ConnCloseReason*
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason"`          // 关闭原因描述，随关闭帧下发
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseConnectionReq) Reset() {
+	*x = CloseConnectionReq{}
+	mi := &file_msggateway_msggateway_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseConnectionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseConnectionReq) ProtoMessage() {}
+
+func (x *CloseConnectionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msggateway_msggateway_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseConnectionReq.ProtoReflect.Descriptor instead.
+func (*CloseConnectionReq) Descriptor() ([]byte, []int) {
+	return file_msggateway_msggateway_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CloseConnectionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CloseConnectionReq) GetConnID() string {
+	if x != nil {
+		return x.ConnID
+	}
+	return ""
+}
+
+func (x *CloseConnectionReq) GetReasonCode() int32 {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return 0
+}
+
+func (x *CloseConnectionReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CloseConnectionResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Closed        bool                   `protobuf:"varint,1,opt,name=closed,proto3" json:"closed"` // 连接不存在时为 false
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseConnectionResp) Reset() {
+	*x = CloseConnectionResp{}
+	mi := &file_msggateway_msggateway_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseConnectionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseConnectionResp) ProtoMessage() {}
+
+func (x *CloseConnectionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msggateway_msggateway_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseConnectionResp.ProtoReflect.Descriptor instead.
+func (*CloseConnectionResp) Descriptor() ([]byte, []int) {
+	return file_msggateway_msggateway_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CloseConnectionResp) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+type PlatformConnStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PlatformID      int32                  `protobuf:"varint,1,opt,name=platformID,proto3" json:"platformID"`
+	ConnCount       int64                  `protobuf:"varint,2,opt,name=connCount,proto3" json:"connCount"`
+	UserCount       int64                  `protobuf:"varint,3,opt,name=userCount,proto3" json:"userCount"`
+	BackgroundCount int64                  `protobuf:"varint,4,opt,name=backgroundCount,proto3" json:"backgroundCount"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlatformConnStats) Reset() {
+	*x = PlatformConnStats{}
+	mi := &file_msggateway_msggateway_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformConnStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformConnStats) ProtoMessage() {}
+
+func (x *PlatformConnStats) ProtoReflect() protoreflect.Message {
+	mi := &file_msggateway_msggateway_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformConnStats.ProtoReflect.Descriptor instead.
+func (*PlatformConnStats) Descriptor() ([]byte, []int) {
+	return file_msggateway_msggateway_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PlatformConnStats) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *PlatformConnStats) GetConnCount() int64 {
+	if x != nil {
+		return x.ConnCount
+	}
+	return 0
+}
+
+func (x *PlatformConnStats) GetUserCount() int64 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *PlatformConnStats) GetBackgroundCount() int64 {
+	if x != nil {
+		return x.BackgroundCount
+	}
+	return 0
+}
+
+type GetConnectionStatsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectionStatsReq) Reset() {
+	*x = GetConnectionStatsReq{}
+	mi := &file_msggateway_msggateway_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectionStatsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionStatsReq) ProtoMessage() {}
+
+func (x *GetConnectionStatsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msggateway_msggateway_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionStatsReq.ProtoReflect.Descriptor instead.
+func (*GetConnectionStatsReq) Descriptor() ([]byte, []int) {
+	return file_msggateway_msggateway_proto_rawDescGZIP(), []int{20}
+}
+
+type GetConnectionStatsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GatewayID     string                 `protobuf:"bytes,1,opt,name=gatewayID,proto3" json:"gatewayID"`
+	ConnCount     int64                  `protobuf:"varint,2,opt,name=connCount,proto3" json:"connCount"`
+	UserCount     int64                  `protobuf:"varint,3,opt,name=userCount,proto3" json:"userCount"`
+	Platforms     []*PlatformConnStats   `protobuf:"bytes,4,rep,name=platforms,proto3" json:"platforms"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectionStatsResp) Reset() {
+	*x = GetConnectionStatsResp{}
+	mi := &file_msggateway_msggateway_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectionStatsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionStatsResp) ProtoMessage() {}
+
+func (x *GetConnectionStatsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msggateway_msggateway_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionStatsResp.ProtoReflect.Descriptor instead.
+func (*GetConnectionStatsResp) Descriptor() ([]byte, []int) {
+	return file_msggateway_msggateway_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetConnectionStatsResp) GetGatewayID() string {
+	if x != nil {
+		return x.GatewayID
+	}
+	return ""
+}
+
+func (x *GetConnectionStatsResp) GetConnCount() int64 {
+	if x != nil {
+		return x.ConnCount
+	}
+	return 0
+}
+
+func (x *GetConnectionStatsResp) GetUserCount() int64 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *GetConnectionStatsResp) GetPlatforms() []*PlatformConnStats {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+// EphemeralSignal 临时信号（正在输入、正在录音、正在查看），不落库、不分配 seq，只推送给在线连接
+type EphemeralSignal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ConversationID string                 `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID"`
+	SessionType    int32                  `protobuf:"varint,2,opt,name=sessionType,proto3" json:"sessionType"`
+	SendID         string                 `protobuf:"bytes,3,opt,name=sendID,proto3" json:"sendID"`
+	PlatformID     int32                  `protobuf:"varint,4,opt,name=platformID,proto3" json:"platformID"` // 发送者平台
+	SignalType     int32                  `protobuf:"varint,5,opt,name=signalType,proto3" json:"signalType"` // constant.EphemeralSignal*
+	Active         bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active"`         // true=开始，false=结束（仅在开始信号未过期时下发一次）
+	Ttl            int32                  `protobuf:"varint,7,opt,name=ttl,proto3" json:"ttl"`               // 有效期（秒），接收方在 ttl 内未收到更新则自动清除
+	SendTime       int64                  `protobuf:"varint,8,opt,name=sendTime,proto3" json:"sendTime"`
+	Ex             string                 `protobuf:"bytes,9,opt,name=ex,proto3" json:"ex"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EphemeralSignal) Reset() {
+	*x = EphemeralSignal{}
+	mi := &file_msggateway_msggateway_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EphemeralSignal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EphemeralSignal) ProtoMessage() {}
+
+func (x *EphemeralSignal) ProtoReflect() protoreflect.Message {
+	mi := &file_msggateway_msggateway_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EphemeralSignal.ProtoReflect.Descriptor instead.
+func (*EphemeralSignal) Descriptor() ([]byte, []int) {
+	return file_msggateway_msggateway_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EphemeralSignal) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *EphemeralSignal) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *EphemeralSignal) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *EphemeralSignal) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *EphemeralSignal) GetSignalType() int32 {
+	if x != nil {
+		return x.SignalType
+	}
+	return 0
+}
+
+func (x *EphemeralSignal) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *EphemeralSignal) GetTtl() int32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *EphemeralSignal) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+func (x *EphemeralSignal) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type SendEphemeralSignalReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signal        *EphemeralSignal       `protobuf:"bytes,1,opt,name=signal,proto3" json:"signal"`
+	RecvUserIDs   []string               `protobuf:"bytes,2,rep,name=recvUserIDs,proto3" json:"recvUserIDs"` // 会话成员中在线的接收者，由调用方展开群成员
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEphemeralSignalReq) Reset() {
+	*x = SendEphemeralSignalReq{}
+	mi := &file_msggateway_msggateway_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEphemeralSignalReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEphemeralSignalReq) ProtoMessage() {}
+
+func (x *SendEphemeralSignalReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msggateway_msggateway_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEphemeralSignalReq.ProtoReflect.Descriptor instead.
+func (*SendEphemeralSignalReq) Descriptor() ([]byte, []int) {
+	return file_msggateway_msggateway_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SendEphemeralSignalReq) GetSignal() *EphemeralSignal {
+	if x != nil {
+		return x.Signal
+	}
+	return nil
+}
+
+func (x *SendEphemeralSignalReq) GetRecvUserIDs() []string {
+	if x != nil {
+		return x.RecvUserIDs
+	}
+	return nil
+}
+
+type SendEphemeralSignalResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnCount     int32                  `protobuf:"varint,1,opt,name=connCount,proto3" json:"connCount"`     // 实际送达的连接数
+	RateLimited   bool                   `protobuf:"varint,2,opt,name=rateLimited,proto3" json:"rateLimited"` // 发送过于频繁被丢弃
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendEphemeralSignalResp) Reset() {
+	*x = SendEphemeralSignalResp{}
+	mi := &file_msggateway_msggateway_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendEphemeralSignalResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendEphemeralSignalResp) ProtoMessage() {}
+
+func (x *SendEphemeralSignalResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msggateway_msggateway_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendEphemeralSignalResp.ProtoReflect.Descriptor instead.
+func (*SendEphemeralSignalResp) Descriptor() ([]byte, []int) {
+	return file_msggateway_msggateway_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SendEphemeralSignalResp) GetConnCount() int32 {
+	if x != nil {
+		return x.ConnCount
+	}
+	return 0
+}
+
+func (x *SendEphemeralSignalResp) GetRateLimited() bool {
+	if x != nil {
+		return x.RateLimited
+	}
+	return false
+}
+
 type GetUsersOnlineStatusResp_SuccessDetail struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlatformID    int32                  `protobuf:"varint,1,opt,name=platformID,proto3" json:"platformID"`
@@ -760,7 +1487,7 @@ type GetUsersOnlineStatusResp_SuccessDetail struct {
 
 func (x *GetUsersOnlineStatusResp_SuccessDetail) Reset() {
 	*x = GetUsersOnlineStatusResp_SuccessDetail{}
-	mi := &file_msggateway_msggateway_proto_msgTypes[14]
+	mi := &file_msggateway_msggateway_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -772,7 +1499,7 @@ func (x *GetUsersOnlineStatusResp_SuccessDetail) String() string {
 func (*GetUsersOnlineStatusResp_SuccessDetail) ProtoMessage() {}
 
 func (x *GetUsersOnlineStatusResp_SuccessDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_msggateway_msggateway_proto_msgTypes[14]
+	mi := &file_msggateway_msggateway_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,7 +1552,7 @@ type GetUsersOnlineStatusResp_FailedDetail struct {
 
 func (x *GetUsersOnlineStatusResp_FailedDetail) Reset() {
 	*x = GetUsersOnlineStatusResp_FailedDetail{}
-	mi := &file_msggateway_msggateway_proto_msgTypes[15]
+	mi := &file_msggateway_msggateway_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -837,7 +1564,7 @@ func (x *GetUsersOnlineStatusResp_FailedDetail) String() string {
 func (*GetUsersOnlineStatusResp_FailedDetail) ProtoMessage() {}
 
 func (x *GetUsersOnlineStatusResp_FailedDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_msggateway_msggateway_proto_msgTypes[15]
+	mi := &file_msggateway_msggateway_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,7 +1598,7 @@ type GetUsersOnlineStatusResp_SuccessResult struct {
 
 func (x *GetUsersOnlineStatusResp_SuccessResult) Reset() {
 	*x = GetUsersOnlineStatusResp_SuccessResult{}
-	mi := &file_msggateway_msggateway_proto_msgTypes[16]
+	mi := &file_msggateway_msggateway_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -883,7 +1610,7 @@ func (x *GetUsersOnlineStatusResp_SuccessResult) String() string {
 func (*GetUsersOnlineStatusResp_SuccessResult) ProtoMessage() {}
 
 func (x *GetUsersOnlineStatusResp_SuccessResult) ProtoReflect() protoreflect.Message {
-	mi := &file_msggateway_msggateway_proto_msgTypes[16]
+	mi := &file_msggateway_msggateway_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,7 +1714,76 @@ const file_msggateway_msggateway_proto_rawDesc = "" +
 	"platformID\x18\x02 \x01(\x05R\n" +
 	"platformID\x12\x14\n" +
 	"\x05token\x18\x03 \x01(\tR\x05token\"\x1d\n" +
-	"\x1bMultiTerminalLoginCheckResp2\xa7\x05\n" +
+	"\x1bMultiTerminalLoginCheckResp\"\x80\x03\n" +
+	"\x0eConnectionInfo\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x02 \x01(\x05R\n" +
+	"platformID\x12\x16\n" +
+	"\x06connID\x18\x03 \x01(\tR\x06connID\x12\"\n" +
+	"\fisBackground\x18\x04 \x01(\bR\fisBackground\x12\x1e\n" +
+	"\n" +
+	"appVersion\x18\x05 \x01(\tR\n" +
+	"appVersion\x12\x1e\n" +
+	"\n" +
+	"sdkVersion\x18\x06 \x01(\tR\n" +
+	"sdkVersion\x12\x0e\n" +
+	"\x02ip\x18\a \x01(\tR\x02ip\x12 \n" +
+	"\vconnectTime\x18\b \x01(\x03R\vconnectTime\x12&\n" +
+	"\x0elastActiveTime\x18\t \x01(\x03R\x0elastActiveTime\x12(\n" +
+	"\x0fpendingMsgCount\x18\n" +
+	" \x01(\x05R\x0fpendingMsgCount\x12\x18\n" +
+	"\apushLag\x18\v \x01(\x03R\apushLag\x12\x1c\n" +
+	"\tgatewayID\x18\f \x01(\tR\tgatewayID\"P\n" +
+	"\x16ListUserConnectionsReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x02 \x01(\x05R\n" +
+	"platformID\"^\n" +
+	"\x17ListUserConnectionsResp\x12C\n" +
+	"\vconnections\x18\x01 \x03(\v2!.openim.msggateway.ConnectionInfoR\vconnections\"|\n" +
+	"\x12CloseConnectionReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06connID\x18\x02 \x01(\tR\x06connID\x12\x1e\n" +
+	"\n" +
+	"reasonCode\x18\x03 \x01(\x05R\n" +
+	"reasonCode\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"-\n" +
+	"\x13CloseConnectionResp\x12\x16\n" +
+	"\x06closed\x18\x01 \x01(\bR\x06closed\"\x99\x01\n" +
+	"\x11PlatformConnStats\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x01 \x01(\x05R\n" +
+	"platformID\x12\x1c\n" +
+	"\tconnCount\x18\x02 \x01(\x03R\tconnCount\x12\x1c\n" +
+	"\tuserCount\x18\x03 \x01(\x03R\tuserCount\x12(\n" +
+	"\x0fbackgroundCount\x18\x04 \x01(\x03R\x0fbackgroundCount\"\x17\n" +
+	"\x15GetConnectionStatsReq\"\xb6\x01\n" +
+	"\x16GetConnectionStatsResp\x12\x1c\n" +
+	"\tgatewayID\x18\x01 \x01(\tR\tgatewayID\x12\x1c\n" +
+	"\tconnCount\x18\x02 \x01(\x03R\tconnCount\x12\x1c\n" +
+	"\tuserCount\x18\x03 \x01(\x03R\tuserCount\x12B\n" +
+	"\tplatforms\x18\x04 \x03(\v2$.openim.msggateway.PlatformConnStatsR\tplatforms\"\x89\x02\n" +
+	"\x0fEphemeralSignal\x12&\n" +
+	"\x0econversationID\x18\x01 \x01(\tR\x0econversationID\x12 \n" +
+	"\vsessionType\x18\x02 \x01(\x05R\vsessionType\x12\x16\n" +
+	"\x06sendID\x18\x03 \x01(\tR\x06sendID\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x04 \x01(\x05R\n" +
+	"platformID\x12\x1e\n" +
+	"\n" +
+	"signalType\x18\x05 \x01(\x05R\n" +
+	"signalType\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\x12\x10\n" +
+	"\x03ttl\x18\a \x01(\x05R\x03ttl\x12\x1a\n" +
+	"\bsendTime\x18\b \x01(\x03R\bsendTime\x12\x0e\n" +
+	"\x02ex\x18\t \x01(\tR\x02ex\"v\n" +
+	"\x16SendEphemeralSignalReq\x12:\n" +
+	"\x06signal\x18\x01 \x01(\v2\".openim.msggateway.EphemeralSignalR\x06signal\x12 \n" +
+	"\vrecvUserIDs\x18\x02 \x03(\tR\vrecvUserIDs\"Y\n" +
+	"\x17SendEphemeralSignalResp\x12\x1c\n" +
+	"\tconnCount\x18\x01 \x01(\x05R\tconnCount\x12 \n" +
+	"\vrateLimited\x18\x02 \x01(\bR\vrateLimited2\xd0\b\n" +
 	"\n" +
 	"msgGateway\x12Z\n" +
 	"\rOnlinePushMsg\x12#.openim.msggateway.OnlinePushMsgReq\x1a$.openim.msggateway.OnlinePushMsgResp\x12o\n" +
@@ -995,7 +1791,11 @@ const file_msggateway_msggateway_proto_rawDesc = "" +
 	"\x15OnlineBatchPushOneMsg\x12+.openim.msggateway.OnlineBatchPushOneMsgReq\x1a,.openim.msggateway.OnlineBatchPushOneMsgResp\x12|\n" +
 	"\x1fSuperGroupOnlineBatchPushOneMsg\x12+.openim.msggateway.OnlineBatchPushOneMsgReq\x1a,.openim.msggateway.OnlineBatchPushOneMsgResp\x12`\n" +
 	"\x0fKickUserOffline\x12%.openim.msggateway.KickUserOfflineReq\x1a&.openim.msggateway.KickUserOfflineResp\x12x\n" +
-	"\x17MultiTerminalLoginCheck\x12-.openim.msggateway.MultiTerminalLoginCheckReq\x1a..openim.msggateway.MultiTerminalLoginCheckRespB*Z(github.com/openimsdk/protocol/msggatewayb\x06proto3"
+	"\x17MultiTerminalLoginCheck\x12-.openim.msggateway.MultiTerminalLoginCheckReq\x1a..openim.msggateway.MultiTerminalLoginCheckResp\x12l\n" +
+	"\x13SendEphemeralSignal\x12).openim.msggateway.SendEphemeralSignalReq\x1a*.openim.msggateway.SendEphemeralSignalResp\x12l\n" +
+	"\x13ListUserConnections\x12).openim.msggateway.ListUserConnectionsReq\x1a*.openim.msggateway.ListUserConnectionsResp\x12`\n" +
+	"\x0fCloseConnection\x12%.openim.msggateway.CloseConnectionReq\x1a&.openim.msggateway.CloseConnectionResp\x12i\n" +
+	"\x12GetConnectionStats\x12(.openim.msggateway.GetConnectionStatsReq\x1a).openim.msggateway.GetConnectionStatsRespB*Z(github.com/openimsdk/protocol/msggatewayb\x06proto3"
 
 var (
 	file_msggateway_msggateway_proto_rawDescOnce sync.Once
@@ -1009,7 +1809,7 @@ func file_msggateway_msggateway_proto_rawDescGZIP() []byte {
 	return file_msggateway_msggateway_proto_rawDescData
 }
 
-var file_msggateway_msggateway_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_msggateway_msggateway_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_msggateway_msggateway_proto_goTypes = []any{
 	(*OnlinePushMsgReq)(nil),                       // 0: openim.msggateway.OnlinePushMsgReq
 	(*OnlinePushMsgResp)(nil),                      // 1: openim.msggateway.OnlinePushMsgResp
@@ -1025,38 +1825,60 @@ var file_msggateway_msggateway_proto_goTypes = []any{
 	(*KickUserOfflineResp)(nil),                    // 11: openim.msggateway.KickUserOfflineResp
 	(*MultiTerminalLoginCheckReq)(nil),             // 12: openim.msggateway.MultiTerminalLoginCheckReq
 	(*MultiTerminalLoginCheckResp)(nil),            // 13: openim.msggateway.MultiTerminalLoginCheckResp
-	(*GetUsersOnlineStatusResp_SuccessDetail)(nil), // 14: openim.msggateway.GetUsersOnlineStatusResp.SuccessDetail
-	(*GetUsersOnlineStatusResp_FailedDetail)(nil),  // 15: openim.msggateway.GetUsersOnlineStatusResp.FailedDetail
-	(*GetUsersOnlineStatusResp_SuccessResult)(nil), // 16: openim.msggateway.GetUsersOnlineStatusResp.SuccessResult
-	(*sdkws.MsgData)(nil),                          // 17: openim.sdkws.MsgData
+	(*ConnectionInfo)(nil),                         // 14: openim.msggateway.ConnectionInfo
+	(*ListUserConnectionsReq)(nil),                 // 15: openim.msggateway.ListUserConnectionsReq
+	(*ListUserConnectionsResp)(nil),                // 16: openim.msggateway.ListUserConnectionsResp
+	(*CloseConnectionReq)(nil),                     // 17: openim.msggateway.CloseConnectionReq
+	(*CloseConnectionResp)(nil),                    // 18: openim.msggateway.CloseConnectionResp
+	(*PlatformConnStats)(nil),                      // 19: openim.msggateway.PlatformConnStats
+	(*GetConnectionStatsReq)(nil),                  // 20: openim.msggateway.GetConnectionStatsReq
+	(*GetConnectionStatsResp)(nil),                 // 21: openim.msggateway.GetConnectionStatsResp
+	(*EphemeralSignal)(nil),                        // 22: openim.msggateway.EphemeralSignal
+	(*SendEphemeralSignalReq)(nil),                 // 23: openim.msggateway.SendEphemeralSignalReq
+	(*SendEphemeralSignalResp)(nil),                // 24: openim.msggateway.SendEphemeralSignalResp
+	(*GetUsersOnlineStatusResp_SuccessDetail)(nil), // 25: openim.msggateway.GetUsersOnlineStatusResp.SuccessDetail
+	(*GetUsersOnlineStatusResp_FailedDetail)(nil),  // 26: openim.msggateway.GetUsersOnlineStatusResp.FailedDetail
+	(*GetUsersOnlineStatusResp_SuccessResult)(nil), // 27: openim.msggateway.GetUsersOnlineStatusResp.SuccessResult
+	(*sdkws.MsgData)(nil),                          // 28: openim.sdkws.MsgData
 }
 var file_msggateway_msggateway_proto_depIdxs = []int32{
-	17, // 0: openim.msggateway.OnlinePushMsgReq.msgData:type_name -> openim.sdkws.MsgData
+	28, // 0: openim.msggateway.OnlinePushMsgReq.msgData:type_name -> openim.sdkws.MsgData
 	5,  // 1: openim.msggateway.OnlinePushMsgResp.resp:type_name -> openim.msggateway.SingleMsgToUserPlatform
 	5,  // 2: openim.msggateway.SingleMsgToUserResults.resp:type_name -> openim.msggateway.SingleMsgToUserPlatform
-	17, // 3: openim.msggateway.OnlineBatchPushOneMsgReq.msgData:type_name -> openim.sdkws.MsgData
+	28, // 3: openim.msggateway.OnlineBatchPushOneMsgReq.msgData:type_name -> openim.sdkws.MsgData
 	2,  // 4: openim.msggateway.OnlineBatchPushOneMsgResp.singlePushResult:type_name -> openim.msggateway.SingleMsgToUserResults
-	16, // 5: openim.msggateway.GetUsersOnlineStatusResp.successResult:type_name -> openim.msggateway.GetUsersOnlineStatusResp.SuccessResult
-	15, // 6: openim.msggateway.GetUsersOnlineStatusResp.failedResult:type_name -> openim.msggateway.GetUsersOnlineStatusResp.FailedDetail
+	27, // 5: openim.msggateway.GetUsersOnlineStatusResp.successResult:type_name -> openim.msggateway.GetUsersOnlineStatusResp.SuccessResult
+	26, // 6: openim.msggateway.GetUsersOnlineStatusResp.failedResult:type_name -> openim.msggateway.GetUsersOnlineStatusResp.FailedDetail
 	9,  // 7: openim.msggateway.SingleDetail.singlePlatformToken:type_name -> openim.msggateway.SinglePlatformToken
-	14, // 8: openim.msggateway.GetUsersOnlineStatusResp.SuccessResult.detailPlatformStatus:type_name -> openim.msggateway.GetUsersOnlineStatusResp.SuccessDetail
-	0,  // 9: openim.msggateway.msgGateway.OnlinePushMsg:input_type -> openim.msggateway.OnlinePushMsgReq
-	6,  // 10: openim.msggateway.msgGateway.GetUsersOnlineStatus:input_type -> openim.msggateway.GetUsersOnlineStatusReq
-	3,  // 11: openim.msggateway.msgGateway.OnlineBatchPushOneMsg:input_type -> openim.msggateway.OnlineBatchPushOneMsgReq
-	3,  // 12: openim.msggateway.msgGateway.SuperGroupOnlineBatchPushOneMsg:input_type -> openim.msggateway.OnlineBatchPushOneMsgReq
-	10, // 13: openim.msggateway.msgGateway.KickUserOffline:input_type -> openim.msggateway.KickUserOfflineReq
-	12, // 14: openim.msggateway.msgGateway.MultiTerminalLoginCheck:input_type -> openim.msggateway.MultiTerminalLoginCheckReq
-	1,  // 15: openim.msggateway.msgGateway.OnlinePushMsg:output_type -> openim.msggateway.OnlinePushMsgResp
-	7,  // 16: openim.msggateway.msgGateway.GetUsersOnlineStatus:output_type -> openim.msggateway.GetUsersOnlineStatusResp
-	4,  // 17: openim.msggateway.msgGateway.OnlineBatchPushOneMsg:output_type -> openim.msggateway.OnlineBatchPushOneMsgResp
-	4,  // 18: openim.msggateway.msgGateway.SuperGroupOnlineBatchPushOneMsg:output_type -> openim.msggateway.OnlineBatchPushOneMsgResp
-	11, // 19: openim.msggateway.msgGateway.KickUserOffline:output_type -> openim.msggateway.KickUserOfflineResp
-	13, // 20: openim.msggateway.msgGateway.MultiTerminalLoginCheck:output_type -> openim.msggateway.MultiTerminalLoginCheckResp
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	14, // 8: openim.msggateway.ListUserConnectionsResp.connections:type_name -> openim.msggateway.ConnectionInfo
+	19, // 9: openim.msggateway.GetConnectionStatsResp.platforms:type_name -> openim.msggateway.PlatformConnStats
+	22, // 10: openim.msggateway.SendEphemeralSignalReq.signal:type_name -> openim.msggateway.EphemeralSignal
+	25, // 11: openim.msggateway.GetUsersOnlineStatusResp.SuccessResult.detailPlatformStatus:type_name -> openim.msggateway.GetUsersOnlineStatusResp.SuccessDetail
+	0,  // 12: openim.msggateway.msgGateway.OnlinePushMsg:input_type -> openim.msggateway.OnlinePushMsgReq
+	6,  // 13: openim.msggateway.msgGateway.GetUsersOnlineStatus:input_type -> openim.msggateway.GetUsersOnlineStatusReq
+	3,  // 14: openim.msggateway.msgGateway.OnlineBatchPushOneMsg:input_type -> openim.msggateway.OnlineBatchPushOneMsgReq
+	3,  // 15: openim.msggateway.msgGateway.SuperGroupOnlineBatchPushOneMsg:input_type -> openim.msggateway.OnlineBatchPushOneMsgReq
+	10, // 16: openim.msggateway.msgGateway.KickUserOffline:input_type -> openim.msggateway.KickUserOfflineReq
+	12, // 17: openim.msggateway.msgGateway.MultiTerminalLoginCheck:input_type -> openim.msggateway.MultiTerminalLoginCheckReq
+	23, // 18: openim.msggateway.msgGateway.SendEphemeralSignal:input_type -> openim.msggateway.SendEphemeralSignalReq
+	15, // 19: openim.msggateway.msgGateway.ListUserConnections:input_type -> openim.msggateway.ListUserConnectionsReq
+	17, // 20: openim.msggateway.msgGateway.CloseConnection:input_type -> openim.msggateway.CloseConnectionReq
+	20, // 21: openim.msggateway.msgGateway.GetConnectionStats:input_type -> openim.msggateway.GetConnectionStatsReq
+	1,  // 22: openim.msggateway.msgGateway.OnlinePushMsg:output_type -> openim.msggateway.OnlinePushMsgResp
+	7,  // 23: openim.msggateway.msgGateway.GetUsersOnlineStatus:output_type -> openim.msggateway.GetUsersOnlineStatusResp
+	4,  // 24: openim.msggateway.msgGateway.OnlineBatchPushOneMsg:output_type -> openim.msggateway.OnlineBatchPushOneMsgResp
+	4,  // 25: openim.msggateway.msgGateway.SuperGroupOnlineBatchPushOneMsg:output_type -> openim.msggateway.OnlineBatchPushOneMsgResp
+	11, // 26: openim.msggateway.msgGateway.KickUserOffline:output_type -> openim.msggateway.KickUserOfflineResp
+	13, // 27: openim.msggateway.msgGateway.MultiTerminalLoginCheck:output_type -> openim.msggateway.MultiTerminalLoginCheckResp
+	24, // 28: openim.msggateway.msgGateway.SendEphemeralSignal:output_type -> openim.msggateway.SendEphemeralSignalResp
+	16, // 29: openim.msggateway.msgGateway.ListUserConnections:output_type -> openim.msggateway.ListUserConnectionsResp
+	18, // 30: openim.msggateway.msgGateway.CloseConnection:output_type -> openim.msggateway.CloseConnectionResp
+	21, // 31: openim.msggateway.msgGateway.GetConnectionStats:output_type -> openim.msggateway.GetConnectionStatsResp
+	22, // [22:32] is the sub-list for method output_type
+	12, // [12:22] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_msggateway_msggateway_proto_init() }
@@ -1070,7 +1892,7 @@ func file_msggateway_msggateway_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_msggateway_msggateway_proto_rawDesc), len(file_msggateway_msggateway_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -39,6 +39,10 @@ const (
 	MsgGateway_SuperGroupOnlineBatchPushOneMsg_FullMethodName = "/openim.msggateway.msgGateway/SuperGroupOnlineBatchPushOneMsg"
 	MsgGateway_KickUserOffline_FullMethodName                 = "/openim.msggateway.msgGateway/KickUserOffline"
 	MsgGateway_MultiTerminalLoginCheck_FullMethodName         = "/openim.msggateway.msgGateway/MultiTerminalLoginCheck"
+	MsgGateway_SendEphemeralSignal_FullMethodName             = "/openim.msggateway.msgGateway/SendEphemeralSignal"
+	MsgGateway_ListUserConnections_FullMethodName             = "/openim.msggateway.msgGateway/ListUserConnections"
+	MsgGateway_CloseConnection_FullMethodName                 = "/openim.msggateway.msgGateway/CloseConnection"
+	MsgGateway_GetConnectionStats_FullMethodName              = "/openim.msggateway.msgGateway/GetConnectionStats"
 )
 
 // MsgGatewayClient is the client API for MsgGateway service.
@@ -51,6 +55,10 @@ type MsgGatewayClient interface {
 	SuperGroupOnlineBatchPushOneMsg(ctx context.Context, in *OnlineBatchPushOneMsgReq, opts ...grpc.CallOption) (*OnlineBatchPushOneMsgResp, error)
 	KickUserOffline(ctx context.Context, in *KickUserOfflineReq, opts ...grpc.CallOption) (*KickUserOfflineResp, error)
 	MultiTerminalLoginCheck(ctx context.Context, in *MultiTerminalLoginCheckReq, opts ...grpc.CallOption) (*MultiTerminalLoginCheckResp, error)
+	SendEphemeralSignal(ctx context.Context, in *SendEphemeralSignalReq, opts ...grpc.CallOption) (*SendEphemeralSignalResp, error)
+	ListUserConnections(ctx context.Context, in *ListUserConnectionsReq, opts ...grpc.CallOption) (*ListUserConnectionsResp, error)
+	CloseConnection(ctx context.Context, in *CloseConnectionReq, opts ...grpc.CallOption) (*CloseConnectionResp, error)
+	GetConnectionStats(ctx context.Context, in *GetConnectionStatsReq, opts ...grpc.CallOption) (*GetConnectionStatsResp, error)
 }
 
 type msgGatewayClient struct {
@@ -121,6 +129,46 @@ func (c *msgGatewayClient) MultiTerminalLoginCheck(ctx context.Context, in *Mult
 	return out, nil
 }
 
+func (c *msgGatewayClient) SendEphemeralSignal(ctx context.Context, in *SendEphemeralSignalReq, opts ...grpc.CallOption) (*SendEphemeralSignalResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendEphemeralSignalResp)
+	err := c.cc.Invoke(ctx, MsgGateway_SendEphemeralSignal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgGatewayClient) ListUserConnections(ctx context.Context, in *ListUserConnectionsReq, opts ...grpc.CallOption) (*ListUserConnectionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserConnectionsResp)
+	err := c.cc.Invoke(ctx, MsgGateway_ListUserConnections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgGatewayClient) CloseConnection(ctx context.Context, in *CloseConnectionReq, opts ...grpc.CallOption) (*CloseConnectionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseConnectionResp)
+	err := c.cc.Invoke(ctx, MsgGateway_CloseConnection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgGatewayClient) GetConnectionStats(ctx context.Context, in *GetConnectionStatsReq, opts ...grpc.CallOption) (*GetConnectionStatsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConnectionStatsResp)
+	err := c.cc.Invoke(ctx, MsgGateway_GetConnectionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgGatewayServer is the server API for MsgGateway service.
 // All implementations must embed UnimplementedMsgGatewayServer
 // for forward compatibility.
@@ -131,6 +179,10 @@ type MsgGatewayServer interface {
 	SuperGroupOnlineBatchPushOneMsg(context.Context, *OnlineBatchPushOneMsgReq) (*OnlineBatchPushOneMsgResp, error)
 	KickUserOffline(context.Context, *KickUserOfflineReq) (*KickUserOfflineResp, error)
 	MultiTerminalLoginCheck(context.Context, *MultiTerminalLoginCheckReq) (*MultiTerminalLoginCheckResp, error)
+	SendEphemeralSignal(context.Context, *SendEphemeralSignalReq) (*SendEphemeralSignalResp, error)
+	ListUserConnections(context.Context, *ListUserConnectionsReq) (*ListUserConnectionsResp, error)
+	CloseConnection(context.Context, *CloseConnectionReq) (*CloseConnectionResp, error)
+	GetConnectionStats(context.Context, *GetConnectionStatsReq) (*GetConnectionStatsResp, error)
 	mustEmbedUnimplementedMsgGatewayServer()
 }
 
@@ -159,6 +211,18 @@ func (UnimplementedMsgGatewayServer) KickUserOffline(context.Context, *KickUserO
 func (UnimplementedMsgGatewayServer) MultiTerminalLoginCheck(context.Context, *MultiTerminalLoginCheckReq) (*MultiTerminalLoginCheckResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method MultiTerminalLoginCheck not implemented")
 }
+func (UnimplementedMsgGatewayServer) SendEphemeralSignal(context.Context, *SendEphemeralSignalReq) (*SendEphemeralSignalResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendEphemeralSignal not implemented")
+}
+func (UnimplementedMsgGatewayServer) ListUserConnections(context.Context, *ListUserConnectionsReq) (*ListUserConnectionsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUserConnections not implemented")
+}
+func (UnimplementedMsgGatewayServer) CloseConnection(context.Context, *CloseConnectionReq) (*CloseConnectionResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloseConnection not implemented")
+}
+func (UnimplementedMsgGatewayServer) GetConnectionStats(context.Context, *GetConnectionStatsReq) (*GetConnectionStatsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetConnectionStats not implemented")
+}
 func (UnimplementedMsgGatewayServer) mustEmbedUnimplementedMsgGatewayServer() {}
 func (UnimplementedMsgGatewayServer) testEmbeddedByValue()                    {}
 
@@ -288,6 +352,78 @@ func _MsgGateway_MultiTerminalLoginCheck_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgGateway_SendEphemeralSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEphemeralSignalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgGatewayServer).SendEphemeralSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgGateway_SendEphemeralSignal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgGatewayServer).SendEphemeralSignal(ctx, req.(*SendEphemeralSignalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgGateway_ListUserConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserConnectionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgGatewayServer).ListUserConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgGateway_ListUserConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgGatewayServer).ListUserConnections(ctx, req.(*ListUserConnectionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgGateway_CloseConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseConnectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgGatewayServer).CloseConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgGateway_CloseConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgGatewayServer).CloseConnection(ctx, req.(*CloseConnectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgGateway_GetConnectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectionStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgGatewayServer).GetConnectionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MsgGateway_GetConnectionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgGatewayServer).GetConnectionStats(ctx, req.(*GetConnectionStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MsgGateway_ServiceDesc is the grpc.ServiceDesc for MsgGateway service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -319,6 +455,22 @@ var MsgGateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MultiTerminalLoginCheck",
 			Handler:    _MsgGateway_MultiTerminalLoginCheck_Handler,
 		},
+		{
+			MethodName: "SendEphemeralSignal",
+			Handler:    _MsgGateway_SendEphemeralSignal_Handler,
+		},
+		{
+			MethodName: "ListUserConnections",
+			Handler:    _MsgGateway_ListUserConnections_Handler,
+		},
+		{
+			MethodName: "CloseConnection",
+			Handler:    _MsgGateway_CloseConnection_Handler,
+		},
+		{
+			MethodName: "GetConnectionStats",
+			Handler:    _MsgGateway_GetConnectionStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "msggateway/msggateway.proto",
@@ -0,0 +1,149 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+// DigestDecision is what the pusher does with a message for one recipient.
+type DigestDecision int
+
+const (
+	DigestPushNow DigestDecision = iota // push immediately
+	DigestBatch                         // add to the recipient's digest batch
+)
+
+// DigestBreakThrough reports whether msg is always pushed immediately to userID:
+// @mentions of the user, @all and calls.
+func DigestBreakThrough(msg *sdkws.MsgData, userID string) bool {
+	if msg.ContentType == constant.SignalMsg ||
+		(msg.ContentType > constant.SignalingNotificationBegin && msg.ContentType < constant.SignalingNotificationEnd) {
+		return true
+	}
+	for _, atUserID := range msg.AtUserIDList {
+		if atUserID == userID || atUserID == constant.AtAllString {
+			return true
+		}
+	}
+	return false
+}
+
+// DigestDecide decides how msg is pushed to userID under setting, which may be nil.
+func DigestDecide(setting *conversation.NotificationDigestSetting, msg *sdkws.MsgData, userID string, now time.Time) DigestDecision {
+	if setting == nil || DigestBreakThrough(msg, userID) {
+		return DigestPushNow
+	}
+	if setting.Mode == constant.NotificationDigestModeDigest || setting.QuietHours.In(now) {
+		return DigestBatch
+	}
+	return DigestPushNow
+}
+
+// DigestEntry is the pending digest of one conversation for one user.
+type DigestEntry struct {
+	UserID         string
+	ConversationID string
+	Count          int
+	FirstTime      time.Time
+	LastTime       time.Time
+	LastMsg        *sdkws.MsgData
+}
+
+type digestKey struct {
+	userID         string
+	conversationID string
+}
+
+// DigestBatcher collects batched pushes and releases one entry per user and conversation when due.
+type DigestBatcher struct {
+	mu      sync.Mutex
+	entries map[digestKey]*DigestEntry
+}
+
+func NewDigestBatcher() *DigestBatcher {
+	return &DigestBatcher{entries: make(map[digestKey]*DigestEntry)}
+}
+
+// Add counts msg into the digest of userID's conversation.
+func (b *DigestBatcher) Add(userID string, conversationID string, msg *sdkws.MsgData, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	key := digestKey{userID: userID, conversationID: conversationID}
+	entry, ok := b.entries[key]
+	if !ok {
+		entry = &DigestEntry{UserID: userID, ConversationID: conversationID, FirstTime: now}
+		b.entries[key] = entry
+	}
+	entry.Count++
+	entry.LastTime = now
+	entry.LastMsg = msg
+}
+
+// Remove drops the pending digest, e.g. after the user read the conversation.
+func (b *DigestBatcher) Remove(userID string, conversationID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.entries, digestKey{userID: userID, conversationID: conversationID})
+}
+
+// Flush removes and returns the entries whose interval has elapsed and that are outside quiet hours.
+// setting returns the recipient's effective setting; entries without one are due immediately.
+func (b *DigestBatcher) Flush(now time.Time, setting func(userID string, conversationID string) *conversation.NotificationDigestSetting) []*DigestEntry {
+	// setting may hit storage, so it is evaluated on a snapshot without holding the lock.
+	b.mu.Lock()
+	pending := make([]*DigestEntry, 0, len(b.entries))
+	for _, entry := range b.entries {
+		pending = append(pending, entry)
+	}
+	b.mu.Unlock()
+	var due []*DigestEntry
+	for _, entry := range pending {
+		if s := setting(entry.UserID, entry.ConversationID); s != nil {
+			if s.QuietHours.In(now) {
+				continue
+			}
+			if s.Mode == constant.NotificationDigestModeDigest && now.Sub(entry.FirstTime) < s.Interval() {
+				continue
+			}
+		}
+		due = append(due, entry)
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	released := due[:0]
+	for _, entry := range due {
+		key := digestKey{userID: entry.UserID, conversationID: entry.ConversationID}
+		// Skip entries removed or replaced while the lock was released.
+		if b.entries[key] != entry {
+			continue
+		}
+		delete(b.entries, key)
+		released = append(released, entry)
+	}
+	return released
+}
+
+// Len returns the number of pending entries.
+func (b *DigestBatcher) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.entries)
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/conversation"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+func TestDigestDecide(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	digest := &conversation.NotificationDigestSetting{Mode: constant.NotificationDigestModeDigest, IntervalMinutes: 10}
+	quiet := &conversation.NotificationDigestSetting{
+		Mode:       constant.NotificationDigestModeOff,
+		QuietHours: &conversation.QuietHours{Enabled: true, StartMinute: 11 * 60, EndMinute: 13 * 60},
+	}
+	text := &sdkws.MsgData{ContentType: constant.Text}
+	tests := []struct {
+		name    string
+		setting *conversation.NotificationDigestSetting
+		msg     *sdkws.MsgData
+		want    DigestDecision
+	}{
+		{"no setting", nil, text, DigestPushNow},
+		{"digest mode", digest, text, DigestBatch},
+		{"quiet hours", quiet, text, DigestBatch},
+		{"off outside quiet hours", &conversation.NotificationDigestSetting{}, text, DigestPushNow},
+		{"at user breaks through", digest, &sdkws.MsgData{ContentType: constant.AtText, AtUserIDList: []string{"u1"}}, DigestPushNow},
+		{"at all breaks through", quiet, &sdkws.MsgData{ContentType: constant.AtText, AtUserIDList: []string{constant.AtAllString}}, DigestPushNow},
+		{"at other batched", digest, &sdkws.MsgData{ContentType: constant.AtText, AtUserIDList: []string{"u2"}}, DigestBatch},
+		{"signal breaks through", digest, &sdkws.MsgData{ContentType: constant.SignalMsg}, DigestPushNow},
+	}
+	for _, tt := range tests {
+		if got := DigestDecide(tt.setting, tt.msg, "u1", now); got != tt.want {
+			t.Errorf("%s: DigestDecide() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDigestBatcherFlushDueTime(t *testing.T) {
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	settings := map[string]*conversation.NotificationDigestSetting{
+		"digest": {Mode: constant.NotificationDigestModeDigest, IntervalMinutes: 10},
+		// 22:00-07:00 UTC, crossing midnight.
+		"quiet": {
+			Mode:       constant.NotificationDigestModeOff,
+			QuietHours: &conversation.QuietHours{Enabled: true, StartMinute: 22 * 60, EndMinute: 7 * 60},
+		},
+	}
+	setting := func(userID string, conversationID string) *conversation.NotificationDigestSetting {
+		return settings[conversationID]
+	}
+	b := NewDigestBatcher()
+	b.Add("u1", "digest", &sdkws.MsgData{}, start)
+	b.Add("u1", "digest", &sdkws.MsgData{}, start.Add(5*time.Minute))
+	b.Add("u1", "none", &sdkws.MsgData{}, start)
+
+	due := b.Flush(start.Add(time.Minute), setting)
+	if len(due) != 1 || due[0].ConversationID != "none" {
+		t.Fatalf("first flush = %v, want only the entry without setting", due)
+	}
+	// The interval counts from the first batched message, not the last.
+	if due := b.Flush(start.Add(10*time.Minute-time.Second), setting); len(due) != 0 {
+		t.Fatalf("flush before interval = %v, want none", due)
+	}
+	due = b.Flush(start.Add(10*time.Minute), setting)
+	if len(due) != 1 || due[0].ConversationID != "digest" || due[0].Count != 2 {
+		t.Fatalf("flush at interval = %v, want digest entry with 2 messages", due)
+	}
+
+	night := time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC)
+	b.Add("u1", "quiet", &sdkws.MsgData{}, night)
+	if due := b.Flush(night.Add(3*time.Hour), setting); len(due) != 0 {
+		t.Fatalf("flush inside quiet hours after midnight = %v, want none", due)
+	}
+	due = b.Flush(time.Date(2024, 3, 2, 7, 0, 0, 0, time.UTC), setting)
+	if len(due) != 1 || due[0].ConversationID != "quiet" {
+		t.Fatalf("flush at quiet hours end = %v, want quiet entry", due)
+	}
+	if b.Len() != 0 {
+		t.Fatalf("Len() = %d, want 0", b.Len())
+	}
+}
+
+func TestDigestBatcherRemove(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	b := NewDigestBatcher()
+	b.Add("u1", "c1", &sdkws.MsgData{}, now)
+	b.Remove("u1", "c1")
+	if due := b.Flush(now, func(string, string) *conversation.NotificationDigestSetting { return nil }); len(due) != 0 {
+		t.Fatalf("Flush() = %v, want none after Remove", due)
+	}
+}
+
+// setting may call back into the batcher, e.g. when it loads from storage and records a miss.
+func TestDigestBatcherFlushWithoutLock(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	b := NewDigestBatcher()
+	b.Add("u1", "c1", &sdkws.MsgData{}, now)
+	b.Add("u1", "c2", &sdkws.MsgData{}, now)
+	due := b.Flush(now, func(userID string, conversationID string) *conversation.NotificationDigestSetting {
+		if conversationID == "c1" {
+			b.Remove("u1", "c2")
+		} else {
+			b.Remove("u1", "c1")
+		}
+		return nil
+	})
+	if len(due) != 0 || b.Len() != 0 {
+		t.Fatalf("Flush() = %v, Len() = %d, want removed entries skipped", due, b.Len())
+	}
+}
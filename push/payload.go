@@ -0,0 +1,274 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Payload is a provider-neutral rendering of sdkws.OfflinePushInfo.
+type Payload struct {
+	Title         string
+	Body          string
+	TitleLocKey   string
+	TitleLocArgs  []string
+	BodyLocKey    string
+	BodyLocArgs   []string
+	Sound         string
+	Badge         *int32 // set by the pusher when the badge should be updated
+	ThreadID      string
+	CollapseID    string
+	ImageURL      string
+	Category      string
+	Actions       []*sdkws.OfflinePushAction
+	HighPriority  bool
+	TimeSensitive bool
+	TTL           time.Duration
+	Data          map[string]string
+}
+
+// NewPayload converts info into a Payload. Ex and SignalInfo are carried in Data.
+func NewPayload(info *sdkws.OfflinePushInfo) *Payload {
+	p := &Payload{
+		Title:         info.GetTitle(),
+		Body:          info.GetDesc(),
+		TitleLocKey:   info.GetTitleLocKey(),
+		TitleLocArgs:  info.GetTitleLocArgs(),
+		BodyLocKey:    info.GetBodyLocKey(),
+		BodyLocArgs:   info.GetBodyLocArgs(),
+		Sound:         info.GetIOSPushSound(),
+		ThreadID:      info.GetThreadID(),
+		CollapseID:    info.GetCollapseID(),
+		ImageURL:      info.GetImageURL(),
+		Category:      info.GetCategory(),
+		Actions:       info.GetActions(),
+		HighPriority:  info.GetPriority() == constant.OfflinePushPriorityHigh || info.GetTimeSensitive(),
+		TimeSensitive: info.GetTimeSensitive(),
+		TTL:           time.Duration(info.GetTtl()) * time.Second,
+		Data:          make(map[string]string),
+	}
+	if info.GetEx() != "" {
+		p.Data["ex"] = info.GetEx()
+	}
+	if info.GetSignalInfo() != "" {
+		p.Data["signalInfo"] = info.GetSignalInfo()
+	}
+	if info.GetIOSBadgeCount() {
+		badge := info.GetBadge()
+		p.Badge = &badge
+	}
+	return p
+}
+
+// APNsAlert is the aps.alert dictionary.
+type APNsAlert struct {
+	Title        string   `json:"title,omitempty"`
+	Body         string   `json:"body,omitempty"`
+	TitleLocKey  string   `json:"title-loc-key,omitempty"`
+	TitleLocArgs []string `json:"title-loc-args,omitempty"`
+	LocKey       string   `json:"loc-key,omitempty"`
+	LocArgs      []string `json:"loc-args,omitempty"`
+}
+
+// APNsAps is the aps dictionary.
+type APNsAps struct {
+	Alert             *APNsAlert `json:"alert,omitempty"`
+	Sound             string     `json:"sound,omitempty"`
+	Badge             *int32     `json:"badge,omitempty"`
+	ThreadID          string     `json:"thread-id,omitempty"`
+	Category          string     `json:"category,omitempty"`
+	MutableContent    int        `json:"mutable-content,omitempty"`
+	InterruptionLevel string     `json:"interruption-level,omitempty"`
+}
+
+// APNsPayload is the JSON body sent to APNs.
+type APNsPayload struct {
+	Aps     APNsAps           `json:"aps"`
+	Image   string            `json:"image,omitempty"`
+	Actions json.RawMessage   `json:"actions,omitempty"`
+	Data    map[string]string `json:"data,omitempty"`
+}
+
+// marshalActions encodes actions as a JSON array with protojson field names.
+func marshalActions(actions []*sdkws.OfflinePushAction) (json.RawMessage, error) {
+	if len(actions) == 0 {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, action := range actions {
+		b, err := protojson.Marshal(action)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		// protojson output is deliberately unstable, compact it so the body is deterministic.
+		if err := json.Compact(&buf, b); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// APNs renders the payload as an APNs request body.
+// The notification service extension reads image to attach it, hence mutable-content.
+func (p *Payload) APNs() (*APNsPayload, error) {
+	actions, err := marshalActions(p.Actions)
+	if err != nil {
+		return nil, err
+	}
+	payload := &APNsPayload{
+		Aps: APNsAps{
+			Alert: &APNsAlert{
+				Title:        p.Title,
+				Body:         p.Body,
+				TitleLocKey:  p.TitleLocKey,
+				TitleLocArgs: p.TitleLocArgs,
+				LocKey:       p.BodyLocKey,
+				LocArgs:      p.BodyLocArgs,
+			},
+			Sound:    p.Sound,
+			Badge:    p.Badge,
+			ThreadID: p.ThreadID,
+			Category: p.Category,
+		},
+		Image:   p.ImageURL,
+		Actions: actions,
+	}
+	if payload.Aps.Sound == "" {
+		payload.Aps.Sound = "default"
+	}
+	if p.ImageURL != "" {
+		payload.Aps.MutableContent = 1
+	}
+	if p.TimeSensitive {
+		payload.Aps.InterruptionLevel = "time-sensitive"
+	}
+	if len(p.Data) > 0 {
+		payload.Data = p.Data
+	}
+	return payload, nil
+}
+
+// APNsHeaders returns the APNs request headers, now is used to compute apns-expiration.
+func (p *Payload) APNsHeaders(now time.Time) map[string]string {
+	headers := map[string]string{
+		"apns-push-type": "alert",
+		"apns-priority":  "5",
+	}
+	if p.HighPriority {
+		headers["apns-priority"] = "10"
+	}
+	if p.CollapseID != "" {
+		headers["apns-collapse-id"] = p.CollapseID
+	}
+	if p.TTL > 0 {
+		headers["apns-expiration"] = strconv.FormatInt(now.Add(p.TTL).Unix(), 10)
+	}
+	return headers
+}
+
+// FCMNotification is the message.notification object.
+type FCMNotification struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+	Image string `json:"image,omitempty"`
+}
+
+// FCMAndroidNotification is the message.android.notification object.
+type FCMAndroidNotification struct {
+	Tag               string   `json:"tag,omitempty"`
+	ClickAction       string   `json:"click_action,omitempty"`
+	Sound             string   `json:"sound,omitempty"`
+	TitleLocKey       string   `json:"title_loc_key,omitempty"`
+	TitleLocArgs      []string `json:"title_loc_args,omitempty"`
+	BodyLocKey        string   `json:"body_loc_key,omitempty"`
+	BodyLocArgs       []string `json:"body_loc_args,omitempty"`
+	NotificationCount *int32   `json:"notification_count,omitempty"` // launcher badge count
+}
+
+// FCMAndroidConfig is the message.android object.
+type FCMAndroidConfig struct {
+	Priority     string                  `json:"priority,omitempty"`
+	TTL          string                  `json:"ttl,omitempty"`
+	CollapseKey  string                  `json:"collapse_key,omitempty"`
+	Notification *FCMAndroidNotification `json:"notification,omitempty"`
+}
+
+// FCMMessage is the FCM HTTP v1 message object without the target token.
+type FCMMessage struct {
+	Notification *FCMNotification  `json:"notification,omitempty"`
+	Android      *FCMAndroidConfig `json:"android,omitempty"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+// FCM renders the payload as an FCM HTTP v1 message.
+// FCM has no native action buttons, so actions are passed to the app in data as JSON.
+// The tag replaces an earlier notification with the same tag, so it carries CollapseID;
+// Android has no thread grouping in FCM and ThreadID is not mapped.
+func (p *Payload) FCM() (*FCMMessage, error) {
+	msg := &FCMMessage{
+		Notification: &FCMNotification{
+			Title: p.Title,
+			Body:  p.Body,
+			Image: p.ImageURL,
+		},
+		Android: &FCMAndroidConfig{
+			Priority:    "NORMAL",
+			CollapseKey: p.CollapseID,
+			Notification: &FCMAndroidNotification{
+				Tag:               p.CollapseID,
+				ClickAction:       p.Category,
+				Sound:             p.Sound,
+				TitleLocKey:       p.TitleLocKey,
+				TitleLocArgs:      p.TitleLocArgs,
+				BodyLocKey:        p.BodyLocKey,
+				BodyLocArgs:       p.BodyLocArgs,
+				NotificationCount: p.Badge,
+			},
+		},
+	}
+	if p.HighPriority {
+		msg.Android.Priority = "HIGH"
+	}
+	if p.TTL > 0 {
+		msg.Android.TTL = strconv.FormatInt(int64(p.TTL/time.Second), 10) + "s"
+	}
+	data := make(map[string]string, len(p.Data)+1)
+	for k, v := range p.Data {
+		data[k] = v
+	}
+	if len(p.Actions) > 0 {
+		actions, err := marshalActions(p.Actions)
+		if err != nil {
+			return nil, err
+		}
+		data["actions"] = string(actions)
+	}
+	if len(data) > 0 {
+		msg.Data = data
+	}
+	return msg, nil
+}
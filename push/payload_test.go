@@ -0,0 +1,137 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/protocol/sdkws"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+type renderedPayload struct {
+	APNsHeaders map[string]string `json:"apnsHeaders"`
+	APNs        *APNsPayload      `json:"apns"`
+	FCM         *FCMMessage       `json:"fcm"`
+}
+
+func TestPayloadGolden(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		name string
+		info *sdkws.OfflinePushInfo
+	}{
+		{
+			name: "plain",
+			info: &sdkws.OfflinePushInfo{Title: "Alice", Desc: "hello", Ex: `{"k":"v"}`},
+		},
+		{
+			name: "loc_keys",
+			info: &sdkws.OfflinePushInfo{
+				Title:        "Alice",
+				Desc:         "sent you a picture",
+				TitleLocKey:  "push.title.single",
+				TitleLocArgs: []string{"Alice"},
+				BodyLocKey:   "push.body.picture",
+				BodyLocArgs:  []string{"Alice", "2"},
+			},
+		},
+		{
+			name: "image",
+			info: &sdkws.OfflinePushInfo{Title: "Alice", Desc: "[image]", ImageURL: "https://example.com/a.jpg"},
+		},
+		{
+			name: "time_sensitive",
+			info: &sdkws.OfflinePushInfo{
+				Title:         "Alice",
+				Desc:          "incoming call",
+				TimeSensitive: true,
+				SignalInfo:    `{"roomID":"r1"}`,
+				IOSPushSound:  "ring.caf",
+			},
+		},
+		{
+			name: "high_priority_ttl",
+			info: &sdkws.OfflinePushInfo{Title: "Alice", Desc: "hello", Priority: constant.OfflinePushPriorityHigh, Ttl: 3600},
+		},
+		{
+			name: "collapse_thread_badge",
+			info: &sdkws.OfflinePushInfo{
+				Title:         "Group",
+				Desc:          "3 new messages",
+				ThreadID:      "sg_g1",
+				CollapseID:    "sg_g1_digest",
+				IOSBadgeCount: true,
+				Badge:         7,
+			},
+		},
+		{
+			name: "actions",
+			info: &sdkws.OfflinePushInfo{
+				Title:    "Alice",
+				Desc:     "hello",
+				Category: "MESSAGE",
+				Ex:       `{"k":"v"}`,
+				Actions: []*sdkws.OfflinePushAction{
+					{ActionID: constant.OfflinePushActionReply, Title: "Reply", Input: true},
+					{ActionID: constant.OfflinePushActionMarkRead, TitleLocKey: "push.action.mark_read"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPayload(tt.info)
+			apns, err := p.APNs()
+			if err != nil {
+				t.Fatal(err)
+			}
+			fcm, err := p.FCM()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := json.MarshalIndent(renderedPayload{
+				APNsHeaders: p.APNsHeaders(now),
+				APNs:        apns,
+				FCM:         fcm,
+			}, "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, '\n')
+			golden := filepath.Join("testdata", tt.name+".golden.json")
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s mismatch, rerun with -update if intended\ngot:\n%s\nwant:\n%s", golden, got, want)
+			}
+		})
+	}
+}
@@ -14,7 +14,12 @@
 
 package push
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/openimsdk/protocol/constant"
+)
 
 func (x *PushMsgReq) Check() error {
 	if x.MsgData == nil {
@@ -38,3 +43,39 @@ func (x *DelUserPushTokenReq) Check() error {
 	}
 	return nil
 }
+
+// TokenInvalid reports whether the outcome requires deleting the platform's push token.
+func (x *PushOutcome) TokenInvalid() bool {
+	return x.Result == constant.PushResultTokenInvalid
+}
+
+// DelUserPushTokenReq builds the cleanup request for an invalid-token outcome, nil otherwise.
+func (x *PushOutcome) DelUserPushTokenReq() *DelUserPushTokenReq {
+	if !x.TokenInvalid() {
+		return nil
+	}
+	return &DelUserPushTokenReq{UserID: x.UserID, PlatformID: x.PlatformID}
+}
+
+func (x *PushMsgResp) Format() any {
+	if len(x.Outcomes) > 50 {
+		return fmt.Sprintf("len is %v", len(x.Outcomes))
+	}
+	return x
+}
+
+func (x *GetPushDeliveryLogReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("UserID is empty")
+	}
+	if x.StartTime > 0 && x.EndTime > 0 && x.StartTime > x.EndTime {
+		return errors.New("StartTime is greater than EndTime")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
@@ -96,15 +96,117 @@ func (x *PushMsgReq) GetUserIDs() []string {
 	return nil
 }
 
+// PushOutcome 单个用户单个平台的推送结果
+type PushOutcome struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	PlatformID    int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID"`
+	Result        int32                  `protobuf:"varint,3,opt,name=result,proto3" json:"result"`              // constant.PushResult*
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason"`               // 未推送原因 constant.PushSuppressReason*
+	Provider      string                 `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider"`           // constant.PushProvider*
+	ProviderMsgID string                 `protobuf:"bytes,6,opt,name=providerMsgID,proto3" json:"providerMsgID"` // 推送厂商返回的消息ID
+	ErrCode       string                 `protobuf:"bytes,7,opt,name=errCode,proto3" json:"errCode"`             // 推送厂商错误码
+	ErrMsg        string                 `protobuf:"bytes,8,opt,name=errMsg,proto3" json:"errMsg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushOutcome) Reset() {
+	*x = PushOutcome{}
+	mi := &file_push_push_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushOutcome) ProtoMessage() {}
+
+func (x *PushOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushOutcome.ProtoReflect.Descriptor instead.
+func (*PushOutcome) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PushOutcome) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *PushOutcome) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *PushOutcome) GetResult() int32 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+func (x *PushOutcome) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PushOutcome) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PushOutcome) GetProviderMsgID() string {
+	if x != nil {
+		return x.ProviderMsgID
+	}
+	return ""
+}
+
+func (x *PushOutcome) GetErrCode() string {
+	if x != nil {
+		return x.ErrCode
+	}
+	return ""
+}
+
+func (x *PushOutcome) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
 type PushMsgResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcomes      []*PushOutcome         `protobuf:"bytes,1,rep,name=outcomes,proto3" json:"outcomes"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PushMsgResp) Reset() {
 	*x = PushMsgResp{}
-	mi := &file_push_push_proto_msgTypes[1]
+	mi := &file_push_push_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -116,7 +218,7 @@ func (x *PushMsgResp) String() string {
 func (*PushMsgResp) ProtoMessage() {}
 
 func (x *PushMsgResp) ProtoReflect() protoreflect.Message {
-	mi := &file_push_push_proto_msgTypes[1]
+	mi := &file_push_push_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -129,7 +231,235 @@ func (x *PushMsgResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushMsgResp.ProtoReflect.Descriptor instead.
 func (*PushMsgResp) Descriptor() ([]byte, []int) {
-	return file_push_push_proto_rawDescGZIP(), []int{1}
+	return file_push_push_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PushMsgResp) GetOutcomes() []*PushOutcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+// PushDeliveryRecord 推送记录，用于客服排查
+type PushDeliveryRecord struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ClientMsgID    string                 `protobuf:"bytes,1,opt,name=clientMsgID,proto3" json:"clientMsgID"`
+	ConversationID string                 `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"`
+	Seq            int64                  `protobuf:"varint,3,opt,name=seq,proto3" json:"seq"`
+	PushTime       int64                  `protobuf:"varint,4,opt,name=pushTime,proto3" json:"pushTime"`
+	Outcome        *PushOutcome           `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PushDeliveryRecord) Reset() {
+	*x = PushDeliveryRecord{}
+	mi := &file_push_push_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushDeliveryRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushDeliveryRecord) ProtoMessage() {}
+
+func (x *PushDeliveryRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushDeliveryRecord.ProtoReflect.Descriptor instead.
+func (*PushDeliveryRecord) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PushDeliveryRecord) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *PushDeliveryRecord) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *PushDeliveryRecord) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *PushDeliveryRecord) GetPushTime() int64 {
+	if x != nil {
+		return x.PushTime
+	}
+	return 0
+}
+
+func (x *PushDeliveryRecord) GetOutcome() *PushOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+type GetPushDeliveryLogReq struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	UserID         string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ConversationID string                   `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID"` // 可选
+	ClientMsgID    string                   `protobuf:"bytes,3,opt,name=clientMsgID,proto3" json:"clientMsgID"`       // 可选
+	StartTime      int64                    `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime"`          // 可选，毫秒时间戳
+	EndTime        int64                    `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime"`
+	Results        []int32                  `protobuf:"varint,6,rep,packed,name=results,proto3" json:"results"` // 可选，按结果过滤 constant.PushResult*
+	Pagination     *sdkws.RequestPagination `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetPushDeliveryLogReq) Reset() {
+	*x = GetPushDeliveryLogReq{}
+	mi := &file_push_push_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushDeliveryLogReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushDeliveryLogReq) ProtoMessage() {}
+
+func (x *GetPushDeliveryLogReq) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushDeliveryLogReq.ProtoReflect.Descriptor instead.
+func (*GetPushDeliveryLogReq) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPushDeliveryLogReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetPushDeliveryLogReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetPushDeliveryLogReq) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *GetPushDeliveryLogReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetPushDeliveryLogReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *GetPushDeliveryLogReq) GetResults() []int32 {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GetPushDeliveryLogReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetPushDeliveryLogResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Records       []*PushDeliveryRecord  `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPushDeliveryLogResp) Reset() {
+	*x = GetPushDeliveryLogResp{}
+	mi := &file_push_push_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPushDeliveryLogResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPushDeliveryLogResp) ProtoMessage() {}
+
+func (x *GetPushDeliveryLogResp) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPushDeliveryLogResp.ProtoReflect.Descriptor instead.
+func (*GetPushDeliveryLogResp) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPushDeliveryLogResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetPushDeliveryLogResp) GetRecords() []*PushDeliveryRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
 }
 
 type DelUserPushTokenReq struct {
@@ -142,7 +472,7 @@ type DelUserPushTokenReq struct {
 
 func (x *DelUserPushTokenReq) Reset() {
 	*x = DelUserPushTokenReq{}
-	mi := &file_push_push_proto_msgTypes[2]
+	mi := &file_push_push_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -154,7 +484,7 @@ func (x *DelUserPushTokenReq) String() string {
 func (*DelUserPushTokenReq) ProtoMessage() {}
 
 func (x *DelUserPushTokenReq) ProtoReflect() protoreflect.Message {
-	mi := &file_push_push_proto_msgTypes[2]
+	mi := &file_push_push_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +497,7 @@ func (x *DelUserPushTokenReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelUserPushTokenReq.ProtoReflect.Descriptor instead.
 func (*DelUserPushTokenReq) Descriptor() ([]byte, []int) {
-	return file_push_push_proto_rawDescGZIP(), []int{2}
+	return file_push_push_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DelUserPushTokenReq) GetUserID() string {
@@ -192,7 +522,7 @@ type DelUserPushTokenResp struct {
 
 func (x *DelUserPushTokenResp) Reset() {
 	*x = DelUserPushTokenResp{}
-	mi := &file_push_push_proto_msgTypes[3]
+	mi := &file_push_push_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -204,7 +534,7 @@ func (x *DelUserPushTokenResp) String() string {
 func (*DelUserPushTokenResp) ProtoMessage() {}
 
 func (x *DelUserPushTokenResp) ProtoReflect() protoreflect.Message {
-	mi := &file_push_push_proto_msgTypes[3]
+	mi := &file_push_push_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -217,7 +547,7 @@ func (x *DelUserPushTokenResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DelUserPushTokenResp.ProtoReflect.Descriptor instead.
 func (*DelUserPushTokenResp) Descriptor() ([]byte, []int) {
-	return file_push_push_proto_rawDescGZIP(), []int{3}
+	return file_push_push_proto_rawDescGZIP(), []int{7}
 }
 
 var File_push_push_proto protoreflect.FileDescriptor
@@ -229,17 +559,49 @@ const file_push_push_proto_rawDesc = "" +
 	"PushMsgReq\x12/\n" +
 	"\amsgData\x18\x01 \x01(\v2\x15.openim.sdkws.MsgDataR\amsgData\x12&\n" +
 	"\x0econversationID\x18\x02 \x01(\tR\x0econversationID\x12\x18\n" +
-	"\auserIDs\x18\x03 \x03(\tR\auserIDs\"\r\n" +
-	"\vPushMsgResp\"M\n" +
+	"\auserIDs\x18\x03 \x03(\tR\auserIDs\"\xe9\x01\n" +
+	"\vPushOutcome\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x02 \x01(\x05R\n" +
+	"platformID\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\x05R\x06result\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bprovider\x18\x05 \x01(\tR\bprovider\x12$\n" +
+	"\rproviderMsgID\x18\x06 \x01(\tR\rproviderMsgID\x12\x18\n" +
+	"\aerrCode\x18\a \x01(\tR\aerrCode\x12\x16\n" +
+	"\x06errMsg\x18\b \x01(\tR\x06errMsg\"C\n" +
+	"\vPushMsgResp\x124\n" +
+	"\boutcomes\x18\x01 \x03(\v2\x18.openim.push.PushOutcomeR\boutcomes\"\xc0\x01\n" +
+	"\x12PushDeliveryRecord\x12 \n" +
+	"\vclientMsgID\x18\x01 \x01(\tR\vclientMsgID\x12&\n" +
+	"\x0econversationID\x18\x02 \x01(\tR\x0econversationID\x12\x10\n" +
+	"\x03seq\x18\x03 \x01(\x03R\x03seq\x12\x1a\n" +
+	"\bpushTime\x18\x04 \x01(\x03R\bpushTime\x122\n" +
+	"\aoutcome\x18\x05 \x01(\v2\x18.openim.push.PushOutcomeR\aoutcome\"\x8c\x02\n" +
+	"\x15GetPushDeliveryLogReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12&\n" +
+	"\x0econversationID\x18\x02 \x01(\tR\x0econversationID\x12 \n" +
+	"\vclientMsgID\x18\x03 \x01(\tR\vclientMsgID\x12\x1c\n" +
+	"\tstartTime\x18\x04 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x05 \x01(\x03R\aendTime\x12\x18\n" +
+	"\aresults\x18\x06 \x03(\x05R\aresults\x12?\n" +
+	"\n" +
+	"pagination\x18\a \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
+	"pagination\"i\n" +
+	"\x16GetPushDeliveryLogResp\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x129\n" +
+	"\arecords\x18\x02 \x03(\v2\x1f.openim.push.PushDeliveryRecordR\arecords\"M\n" +
 	"\x13DelUserPushTokenReq\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1e\n" +
 	"\n" +
 	"platformID\x18\x02 \x01(\x05R\n" +
 	"platformID\"\x16\n" +
-	"\x14DelUserPushTokenResp2\xa7\x01\n" +
+	"\x14DelUserPushTokenResp2\x86\x02\n" +
 	"\x0ePushMsgService\x12<\n" +
 	"\aPushMsg\x12\x17.openim.push.PushMsgReq\x1a\x18.openim.push.PushMsgResp\x12W\n" +
-	"\x10DelUserPushToken\x12 .openim.push.DelUserPushTokenReq\x1a!.openim.push.DelUserPushTokenRespB$Z\"github.com/openimsdk/protocol/pushb\x06proto3"
+	"\x10DelUserPushToken\x12 .openim.push.DelUserPushTokenReq\x1a!.openim.push.DelUserPushTokenResp\x12]\n" +
+	"\x12GetPushDeliveryLog\x12\".openim.push.GetPushDeliveryLogReq\x1a#.openim.push.GetPushDeliveryLogRespB$Z\"github.com/openimsdk/protocol/pushb\x06proto3"
 
 var (
 	file_push_push_proto_rawDescOnce sync.Once
@@ -253,25 +615,36 @@ func file_push_push_proto_rawDescGZIP() []byte {
 	return file_push_push_proto_rawDescData
 }
 
-var file_push_push_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_push_push_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_push_push_proto_goTypes = []any{
-	(*PushMsgReq)(nil),           // 0: openim.push.PushMsgReq
-	(*PushMsgResp)(nil),          // 1: openim.push.PushMsgResp
-	(*DelUserPushTokenReq)(nil),  // 2: openim.push.DelUserPushTokenReq
-	(*DelUserPushTokenResp)(nil), // 3: openim.push.DelUserPushTokenResp
-	(*sdkws.MsgData)(nil),        // 4: openim.sdkws.MsgData
+	(*PushMsgReq)(nil),              // 0: openim.push.PushMsgReq
+	(*PushOutcome)(nil),             // 1: openim.push.PushOutcome
+	(*PushMsgResp)(nil),             // 2: openim.push.PushMsgResp
+	(*PushDeliveryRecord)(nil),      // 3: openim.push.PushDeliveryRecord
+	(*GetPushDeliveryLogReq)(nil),   // 4: openim.push.GetPushDeliveryLogReq
+	(*GetPushDeliveryLogResp)(nil),  // 5: openim.push.GetPushDeliveryLogResp
+	(*DelUserPushTokenReq)(nil),     // 6: openim.push.DelUserPushTokenReq
+	(*DelUserPushTokenResp)(nil),    // 7: openim.push.DelUserPushTokenResp
+	(*sdkws.MsgData)(nil),           // 8: openim.sdkws.MsgData
+	(*sdkws.RequestPagination)(nil), // 9: openim.sdkws.RequestPagination
 }
 var file_push_push_proto_depIdxs = []int32{
-	4, // 0: openim.push.PushMsgReq.msgData:type_name -> openim.sdkws.MsgData
-	0, // 1: openim.push.PushMsgService.PushMsg:input_type -> openim.push.PushMsgReq
-	2, // 2: openim.push.PushMsgService.DelUserPushToken:input_type -> openim.push.DelUserPushTokenReq
-	1, // 3: openim.push.PushMsgService.PushMsg:output_type -> openim.push.PushMsgResp
-	3, // 4: openim.push.PushMsgService.DelUserPushToken:output_type -> openim.push.DelUserPushTokenResp
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8, // 0: openim.push.PushMsgReq.msgData:type_name -> openim.sdkws.MsgData
+	1, // 1: openim.push.PushMsgResp.outcomes:type_name -> openim.push.PushOutcome
+	1, // 2: openim.push.PushDeliveryRecord.outcome:type_name -> openim.push.PushOutcome
+	9, // 3: openim.push.GetPushDeliveryLogReq.pagination:type_name -> openim.sdkws.RequestPagination
+	3, // 4: openim.push.GetPushDeliveryLogResp.records:type_name -> openim.push.PushDeliveryRecord
+	0, // 5: openim.push.PushMsgService.PushMsg:input_type -> openim.push.PushMsgReq
+	6, // 6: openim.push.PushMsgService.DelUserPushToken:input_type -> openim.push.DelUserPushTokenReq
+	4, // 7: openim.push.PushMsgService.GetPushDeliveryLog:input_type -> openim.push.GetPushDeliveryLogReq
+	2, // 8: openim.push.PushMsgService.PushMsg:output_type -> openim.push.PushMsgResp
+	7, // 9: openim.push.PushMsgService.DelUserPushToken:output_type -> openim.push.DelUserPushTokenResp
+	5, // 10: openim.push.PushMsgService.GetPushDeliveryLog:output_type -> openim.push.GetPushDeliveryLogResp
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_push_push_proto_init() }
@@ -285,7 +658,7 @@ func file_push_push_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_push_push_proto_rawDesc), len(file_push_push_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
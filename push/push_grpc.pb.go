@@ -33,8 +33,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PushMsgService_PushMsg_FullMethodName          = "/openim.push.PushMsgService/PushMsg"
-	PushMsgService_DelUserPushToken_FullMethodName = "/openim.push.PushMsgService/DelUserPushToken"
+	PushMsgService_PushMsg_FullMethodName            = "/openim.push.PushMsgService/PushMsg"
+	PushMsgService_DelUserPushToken_FullMethodName   = "/openim.push.PushMsgService/DelUserPushToken"
+	PushMsgService_GetPushDeliveryLog_FullMethodName = "/openim.push.PushMsgService/GetPushDeliveryLog"
 )
 
 // PushMsgServiceClient is the client API for PushMsgService service.
@@ -43,6 +44,7 @@ const (
 type PushMsgServiceClient interface {
 	PushMsg(ctx context.Context, in *PushMsgReq, opts ...grpc.CallOption) (*PushMsgResp, error)
 	DelUserPushToken(ctx context.Context, in *DelUserPushTokenReq, opts ...grpc.CallOption) (*DelUserPushTokenResp, error)
+	GetPushDeliveryLog(ctx context.Context, in *GetPushDeliveryLogReq, opts ...grpc.CallOption) (*GetPushDeliveryLogResp, error)
 }
 
 type pushMsgServiceClient struct {
@@ -73,12 +75,23 @@ func (c *pushMsgServiceClient) DelUserPushToken(ctx context.Context, in *DelUser
 	return out, nil
 }
 
+func (c *pushMsgServiceClient) GetPushDeliveryLog(ctx context.Context, in *GetPushDeliveryLogReq, opts ...grpc.CallOption) (*GetPushDeliveryLogResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPushDeliveryLogResp)
+	err := c.cc.Invoke(ctx, PushMsgService_GetPushDeliveryLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PushMsgServiceServer is the server API for PushMsgService service.
 // All implementations must embed UnimplementedPushMsgServiceServer
 // for forward compatibility.
 type PushMsgServiceServer interface {
 	PushMsg(context.Context, *PushMsgReq) (*PushMsgResp, error)
 	DelUserPushToken(context.Context, *DelUserPushTokenReq) (*DelUserPushTokenResp, error)
+	GetPushDeliveryLog(context.Context, *GetPushDeliveryLogReq) (*GetPushDeliveryLogResp, error)
 	mustEmbedUnimplementedPushMsgServiceServer()
 }
 
@@ -95,6 +108,9 @@ func (UnimplementedPushMsgServiceServer) PushMsg(context.Context, *PushMsgReq) (
 func (UnimplementedPushMsgServiceServer) DelUserPushToken(context.Context, *DelUserPushTokenReq) (*DelUserPushTokenResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method DelUserPushToken not implemented")
 }
+func (UnimplementedPushMsgServiceServer) GetPushDeliveryLog(context.Context, *GetPushDeliveryLogReq) (*GetPushDeliveryLogResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPushDeliveryLog not implemented")
+}
 func (UnimplementedPushMsgServiceServer) mustEmbedUnimplementedPushMsgServiceServer() {}
 func (UnimplementedPushMsgServiceServer) testEmbeddedByValue()                        {}
 
@@ -152,6 +168,24 @@ func _PushMsgService_DelUserPushToken_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PushMsgService_GetPushDeliveryLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPushDeliveryLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushMsgServiceServer).GetPushDeliveryLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PushMsgService_GetPushDeliveryLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushMsgServiceServer).GetPushDeliveryLog(ctx, req.(*GetPushDeliveryLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PushMsgService_ServiceDesc is the grpc.ServiceDesc for PushMsgService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -167,6 +201,10 @@ var PushMsgService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelUserPushToken",
 			Handler:    _PushMsgService_DelUserPushToken_Handler,
 		},
+		{
+			MethodName: "GetPushDeliveryLog",
+			Handler:    _PushMsgService_GetPushDeliveryLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "push/push.proto",
@@ -0,0 +1,253 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/protocol/third"
+)
+
+// WebPushRecordSize is the aes128gcm record size used by EncryptWebPush.
+const WebPushRecordSize = 4096
+
+// webPushHeaderSize is the aes128gcm header: salt(16) | rs(4) | idlen(1) | keyid(65).
+const webPushHeaderSize = 16 + 4 + 1 + 65
+
+// WebPushMaxPlaintext is the largest payload that fits in one record
+// after the header, the padding delimiter and the 16-byte GCM tag.
+const WebPushMaxPlaintext = WebPushRecordSize - webPushHeaderSize - 1 - 16
+
+var webPushEncoding = base64.RawURLEncoding
+
+// decodeWebPushKey accepts both padded and unpadded base64url, browsers emit either.
+func decodeWebPushKey(s string) ([]byte, error) {
+	if b, err := webPushEncoding.DecodeString(s); err == nil {
+		return b, nil
+	}
+	return base64.URLEncoding.DecodeString(s)
+}
+
+// EncryptWebPush encrypts plaintext for sub as a single aes128gcm record (RFC 8291, RFC 8188).
+func EncryptWebPush(sub *third.WebPushSubscription, plaintext []byte) ([]byte, error) {
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return encryptWebPush(sub, plaintext, asPrivate, salt)
+}
+
+// encryptWebPush is EncryptWebPush with the ephemeral key and salt supplied by the caller.
+func encryptWebPush(sub *third.WebPushSubscription, plaintext []byte, asPrivate *ecdh.PrivateKey, salt []byte) ([]byte, error) {
+	uaPublicBytes, err := decodeWebPushKey(sub.GetP256Dh())
+	if err != nil {
+		return nil, fmt.Errorf("p256dh is invalid: %w", err)
+	}
+	authSecret, err := decodeWebPushKey(sub.GetAuth())
+	if err != nil {
+		return nil, fmt.Errorf("auth is invalid: %w", err)
+	}
+	if len(authSecret) != 16 {
+		return nil, errors.New("auth must be 16 bytes")
+	}
+	if len(plaintext) > WebPushMaxPlaintext {
+		return nil, errors.New("payload too large")
+	}
+	uaPublic, err := ecdh.P256().NewPublicKey(uaPublicBytes)
+	if err != nil {
+		return nil, fmt.Errorf("p256dh is invalid: %w", err)
+	}
+	asPublicBytes := asPrivate.PublicKey().Bytes()
+	ecdhSecret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+	keyInfo := "WebPush: info\x00" + string(uaPublicBytes) + string(asPublicBytes)
+	ikm, err := hkdf.Key(sha256.New, ecdhSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	var body bytes.Buffer
+	body.Write(salt)
+	_ = binary.Write(&body, binary.BigEndian, uint32(WebPushRecordSize))
+	body.WriteByte(byte(len(asPublicBytes)))
+	body.Write(asPublicBytes)
+	// 0x02 is the padding delimiter of the last record.
+	record := append(append(make([]byte, 0, len(plaintext)+1), plaintext...), 0x02)
+	body.Write(gcm.Seal(nil, nonce, record, nil))
+	return body.Bytes(), nil
+}
+
+// VapidKey is the application server key pair used to sign push requests (RFC 8292).
+type VapidKey struct {
+	KeyID      string
+	PrivateKey *ecdsa.PrivateKey
+}
+
+// GenerateVapidKey creates a new P-256 key pair.
+func GenerateVapidKey(keyID string) (*VapidKey, error) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &VapidKey{KeyID: keyID, PrivateKey: priv}, nil
+}
+
+// ParseVapidKey restores a key from its base64url encoded 32-byte private scalar.
+func ParseVapidKey(keyID string, privateKey string) (*VapidKey, error) {
+	raw, err := decodeWebPushKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	ecdhKey, err := ecdh.P256().NewPrivateKey(raw)
+	if err != nil {
+		return nil, err
+	}
+	pub := ecdhKey.PublicKey().Bytes()
+	priv := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(pub[1:33]),
+			Y:     new(big.Int).SetBytes(pub[33:]),
+		},
+		D: new(big.Int).SetBytes(raw),
+	}
+	return &VapidKey{KeyID: keyID, PrivateKey: priv}, nil
+}
+
+// PrivateKeyString returns the base64url encoded private scalar for storage.
+func (k *VapidKey) PrivateKeyString() (string, error) {
+	ecdhKey, err := k.PrivateKey.ECDH()
+	if err != nil {
+		return "", err
+	}
+	return webPushEncoding.EncodeToString(ecdhKey.Bytes()), nil
+}
+
+// PublicKeyString returns the base64url uncompressed public key, the browser's applicationServerKey.
+func (k *VapidKey) PublicKeyString() (string, error) {
+	pub, err := k.PrivateKey.PublicKey.ECDH()
+	if err != nil {
+		return "", err
+	}
+	return webPushEncoding.EncodeToString(pub.Bytes()), nil
+}
+
+// Authorization returns the "vapid t=..., k=..." header value for endpoint.
+func (k *VapidKey) Authorization(endpoint string, subject string, expire time.Time) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	header, err := json.Marshal(map[string]string{"typ": "JWT", "alg": "ES256"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]any{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": expire.Unix(),
+		"sub": subject,
+	})
+	if err != nil {
+		return "", err
+	}
+	unsigned := webPushEncoding.EncodeToString(header) + "." + webPushEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, k.PrivateKey, digest[:])
+	if err != nil {
+		return "", err
+	}
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+	pub, err := k.PublicKeyString()
+	if err != nil {
+		return "", err
+	}
+	return "vapid t=" + unsigned + "." + webPushEncoding.EncodeToString(sig) + ", k=" + pub, nil
+}
+
+// NewWebPushRequest builds the POST to the subscription endpoint with an encrypted payload.
+// urgency is one of very-low, low, normal and high.
+func NewWebPushRequest(ctx context.Context, sub *third.WebPushSubscription, plaintext []byte, key *VapidKey, subject string, ttl time.Duration, urgency string) (*http.Request, error) {
+	body, err := EncryptWebPush(sub, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	auth, err := key.Authorization(sub.GetEndpoint(), subject, time.Now().Add(12*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.GetEndpoint(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Authorization", auth)
+	req.Header.Set("TTL", strconv.FormatInt(int64(ttl/time.Second), 10))
+	if urgency != "" {
+		req.Header.Set("Urgency", urgency)
+	}
+	return req, nil
+}
+
+// WebPushTokenInvalid reports whether the push service response means the subscription is gone.
+func WebPushTokenInvalid(statusCode int) bool {
+	return statusCode == http.StatusNotFound || statusCode == http.StatusGone
+}
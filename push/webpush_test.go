@@ -0,0 +1,333 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/protocol/third"
+)
+
+// testUserAgent is the browser side of a subscription.
+type testUserAgent struct {
+	private *ecdh.PrivateKey
+	auth    []byte
+}
+
+func newTestUserAgent(t *testing.T) *testUserAgent {
+	t.Helper()
+	private, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := make([]byte, 16)
+	if _, err := rand.Read(auth); err != nil {
+		t.Fatal(err)
+	}
+	return &testUserAgent{private: private, auth: auth}
+}
+
+func (ua *testUserAgent) subscription(endpoint string) *third.WebPushSubscription {
+	return &third.WebPushSubscription{
+		Endpoint: endpoint,
+		P256Dh:   webPushEncoding.EncodeToString(ua.private.PublicKey().Bytes()),
+		Auth:     webPushEncoding.EncodeToString(ua.auth),
+	}
+}
+
+// decrypt reverses EncryptWebPush the way a browser does (RFC 8291 section 3.4).
+func (ua *testUserAgent) decrypt(t *testing.T, body []byte) []byte {
+	t.Helper()
+	if len(body) < webPushHeaderSize {
+		t.Fatalf("body is %d bytes, shorter than the header", len(body))
+	}
+	salt := body[:16]
+	if rs := binary.BigEndian.Uint32(body[16:20]); rs != WebPushRecordSize {
+		t.Fatalf("rs = %d, want %d", rs, WebPushRecordSize)
+	}
+	idlen := int(body[20])
+	asPublicBytes := body[21 : 21+idlen]
+	ciphertext := body[21+idlen:]
+	asPublic, err := ecdh.P256().NewPublicKey(asPublicBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdhSecret, err := ua.private.ECDH(asPublic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyInfo := "WebPush: info\x00" + string(ua.private.PublicKey().Bytes()) + string(asPublicBytes)
+	ikm, err := hkdf.Key(sha256.New, ecdhSecret, ua.auth, keyInfo, 32)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		t.Fatalf("decrypt: %v", err)
+	}
+	record = bytes.TrimRight(record, "\x00")
+	if len(record) == 0 || record[len(record)-1] != 0x02 {
+		t.Fatal("last record delimiter is missing")
+	}
+	return record[:len(record)-1]
+}
+
+// verifyVapid checks the "vapid t=..., k=..." header against key and returns the JWT claims.
+func verifyVapid(t *testing.T, header string, key *VapidKey) map[string]any {
+	t.Helper()
+	rest, ok := strings.CutPrefix(header, "vapid t=")
+	if !ok {
+		t.Fatalf("authorization %q is not vapid", header)
+	}
+	token, k, ok := strings.Cut(rest, ", k=")
+	if !ok {
+		t.Fatalf("authorization %q has no k", header)
+	}
+	if pub, _ := key.PublicKeyString(); k != pub {
+		t.Fatalf("k = %s, want %s", k, pub)
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("jwt has %d parts", len(parts))
+	}
+	sig, err := webPushEncoding.DecodeString(parts[2])
+	if err != nil || len(sig) != 64 {
+		t.Fatalf("signature is invalid: %v", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	if !ecdsa.Verify(&key.PrivateKey.PublicKey, digest[:], r, s) {
+		t.Fatal("jwt signature does not verify")
+	}
+	var jwtHeader map[string]string
+	if err := decodeJWTPart(parts[0], &jwtHeader); err != nil {
+		t.Fatal(err)
+	}
+	if jwtHeader["alg"] != "ES256" || jwtHeader["typ"] != "JWT" {
+		t.Fatalf("jwt header = %v", jwtHeader)
+	}
+	var claims map[string]any
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		t.Fatal(err)
+	}
+	return claims
+}
+
+func decodeJWTPart(part string, v any) error {
+	b, err := webPushEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func TestNewWebPushRequest(t *testing.T) {
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	requests := make(chan received, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- received{header: r.Header.Clone(), body: body}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	ua := newTestUserAgent(t)
+	key, err := GenerateVapidKey("k1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext := []byte(`{"title":"Alice","body":"hello"}`)
+	req, err := NewWebPushRequest(context.Background(), ua.subscription(server.URL+"/push/abc"), plaintext, key, "mailto:admin@example.com", time.Hour, "high")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated || WebPushTokenInvalid(resp.StatusCode) {
+		t.Fatalf("status = %d", resp.StatusCode)
+	}
+	got := <-requests
+
+	for name, want := range map[string]string{
+		"Content-Encoding": "aes128gcm",
+		"Content-Type":     "application/octet-stream",
+		"TTL":              "3600",
+		"Urgency":          "high",
+	} {
+		if v := got.header.Get(name); v != want {
+			t.Errorf("%s = %q, want %q", name, v, want)
+		}
+	}
+	claims := verifyVapid(t, got.header.Get("Authorization"), key)
+	if claims["aud"] != server.URL {
+		t.Errorf("aud = %v, want %s", claims["aud"], server.URL)
+	}
+	if claims["sub"] != "mailto:admin@example.com" {
+		t.Errorf("sub = %v", claims["sub"])
+	}
+	if exp, _ := claims["exp"].(float64); int64(exp) <= time.Now().Unix() {
+		t.Errorf("exp = %v is not in the future", claims["exp"])
+	}
+	if decrypted := ua.decrypt(t, got.body); !bytes.Equal(decrypted, plaintext) {
+		t.Fatalf("decrypted = %q, want %q", decrypted, plaintext)
+	}
+}
+
+func TestWebPushTokenInvalid(t *testing.T) {
+	tests := []struct {
+		status int
+		want   bool
+	}{
+		{http.StatusCreated, false},
+		{http.StatusBadRequest, false},
+		{http.StatusNotFound, true},
+		{http.StatusGone, true},
+		{http.StatusRequestEntityTooLarge, false},
+		{http.StatusTooManyRequests, false},
+	}
+	for _, tt := range tests {
+		if got := WebPushTokenInvalid(tt.status); got != tt.want {
+			t.Errorf("WebPushTokenInvalid(%d) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+// TestEncryptWebPushRFC8291 checks the example of RFC 8291 Appendix A with its fixed keys and salt.
+func TestEncryptWebPushRFC8291(t *testing.T) {
+	decode := func(s string) []byte {
+		b, err := webPushEncoding.DecodeString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	asPrivate, err := ecdh.P256().NewPrivateKey(decode("yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := webPushEncoding.EncodeToString(asPrivate.PublicKey().Bytes()); got != "BP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A8" {
+		t.Fatalf("as_public = %s", got)
+	}
+	sub := &third.WebPushSubscription{
+		Endpoint: "https://push.example.net/push/JzLQ3raZJfFBR0aqvOMsLrt54w4rJUsV",
+		P256Dh:   "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+		Auth:     "BTBZMqHH6r4Tts7J_aSIgg",
+	}
+	body, err := encryptWebPush(sub, []byte("When I grow up, I want to be a watermelon"), asPrivate, decode("DGv6ra1nlYgDCS1FRnbzlw"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN"
+	if got := webPushEncoding.EncodeToString(body); got != want {
+		t.Fatalf("body = %s\nwant   %s", got, want)
+	}
+	// The vector also pins down the decrypt helper used by the other tests.
+	uaPrivate, err := ecdh.P256().NewPrivateKey(decode("q1dXpw3UpT5VOmu_cf_v6ih07Aems3njxI-JWgLcM94"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ua := &testUserAgent{private: uaPrivate, auth: decode(sub.Auth)}
+	if got := ua.decrypt(t, body); string(got) != "When I grow up, I want to be a watermelon" {
+		t.Fatalf("decrypted = %q", got)
+	}
+}
+
+func TestEncryptWebPushSizeLimit(t *testing.T) {
+	ua := newTestUserAgent(t)
+	sub := ua.subscription("https://push.example.com/abc")
+	plaintext := bytes.Repeat([]byte("a"), WebPushMaxPlaintext)
+	body, err := EncryptWebPush(sub, plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(body) != WebPushRecordSize {
+		t.Fatalf("body is %d bytes, want %d", len(body), WebPushRecordSize)
+	}
+	if decrypted := ua.decrypt(t, body); !bytes.Equal(decrypted, plaintext) {
+		t.Fatal("round trip of the largest payload failed")
+	}
+	if _, err := EncryptWebPush(sub, append(plaintext, 'a')); err == nil {
+		t.Fatal("payload over the limit should be rejected")
+	}
+}
+
+func TestParseVapidKey(t *testing.T) {
+	key, err := GenerateVapidKey("k1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	private, err := key.PrivateKeyString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := ParseVapidKey("k1", private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := key.PublicKeyString()
+	if got, _ := parsed.PublicKeyString(); got != want {
+		t.Fatalf("public key = %s, want %s", got, want)
+	}
+	auth, err := parsed.Authorization("https://push.example.com/abc", "mailto:admin@example.com", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims := verifyVapid(t, auth, key); claims["aud"] != "https://push.example.com" {
+		t.Fatalf("aud = %v", claims["aud"])
+	}
+}
@@ -17,8 +17,11 @@ package relation
 import (
 	"errors"
 	"fmt"
+	"time"
+	"unicode/utf8"
 
 	"github.com/openimsdk/protocol/constant"
+	"github.com/openimsdk/tools/utils/datautil"
 )
 
 func (x *GetPaginationFriendsReq) Check() error {
@@ -44,6 +47,15 @@ func (x *ApplyToAddFriendReq) Check() error {
 	return nil
 }
 
+// FriendApplyExpireTime returns the millisecond expireTime of a FriendRequest created at now.
+// ttl is the server-configured validity period, 0 uses constant.FriendApplyDefaultExpireSeconds.
+func FriendApplyExpireTime(now time.Time, ttl time.Duration) int64 {
+	if ttl <= 0 {
+		ttl = constant.FriendApplyDefaultExpireSeconds * time.Second
+	}
+	return now.Add(ttl).UnixMilli()
+}
+
 func (x *ImportFriendReq) Check() error {
 	if x.OwnerUserID == "" {
 		return errors.New("ownerUserID is empty")
@@ -262,3 +274,188 @@ func (x *AddFriendCategoryReq) Check() error {
 	}
 	return nil
 }
+
+func (x *CreateFriendCategoryReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.Name == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *UpdateFriendCategoryReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.CategoryID == "" {
+		return errors.New("categoryID is empty")
+	}
+	if x.Name != nil && x.Name.Value == "" {
+		return errors.New("name is empty")
+	}
+	return nil
+}
+
+func (x *DeleteFriendCategoryReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.CategoryID == "" {
+		return errors.New("categoryID is empty")
+	}
+	return nil
+}
+
+func (x *SortFriendCategoriesReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if len(x.Orders) == 0 {
+		return errors.New("orders is empty")
+	}
+	for _, order := range x.Orders {
+		if order.CategoryID == "" {
+			return errors.New("categoryID is empty")
+		}
+	}
+	if datautil.DuplicateAny(x.Orders, func(order *FriendCategoryOrder) string { return order.CategoryID }) {
+		return errors.New("duplicate categoryID")
+	}
+	return nil
+}
+
+func (x *GetFriendCategoriesReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	return nil
+}
+
+func (x *SetFriendCategoriesReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.FriendUserID == "" {
+		return errors.New("friendUserID is empty")
+	}
+	return nil
+}
+
+func (x *AddFriendsToCategoryReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.CategoryID == "" {
+		return errors.New("categoryID is empty")
+	}
+	if len(x.FriendUserIDs) == 0 {
+		return errors.New("friendUserIDs is empty")
+	}
+	if len(x.FriendUserIDs) > constant.ParamMaxLength {
+		return errors.New("too many FriendUserIDs, need to be less than 1000")
+	}
+	return nil
+}
+
+func (x *RemoveFriendsFromCategoryReq) Check() error {
+	if x.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if x.CategoryID == "" {
+		return errors.New("categoryID is empty")
+	}
+	if len(x.FriendUserIDs) == 0 {
+		return errors.New("friendUserIDs is empty")
+	}
+	if len(x.FriendUserIDs) > constant.ParamMaxLength {
+		return errors.New("too many FriendUserIDs, need to be less than 1000")
+	}
+	return nil
+}
+
+func (x *GetIncrementalFriendCategoriesReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *ReplyFriendApplyReq) Check() error {
+	if x.FromUserID == "" {
+		return errors.New("fromUserID is empty")
+	}
+	if x.ToUserID == "" {
+		return errors.New("toUserID is empty")
+	}
+	if x.SenderUserID != x.FromUserID && x.SenderUserID != x.ToUserID {
+		return errors.New("senderUserID is invalid")
+	}
+	if x.Content == "" {
+		return errors.New("content is empty")
+	}
+	if utf8.RuneCountInString(x.Content) > constant.FriendApplyMessageMaxLength {
+		return errors.New("content is too long")
+	}
+	return nil
+}
+
+func (x *ExpireFriendApplicationsReq) Check() error {
+	if x.Timestamp > time.Now().UnixMilli() {
+		return errors.New("request millisecond timestamp error")
+	}
+	if x.Limit <= 0 {
+		return errors.New("request limit error")
+	}
+	return nil
+}
+
+func (x *GetMutualFriendsReq) Check() error {
+	if x.UserID1 == "" {
+		return errors.New("userID1 is empty")
+	}
+	if x.UserID2 == "" {
+		return errors.New("userID2 is empty")
+	}
+	if x.UserID1 == x.UserID2 {
+		return errors.New("userID1 and userID2 are the same")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	return nil
+}
+
+func (x *GetFriendSuggestionsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Pagination == nil {
+		return errors.New("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errors.New("pageNumber is invalid")
+	}
+	if len(x.ExcludeUserIDs) > constant.ParamMaxLength {
+		return errors.New("too many ExcludeUserIDs, need to be less than 1000")
+	}
+	return nil
+}
+
+func (x *GetMutualFriendsResp) Format() any {
+	if len(x.FriendsInfo) > 20 {
+		return fmt.Sprintf("len is %v", len(x.FriendsInfo))
+	}
+	return x
+}
+
+func (x *GetFriendSuggestionsResp) Format() any {
+	if len(x.Suggestions) > 20 {
+		return fmt.Sprintf("len is %v", len(x.Suggestions))
+	}
+	return x
+}
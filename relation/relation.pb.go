@@ -257,6 +257,7 @@ type FriendInfo struct {
 	OperatorUserID string                 `protobuf:"bytes,8,opt,name=operatorUserID,proto3" json:"operatorUserID"`
 	Ex             string                 `protobuf:"bytes,9,opt,name=ex,proto3" json:"ex"`
 	IsPinned       bool                   `protobuf:"varint,10,opt,name=isPinned,proto3" json:"isPinned"`
+	CategoryIDs    []string               `protobuf:"bytes,11,rep,name=categoryIDs,proto3" json:"categoryIDs"` // 好友所属的分组ID列表
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -361,6 +362,13 @@ func (x *FriendInfo) GetIsPinned() bool {
 	return false
 }
 
+func (x *FriendInfo) GetCategoryIDs() []string {
+	if x != nil {
+		return x.CategoryIDs
+	}
+	return nil
+}
+
 type ImportFriendReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OwnerUserID   string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
@@ -657,6 +665,7 @@ func (x *GetDesignatedFriendsApplyResp) GetFriendRequests() []*sdkws.FriendReque
 	return nil
 }
 
+// 已过期的申请不计入未处理数
 type GetSelfUnhandledApplyCountReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
@@ -753,6 +762,7 @@ func (x *GetSelfUnhandledApplyCountResp) GetCount() int64 {
 	return 0
 }
 
+// 增量同步不返回已过期的申请，过期的申请会出现在 deleteUserIds 中
 type GetIncrementalFriendsApplyToReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
@@ -889,6 +899,7 @@ func (x *GetIncrementalFriendsApplyToResp) GetChanges() []*sdkws.FriendRequest {
 	return nil
 }
 
+// 增量同步不返回已过期的申请，过期的申请会出现在 deleteUserIds 中
 type GetIncrementalFriendsApplyFromReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
@@ -3000,6 +3011,7 @@ type FriendInfoOnly struct {
 	OperatorUserID string                 `protobuf:"bytes,6,opt,name=operatorUserID,proto3" json:"operatorUserID"`
 	Ex             string                 `protobuf:"bytes,7,opt,name=ex,proto3" json:"ex"`
 	IsPinned       bool                   `protobuf:"varint,8,opt,name=isPinned,proto3" json:"isPinned"`
+	CategoryIDs    []string               `protobuf:"bytes,9,rep,name=categoryIDs,proto3" json:"categoryIDs"` // 好友所属的分组ID列表
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -3090,6 +3102,13 @@ func (x *FriendInfoOnly) GetIsPinned() bool {
 	return false
 }
 
+func (x *FriendInfoOnly) GetCategoryIDs() []string {
+	if x != nil {
+		return x.CategoryIDs
+	}
+	return nil
+}
+
 type GetFriendInfoReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OwnerUserID   string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
@@ -3187,6 +3206,7 @@ func (x *GetFriendInfoResp) GetFriendInfos() []*FriendInfoOnly {
 }
 
 // 定义 AddFriendCategory 的请求参数
+// Deprecated: 使用 SetFriendCategories / AddFriendsToCategory 代替
 type AddFriendCategoryReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OwnerUserID   string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
@@ -3285,237 +3305,1947 @@ func (*AddFriendCategoryResp) Descriptor() ([]byte, []int) {
 	return file_relation_relation_proto_rawDescGZIP(), []int{58}
 }
 
-var File_relation_relation_proto protoreflect.FileDescriptor
+// FriendCategory 用户自定义的好友分组，好友与分组为多对多关系
+type FriendCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryID    string                 `protobuf:"bytes,1,opt,name=categoryID,proto3" json:"categoryID"`   // 分组ID
+	OwnerUserID   string                 `protobuf:"bytes,2,opt,name=ownerUserID,proto3" json:"ownerUserID"` // 所属用户ID
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`               // 分组名称
+	SortOrder     int32                  `protobuf:"varint,4,opt,name=sortOrder,proto3" json:"sortOrder"`    // 排序顺序（越小越靠前）
+	CreateTime    int64                  `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime"`  // 创建时间
+	UpdateTime    int64                  `protobuf:"varint,6,opt,name=updateTime,proto3" json:"updateTime"`  // 更新时间
+	Ex            string                 `protobuf:"bytes,7,opt,name=ex,proto3" json:"ex"`                   // 扩展字段
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_relation_relation_proto_rawDesc = "" +
-	"\n" +
-	"\x17relation/relation.proto\x12\x0fopenim.relation\x1a\x11sdkws/sdkws.proto\x1a\x1bwrapperspb/wrapperspb.proto\"r\n" +
-	"\x17getPaginationFriendsReq\x12?\n" +
-	"\n" +
-	"pagination\x18\x01 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
-	"pagination\x12\x16\n" +
-	"\x06userID\x18\x02 \x01(\tR\x06userID\"l\n" +
-	"\x18getPaginationFriendsResp\x12:\n" +
-	"\vfriendsInfo\x18\x01 \x03(\v2\x18.openim.sdkws.FriendInfoR\vfriendsInfo\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"y\n" +
-	"\x13applyToAddFriendReq\x12\x1e\n" +
-	"\n" +
-	"fromUserID\x18\x01 \x01(\tR\n" +
-	"fromUserID\x12\x1a\n" +
-	"\btoUserID\x18\x02 \x01(\tR\btoUserID\x12\x16\n" +
-	"\x06reqMsg\x18\x03 \x01(\tR\x06reqMsg\x12\x0e\n" +
-	"\x02ex\x18\x04 \x01(\tR\x02ex\"\x16\n" +
-	"\x14applyToAddFriendResp\"\xca\x02\n" +
-	"\n" +
-	"friendInfo\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\"\n" +
-	"\ffriendUserID\x18\x02 \x01(\tR\ffriendUserID\x12&\n" +
-	"\x0efriendNickname\x18\x03 \x01(\tR\x0efriendNickname\x12$\n" +
-	"\rfriendFaceURL\x18\x04 \x01(\tR\rfriendFaceURL\x12\x16\n" +
-	"\x06remark\x18\x05 \x01(\tR\x06remark\x12\x1e\n" +
-	"\n" +
-	"createTime\x18\x06 \x01(\x03R\n" +
-	"createTime\x12\x1c\n" +
-	"\taddSource\x18\a \x01(\x05R\taddSource\x12&\n" +
-	"\x0eoperatorUserID\x18\b \x01(\tR\x0eoperatorUserID\x12\x0e\n" +
-	"\x02ex\x18\t \x01(\tR\x02ex\x12\x1a\n" +
-	"\bisPinned\x18\n" +
-	" \x01(\bR\bisPinned\"Y\n" +
-	"\x0fimportFriendReq\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12$\n" +
-	"\rfriendUserIDs\x18\x02 \x03(\tR\rfriendUserIDs\"\x12\n" +
-	"\x10importFriendResp\"\x9f\x01\n" +
-	"\x1egetPaginationFriendsApplyToReq\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\x12?\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
-	"pagination\x12$\n" +
-	"\rhandleResults\x18\x03 \x03(\x05R\rhandleResults\"|\n" +
-	"\x1fgetPaginationFriendsApplyToResp\x12C\n" +
-	"\x0eFriendRequests\x18\x01 \x03(\v2\x1b.openim.sdkws.FriendRequestR\x0eFriendRequests\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"Z\n" +
-	"\x1cgetDesignatedFriendsApplyReq\x12\x1e\n" +
-	"\n" +
-	"fromUserID\x18\x01 \x01(\tR\n" +
-	"fromUserID\x12\x1a\n" +
-	"\btoUserID\x18\x02 \x01(\tR\btoUserID\"d\n" +
-	"\x1dgetDesignatedFriendsApplyResp\x12C\n" +
-	"\x0efriendRequests\x18\x01 \x03(\v2\x1b.openim.sdkws.FriendRequestR\x0efriendRequests\"K\n" +
-	"\x1dgetSelfUnhandledApplyCountReq\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x12\n" +
-	"\x04time\x18\x02 \x01(\x03R\x04time\"6\n" +
-	"\x1egetSelfUnhandledApplyCountResp\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x03R\x05count\"q\n" +
-	"\x1fgetIncrementalFriendsApplyToReq\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
-	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\x04R\aversion\"\xcb\x01\n" +
-	" getIncrementalFriendsApplyToResp\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x1c\n" +
-	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x12\n" +
-	"\x04full\x18\x03 \x01(\bR\x04full\x12$\n" +
-	"\rdeleteUserIds\x18\x04 \x03(\tR\rdeleteUserIds\x125\n" +
-	"\achanges\x18\x05 \x03(\v2\x1b.openim.sdkws.FriendRequestR\achanges\"s\n" +
-	"!getIncrementalFriendsApplyFromReq\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
-	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\x04R\aversion\"\xcd\x01\n" +
-	"\"getIncrementalFriendsApplyFromResp\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x1c\n" +
-	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x12\n" +
-	"\x04full\x18\x03 \x01(\bR\x04full\x12$\n" +
-	"\rdeleteUserIds\x18\x04 \x03(\tR\rdeleteUserIds\x125\n" +
-	"\achanges\x18\x05 \x03(\v2\x1b.openim.sdkws.FriendRequestR\achanges\"a\n" +
-	"\x17getDesignatedFriendsReq\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12$\n" +
-	"\rfriendUserIDs\x18\x02 \x03(\tR\rfriendUserIDs\"V\n" +
-	"\x18getDesignatedFriendsResp\x12:\n" +
-	"\vfriendsInfo\x18\x01 \x03(\v2\x18.openim.sdkws.FriendInfoR\vfriendsInfo\"a\n" +
-	"\vaddBlackReq\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12 \n" +
-	"\vblackUserID\x18\x02 \x01(\tR\vblackUserID\x12\x0e\n" +
-	"\x02ex\x18\x03 \x01(\tR\x02ex\"\x0e\n" +
-	"\faddBlackResp\"T\n" +
-	"\x0eremoveBlackReq\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12 \n" +
-	"\vblackUserID\x18\x02 \x01(\tR\vblackUserID\"\x11\n" +
-	"\x0fremoveBlackResp\"q\n" +
-	"\x16getPaginationBlacksReq\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\x12?\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
-	"pagination\"`\n" +
-	"\x17getPaginationBlacksResp\x12/\n" +
-	"\x06blacks\x18\x01 \x03(\v2\x17.openim.sdkws.BlackInfoR\x06blacks\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"A\n" +
-	"\visFriendReq\x12\x18\n" +
-	"\auserID1\x18\x01 \x01(\tR\auserID1\x12\x18\n" +
-	"\auserID2\x18\x02 \x01(\tR\auserID2\"^\n" +
-	"\fisFriendResp\x12&\n" +
-	"\x0einUser1Friends\x18\x01 \x01(\bR\x0einUser1Friends\x12&\n" +
-	"\x0einUser2Friends\x18\x02 \x01(\bR\x0einUser2Friends\"@\n" +
-	"\n" +
-	"isBlackReq\x12\x18\n" +
-	"\auserID1\x18\x01 \x01(\tR\auserID1\x12\x18\n" +
-	"\auserID2\x18\x02 \x01(\tR\auserID2\"Y\n" +
-	"\visBlackResp\x12$\n" +
-	"\rinUser1Blacks\x18\x01 \x01(\bR\rinUser1Blacks\x12$\n" +
-	"\rinUser2Blacks\x18\x02 \x01(\bR\rinUser2Blacks\"W\n" +
-	"\x0fdeleteFriendReq\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\"\n" +
-	"\ffriendUserID\x18\x02 \x01(\tR\ffriendUserID\"\x12\n" +
-	"\x10deleteFriendResp\"\x95\x01\n" +
-	"\x15respondFriendApplyReq\x12\x1e\n" +
-	"\n" +
-	"fromUserID\x18\x01 \x01(\tR\n" +
-	"fromUserID\x12\x1a\n" +
-	"\btoUserID\x18\x02 \x01(\tR\btoUserID\x12\"\n" +
-	"\fhandleResult\x18\x03 \x01(\x05R\fhandleResult\x12\x1c\n" +
-	"\thandleMsg\x18\x04 \x01(\tR\thandleMsg\"\x18\n" +
-	"\x16respondFriendApplyResp\"\xf6\x01\n" +
-	"\x10updateFriendsReq\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12$\n" +
-	"\rfriendUserIDs\x18\x02 \x03(\tR\rfriendUserIDs\x126\n" +
-	"\bisPinned\x18\x03 \x01(\v2\x1a.openim.protobuf.BoolValueR\bisPinned\x124\n" +
-	"\x06remark\x18\x04 \x01(\v2\x1c.openim.protobuf.StringValueR\x06remark\x12,\n" +
-	"\x02ex\x18\x05 \x01(\v2\x1c.openim.protobuf.StringValueR\x02ex\"\x13\n" +
-	"\x11updateFriendsResp\"r\n" +
-	"\x12setFriendRemarkReq\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\"\n" +
-	"\ffriendUserID\x18\x02 \x01(\tR\ffriendUserID\x12\x16\n" +
-	"\x06remark\x18\x03 \x01(\tR\x06remark\"\x15\n" +
-	"\x13setFriendRemarkResp\"\xa1\x01\n" +
-	" getPaginationFriendsApplyFromReq\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\x12?\n" +
-	"\n" +
-	"pagination\x18\x02 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
-	"pagination\x12$\n" +
-	"\rhandleResults\x18\x03 \x03(\x05R\rhandleResults\"~\n" +
-	"!getPaginationFriendsApplyFromResp\x12C\n" +
-	"\x0efriendRequests\x18\x01 \x03(\v2\x1b.openim.sdkws.FriendRequestR\x0efriendRequests\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\")\n" +
-	"\x0fgetFriendIDsReq\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\"0\n" +
-	"\x10getFriendIDsResp\x12\x1c\n" +
-	"\tfriendIDs\x18\x01 \x03(\tR\tfriendIDs\"^\n" +
-	"\x1agetSpecifiedFriendsInfoReq\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\x1e\n" +
-	"\n" +
-	"userIDList\x18\x02 \x03(\tR\n" +
-	"userIDList\"\xc2\x01\n" +
-	"\x1bgetSpecifiedFriendsInfoInfo\x122\n" +
-	"\buserInfo\x18\x01 \x01(\v2\x16.openim.sdkws.UserInfoR\buserInfo\x128\n" +
-	"\n" +
-	"friendInfo\x18\x02 \x01(\v2\x18.openim.sdkws.FriendInfoR\n" +
-	"friendInfo\x125\n" +
-	"\tblackInfo\x18\x03 \x01(\v2\x17.openim.sdkws.BlackInfoR\tblackInfo\"a\n" +
-	"\x1bgetSpecifiedFriendsInfoResp\x12B\n" +
-	"\x05infos\x18\x01 \x03(\v2,.openim.relation.getSpecifiedFriendsInfoInfoR\x05infos\"j\n" +
-	"\x18getIncrementalFriendsReq\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
-	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\x04R\aversion\"\x85\x02\n" +
-	"\x19getIncrementalFriendsResp\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x1c\n" +
-	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x12\n" +
-	"\x04full\x18\x03 \x01(\bR\x04full\x12\x16\n" +
-	"\x06delete\x18\x04 \x03(\tR\x06delete\x120\n" +
-	"\x06insert\x18\x05 \x03(\v2\x18.openim.sdkws.FriendInfoR\x06insert\x120\n" +
-	"\x06update\x18\x06 \x03(\v2\x18.openim.sdkws.FriendInfoR\x06update\x12 \n" +
-	"\vsortVersion\x18\a \x01(\x04R\vsortVersion\"i\n" +
-	"\x17getIncrementalBlacksReq\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
-	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\x04R\aversion\"\xe0\x01\n" +
-	"\x18getIncrementalBlacksResp\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x1c\n" +
-	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x12\n" +
-	"\x04full\x18\x03 \x01(\bR\x04full\x12\x16\n" +
-	"\x06delete\x18\x04 \x03(\tR\x06delete\x12/\n" +
-	"\x06insert\x18\x05 \x03(\v2\x17.openim.sdkws.BlackInfoR\x06insert\x12/\n" +
-	"\x06update\x18\x06 \x03(\v2\x17.openim.sdkws.BlackInfoR\x06update\"Y\n" +
-	"\x15GetSpecifiedBlacksReq\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\x1e\n" +
-	"\n" +
-	"userIDList\x18\x02 \x03(\tR\n" +
-	"userIDList\"_\n" +
-	"\x16GetSpecifiedBlacksResp\x12/\n" +
-	"\x06blacks\x18\x01 \x03(\v2\x17.openim.sdkws.BlackInfoR\x06blacks\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"I\n" +
-	"\x17getFullFriendUserIDsReq\x12\x16\n" +
-	"\x06idHash\x18\x01 \x01(\x04R\x06idHash\x12\x16\n" +
-	"\x06userID\x18\x02 \x01(\tR\x06userID\"\x82\x01\n" +
-	"\x18getFullFriendUserIDsResp\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x1c\n" +
-	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x14\n" +
-	"\x05equal\x18\x03 \x01(\bR\x05equal\x12\x18\n" +
-	"\auserIDs\x18\x04 \x03(\tR\auserIDs\"\xab\x01\n" +
-	"\x1dnotificationUserInfoUpdateReq\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\x128\n" +
-	"\voldUserInfo\x18\x02 \x01(\v2\x16.openim.sdkws.UserInfoR\voldUserInfo\x128\n" +
-	"\vnewUserInfo\x18\x03 \x01(\v2\x16.openim.sdkws.UserInfoR\vnewUserInfo\" \n" +
-	"\x1enotificationUserInfoUpdateResp\"\x80\x02\n" +
-	"\x0eFriendInfoOnly\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\"\n" +
-	"\ffriendUserID\x18\x02 \x01(\tR\ffriendUserID\x12\x16\n" +
-	"\x06remark\x18\x03 \x01(\tR\x06remark\x12\x1e\n" +
-	"\n" +
-	"createTime\x18\x04 \x01(\x03R\n" +
-	"createTime\x12\x1c\n" +
-	"\taddSource\x18\x05 \x01(\x05R\taddSource\x12&\n" +
-	"\x0eoperatorUserID\x18\x06 \x01(\tR\x0eoperatorUserID\x12\x0e\n" +
-	"\x02ex\x18\a \x01(\tR\x02ex\x12\x1a\n" +
-	"\bisPinned\x18\b \x01(\bR\bisPinned\"Z\n" +
-	"\x10getFriendInfoReq\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12$\n" +
-	"\rfriendUserIDs\x18\x02 \x03(\tR\rfriendUserIDs\"V\n" +
-	"\x11getFriendInfoResp\x12A\n" +
-	"\vfriendInfos\x18\x01 \x03(\v2\x1f.openim.relation.FriendInfoOnlyR\vfriendInfos\"x\n" +
-	"\x14AddFriendCategoryReq\x12 \n" +
-	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\"\n" +
-	"\ffriendUserID\x18\x02 \x01(\tR\ffriendUserID\x12\x1a\n" +
-	"\bcategory\x18\x03 \x01(\x05R\bcategory\"\x17\n" +
-	"\x15AddFriendCategoryResp2\xef\x16\n" +
+func (x *FriendCategory) Reset() {
+	*x = FriendCategory{}
+	mi := &file_relation_relation_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendCategory) ProtoMessage() {}
+
+func (x *FriendCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendCategory.ProtoReflect.Descriptor instead.
+func (*FriendCategory) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FriendCategory) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+func (x *FriendCategory) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *FriendCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FriendCategory) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *FriendCategory) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *FriendCategory) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *FriendCategory) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type CreateFriendCategoryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID   string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`            // 分组名称
+	SortOrder     int32                  `protobuf:"varint,3,opt,name=sortOrder,proto3" json:"sortOrder"` // 排序顺序，为0时追加到末尾
+	Ex            string                 `protobuf:"bytes,4,opt,name=ex,proto3" json:"ex"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFriendCategoryReq) Reset() {
+	*x = CreateFriendCategoryReq{}
+	mi := &file_relation_relation_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFriendCategoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFriendCategoryReq) ProtoMessage() {}
+
+func (x *CreateFriendCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFriendCategoryReq.ProtoReflect.Descriptor instead.
+func (*CreateFriendCategoryReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateFriendCategoryReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *CreateFriendCategoryReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFriendCategoryReq) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *CreateFriendCategoryReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type CreateFriendCategoryResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *FriendCategory        `protobuf:"bytes,1,opt,name=category,proto3" json:"category"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFriendCategoryResp) Reset() {
+	*x = CreateFriendCategoryResp{}
+	mi := &file_relation_relation_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFriendCategoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFriendCategoryResp) ProtoMessage() {}
+
+func (x *CreateFriendCategoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFriendCategoryResp.ProtoReflect.Descriptor instead.
+func (*CreateFriendCategoryResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateFriendCategoryResp) GetCategory() *FriendCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type UpdateFriendCategoryReq struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	OwnerUserID   string                  `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	CategoryID    string                  `protobuf:"bytes,2,opt,name=categoryID,proto3" json:"categoryID"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=name,proto3" json:"name"` // 分组名称（可选）
+	Ex            *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=ex,proto3" json:"ex"`     // 扩展字段（可选）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFriendCategoryReq) Reset() {
+	*x = UpdateFriendCategoryReq{}
+	mi := &file_relation_relation_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFriendCategoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFriendCategoryReq) ProtoMessage() {}
+
+func (x *UpdateFriendCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFriendCategoryReq.ProtoReflect.Descriptor instead.
+func (*UpdateFriendCategoryReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UpdateFriendCategoryReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *UpdateFriendCategoryReq) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+func (x *UpdateFriendCategoryReq) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateFriendCategoryReq) GetEx() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Ex
+	}
+	return nil
+}
+
+type UpdateFriendCategoryResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFriendCategoryResp) Reset() {
+	*x = UpdateFriendCategoryResp{}
+	mi := &file_relation_relation_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFriendCategoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFriendCategoryResp) ProtoMessage() {}
+
+func (x *UpdateFriendCategoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFriendCategoryResp.ProtoReflect.Descriptor instead.
+func (*UpdateFriendCategoryResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{63}
+}
+
+// DeleteFriendCategory 删除分组，分组内的好友关系不受影响
+type DeleteFriendCategoryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID   string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	CategoryID    string                 `protobuf:"bytes,2,opt,name=categoryID,proto3" json:"categoryID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFriendCategoryReq) Reset() {
+	*x = DeleteFriendCategoryReq{}
+	mi := &file_relation_relation_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFriendCategoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFriendCategoryReq) ProtoMessage() {}
+
+func (x *DeleteFriendCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFriendCategoryReq.ProtoReflect.Descriptor instead.
+func (*DeleteFriendCategoryReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteFriendCategoryReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *DeleteFriendCategoryReq) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+type DeleteFriendCategoryResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFriendCategoryResp) Reset() {
+	*x = DeleteFriendCategoryResp{}
+	mi := &file_relation_relation_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFriendCategoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFriendCategoryResp) ProtoMessage() {}
+
+func (x *DeleteFriendCategoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFriendCategoryResp.ProtoReflect.Descriptor instead.
+func (*DeleteFriendCategoryResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{65}
+}
+
+type FriendCategoryOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryID    string                 `protobuf:"bytes,1,opt,name=categoryID,proto3" json:"categoryID"`
+	SortOrder     int32                  `protobuf:"varint,2,opt,name=sortOrder,proto3" json:"sortOrder"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendCategoryOrder) Reset() {
+	*x = FriendCategoryOrder{}
+	mi := &file_relation_relation_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendCategoryOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendCategoryOrder) ProtoMessage() {}
+
+func (x *FriendCategoryOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendCategoryOrder.ProtoReflect.Descriptor instead.
+func (*FriendCategoryOrder) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *FriendCategoryOrder) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+func (x *FriendCategoryOrder) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type SortFriendCategoriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID   string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	Orders        []*FriendCategoryOrder `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortFriendCategoriesReq) Reset() {
+	*x = SortFriendCategoriesReq{}
+	mi := &file_relation_relation_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortFriendCategoriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortFriendCategoriesReq) ProtoMessage() {}
+
+func (x *SortFriendCategoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortFriendCategoriesReq.ProtoReflect.Descriptor instead.
+func (*SortFriendCategoriesReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SortFriendCategoriesReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *SortFriendCategoriesReq) GetOrders() []*FriendCategoryOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type SortFriendCategoriesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortFriendCategoriesResp) Reset() {
+	*x = SortFriendCategoriesResp{}
+	mi := &file_relation_relation_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortFriendCategoriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortFriendCategoriesResp) ProtoMessage() {}
+
+func (x *SortFriendCategoriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortFriendCategoriesResp.ProtoReflect.Descriptor instead.
+func (*SortFriendCategoriesResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{68}
+}
+
+type GetFriendCategoriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID   string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendCategoriesReq) Reset() {
+	*x = GetFriendCategoriesReq{}
+	mi := &file_relation_relation_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendCategoriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendCategoriesReq) ProtoMessage() {}
+
+func (x *GetFriendCategoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendCategoriesReq.ProtoReflect.Descriptor instead.
+func (*GetFriendCategoriesReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetFriendCategoriesReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+type GetFriendCategoriesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*FriendCategory      `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories"` // 按 sortOrder 排序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendCategoriesResp) Reset() {
+	*x = GetFriendCategoriesResp{}
+	mi := &file_relation_relation_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendCategoriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendCategoriesResp) ProtoMessage() {}
+
+func (x *GetFriendCategoriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendCategoriesResp.ProtoReflect.Descriptor instead.
+func (*GetFriendCategoriesResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetFriendCategoriesResp) GetCategories() []*FriendCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// SetFriendCategories 覆盖设置某个好友所属的分组，categoryIDs 为空表示移出所有分组
+type SetFriendCategoriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID   string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	FriendUserID  string                 `protobuf:"bytes,2,opt,name=friendUserID,proto3" json:"friendUserID"`
+	CategoryIDs   []string               `protobuf:"bytes,3,rep,name=categoryIDs,proto3" json:"categoryIDs"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFriendCategoriesReq) Reset() {
+	*x = SetFriendCategoriesReq{}
+	mi := &file_relation_relation_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFriendCategoriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendCategoriesReq) ProtoMessage() {}
+
+func (x *SetFriendCategoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendCategoriesReq.ProtoReflect.Descriptor instead.
+func (*SetFriendCategoriesReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SetFriendCategoriesReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *SetFriendCategoriesReq) GetFriendUserID() string {
+	if x != nil {
+		return x.FriendUserID
+	}
+	return ""
+}
+
+func (x *SetFriendCategoriesReq) GetCategoryIDs() []string {
+	if x != nil {
+		return x.CategoryIDs
+	}
+	return nil
+}
+
+type SetFriendCategoriesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFriendCategoriesResp) Reset() {
+	*x = SetFriendCategoriesResp{}
+	mi := &file_relation_relation_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFriendCategoriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendCategoriesResp) ProtoMessage() {}
+
+func (x *SetFriendCategoriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendCategoriesResp.ProtoReflect.Descriptor instead.
+func (*SetFriendCategoriesResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{72}
+}
+
+type AddFriendsToCategoryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID   string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	CategoryID    string                 `protobuf:"bytes,2,opt,name=categoryID,proto3" json:"categoryID"`
+	FriendUserIDs []string               `protobuf:"bytes,3,rep,name=friendUserIDs,proto3" json:"friendUserIDs"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFriendsToCategoryReq) Reset() {
+	*x = AddFriendsToCategoryReq{}
+	mi := &file_relation_relation_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFriendsToCategoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFriendsToCategoryReq) ProtoMessage() {}
+
+func (x *AddFriendsToCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFriendsToCategoryReq.ProtoReflect.Descriptor instead.
+func (*AddFriendsToCategoryReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AddFriendsToCategoryReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *AddFriendsToCategoryReq) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+func (x *AddFriendsToCategoryReq) GetFriendUserIDs() []string {
+	if x != nil {
+		return x.FriendUserIDs
+	}
+	return nil
+}
+
+type AddFriendsToCategoryResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFriendsToCategoryResp) Reset() {
+	*x = AddFriendsToCategoryResp{}
+	mi := &file_relation_relation_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFriendsToCategoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFriendsToCategoryResp) ProtoMessage() {}
+
+func (x *AddFriendsToCategoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFriendsToCategoryResp.ProtoReflect.Descriptor instead.
+func (*AddFriendsToCategoryResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{74}
+}
+
+type RemoveFriendsFromCategoryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerUserID   string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID"`
+	CategoryID    string                 `protobuf:"bytes,2,opt,name=categoryID,proto3" json:"categoryID"`
+	FriendUserIDs []string               `protobuf:"bytes,3,rep,name=friendUserIDs,proto3" json:"friendUserIDs"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFriendsFromCategoryReq) Reset() {
+	*x = RemoveFriendsFromCategoryReq{}
+	mi := &file_relation_relation_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFriendsFromCategoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendsFromCategoryReq) ProtoMessage() {}
+
+func (x *RemoveFriendsFromCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendsFromCategoryReq.ProtoReflect.Descriptor instead.
+func (*RemoveFriendsFromCategoryReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RemoveFriendsFromCategoryReq) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *RemoveFriendsFromCategoryReq) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+func (x *RemoveFriendsFromCategoryReq) GetFriendUserIDs() []string {
+	if x != nil {
+		return x.FriendUserIDs
+	}
+	return nil
+}
+
+type RemoveFriendsFromCategoryResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFriendsFromCategoryResp) Reset() {
+	*x = RemoveFriendsFromCategoryResp{}
+	mi := &file_relation_relation_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFriendsFromCategoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFriendsFromCategoryResp) ProtoMessage() {}
+
+func (x *RemoveFriendsFromCategoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFriendsFromCategoryResp.ProtoReflect.Descriptor instead.
+func (*RemoveFriendsFromCategoryResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{76}
+}
+
+// ReplyFriendApply 在未处理的好友申请下追加留言，申请双方均可留言
+// 单个申请最多 constant.FriendApplyMaxMessageNum 条留言（不含申请时的 reqMsg），超出时返回错误
+type ReplyFriendApplyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserID    string                 `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID"`     // 申请发起者
+	ToUserID      string                 `protobuf:"bytes,2,opt,name=toUserID,proto3" json:"toUserID"`         // 申请接收者
+	SenderUserID  string                 `protobuf:"bytes,3,opt,name=senderUserID,proto3" json:"senderUserID"` // 留言发送者，必须是 fromUserID 或 toUserID
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content"`           // 留言内容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyFriendApplyReq) Reset() {
+	*x = ReplyFriendApplyReq{}
+	mi := &file_relation_relation_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyFriendApplyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyFriendApplyReq) ProtoMessage() {}
+
+func (x *ReplyFriendApplyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyFriendApplyReq.ProtoReflect.Descriptor instead.
+func (*ReplyFriendApplyReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ReplyFriendApplyReq) GetFromUserID() string {
+	if x != nil {
+		return x.FromUserID
+	}
+	return ""
+}
+
+func (x *ReplyFriendApplyReq) GetToUserID() string {
+	if x != nil {
+		return x.ToUserID
+	}
+	return ""
+}
+
+func (x *ReplyFriendApplyReq) GetSenderUserID() string {
+	if x != nil {
+		return x.SenderUserID
+	}
+	return ""
+}
+
+func (x *ReplyFriendApplyReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ReplyFriendApplyResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *sdkws.FriendRequest   `protobuf:"bytes,1,opt,name=request,proto3" json:"request"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyFriendApplyResp) Reset() {
+	*x = ReplyFriendApplyResp{}
+	mi := &file_relation_relation_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyFriendApplyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyFriendApplyResp) ProtoMessage() {}
+
+func (x *ReplyFriendApplyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyFriendApplyResp.ProtoReflect.Descriptor instead.
+func (*ReplyFriendApplyResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ReplyFriendApplyResp) GetRequest() *sdkws.FriendRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// ExpireFriendApplications 由定时任务调用，将 expireTime 早于 timestamp 的未处理申请标记为过期
+type ExpireFriendApplicationsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireFriendApplicationsReq) Reset() {
+	*x = ExpireFriendApplicationsReq{}
+	mi := &file_relation_relation_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireFriendApplicationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireFriendApplicationsReq) ProtoMessage() {}
+
+func (x *ExpireFriendApplicationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireFriendApplicationsReq.ProtoReflect.Descriptor instead.
+func (*ExpireFriendApplicationsReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ExpireFriendApplicationsReq) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ExpireFriendApplicationsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ExpireFriendApplicationsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpireFriendApplicationsResp) Reset() {
+	*x = ExpireFriendApplicationsResp{}
+	mi := &file_relation_relation_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpireFriendApplicationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpireFriendApplicationsResp) ProtoMessage() {}
+
+func (x *ExpireFriendApplicationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpireFriendApplicationsResp.ProtoReflect.Descriptor instead.
+func (*ExpireFriendApplicationsResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ExpireFriendApplicationsResp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// GetMutualFriends 查询两个用户的共同好友，按 userID1 的好友视角返回
+type GetMutualFriendsReq struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	UserID1       string                   `protobuf:"bytes,1,opt,name=userID1,proto3" json:"userID1"`
+	UserID2       string                   `protobuf:"bytes,2,opt,name=userID2,proto3" json:"userID2"`
+	Pagination    *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMutualFriendsReq) Reset() {
+	*x = GetMutualFriendsReq{}
+	mi := &file_relation_relation_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutualFriendsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutualFriendsReq) ProtoMessage() {}
+
+func (x *GetMutualFriendsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutualFriendsReq.ProtoReflect.Descriptor instead.
+func (*GetMutualFriendsReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetMutualFriendsReq) GetUserID1() string {
+	if x != nil {
+		return x.UserID1
+	}
+	return ""
+}
+
+func (x *GetMutualFriendsReq) GetUserID2() string {
+	if x != nil {
+		return x.UserID2
+	}
+	return ""
+}
+
+func (x *GetMutualFriendsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetMutualFriendsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FriendsInfo   []*sdkws.FriendInfo    `protobuf:"bytes,1,rep,name=friendsInfo,proto3" json:"friendsInfo"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMutualFriendsResp) Reset() {
+	*x = GetMutualFriendsResp{}
+	mi := &file_relation_relation_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutualFriendsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutualFriendsResp) ProtoMessage() {}
+
+func (x *GetMutualFriendsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutualFriendsResp.ProtoReflect.Descriptor instead.
+func (*GetMutualFriendsResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetMutualFriendsResp) GetFriendsInfo() []*sdkws.FriendInfo {
+	if x != nil {
+		return x.FriendsInfo
+	}
+	return nil
+}
+
+func (x *GetMutualFriendsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// FriendSuggestion 好友推荐项，排除已是好友、黑名单双向用户以及关闭了可被发现的用户
+type FriendSuggestion struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	User                *sdkws.UserInfo        `protobuf:"bytes,1,opt,name=user,proto3" json:"user"`
+	MutualFriendCount   int32                  `protobuf:"varint,2,opt,name=mutualFriendCount,proto3" json:"mutualFriendCount"`    // 共同好友数
+	SharedGroupCount    int32                  `protobuf:"varint,3,opt,name=sharedGroupCount,proto3" json:"sharedGroupCount"`      // 共同群组数
+	SameDepartment      bool                   `protobuf:"varint,4,opt,name=sameDepartment,proto3" json:"sameDepartment"`          // 是否同一 OA 部门
+	MutualFriendUserIDs []string               `protobuf:"bytes,5,rep,name=mutualFriendUserIDs,proto3" json:"mutualFriendUserIDs"` // 部分共同好友ID，用于展示
+	Score               float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score"`                           // 排序分值，越大越靠前
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *FriendSuggestion) Reset() {
+	*x = FriendSuggestion{}
+	mi := &file_relation_relation_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendSuggestion) ProtoMessage() {}
+
+func (x *FriendSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendSuggestion.ProtoReflect.Descriptor instead.
+func (*FriendSuggestion) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *FriendSuggestion) GetUser() *sdkws.UserInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *FriendSuggestion) GetMutualFriendCount() int32 {
+	if x != nil {
+		return x.MutualFriendCount
+	}
+	return 0
+}
+
+func (x *FriendSuggestion) GetSharedGroupCount() int32 {
+	if x != nil {
+		return x.SharedGroupCount
+	}
+	return 0
+}
+
+func (x *FriendSuggestion) GetSameDepartment() bool {
+	if x != nil {
+		return x.SameDepartment
+	}
+	return false
+}
+
+func (x *FriendSuggestion) GetMutualFriendUserIDs() []string {
+	if x != nil {
+		return x.MutualFriendUserIDs
+	}
+	return nil
+}
+
+func (x *FriendSuggestion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetFriendSuggestionsReq struct {
+	state          protoimpl.MessageState   `protogen:"open.v1"`
+	UserID         string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Pagination     *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	ExcludeUserIDs []string                 `protobuf:"bytes,3,rep,name=excludeUserIDs,proto3" json:"excludeUserIDs"` // 额外排除的用户（如客户端已忽略的推荐）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetFriendSuggestionsReq) Reset() {
+	*x = GetFriendSuggestionsReq{}
+	mi := &file_relation_relation_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendSuggestionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendSuggestionsReq) ProtoMessage() {}
+
+func (x *GetFriendSuggestionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendSuggestionsReq.ProtoReflect.Descriptor instead.
+func (*GetFriendSuggestionsReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetFriendSuggestionsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetFriendSuggestionsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetFriendSuggestionsReq) GetExcludeUserIDs() []string {
+	if x != nil {
+		return x.ExcludeUserIDs
+	}
+	return nil
+}
+
+type GetFriendSuggestionsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*FriendSuggestion    `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendSuggestionsResp) Reset() {
+	*x = GetFriendSuggestionsResp{}
+	mi := &file_relation_relation_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendSuggestionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendSuggestionsResp) ProtoMessage() {}
+
+func (x *GetFriendSuggestionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendSuggestionsResp.ProtoReflect.Descriptor instead.
+func (*GetFriendSuggestionsResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetFriendSuggestionsResp) GetSuggestions() []*FriendSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *GetFriendSuggestionsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetIncrementalFriendCategoriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	VersionID     string                 `protobuf:"bytes,2,opt,name=versionID,proto3" json:"versionID"`
+	Version       uint64                 `protobuf:"varint,3,opt,name=version,proto3" json:"version"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncrementalFriendCategoriesReq) Reset() {
+	*x = GetIncrementalFriendCategoriesReq{}
+	mi := &file_relation_relation_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncrementalFriendCategoriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncrementalFriendCategoriesReq) ProtoMessage() {}
+
+func (x *GetIncrementalFriendCategoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncrementalFriendCategoriesReq.ProtoReflect.Descriptor instead.
+func (*GetIncrementalFriendCategoriesReq) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetIncrementalFriendCategoriesReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetIncrementalFriendCategoriesReq) GetVersionID() string {
+	if x != nil {
+		return x.VersionID
+	}
+	return ""
+}
+
+func (x *GetIncrementalFriendCategoriesReq) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetIncrementalFriendCategoriesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       uint64                 `protobuf:"varint,1,opt,name=version,proto3" json:"version"`
+	VersionID     string                 `protobuf:"bytes,2,opt,name=versionID,proto3" json:"versionID"`
+	Full          bool                   `protobuf:"varint,3,opt,name=full,proto3" json:"full"`
+	Delete        []string               `protobuf:"bytes,4,rep,name=delete,proto3" json:"delete"`
+	Insert        []*FriendCategory      `protobuf:"bytes,5,rep,name=insert,proto3" json:"insert"`
+	Update        []*FriendCategory      `protobuf:"bytes,6,rep,name=update,proto3" json:"update"`
+	SortVersion   uint64                 `protobuf:"varint,7,opt,name=sortVersion,proto3" json:"sortVersion"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncrementalFriendCategoriesResp) Reset() {
+	*x = GetIncrementalFriendCategoriesResp{}
+	mi := &file_relation_relation_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncrementalFriendCategoriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncrementalFriendCategoriesResp) ProtoMessage() {}
+
+func (x *GetIncrementalFriendCategoriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_relation_relation_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncrementalFriendCategoriesResp.ProtoReflect.Descriptor instead.
+func (*GetIncrementalFriendCategoriesResp) Descriptor() ([]byte, []int) {
+	return file_relation_relation_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetIncrementalFriendCategoriesResp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetIncrementalFriendCategoriesResp) GetVersionID() string {
+	if x != nil {
+		return x.VersionID
+	}
+	return ""
+}
+
+func (x *GetIncrementalFriendCategoriesResp) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *GetIncrementalFriendCategoriesResp) GetDelete() []string {
+	if x != nil {
+		return x.Delete
+	}
+	return nil
+}
+
+func (x *GetIncrementalFriendCategoriesResp) GetInsert() []*FriendCategory {
+	if x != nil {
+		return x.Insert
+	}
+	return nil
+}
+
+func (x *GetIncrementalFriendCategoriesResp) GetUpdate() []*FriendCategory {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *GetIncrementalFriendCategoriesResp) GetSortVersion() uint64 {
+	if x != nil {
+		return x.SortVersion
+	}
+	return 0
+}
+
+var File_relation_relation_proto protoreflect.FileDescriptor
+
+const file_relation_relation_proto_rawDesc = "" +
+	"\n" +
+	"\x17relation/relation.proto\x12\x0fopenim.relation\x1a\x11sdkws/sdkws.proto\x1a\x1bwrapperspb/wrapperspb.proto\"r\n" +
+	"\x17getPaginationFriendsReq\x12?\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
+	"pagination\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\"l\n" +
+	"\x18getPaginationFriendsResp\x12:\n" +
+	"\vfriendsInfo\x18\x01 \x03(\v2\x18.openim.sdkws.FriendInfoR\vfriendsInfo\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"y\n" +
+	"\x13applyToAddFriendReq\x12\x1e\n" +
+	"\n" +
+	"fromUserID\x18\x01 \x01(\tR\n" +
+	"fromUserID\x12\x1a\n" +
+	"\btoUserID\x18\x02 \x01(\tR\btoUserID\x12\x16\n" +
+	"\x06reqMsg\x18\x03 \x01(\tR\x06reqMsg\x12\x0e\n" +
+	"\x02ex\x18\x04 \x01(\tR\x02ex\"\x16\n" +
+	"\x14applyToAddFriendResp\"\xec\x02\n" +
+	"\n" +
+	"friendInfo\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\"\n" +
+	"\ffriendUserID\x18\x02 \x01(\tR\ffriendUserID\x12&\n" +
+	"\x0efriendNickname\x18\x03 \x01(\tR\x0efriendNickname\x12$\n" +
+	"\rfriendFaceURL\x18\x04 \x01(\tR\rfriendFaceURL\x12\x16\n" +
+	"\x06remark\x18\x05 \x01(\tR\x06remark\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x06 \x01(\x03R\n" +
+	"createTime\x12\x1c\n" +
+	"\taddSource\x18\a \x01(\x05R\taddSource\x12&\n" +
+	"\x0eoperatorUserID\x18\b \x01(\tR\x0eoperatorUserID\x12\x0e\n" +
+	"\x02ex\x18\t \x01(\tR\x02ex\x12\x1a\n" +
+	"\bisPinned\x18\n" +
+	" \x01(\bR\bisPinned\x12 \n" +
+	"\vcategoryIDs\x18\v \x03(\tR\vcategoryIDs\"Y\n" +
+	"\x0fimportFriendReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12$\n" +
+	"\rfriendUserIDs\x18\x02 \x03(\tR\rfriendUserIDs\"\x12\n" +
+	"\x10importFriendResp\"\x9f\x01\n" +
+	"\x1egetPaginationFriendsApplyToReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12?\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
+	"pagination\x12$\n" +
+	"\rhandleResults\x18\x03 \x03(\x05R\rhandleResults\"|\n" +
+	"\x1fgetPaginationFriendsApplyToResp\x12C\n" +
+	"\x0eFriendRequests\x18\x01 \x03(\v2\x1b.openim.sdkws.FriendRequestR\x0eFriendRequests\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"Z\n" +
+	"\x1cgetDesignatedFriendsApplyReq\x12\x1e\n" +
+	"\n" +
+	"fromUserID\x18\x01 \x01(\tR\n" +
+	"fromUserID\x12\x1a\n" +
+	"\btoUserID\x18\x02 \x01(\tR\btoUserID\"d\n" +
+	"\x1dgetDesignatedFriendsApplyResp\x12C\n" +
+	"\x0efriendRequests\x18\x01 \x03(\v2\x1b.openim.sdkws.FriendRequestR\x0efriendRequests\"K\n" +
+	"\x1dgetSelfUnhandledApplyCountReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\x03R\x04time\"6\n" +
+	"\x1egetSelfUnhandledApplyCountResp\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"q\n" +
+	"\x1fgetIncrementalFriendsApplyToReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\"\xcb\x01\n" +
+	" getIncrementalFriendsApplyToResp\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x12\n" +
+	"\x04full\x18\x03 \x01(\bR\x04full\x12$\n" +
+	"\rdeleteUserIds\x18\x04 \x03(\tR\rdeleteUserIds\x125\n" +
+	"\achanges\x18\x05 \x03(\v2\x1b.openim.sdkws.FriendRequestR\achanges\"s\n" +
+	"!getIncrementalFriendsApplyFromReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\"\xcd\x01\n" +
+	"\"getIncrementalFriendsApplyFromResp\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x12\n" +
+	"\x04full\x18\x03 \x01(\bR\x04full\x12$\n" +
+	"\rdeleteUserIds\x18\x04 \x03(\tR\rdeleteUserIds\x125\n" +
+	"\achanges\x18\x05 \x03(\v2\x1b.openim.sdkws.FriendRequestR\achanges\"a\n" +
+	"\x17getDesignatedFriendsReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12$\n" +
+	"\rfriendUserIDs\x18\x02 \x03(\tR\rfriendUserIDs\"V\n" +
+	"\x18getDesignatedFriendsResp\x12:\n" +
+	"\vfriendsInfo\x18\x01 \x03(\v2\x18.openim.sdkws.FriendInfoR\vfriendsInfo\"a\n" +
+	"\vaddBlackReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12 \n" +
+	"\vblackUserID\x18\x02 \x01(\tR\vblackUserID\x12\x0e\n" +
+	"\x02ex\x18\x03 \x01(\tR\x02ex\"\x0e\n" +
+	"\faddBlackResp\"T\n" +
+	"\x0eremoveBlackReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12 \n" +
+	"\vblackUserID\x18\x02 \x01(\tR\vblackUserID\"\x11\n" +
+	"\x0fremoveBlackResp\"q\n" +
+	"\x16getPaginationBlacksReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12?\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
+	"pagination\"`\n" +
+	"\x17getPaginationBlacksResp\x12/\n" +
+	"\x06blacks\x18\x01 \x03(\v2\x17.openim.sdkws.BlackInfoR\x06blacks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"A\n" +
+	"\visFriendReq\x12\x18\n" +
+	"\auserID1\x18\x01 \x01(\tR\auserID1\x12\x18\n" +
+	"\auserID2\x18\x02 \x01(\tR\auserID2\"^\n" +
+	"\fisFriendResp\x12&\n" +
+	"\x0einUser1Friends\x18\x01 \x01(\bR\x0einUser1Friends\x12&\n" +
+	"\x0einUser2Friends\x18\x02 \x01(\bR\x0einUser2Friends\"@\n" +
+	"\n" +
+	"isBlackReq\x12\x18\n" +
+	"\auserID1\x18\x01 \x01(\tR\auserID1\x12\x18\n" +
+	"\auserID2\x18\x02 \x01(\tR\auserID2\"Y\n" +
+	"\visBlackResp\x12$\n" +
+	"\rinUser1Blacks\x18\x01 \x01(\bR\rinUser1Blacks\x12$\n" +
+	"\rinUser2Blacks\x18\x02 \x01(\bR\rinUser2Blacks\"W\n" +
+	"\x0fdeleteFriendReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\"\n" +
+	"\ffriendUserID\x18\x02 \x01(\tR\ffriendUserID\"\x12\n" +
+	"\x10deleteFriendResp\"\x95\x01\n" +
+	"\x15respondFriendApplyReq\x12\x1e\n" +
+	"\n" +
+	"fromUserID\x18\x01 \x01(\tR\n" +
+	"fromUserID\x12\x1a\n" +
+	"\btoUserID\x18\x02 \x01(\tR\btoUserID\x12\"\n" +
+	"\fhandleResult\x18\x03 \x01(\x05R\fhandleResult\x12\x1c\n" +
+	"\thandleMsg\x18\x04 \x01(\tR\thandleMsg\"\x18\n" +
+	"\x16respondFriendApplyResp\"\xf6\x01\n" +
+	"\x10updateFriendsReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12$\n" +
+	"\rfriendUserIDs\x18\x02 \x03(\tR\rfriendUserIDs\x126\n" +
+	"\bisPinned\x18\x03 \x01(\v2\x1a.openim.protobuf.BoolValueR\bisPinned\x124\n" +
+	"\x06remark\x18\x04 \x01(\v2\x1c.openim.protobuf.StringValueR\x06remark\x12,\n" +
+	"\x02ex\x18\x05 \x01(\v2\x1c.openim.protobuf.StringValueR\x02ex\"\x13\n" +
+	"\x11updateFriendsResp\"r\n" +
+	"\x12setFriendRemarkReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\"\n" +
+	"\ffriendUserID\x18\x02 \x01(\tR\ffriendUserID\x12\x16\n" +
+	"\x06remark\x18\x03 \x01(\tR\x06remark\"\x15\n" +
+	"\x13setFriendRemarkResp\"\xa1\x01\n" +
+	" getPaginationFriendsApplyFromReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12?\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
+	"pagination\x12$\n" +
+	"\rhandleResults\x18\x03 \x03(\x05R\rhandleResults\"~\n" +
+	"!getPaginationFriendsApplyFromResp\x12C\n" +
+	"\x0efriendRequests\x18\x01 \x03(\v2\x1b.openim.sdkws.FriendRequestR\x0efriendRequests\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\")\n" +
+	"\x0fgetFriendIDsReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"0\n" +
+	"\x10getFriendIDsResp\x12\x1c\n" +
+	"\tfriendIDs\x18\x01 \x03(\tR\tfriendIDs\"^\n" +
+	"\x1agetSpecifiedFriendsInfoReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\x1e\n" +
+	"\n" +
+	"userIDList\x18\x02 \x03(\tR\n" +
+	"userIDList\"\xc2\x01\n" +
+	"\x1bgetSpecifiedFriendsInfoInfo\x122\n" +
+	"\buserInfo\x18\x01 \x01(\v2\x16.openim.sdkws.UserInfoR\buserInfo\x128\n" +
+	"\n" +
+	"friendInfo\x18\x02 \x01(\v2\x18.openim.sdkws.FriendInfoR\n" +
+	"friendInfo\x125\n" +
+	"\tblackInfo\x18\x03 \x01(\v2\x17.openim.sdkws.BlackInfoR\tblackInfo\"a\n" +
+	"\x1bgetSpecifiedFriendsInfoResp\x12B\n" +
+	"\x05infos\x18\x01 \x03(\v2,.openim.relation.getSpecifiedFriendsInfoInfoR\x05infos\"j\n" +
+	"\x18getIncrementalFriendsReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\"\x85\x02\n" +
+	"\x19getIncrementalFriendsResp\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x12\n" +
+	"\x04full\x18\x03 \x01(\bR\x04full\x12\x16\n" +
+	"\x06delete\x18\x04 \x03(\tR\x06delete\x120\n" +
+	"\x06insert\x18\x05 \x03(\v2\x18.openim.sdkws.FriendInfoR\x06insert\x120\n" +
+	"\x06update\x18\x06 \x03(\v2\x18.openim.sdkws.FriendInfoR\x06update\x12 \n" +
+	"\vsortVersion\x18\a \x01(\x04R\vsortVersion\"i\n" +
+	"\x17getIncrementalBlacksReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\"\xe0\x01\n" +
+	"\x18getIncrementalBlacksResp\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x12\n" +
+	"\x04full\x18\x03 \x01(\bR\x04full\x12\x16\n" +
+	"\x06delete\x18\x04 \x03(\tR\x06delete\x12/\n" +
+	"\x06insert\x18\x05 \x03(\v2\x17.openim.sdkws.BlackInfoR\x06insert\x12/\n" +
+	"\x06update\x18\x06 \x03(\v2\x17.openim.sdkws.BlackInfoR\x06update\"Y\n" +
+	"\x15GetSpecifiedBlacksReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\x1e\n" +
+	"\n" +
+	"userIDList\x18\x02 \x03(\tR\n" +
+	"userIDList\"_\n" +
+	"\x16GetSpecifiedBlacksResp\x12/\n" +
+	"\x06blacks\x18\x01 \x03(\v2\x17.openim.sdkws.BlackInfoR\x06blacks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"I\n" +
+	"\x17getFullFriendUserIDsReq\x12\x16\n" +
+	"\x06idHash\x18\x01 \x01(\x04R\x06idHash\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\"\x82\x01\n" +
+	"\x18getFullFriendUserIDsResp\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x14\n" +
+	"\x05equal\x18\x03 \x01(\bR\x05equal\x12\x18\n" +
+	"\auserIDs\x18\x04 \x03(\tR\auserIDs\"\xab\x01\n" +
+	"\x1dnotificationUserInfoUpdateReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x128\n" +
+	"\voldUserInfo\x18\x02 \x01(\v2\x16.openim.sdkws.UserInfoR\voldUserInfo\x128\n" +
+	"\vnewUserInfo\x18\x03 \x01(\v2\x16.openim.sdkws.UserInfoR\vnewUserInfo\" \n" +
+	"\x1enotificationUserInfoUpdateResp\"\xa2\x02\n" +
+	"\x0eFriendInfoOnly\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\"\n" +
+	"\ffriendUserID\x18\x02 \x01(\tR\ffriendUserID\x12\x16\n" +
+	"\x06remark\x18\x03 \x01(\tR\x06remark\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x04 \x01(\x03R\n" +
+	"createTime\x12\x1c\n" +
+	"\taddSource\x18\x05 \x01(\x05R\taddSource\x12&\n" +
+	"\x0eoperatorUserID\x18\x06 \x01(\tR\x0eoperatorUserID\x12\x0e\n" +
+	"\x02ex\x18\a \x01(\tR\x02ex\x12\x1a\n" +
+	"\bisPinned\x18\b \x01(\bR\bisPinned\x12 \n" +
+	"\vcategoryIDs\x18\t \x03(\tR\vcategoryIDs\"Z\n" +
+	"\x10getFriendInfoReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12$\n" +
+	"\rfriendUserIDs\x18\x02 \x03(\tR\rfriendUserIDs\"V\n" +
+	"\x11getFriendInfoResp\x12A\n" +
+	"\vfriendInfos\x18\x01 \x03(\v2\x1f.openim.relation.FriendInfoOnlyR\vfriendInfos\"x\n" +
+	"\x14AddFriendCategoryReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\"\n" +
+	"\ffriendUserID\x18\x02 \x01(\tR\ffriendUserID\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\x05R\bcategory\"\x17\n" +
+	"\x15AddFriendCategoryResp\"\xd4\x01\n" +
+	"\x0eFriendCategory\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x01 \x01(\tR\n" +
+	"categoryID\x12 \n" +
+	"\vownerUserID\x18\x02 \x01(\tR\vownerUserID\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tsortOrder\x18\x04 \x01(\x05R\tsortOrder\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x05 \x01(\x03R\n" +
+	"createTime\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\x06 \x01(\x03R\n" +
+	"updateTime\x12\x0e\n" +
+	"\x02ex\x18\a \x01(\tR\x02ex\"}\n" +
+	"\x17CreateFriendCategoryReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tsortOrder\x18\x03 \x01(\x05R\tsortOrder\x12\x0e\n" +
+	"\x02ex\x18\x04 \x01(\tR\x02ex\"W\n" +
+	"\x18CreateFriendCategoryResp\x12;\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1f.openim.relation.FriendCategoryR\bcategory\"\xbb\x01\n" +
+	"\x17UpdateFriendCategoryReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x02 \x01(\tR\n" +
+	"categoryID\x120\n" +
+	"\x04name\x18\x03 \x01(\v2\x1c.openim.protobuf.StringValueR\x04name\x12,\n" +
+	"\x02ex\x18\x04 \x01(\v2\x1c.openim.protobuf.StringValueR\x02ex\"\x1a\n" +
+	"\x18UpdateFriendCategoryResp\"[\n" +
+	"\x17DeleteFriendCategoryReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x02 \x01(\tR\n" +
+	"categoryID\"\x1a\n" +
+	"\x18DeleteFriendCategoryResp\"S\n" +
+	"\x13FriendCategoryOrder\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x01 \x01(\tR\n" +
+	"categoryID\x12\x1c\n" +
+	"\tsortOrder\x18\x02 \x01(\x05R\tsortOrder\"y\n" +
+	"\x17SortFriendCategoriesReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12<\n" +
+	"\x06orders\x18\x02 \x03(\v2$.openim.relation.FriendCategoryOrderR\x06orders\"\x1a\n" +
+	"\x18SortFriendCategoriesResp\":\n" +
+	"\x16GetFriendCategoriesReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\"Z\n" +
+	"\x17GetFriendCategoriesResp\x12?\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1f.openim.relation.FriendCategoryR\n" +
+	"categories\"\x80\x01\n" +
+	"\x16SetFriendCategoriesReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\"\n" +
+	"\ffriendUserID\x18\x02 \x01(\tR\ffriendUserID\x12 \n" +
+	"\vcategoryIDs\x18\x03 \x03(\tR\vcategoryIDs\"\x19\n" +
+	"\x17SetFriendCategoriesResp\"\x81\x01\n" +
+	"\x17AddFriendsToCategoryReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x02 \x01(\tR\n" +
+	"categoryID\x12$\n" +
+	"\rfriendUserIDs\x18\x03 \x03(\tR\rfriendUserIDs\"\x1a\n" +
+	"\x18AddFriendsToCategoryResp\"\x86\x01\n" +
+	"\x1cRemoveFriendsFromCategoryReq\x12 \n" +
+	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x02 \x01(\tR\n" +
+	"categoryID\x12$\n" +
+	"\rfriendUserIDs\x18\x03 \x03(\tR\rfriendUserIDs\"\x1f\n" +
+	"\x1dRemoveFriendsFromCategoryResp\"\x8f\x01\n" +
+	"\x13replyFriendApplyReq\x12\x1e\n" +
+	"\n" +
+	"fromUserID\x18\x01 \x01(\tR\n" +
+	"fromUserID\x12\x1a\n" +
+	"\btoUserID\x18\x02 \x01(\tR\btoUserID\x12\"\n" +
+	"\fsenderUserID\x18\x03 \x01(\tR\fsenderUserID\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\"M\n" +
+	"\x14replyFriendApplyResp\x125\n" +
+	"\arequest\x18\x01 \x01(\v2\x1b.openim.sdkws.FriendRequestR\arequest\"Q\n" +
+	"\x1bexpireFriendApplicationsReq\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"4\n" +
+	"\x1cexpireFriendApplicationsResp\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"\x8a\x01\n" +
+	"\x13getMutualFriendsReq\x12\x18\n" +
+	"\auserID1\x18\x01 \x01(\tR\auserID1\x12\x18\n" +
+	"\auserID2\x18\x02 \x01(\tR\auserID2\x12?\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
+	"pagination\"h\n" +
+	"\x14getMutualFriendsResp\x12:\n" +
+	"\vfriendsInfo\x18\x01 \x03(\v2\x18.openim.sdkws.FriendInfoR\vfriendsInfo\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x88\x02\n" +
+	"\x10FriendSuggestion\x12*\n" +
+	"\x04user\x18\x01 \x01(\v2\x16.openim.sdkws.UserInfoR\x04user\x12,\n" +
+	"\x11mutualFriendCount\x18\x02 \x01(\x05R\x11mutualFriendCount\x12*\n" +
+	"\x10sharedGroupCount\x18\x03 \x01(\x05R\x10sharedGroupCount\x12&\n" +
+	"\x0esameDepartment\x18\x04 \x01(\bR\x0esameDepartment\x120\n" +
+	"\x13mutualFriendUserIDs\x18\x05 \x03(\tR\x13mutualFriendUserIDs\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\"\x9a\x01\n" +
+	"\x17getFriendSuggestionsReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12?\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1f.openim.sdkws.RequestPaginationR\n" +
+	"pagination\x12&\n" +
+	"\x0eexcludeUserIDs\x18\x03 \x03(\tR\x0eexcludeUserIDs\"u\n" +
+	"\x18getFriendSuggestionsResp\x12C\n" +
+	"\vsuggestions\x18\x01 \x03(\v2!.openim.relation.FriendSuggestionR\vsuggestions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"s\n" +
+	"!getIncrementalFriendCategoriesReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\"\x9c\x02\n" +
+	"\"getIncrementalFriendCategoriesResp\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x12\n" +
+	"\x04full\x18\x03 \x01(\bR\x04full\x12\x16\n" +
+	"\x06delete\x18\x04 \x03(\tR\x06delete\x127\n" +
+	"\x06insert\x18\x05 \x03(\v2\x1f.openim.relation.FriendCategoryR\x06insert\x127\n" +
+	"\x06update\x18\x06 \x03(\v2\x1f.openim.relation.FriendCategoryR\x06update\x12 \n" +
+	"\vsortVersion\x18\a \x01(\x04R\vsortVersion2\x94\"\n" +
 	"\x06friend\x12_\n" +
 	"\x10applyToAddFriend\x12$.openim.relation.applyToAddFriendReq\x1a%.openim.relation.applyToAddFriendResp\x12\x80\x01\n" +
 	"\x1bgetPaginationFriendsApplyTo\x12/.openim.relation.getPaginationFriendsApplyToReq\x1a0.openim.relation.getPaginationFriendsApplyToResp\x12\x86\x01\n" +
@@ -3544,7 +5274,20 @@ const file_relation_relation_proto_rawDesc = "" +
 	"\x14getFullFriendUserIDs\x12(.openim.relation.getFullFriendUserIDsReq\x1a).openim.relation.getFullFriendUserIDsResp\x12}\n" +
 	"\x1aNotificationUserInfoUpdate\x12..openim.relation.notificationUserInfoUpdateReq\x1a/.openim.relation.notificationUserInfoUpdateResp\x12V\n" +
 	"\rgetFriendInfo\x12!.openim.relation.getFriendInfoReq\x1a\".openim.relation.getFriendInfoResp\x12b\n" +
-	"\x11AddFriendCategory\x12%.openim.relation.AddFriendCategoryReq\x1a&.openim.relation.AddFriendCategoryRespB(Z&github.com/openimsdk/protocol/relationb\x06proto3"
+	"\x11AddFriendCategory\x12%.openim.relation.AddFriendCategoryReq\x1a&.openim.relation.AddFriendCategoryResp\x12k\n" +
+	"\x14CreateFriendCategory\x12(.openim.relation.CreateFriendCategoryReq\x1a).openim.relation.CreateFriendCategoryResp\x12k\n" +
+	"\x14UpdateFriendCategory\x12(.openim.relation.UpdateFriendCategoryReq\x1a).openim.relation.UpdateFriendCategoryResp\x12k\n" +
+	"\x14DeleteFriendCategory\x12(.openim.relation.DeleteFriendCategoryReq\x1a).openim.relation.DeleteFriendCategoryResp\x12k\n" +
+	"\x14SortFriendCategories\x12(.openim.relation.SortFriendCategoriesReq\x1a).openim.relation.SortFriendCategoriesResp\x12h\n" +
+	"\x13GetFriendCategories\x12'.openim.relation.GetFriendCategoriesReq\x1a(.openim.relation.GetFriendCategoriesResp\x12h\n" +
+	"\x13SetFriendCategories\x12'.openim.relation.SetFriendCategoriesReq\x1a(.openim.relation.SetFriendCategoriesResp\x12k\n" +
+	"\x14AddFriendsToCategory\x12(.openim.relation.AddFriendsToCategoryReq\x1a).openim.relation.AddFriendsToCategoryResp\x12z\n" +
+	"\x19RemoveFriendsFromCategory\x12-.openim.relation.RemoveFriendsFromCategoryReq\x1a..openim.relation.RemoveFriendsFromCategoryResp\x12\x89\x01\n" +
+	"\x1egetIncrementalFriendCategories\x122.openim.relation.getIncrementalFriendCategoriesReq\x1a3.openim.relation.getIncrementalFriendCategoriesResp\x12_\n" +
+	"\x10replyFriendApply\x12$.openim.relation.replyFriendApplyReq\x1a%.openim.relation.replyFriendApplyResp\x12w\n" +
+	"\x18expireFriendApplications\x12,.openim.relation.expireFriendApplicationsReq\x1a-.openim.relation.expireFriendApplicationsResp\x12_\n" +
+	"\x10getMutualFriends\x12$.openim.relation.getMutualFriendsReq\x1a%.openim.relation.getMutualFriendsResp\x12k\n" +
+	"\x14getFriendSuggestions\x12(.openim.relation.getFriendSuggestionsReq\x1a).openim.relation.getFriendSuggestionsRespB(Z&github.com/openimsdk/protocol/relationb\x06proto3"
 
 var (
 	file_relation_relation_proto_rawDescOnce sync.Once
@@ -3558,7 +5301,7 @@ func file_relation_relation_proto_rawDescGZIP() []byte {
 	return file_relation_relation_proto_rawDescData
 }
 
-var file_relation_relation_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_relation_relation_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_relation_relation_proto_goTypes = []any{
 	(*GetPaginationFriendsReq)(nil),            // 0: openim.relation.getPaginationFriendsReq
 	(*GetPaginationFriendsResp)(nil),           // 1: openim.relation.getPaginationFriendsResp
@@ -3619,103 +5362,171 @@ var file_relation_relation_proto_goTypes = []any{
 	(*GetFriendInfoResp)(nil),                  // 56: openim.relation.getFriendInfoResp
 	(*AddFriendCategoryReq)(nil),               // 57: openim.relation.AddFriendCategoryReq
 	(*AddFriendCategoryResp)(nil),              // 58: openim.relation.AddFriendCategoryResp
-	(*sdkws.RequestPagination)(nil),            // 59: openim.sdkws.RequestPagination
-	(*sdkws.FriendInfo)(nil),                   // 60: openim.sdkws.FriendInfo
-	(*sdkws.FriendRequest)(nil),                // 61: openim.sdkws.FriendRequest
-	(*sdkws.BlackInfo)(nil),                    // 62: openim.sdkws.BlackInfo
-	(*wrapperspb.BoolValue)(nil),               // 63: openim.protobuf.BoolValue
-	(*wrapperspb.StringValue)(nil),             // 64: openim.protobuf.StringValue
-	(*sdkws.UserInfo)(nil),                     // 65: openim.sdkws.UserInfo
+	(*FriendCategory)(nil),                     // 59: openim.relation.FriendCategory
+	(*CreateFriendCategoryReq)(nil),            // 60: openim.relation.CreateFriendCategoryReq
+	(*CreateFriendCategoryResp)(nil),           // 61: openim.relation.CreateFriendCategoryResp
+	(*UpdateFriendCategoryReq)(nil),            // 62: openim.relation.UpdateFriendCategoryReq
+	(*UpdateFriendCategoryResp)(nil),           // 63: openim.relation.UpdateFriendCategoryResp
+	(*DeleteFriendCategoryReq)(nil),            // 64: openim.relation.DeleteFriendCategoryReq
+	(*DeleteFriendCategoryResp)(nil),           // 65: openim.relation.DeleteFriendCategoryResp
+	(*FriendCategoryOrder)(nil),                // 66: openim.relation.FriendCategoryOrder
+	(*SortFriendCategoriesReq)(nil),            // 67: openim.relation.SortFriendCategoriesReq
+	(*SortFriendCategoriesResp)(nil),           // 68: openim.relation.SortFriendCategoriesResp
+	(*GetFriendCategoriesReq)(nil),             // 69: openim.relation.GetFriendCategoriesReq
+	(*GetFriendCategoriesResp)(nil),            // 70: openim.relation.GetFriendCategoriesResp
+	(*SetFriendCategoriesReq)(nil),             // 71: openim.relation.SetFriendCategoriesReq
+	(*SetFriendCategoriesResp)(nil),            // 72: openim.relation.SetFriendCategoriesResp
+	(*AddFriendsToCategoryReq)(nil),            // 73: openim.relation.AddFriendsToCategoryReq
+	(*AddFriendsToCategoryResp)(nil),           // 74: openim.relation.AddFriendsToCategoryResp
+	(*RemoveFriendsFromCategoryReq)(nil),       // 75: openim.relation.RemoveFriendsFromCategoryReq
+	(*RemoveFriendsFromCategoryResp)(nil),      // 76: openim.relation.RemoveFriendsFromCategoryResp
+	(*ReplyFriendApplyReq)(nil),                // 77: openim.relation.replyFriendApplyReq
+	(*ReplyFriendApplyResp)(nil),               // 78: openim.relation.replyFriendApplyResp
+	(*ExpireFriendApplicationsReq)(nil),        // 79: openim.relation.expireFriendApplicationsReq
+	(*ExpireFriendApplicationsResp)(nil),       // 80: openim.relation.expireFriendApplicationsResp
+	(*GetMutualFriendsReq)(nil),                // 81: openim.relation.getMutualFriendsReq
+	(*GetMutualFriendsResp)(nil),               // 82: openim.relation.getMutualFriendsResp
+	(*FriendSuggestion)(nil),                   // 83: openim.relation.FriendSuggestion
+	(*GetFriendSuggestionsReq)(nil),            // 84: openim.relation.getFriendSuggestionsReq
+	(*GetFriendSuggestionsResp)(nil),           // 85: openim.relation.getFriendSuggestionsResp
+	(*GetIncrementalFriendCategoriesReq)(nil),  // 86: openim.relation.getIncrementalFriendCategoriesReq
+	(*GetIncrementalFriendCategoriesResp)(nil), // 87: openim.relation.getIncrementalFriendCategoriesResp
+	(*sdkws.RequestPagination)(nil),            // 88: openim.sdkws.RequestPagination
+	(*sdkws.FriendInfo)(nil),                   // 89: openim.sdkws.FriendInfo
+	(*sdkws.FriendRequest)(nil),                // 90: openim.sdkws.FriendRequest
+	(*sdkws.BlackInfo)(nil),                    // 91: openim.sdkws.BlackInfo
+	(*wrapperspb.BoolValue)(nil),               // 92: openim.protobuf.BoolValue
+	(*wrapperspb.StringValue)(nil),             // 93: openim.protobuf.StringValue
+	(*sdkws.UserInfo)(nil),                     // 94: openim.sdkws.UserInfo
 }
 var file_relation_relation_proto_depIdxs = []int32{
-	59, // 0: openim.relation.getPaginationFriendsReq.pagination:type_name -> openim.sdkws.RequestPagination
-	60, // 1: openim.relation.getPaginationFriendsResp.friendsInfo:type_name -> openim.sdkws.FriendInfo
-	59, // 2: openim.relation.getPaginationFriendsApplyToReq.pagination:type_name -> openim.sdkws.RequestPagination
-	61, // 3: openim.relation.getPaginationFriendsApplyToResp.FriendRequests:type_name -> openim.sdkws.FriendRequest
-	61, // 4: openim.relation.getDesignatedFriendsApplyResp.friendRequests:type_name -> openim.sdkws.FriendRequest
-	61, // 5: openim.relation.getIncrementalFriendsApplyToResp.changes:type_name -> openim.sdkws.FriendRequest
-	61, // 6: openim.relation.getIncrementalFriendsApplyFromResp.changes:type_name -> openim.sdkws.FriendRequest
-	60, // 7: openim.relation.getDesignatedFriendsResp.friendsInfo:type_name -> openim.sdkws.FriendInfo
-	59, // 8: openim.relation.getPaginationBlacksReq.pagination:type_name -> openim.sdkws.RequestPagination
-	62, // 9: openim.relation.getPaginationBlacksResp.blacks:type_name -> openim.sdkws.BlackInfo
-	63, // 10: openim.relation.updateFriendsReq.isPinned:type_name -> openim.protobuf.BoolValue
-	64, // 11: openim.relation.updateFriendsReq.remark:type_name -> openim.protobuf.StringValue
-	64, // 12: openim.relation.updateFriendsReq.ex:type_name -> openim.protobuf.StringValue
-	59, // 13: openim.relation.getPaginationFriendsApplyFromReq.pagination:type_name -> openim.sdkws.RequestPagination
-	61, // 14: openim.relation.getPaginationFriendsApplyFromResp.friendRequests:type_name -> openim.sdkws.FriendRequest
-	65, // 15: openim.relation.getSpecifiedFriendsInfoInfo.userInfo:type_name -> openim.sdkws.UserInfo
-	60, // 16: openim.relation.getSpecifiedFriendsInfoInfo.friendInfo:type_name -> openim.sdkws.FriendInfo
-	62, // 17: openim.relation.getSpecifiedFriendsInfoInfo.blackInfo:type_name -> openim.sdkws.BlackInfo
+	88, // 0: openim.relation.getPaginationFriendsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	89, // 1: openim.relation.getPaginationFriendsResp.friendsInfo:type_name -> openim.sdkws.FriendInfo
+	88, // 2: openim.relation.getPaginationFriendsApplyToReq.pagination:type_name -> openim.sdkws.RequestPagination
+	90, // 3: openim.relation.getPaginationFriendsApplyToResp.FriendRequests:type_name -> openim.sdkws.FriendRequest
+	90, // 4: openim.relation.getDesignatedFriendsApplyResp.friendRequests:type_name -> openim.sdkws.FriendRequest
+	90, // 5: openim.relation.getIncrementalFriendsApplyToResp.changes:type_name -> openim.sdkws.FriendRequest
+	90, // 6: openim.relation.getIncrementalFriendsApplyFromResp.changes:type_name -> openim.sdkws.FriendRequest
+	89, // 7: openim.relation.getDesignatedFriendsResp.friendsInfo:type_name -> openim.sdkws.FriendInfo
+	88, // 8: openim.relation.getPaginationBlacksReq.pagination:type_name -> openim.sdkws.RequestPagination
+	91, // 9: openim.relation.getPaginationBlacksResp.blacks:type_name -> openim.sdkws.BlackInfo
+	92, // 10: openim.relation.updateFriendsReq.isPinned:type_name -> openim.protobuf.BoolValue
+	93, // 11: openim.relation.updateFriendsReq.remark:type_name -> openim.protobuf.StringValue
+	93, // 12: openim.relation.updateFriendsReq.ex:type_name -> openim.protobuf.StringValue
+	88, // 13: openim.relation.getPaginationFriendsApplyFromReq.pagination:type_name -> openim.sdkws.RequestPagination
+	90, // 14: openim.relation.getPaginationFriendsApplyFromResp.friendRequests:type_name -> openim.sdkws.FriendRequest
+	94, // 15: openim.relation.getSpecifiedFriendsInfoInfo.userInfo:type_name -> openim.sdkws.UserInfo
+	89, // 16: openim.relation.getSpecifiedFriendsInfoInfo.friendInfo:type_name -> openim.sdkws.FriendInfo
+	91, // 17: openim.relation.getSpecifiedFriendsInfoInfo.blackInfo:type_name -> openim.sdkws.BlackInfo
 	42, // 18: openim.relation.getSpecifiedFriendsInfoResp.infos:type_name -> openim.relation.getSpecifiedFriendsInfoInfo
-	60, // 19: openim.relation.getIncrementalFriendsResp.insert:type_name -> openim.sdkws.FriendInfo
-	60, // 20: openim.relation.getIncrementalFriendsResp.update:type_name -> openim.sdkws.FriendInfo
-	62, // 21: openim.relation.getIncrementalBlacksResp.insert:type_name -> openim.sdkws.BlackInfo
-	62, // 22: openim.relation.getIncrementalBlacksResp.update:type_name -> openim.sdkws.BlackInfo
-	62, // 23: openim.relation.GetSpecifiedBlacksResp.blacks:type_name -> openim.sdkws.BlackInfo
-	65, // 24: openim.relation.notificationUserInfoUpdateReq.oldUserInfo:type_name -> openim.sdkws.UserInfo
-	65, // 25: openim.relation.notificationUserInfoUpdateReq.newUserInfo:type_name -> openim.sdkws.UserInfo
+	89, // 19: openim.relation.getIncrementalFriendsResp.insert:type_name -> openim.sdkws.FriendInfo
+	89, // 20: openim.relation.getIncrementalFriendsResp.update:type_name -> openim.sdkws.FriendInfo
+	91, // 21: openim.relation.getIncrementalBlacksResp.insert:type_name -> openim.sdkws.BlackInfo
+	91, // 22: openim.relation.getIncrementalBlacksResp.update:type_name -> openim.sdkws.BlackInfo
+	91, // 23: openim.relation.GetSpecifiedBlacksResp.blacks:type_name -> openim.sdkws.BlackInfo
+	94, // 24: openim.relation.notificationUserInfoUpdateReq.oldUserInfo:type_name -> openim.sdkws.UserInfo
+	94, // 25: openim.relation.notificationUserInfoUpdateReq.newUserInfo:type_name -> openim.sdkws.UserInfo
 	54, // 26: openim.relation.getFriendInfoResp.friendInfos:type_name -> openim.relation.FriendInfoOnly
-	2,  // 27: openim.relation.friend.applyToAddFriend:input_type -> openim.relation.applyToAddFriendReq
-	7,  // 28: openim.relation.friend.getPaginationFriendsApplyTo:input_type -> openim.relation.getPaginationFriendsApplyToReq
-	37, // 29: openim.relation.friend.getPaginationFriendsApplyFrom:input_type -> openim.relation.getPaginationFriendsApplyFromReq
-	11, // 30: openim.relation.friend.getSelfUnhandledApplyCount:input_type -> openim.relation.getSelfUnhandledApplyCountReq
-	9,  // 31: openim.relation.friend.getDesignatedFriendsApply:input_type -> openim.relation.getDesignatedFriendsApplyReq
-	13, // 32: openim.relation.friend.getIncrementalFriendsApplyTo:input_type -> openim.relation.getIncrementalFriendsApplyToReq
-	15, // 33: openim.relation.friend.getIncrementalFriendsApplyFrom:input_type -> openim.relation.getIncrementalFriendsApplyFromReq
-	19, // 34: openim.relation.friend.addBlack:input_type -> openim.relation.addBlackReq
-	21, // 35: openim.relation.friend.removeBlack:input_type -> openim.relation.removeBlackReq
-	25, // 36: openim.relation.friend.isFriend:input_type -> openim.relation.isFriendReq
-	27, // 37: openim.relation.friend.isBlack:input_type -> openim.relation.isBlackReq
-	23, // 38: openim.relation.friend.getPaginationBlacks:input_type -> openim.relation.getPaginationBlacksReq
-	48, // 39: openim.relation.friend.GetSpecifiedBlacks:input_type -> openim.relation.GetSpecifiedBlacksReq
-	29, // 40: openim.relation.friend.deleteFriend:input_type -> openim.relation.deleteFriendReq
-	31, // 41: openim.relation.friend.respondFriendApply:input_type -> openim.relation.respondFriendApplyReq
-	33, // 42: openim.relation.friend.updateFriends:input_type -> openim.relation.updateFriendsReq
-	35, // 43: openim.relation.friend.setFriendRemark:input_type -> openim.relation.setFriendRemarkReq
-	5,  // 44: openim.relation.friend.importFriends:input_type -> openim.relation.importFriendReq
-	17, // 45: openim.relation.friend.getDesignatedFriends:input_type -> openim.relation.getDesignatedFriendsReq
-	0,  // 46: openim.relation.friend.getPaginationFriends:input_type -> openim.relation.getPaginationFriendsReq
-	39, // 47: openim.relation.friend.getFriendIDs:input_type -> openim.relation.getFriendIDsReq
-	41, // 48: openim.relation.friend.GetSpecifiedFriendsInfo:input_type -> openim.relation.getSpecifiedFriendsInfoReq
-	44, // 49: openim.relation.friend.getIncrementalFriends:input_type -> openim.relation.getIncrementalFriendsReq
-	46, // 50: openim.relation.friend.getIncrementalBlacks:input_type -> openim.relation.getIncrementalBlacksReq
-	50, // 51: openim.relation.friend.getFullFriendUserIDs:input_type -> openim.relation.getFullFriendUserIDsReq
-	52, // 52: openim.relation.friend.NotificationUserInfoUpdate:input_type -> openim.relation.notificationUserInfoUpdateReq
-	55, // 53: openim.relation.friend.getFriendInfo:input_type -> openim.relation.getFriendInfoReq
-	57, // 54: openim.relation.friend.AddFriendCategory:input_type -> openim.relation.AddFriendCategoryReq
-	3,  // 55: openim.relation.friend.applyToAddFriend:output_type -> openim.relation.applyToAddFriendResp
-	8,  // 56: openim.relation.friend.getPaginationFriendsApplyTo:output_type -> openim.relation.getPaginationFriendsApplyToResp
-	38, // 57: openim.relation.friend.getPaginationFriendsApplyFrom:output_type -> openim.relation.getPaginationFriendsApplyFromResp
-	12, // 58: openim.relation.friend.getSelfUnhandledApplyCount:output_type -> openim.relation.getSelfUnhandledApplyCountResp
-	10, // 59: openim.relation.friend.getDesignatedFriendsApply:output_type -> openim.relation.getDesignatedFriendsApplyResp
-	14, // 60: openim.relation.friend.getIncrementalFriendsApplyTo:output_type -> openim.relation.getIncrementalFriendsApplyToResp
-	16, // 61: openim.relation.friend.getIncrementalFriendsApplyFrom:output_type -> openim.relation.getIncrementalFriendsApplyFromResp
-	20, // 62: openim.relation.friend.addBlack:output_type -> openim.relation.addBlackResp
-	22, // 63: openim.relation.friend.removeBlack:output_type -> openim.relation.removeBlackResp
-	26, // 64: openim.relation.friend.isFriend:output_type -> openim.relation.isFriendResp
-	28, // 65: openim.relation.friend.isBlack:output_type -> openim.relation.isBlackResp
-	24, // 66: openim.relation.friend.getPaginationBlacks:output_type -> openim.relation.getPaginationBlacksResp
-	49, // 67: openim.relation.friend.GetSpecifiedBlacks:output_type -> openim.relation.GetSpecifiedBlacksResp
-	30, // 68: openim.relation.friend.deleteFriend:output_type -> openim.relation.deleteFriendResp
-	32, // 69: openim.relation.friend.respondFriendApply:output_type -> openim.relation.respondFriendApplyResp
-	34, // 70: openim.relation.friend.updateFriends:output_type -> openim.relation.updateFriendsResp
-	36, // 71: openim.relation.friend.setFriendRemark:output_type -> openim.relation.setFriendRemarkResp
-	6,  // 72: openim.relation.friend.importFriends:output_type -> openim.relation.importFriendResp
-	18, // 73: openim.relation.friend.getDesignatedFriends:output_type -> openim.relation.getDesignatedFriendsResp
-	1,  // 74: openim.relation.friend.getPaginationFriends:output_type -> openim.relation.getPaginationFriendsResp
-	40, // 75: openim.relation.friend.getFriendIDs:output_type -> openim.relation.getFriendIDsResp
-	43, // 76: openim.relation.friend.GetSpecifiedFriendsInfo:output_type -> openim.relation.getSpecifiedFriendsInfoResp
-	45, // 77: openim.relation.friend.getIncrementalFriends:output_type -> openim.relation.getIncrementalFriendsResp
-	47, // 78: openim.relation.friend.getIncrementalBlacks:output_type -> openim.relation.getIncrementalBlacksResp
-	51, // 79: openim.relation.friend.getFullFriendUserIDs:output_type -> openim.relation.getFullFriendUserIDsResp
-	53, // 80: openim.relation.friend.NotificationUserInfoUpdate:output_type -> openim.relation.notificationUserInfoUpdateResp
-	56, // 81: openim.relation.friend.getFriendInfo:output_type -> openim.relation.getFriendInfoResp
-	58, // 82: openim.relation.friend.AddFriendCategory:output_type -> openim.relation.AddFriendCategoryResp
-	55, // [55:83] is the sub-list for method output_type
-	27, // [27:55] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	59, // 27: openim.relation.CreateFriendCategoryResp.category:type_name -> openim.relation.FriendCategory
+	93, // 28: openim.relation.UpdateFriendCategoryReq.name:type_name -> openim.protobuf.StringValue
+	93, // 29: openim.relation.UpdateFriendCategoryReq.ex:type_name -> openim.protobuf.StringValue
+	66, // 30: openim.relation.SortFriendCategoriesReq.orders:type_name -> openim.relation.FriendCategoryOrder
+	59, // 31: openim.relation.GetFriendCategoriesResp.categories:type_name -> openim.relation.FriendCategory
+	90, // 32: openim.relation.replyFriendApplyResp.request:type_name -> openim.sdkws.FriendRequest
+	88, // 33: openim.relation.getMutualFriendsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	89, // 34: openim.relation.getMutualFriendsResp.friendsInfo:type_name -> openim.sdkws.FriendInfo
+	94, // 35: openim.relation.FriendSuggestion.user:type_name -> openim.sdkws.UserInfo
+	88, // 36: openim.relation.getFriendSuggestionsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	83, // 37: openim.relation.getFriendSuggestionsResp.suggestions:type_name -> openim.relation.FriendSuggestion
+	59, // 38: openim.relation.getIncrementalFriendCategoriesResp.insert:type_name -> openim.relation.FriendCategory
+	59, // 39: openim.relation.getIncrementalFriendCategoriesResp.update:type_name -> openim.relation.FriendCategory
+	2,  // 40: openim.relation.friend.applyToAddFriend:input_type -> openim.relation.applyToAddFriendReq
+	7,  // 41: openim.relation.friend.getPaginationFriendsApplyTo:input_type -> openim.relation.getPaginationFriendsApplyToReq
+	37, // 42: openim.relation.friend.getPaginationFriendsApplyFrom:input_type -> openim.relation.getPaginationFriendsApplyFromReq
+	11, // 43: openim.relation.friend.getSelfUnhandledApplyCount:input_type -> openim.relation.getSelfUnhandledApplyCountReq
+	9,  // 44: openim.relation.friend.getDesignatedFriendsApply:input_type -> openim.relation.getDesignatedFriendsApplyReq
+	13, // 45: openim.relation.friend.getIncrementalFriendsApplyTo:input_type -> openim.relation.getIncrementalFriendsApplyToReq
+	15, // 46: openim.relation.friend.getIncrementalFriendsApplyFrom:input_type -> openim.relation.getIncrementalFriendsApplyFromReq
+	19, // 47: openim.relation.friend.addBlack:input_type -> openim.relation.addBlackReq
+	21, // 48: openim.relation.friend.removeBlack:input_type -> openim.relation.removeBlackReq
+	25, // 49: openim.relation.friend.isFriend:input_type -> openim.relation.isFriendReq
+	27, // 50: openim.relation.friend.isBlack:input_type -> openim.relation.isBlackReq
+	23, // 51: openim.relation.friend.getPaginationBlacks:input_type -> openim.relation.getPaginationBlacksReq
+	48, // 52: openim.relation.friend.GetSpecifiedBlacks:input_type -> openim.relation.GetSpecifiedBlacksReq
+	29, // 53: openim.relation.friend.deleteFriend:input_type -> openim.relation.deleteFriendReq
+	31, // 54: openim.relation.friend.respondFriendApply:input_type -> openim.relation.respondFriendApplyReq
+	33, // 55: openim.relation.friend.updateFriends:input_type -> openim.relation.updateFriendsReq
+	35, // 56: openim.relation.friend.setFriendRemark:input_type -> openim.relation.setFriendRemarkReq
+	5,  // 57: openim.relation.friend.importFriends:input_type -> openim.relation.importFriendReq
+	17, // 58: openim.relation.friend.getDesignatedFriends:input_type -> openim.relation.getDesignatedFriendsReq
+	0,  // 59: openim.relation.friend.getPaginationFriends:input_type -> openim.relation.getPaginationFriendsReq
+	39, // 60: openim.relation.friend.getFriendIDs:input_type -> openim.relation.getFriendIDsReq
+	41, // 61: openim.relation.friend.GetSpecifiedFriendsInfo:input_type -> openim.relation.getSpecifiedFriendsInfoReq
+	44, // 62: openim.relation.friend.getIncrementalFriends:input_type -> openim.relation.getIncrementalFriendsReq
+	46, // 63: openim.relation.friend.getIncrementalBlacks:input_type -> openim.relation.getIncrementalBlacksReq
+	50, // 64: openim.relation.friend.getFullFriendUserIDs:input_type -> openim.relation.getFullFriendUserIDsReq
+	52, // 65: openim.relation.friend.NotificationUserInfoUpdate:input_type -> openim.relation.notificationUserInfoUpdateReq
+	55, // 66: openim.relation.friend.getFriendInfo:input_type -> openim.relation.getFriendInfoReq
+	57, // 67: openim.relation.friend.AddFriendCategory:input_type -> openim.relation.AddFriendCategoryReq
+	60, // 68: openim.relation.friend.CreateFriendCategory:input_type -> openim.relation.CreateFriendCategoryReq
+	62, // 69: openim.relation.friend.UpdateFriendCategory:input_type -> openim.relation.UpdateFriendCategoryReq
+	64, // 70: openim.relation.friend.DeleteFriendCategory:input_type -> openim.relation.DeleteFriendCategoryReq
+	67, // 71: openim.relation.friend.SortFriendCategories:input_type -> openim.relation.SortFriendCategoriesReq
+	69, // 72: openim.relation.friend.GetFriendCategories:input_type -> openim.relation.GetFriendCategoriesReq
+	71, // 73: openim.relation.friend.SetFriendCategories:input_type -> openim.relation.SetFriendCategoriesReq
+	73, // 74: openim.relation.friend.AddFriendsToCategory:input_type -> openim.relation.AddFriendsToCategoryReq
+	75, // 75: openim.relation.friend.RemoveFriendsFromCategory:input_type -> openim.relation.RemoveFriendsFromCategoryReq
+	86, // 76: openim.relation.friend.getIncrementalFriendCategories:input_type -> openim.relation.getIncrementalFriendCategoriesReq
+	77, // 77: openim.relation.friend.replyFriendApply:input_type -> openim.relation.replyFriendApplyReq
+	79, // 78: openim.relation.friend.expireFriendApplications:input_type -> openim.relation.expireFriendApplicationsReq
+	81, // 79: openim.relation.friend.getMutualFriends:input_type -> openim.relation.getMutualFriendsReq
+	84, // 80: openim.relation.friend.getFriendSuggestions:input_type -> openim.relation.getFriendSuggestionsReq
+	3,  // 81: openim.relation.friend.applyToAddFriend:output_type -> openim.relation.applyToAddFriendResp
+	8,  // 82: openim.relation.friend.getPaginationFriendsApplyTo:output_type -> openim.relation.getPaginationFriendsApplyToResp
+	38, // 83: openim.relation.friend.getPaginationFriendsApplyFrom:output_type -> openim.relation.getPaginationFriendsApplyFromResp
+	12, // 84: openim.relation.friend.getSelfUnhandledApplyCount:output_type -> openim.relation.getSelfUnhandledApplyCountResp
+	10, // 85: openim.relation.friend.getDesignatedFriendsApply:output_type -> openim.relation.getDesignatedFriendsApplyResp
+	14, // 86: openim.relation.friend.getIncrementalFriendsApplyTo:output_type -> openim.relation.getIncrementalFriendsApplyToResp
+	16, // 87: openim.relation.friend.getIncrementalFriendsApplyFrom:output_type -> openim.relation.getIncrementalFriendsApplyFromResp
+	20, // 88: openim.relation.friend.addBlack:output_type -> openim.relation.addBlackResp
+	22, // 89: openim.relation.friend.removeBlack:output_type -> openim.relation.removeBlackResp
+	26, // 90: openim.relation.friend.isFriend:output_type -> openim.relation.isFriendResp
+	28, // 91: openim.relation.friend.isBlack:output_type -> openim.relation.isBlackResp
+	24, // 92: openim.relation.friend.getPaginationBlacks:output_type -> openim.relation.getPaginationBlacksResp
+	49, // 93: openim.relation.friend.GetSpecifiedBlacks:output_type -> openim.relation.GetSpecifiedBlacksResp
+	30, // 94: openim.relation.friend.deleteFriend:output_type -> openim.relation.deleteFriendResp
+	32, // 95: openim.relation.friend.respondFriendApply:output_type -> openim.relation.respondFriendApplyResp
+	34, // 96: openim.relation.friend.updateFriends:output_type -> openim.relation.updateFriendsResp
+	36, // 97: openim.relation.friend.setFriendRemark:output_type -> openim.relation.setFriendRemarkResp
+	6,  // 98: openim.relation.friend.importFriends:output_type -> openim.relation.importFriendResp
+	18, // 99: openim.relation.friend.getDesignatedFriends:output_type -> openim.relation.getDesignatedFriendsResp
+	1,  // 100: openim.relation.friend.getPaginationFriends:output_type -> openim.relation.getPaginationFriendsResp
+	40, // 101: openim.relation.friend.getFriendIDs:output_type -> openim.relation.getFriendIDsResp
+	43, // 102: openim.relation.friend.GetSpecifiedFriendsInfo:output_type -> openim.relation.getSpecifiedFriendsInfoResp
+	45, // 103: openim.relation.friend.getIncrementalFriends:output_type -> openim.relation.getIncrementalFriendsResp
+	47, // 104: openim.relation.friend.getIncrementalBlacks:output_type -> openim.relation.getIncrementalBlacksResp
+	51, // 105: openim.relation.friend.getFullFriendUserIDs:output_type -> openim.relation.getFullFriendUserIDsResp
+	53, // 106: openim.relation.friend.NotificationUserInfoUpdate:output_type -> openim.relation.notificationUserInfoUpdateResp
+	56, // 107: openim.relation.friend.getFriendInfo:output_type -> openim.relation.getFriendInfoResp
+	58, // 108: openim.relation.friend.AddFriendCategory:output_type -> openim.relation.AddFriendCategoryResp
+	61, // 109: openim.relation.friend.CreateFriendCategory:output_type -> openim.relation.CreateFriendCategoryResp
+	63, // 110: openim.relation.friend.UpdateFriendCategory:output_type -> openim.relation.UpdateFriendCategoryResp
+	65, // 111: openim.relation.friend.DeleteFriendCategory:output_type -> openim.relation.DeleteFriendCategoryResp
+	68, // 112: openim.relation.friend.SortFriendCategories:output_type -> openim.relation.SortFriendCategoriesResp
+	70, // 113: openim.relation.friend.GetFriendCategories:output_type -> openim.relation.GetFriendCategoriesResp
+	72, // 114: openim.relation.friend.SetFriendCategories:output_type -> openim.relation.SetFriendCategoriesResp
+	74, // 115: openim.relation.friend.AddFriendsToCategory:output_type -> openim.relation.AddFriendsToCategoryResp
+	76, // 116: openim.relation.friend.RemoveFriendsFromCategory:output_type -> openim.relation.RemoveFriendsFromCategoryResp
+	87, // 117: openim.relation.friend.getIncrementalFriendCategories:output_type -> openim.relation.getIncrementalFriendCategoriesResp
+	78, // 118: openim.relation.friend.replyFriendApply:output_type -> openim.relation.replyFriendApplyResp
+	80, // 119: openim.relation.friend.expireFriendApplications:output_type -> openim.relation.expireFriendApplicationsResp
+	82, // 120: openim.relation.friend.getMutualFriends:output_type -> openim.relation.getMutualFriendsResp
+	85, // 121: openim.relation.friend.getFriendSuggestions:output_type -> openim.relation.getFriendSuggestionsResp
+	81, // [81:122] is the sub-list for method output_type
+	40, // [40:81] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_relation_relation_proto_init() }
@@ -3729,7 +5540,7 @@ func file_relation_relation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_relation_relation_proto_rawDesc), len(file_relation_relation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   59,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
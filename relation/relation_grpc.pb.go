@@ -61,6 +61,19 @@ const (
 	Friend_NotificationUserInfoUpdate_FullMethodName     = "/openim.relation.friend/NotificationUserInfoUpdate"
 	Friend_GetFriendInfo_FullMethodName                  = "/openim.relation.friend/getFriendInfo"
 	Friend_AddFriendCategory_FullMethodName              = "/openim.relation.friend/AddFriendCategory"
+	Friend_CreateFriendCategory_FullMethodName           = "/openim.relation.friend/CreateFriendCategory"
+	Friend_UpdateFriendCategory_FullMethodName           = "/openim.relation.friend/UpdateFriendCategory"
+	Friend_DeleteFriendCategory_FullMethodName           = "/openim.relation.friend/DeleteFriendCategory"
+	Friend_SortFriendCategories_FullMethodName           = "/openim.relation.friend/SortFriendCategories"
+	Friend_GetFriendCategories_FullMethodName            = "/openim.relation.friend/GetFriendCategories"
+	Friend_SetFriendCategories_FullMethodName            = "/openim.relation.friend/SetFriendCategories"
+	Friend_AddFriendsToCategory_FullMethodName           = "/openim.relation.friend/AddFriendsToCategory"
+	Friend_RemoveFriendsFromCategory_FullMethodName      = "/openim.relation.friend/RemoveFriendsFromCategory"
+	Friend_GetIncrementalFriendCategories_FullMethodName = "/openim.relation.friend/getIncrementalFriendCategories"
+	Friend_ReplyFriendApply_FullMethodName               = "/openim.relation.friend/replyFriendApply"
+	Friend_ExpireFriendApplications_FullMethodName       = "/openim.relation.friend/expireFriendApplications"
+	Friend_GetMutualFriends_FullMethodName               = "/openim.relation.friend/getMutualFriends"
+	Friend_GetFriendSuggestions_FullMethodName           = "/openim.relation.friend/getFriendSuggestions"
 )
 
 // FriendClient is the client API for Friend service.
@@ -120,6 +133,25 @@ type FriendClient interface {
 	GetFriendInfo(ctx context.Context, in *GetFriendInfoReq, opts ...grpc.CallOption) (*GetFriendInfoResp, error)
 	// 定义一个 AddFriendCategory 的 RPC 方法
 	AddFriendCategory(ctx context.Context, in *AddFriendCategoryReq, opts ...grpc.CallOption) (*AddFriendCategoryResp, error)
+	// 好友分组相关接口
+	CreateFriendCategory(ctx context.Context, in *CreateFriendCategoryReq, opts ...grpc.CallOption) (*CreateFriendCategoryResp, error)
+	UpdateFriendCategory(ctx context.Context, in *UpdateFriendCategoryReq, opts ...grpc.CallOption) (*UpdateFriendCategoryResp, error)
+	DeleteFriendCategory(ctx context.Context, in *DeleteFriendCategoryReq, opts ...grpc.CallOption) (*DeleteFriendCategoryResp, error)
+	SortFriendCategories(ctx context.Context, in *SortFriendCategoriesReq, opts ...grpc.CallOption) (*SortFriendCategoriesResp, error)
+	GetFriendCategories(ctx context.Context, in *GetFriendCategoriesReq, opts ...grpc.CallOption) (*GetFriendCategoriesResp, error)
+	SetFriendCategories(ctx context.Context, in *SetFriendCategoriesReq, opts ...grpc.CallOption) (*SetFriendCategoriesResp, error)
+	AddFriendsToCategory(ctx context.Context, in *AddFriendsToCategoryReq, opts ...grpc.CallOption) (*AddFriendsToCategoryResp, error)
+	RemoveFriendsFromCategory(ctx context.Context, in *RemoveFriendsFromCategoryReq, opts ...grpc.CallOption) (*RemoveFriendsFromCategoryResp, error)
+	GetIncrementalFriendCategories(ctx context.Context, in *GetIncrementalFriendCategoriesReq, opts ...grpc.CallOption) (*GetIncrementalFriendCategoriesResp, error)
+	// Reply to an unhandled friend request
+	ReplyFriendApply(ctx context.Context, in *ReplyFriendApplyReq, opts ...grpc.CallOption) (*ReplyFriendApplyResp, error)
+	// Expire unhandled friend requests, called by the cron task
+	ExpireFriendApplications(ctx context.Context, in *ExpireFriendApplicationsReq, opts ...grpc.CallOption) (*ExpireFriendApplicationsResp, error)
+	// Get friends shared by two users
+	GetMutualFriends(ctx context.Context, in *GetMutualFriendsReq, opts ...grpc.CallOption) (*GetMutualFriendsResp, error)
+	// Suggest contacts ranked by mutual friends, shared groups and OA department,
+	// users whose privacy settings hide them (constant.PrivacyItemDiscoverable) are filtered out
+	GetFriendSuggestions(ctx context.Context, in *GetFriendSuggestionsReq, opts ...grpc.CallOption) (*GetFriendSuggestionsResp, error)
 }
 
 type friendClient struct {
@@ -410,6 +442,136 @@ func (c *friendClient) AddFriendCategory(ctx context.Context, in *AddFriendCateg
 	return out, nil
 }
 
+func (c *friendClient) CreateFriendCategory(ctx context.Context, in *CreateFriendCategoryReq, opts ...grpc.CallOption) (*CreateFriendCategoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFriendCategoryResp)
+	err := c.cc.Invoke(ctx, Friend_CreateFriendCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) UpdateFriendCategory(ctx context.Context, in *UpdateFriendCategoryReq, opts ...grpc.CallOption) (*UpdateFriendCategoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFriendCategoryResp)
+	err := c.cc.Invoke(ctx, Friend_UpdateFriendCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) DeleteFriendCategory(ctx context.Context, in *DeleteFriendCategoryReq, opts ...grpc.CallOption) (*DeleteFriendCategoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFriendCategoryResp)
+	err := c.cc.Invoke(ctx, Friend_DeleteFriendCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) SortFriendCategories(ctx context.Context, in *SortFriendCategoriesReq, opts ...grpc.CallOption) (*SortFriendCategoriesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SortFriendCategoriesResp)
+	err := c.cc.Invoke(ctx, Friend_SortFriendCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) GetFriendCategories(ctx context.Context, in *GetFriendCategoriesReq, opts ...grpc.CallOption) (*GetFriendCategoriesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFriendCategoriesResp)
+	err := c.cc.Invoke(ctx, Friend_GetFriendCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) SetFriendCategories(ctx context.Context, in *SetFriendCategoriesReq, opts ...grpc.CallOption) (*SetFriendCategoriesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFriendCategoriesResp)
+	err := c.cc.Invoke(ctx, Friend_SetFriendCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) AddFriendsToCategory(ctx context.Context, in *AddFriendsToCategoryReq, opts ...grpc.CallOption) (*AddFriendsToCategoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddFriendsToCategoryResp)
+	err := c.cc.Invoke(ctx, Friend_AddFriendsToCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) RemoveFriendsFromCategory(ctx context.Context, in *RemoveFriendsFromCategoryReq, opts ...grpc.CallOption) (*RemoveFriendsFromCategoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFriendsFromCategoryResp)
+	err := c.cc.Invoke(ctx, Friend_RemoveFriendsFromCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) GetIncrementalFriendCategories(ctx context.Context, in *GetIncrementalFriendCategoriesReq, opts ...grpc.CallOption) (*GetIncrementalFriendCategoriesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIncrementalFriendCategoriesResp)
+	err := c.cc.Invoke(ctx, Friend_GetIncrementalFriendCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) ReplyFriendApply(ctx context.Context, in *ReplyFriendApplyReq, opts ...grpc.CallOption) (*ReplyFriendApplyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplyFriendApplyResp)
+	err := c.cc.Invoke(ctx, Friend_ReplyFriendApply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) ExpireFriendApplications(ctx context.Context, in *ExpireFriendApplicationsReq, opts ...grpc.CallOption) (*ExpireFriendApplicationsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpireFriendApplicationsResp)
+	err := c.cc.Invoke(ctx, Friend_ExpireFriendApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) GetMutualFriends(ctx context.Context, in *GetMutualFriendsReq, opts ...grpc.CallOption) (*GetMutualFriendsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMutualFriendsResp)
+	err := c.cc.Invoke(ctx, Friend_GetMutualFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) GetFriendSuggestions(ctx context.Context, in *GetFriendSuggestionsReq, opts ...grpc.CallOption) (*GetFriendSuggestionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFriendSuggestionsResp)
+	err := c.cc.Invoke(ctx, Friend_GetFriendSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FriendServer is the server API for Friend service.
 // All implementations must embed UnimplementedFriendServer
 // for forward compatibility.
@@ -467,6 +629,25 @@ type FriendServer interface {
 	GetFriendInfo(context.Context, *GetFriendInfoReq) (*GetFriendInfoResp, error)
 	// 定义一个 AddFriendCategory 的 RPC 方法
 	AddFriendCategory(context.Context, *AddFriendCategoryReq) (*AddFriendCategoryResp, error)
+	// 好友分组相关接口
+	CreateFriendCategory(context.Context, *CreateFriendCategoryReq) (*CreateFriendCategoryResp, error)
+	UpdateFriendCategory(context.Context, *UpdateFriendCategoryReq) (*UpdateFriendCategoryResp, error)
+	DeleteFriendCategory(context.Context, *DeleteFriendCategoryReq) (*DeleteFriendCategoryResp, error)
+	SortFriendCategories(context.Context, *SortFriendCategoriesReq) (*SortFriendCategoriesResp, error)
+	GetFriendCategories(context.Context, *GetFriendCategoriesReq) (*GetFriendCategoriesResp, error)
+	SetFriendCategories(context.Context, *SetFriendCategoriesReq) (*SetFriendCategoriesResp, error)
+	AddFriendsToCategory(context.Context, *AddFriendsToCategoryReq) (*AddFriendsToCategoryResp, error)
+	RemoveFriendsFromCategory(context.Context, *RemoveFriendsFromCategoryReq) (*RemoveFriendsFromCategoryResp, error)
+	GetIncrementalFriendCategories(context.Context, *GetIncrementalFriendCategoriesReq) (*GetIncrementalFriendCategoriesResp, error)
+	// Reply to an unhandled friend request
+	ReplyFriendApply(context.Context, *ReplyFriendApplyReq) (*ReplyFriendApplyResp, error)
+	// Expire unhandled friend requests, called by the cron task
+	ExpireFriendApplications(context.Context, *ExpireFriendApplicationsReq) (*ExpireFriendApplicationsResp, error)
+	// Get friends shared by two users
+	GetMutualFriends(context.Context, *GetMutualFriendsReq) (*GetMutualFriendsResp, error)
+	// Suggest contacts ranked by mutual friends, shared groups and OA department,
+	// users whose privacy settings hide them (constant.PrivacyItemDiscoverable) are filtered out
+	GetFriendSuggestions(context.Context, *GetFriendSuggestionsReq) (*GetFriendSuggestionsResp, error)
 	mustEmbedUnimplementedFriendServer()
 }
 
@@ -561,6 +742,45 @@ func (UnimplementedFriendServer) GetFriendInfo(context.Context, *GetFriendInfoRe
 func (UnimplementedFriendServer) AddFriendCategory(context.Context, *AddFriendCategoryReq) (*AddFriendCategoryResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method AddFriendCategory not implemented")
 }
+func (UnimplementedFriendServer) CreateFriendCategory(context.Context, *CreateFriendCategoryReq) (*CreateFriendCategoryResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFriendCategory not implemented")
+}
+func (UnimplementedFriendServer) UpdateFriendCategory(context.Context, *UpdateFriendCategoryReq) (*UpdateFriendCategoryResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateFriendCategory not implemented")
+}
+func (UnimplementedFriendServer) DeleteFriendCategory(context.Context, *DeleteFriendCategoryReq) (*DeleteFriendCategoryResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteFriendCategory not implemented")
+}
+func (UnimplementedFriendServer) SortFriendCategories(context.Context, *SortFriendCategoriesReq) (*SortFriendCategoriesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SortFriendCategories not implemented")
+}
+func (UnimplementedFriendServer) GetFriendCategories(context.Context, *GetFriendCategoriesReq) (*GetFriendCategoriesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFriendCategories not implemented")
+}
+func (UnimplementedFriendServer) SetFriendCategories(context.Context, *SetFriendCategoriesReq) (*SetFriendCategoriesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFriendCategories not implemented")
+}
+func (UnimplementedFriendServer) AddFriendsToCategory(context.Context, *AddFriendsToCategoryReq) (*AddFriendsToCategoryResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddFriendsToCategory not implemented")
+}
+func (UnimplementedFriendServer) RemoveFriendsFromCategory(context.Context, *RemoveFriendsFromCategoryReq) (*RemoveFriendsFromCategoryResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveFriendsFromCategory not implemented")
+}
+func (UnimplementedFriendServer) GetIncrementalFriendCategories(context.Context, *GetIncrementalFriendCategoriesReq) (*GetIncrementalFriendCategoriesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIncrementalFriendCategories not implemented")
+}
+func (UnimplementedFriendServer) ReplyFriendApply(context.Context, *ReplyFriendApplyReq) (*ReplyFriendApplyResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplyFriendApply not implemented")
+}
+func (UnimplementedFriendServer) ExpireFriendApplications(context.Context, *ExpireFriendApplicationsReq) (*ExpireFriendApplicationsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExpireFriendApplications not implemented")
+}
+func (UnimplementedFriendServer) GetMutualFriends(context.Context, *GetMutualFriendsReq) (*GetMutualFriendsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMutualFriends not implemented")
+}
+func (UnimplementedFriendServer) GetFriendSuggestions(context.Context, *GetFriendSuggestionsReq) (*GetFriendSuggestionsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFriendSuggestions not implemented")
+}
 func (UnimplementedFriendServer) mustEmbedUnimplementedFriendServer() {}
 func (UnimplementedFriendServer) testEmbeddedByValue()                {}
 
@@ -1086,6 +1306,240 @@ func _Friend_AddFriendCategory_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Friend_CreateFriendCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFriendCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).CreateFriendCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_CreateFriendCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).CreateFriendCategory(ctx, req.(*CreateFriendCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_UpdateFriendCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFriendCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).UpdateFriendCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_UpdateFriendCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).UpdateFriendCategory(ctx, req.(*UpdateFriendCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_DeleteFriendCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFriendCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).DeleteFriendCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_DeleteFriendCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).DeleteFriendCategory(ctx, req.(*DeleteFriendCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_SortFriendCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortFriendCategoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).SortFriendCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_SortFriendCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).SortFriendCategories(ctx, req.(*SortFriendCategoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_GetFriendCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendCategoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).GetFriendCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_GetFriendCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).GetFriendCategories(ctx, req.(*GetFriendCategoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_SetFriendCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFriendCategoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).SetFriendCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_SetFriendCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).SetFriendCategories(ctx, req.(*SetFriendCategoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_AddFriendsToCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFriendsToCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).AddFriendsToCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_AddFriendsToCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).AddFriendsToCategory(ctx, req.(*AddFriendsToCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_RemoveFriendsFromCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFriendsFromCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).RemoveFriendsFromCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_RemoveFriendsFromCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).RemoveFriendsFromCategory(ctx, req.(*RemoveFriendsFromCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_GetIncrementalFriendCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncrementalFriendCategoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).GetIncrementalFriendCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_GetIncrementalFriendCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).GetIncrementalFriendCategories(ctx, req.(*GetIncrementalFriendCategoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_ReplyFriendApply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyFriendApplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).ReplyFriendApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_ReplyFriendApply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).ReplyFriendApply(ctx, req.(*ReplyFriendApplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_ExpireFriendApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpireFriendApplicationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).ExpireFriendApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_ExpireFriendApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).ExpireFriendApplications(ctx, req.(*ExpireFriendApplicationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_GetMutualFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMutualFriendsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).GetMutualFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_GetMutualFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).GetMutualFriends(ctx, req.(*GetMutualFriendsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_GetFriendSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendSuggestionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).GetFriendSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Friend_GetFriendSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).GetFriendSuggestions(ctx, req.(*GetFriendSuggestionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Friend_ServiceDesc is the grpc.ServiceDesc for Friend service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1205,6 +1659,58 @@ var Friend_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddFriendCategory",
 			Handler:    _Friend_AddFriendCategory_Handler,
 		},
+		{
+			MethodName: "CreateFriendCategory",
+			Handler:    _Friend_CreateFriendCategory_Handler,
+		},
+		{
+			MethodName: "UpdateFriendCategory",
+			Handler:    _Friend_UpdateFriendCategory_Handler,
+		},
+		{
+			MethodName: "DeleteFriendCategory",
+			Handler:    _Friend_DeleteFriendCategory_Handler,
+		},
+		{
+			MethodName: "SortFriendCategories",
+			Handler:    _Friend_SortFriendCategories_Handler,
+		},
+		{
+			MethodName: "GetFriendCategories",
+			Handler:    _Friend_GetFriendCategories_Handler,
+		},
+		{
+			MethodName: "SetFriendCategories",
+			Handler:    _Friend_SetFriendCategories_Handler,
+		},
+		{
+			MethodName: "AddFriendsToCategory",
+			Handler:    _Friend_AddFriendsToCategory_Handler,
+		},
+		{
+			MethodName: "RemoveFriendsFromCategory",
+			Handler:    _Friend_RemoveFriendsFromCategory_Handler,
+		},
+		{
+			MethodName: "getIncrementalFriendCategories",
+			Handler:    _Friend_GetIncrementalFriendCategories_Handler,
+		},
+		{
+			MethodName: "replyFriendApply",
+			Handler:    _Friend_ReplyFriendApply_Handler,
+		},
+		{
+			MethodName: "expireFriendApplications",
+			Handler:    _Friend_ExpireFriendApplications_Handler,
+		},
+		{
+			MethodName: "getMutualFriends",
+			Handler:    _Friend_GetMutualFriends_Handler,
+		},
+		{
+			MethodName: "getFriendSuggestions",
+			Handler:    _Friend_GetFriendSuggestions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "relation/relation.proto",
@@ -17,6 +17,9 @@ package sdkws
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"unicode/utf8"
+
 	"github.com/openimsdk/protocol/constant"
 )
 
@@ -56,3 +59,74 @@ func (x *GetMaxSeqResp) Format() any {
 	}
 	return x
 }
+
+func (x *UserPresence) Check() error {
+	if x == nil {
+		return errors.New("presence is nil")
+	}
+	if utf8.RuneCountInString(x.Text) > constant.UserPresenceTextMaxLength {
+		return errors.New("presence text is too long")
+	}
+	if x.ClearTime < 0 {
+		return errors.New("presence clearTime is invalid")
+	}
+	return nil
+}
+
+// Expired reports whether the presence has passed its clear time, now is a millisecond timestamp.
+func (x *UserPresence) Expired(now int64) bool {
+	return x.GetClearTime() > 0 && x.GetClearTime() <= now
+}
+
+func (x *UserActivity) Check() error {
+	if x == nil {
+		return errors.New("activity is nil")
+	}
+	if x.Activity != constant.UserActivityInMeeting && x.Activity != constant.UserActivityOnCall {
+		return errors.New("activity is invalid")
+	}
+	switch x.Source {
+	case constant.UserActivitySourceCall, constant.UserActivitySourceMeeting, constant.UserActivitySourceSchedule:
+	default:
+		return errors.New("activity source is invalid")
+	}
+	if x.SourceID == "" {
+		return errors.New("activity sourceID is empty")
+	}
+	if x.EndTime != 0 && x.EndTime < x.StartTime {
+		return errors.New("activity endTime is invalid")
+	}
+	return nil
+}
+
+// TopUserActivity returns the highest priority activity that has not ended at now,
+// a millisecond timestamp. platformID 0 considers every platform.
+func TopUserActivity(activities []*UserActivity, platformID int32, now int64) int32 {
+	top := int32(constant.UserActivityNone)
+	for _, a := range activities {
+		if a.EndTime > 0 && a.EndTime <= now {
+			continue
+		}
+		if platformID != 0 && a.PlatformID != 0 && a.PlatformID != platformID {
+			continue
+		}
+		top = max(top, a.Activity)
+	}
+	return top
+}
+
+// MessageFull reports whether the request already holds constant.FriendApplyMaxMessageNum messages.
+func (x *FriendRequest) MessageFull() bool {
+	return len(x.Messages) >= constant.FriendApplyMaxMessageNum
+}
+
+// Match reports whether the reply applies to the conversation. An empty scope applies everywhere.
+func (x *QuickReplyScope) Match(conversationID string, groupID string) bool {
+	if x == nil || (len(x.ConversationIDs) == 0 && len(x.GroupIDs) == 0) {
+		return true
+	}
+	if conversationID != "" && slices.Contains(x.ConversationIDs, conversationID) {
+		return true
+	}
+	return groupID != "" && slices.Contains(x.GroupIDs, groupID)
+}
@@ -610,6 +610,7 @@ type UserInfo struct {
 	DeptAllName    string `protobuf:"bytes,24,opt,name=deptAllName,proto3" json:"deptAllName,omitempty"`       // 部门全路径名称
 	JobTitle       string `protobuf:"bytes,25,opt,name=jobTitle,proto3" json:"jobTitle,omitempty"`             // OA 口径职位
 	WorkCode       string `protobuf:"bytes,26,opt,name=workCode,proto3" json:"workCode,omitempty"`             // 工号
+	AccountStatus  int32  `protobuf:"varint,28,opt,name=accountStatus,proto3" json:"accountStatus,omitempty"`  // 账号状态 constant.AccountStatus*
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -833,11 +834,19 @@ func (x *UserInfo) GetWorkCode() string {
 	return ""
 }
 
+func (x *UserInfo) GetAccountStatus() int32 {
+	if x != nil {
+		return x.AccountStatus
+	}
+	return 0
+}
+
 // PlatformDetail 平台详细状态信息（与 user.proto 中的 platformDetail 保持一致）
 type PlatformDetail struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlatformID    int32                  `protobuf:"varint,1,opt,name=platformID,proto3" json:"platformID,omitempty"` // 平台ID
 	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`         // 该平台的状态：0=离线, 1=在线, 2=离开
+	Activity      int32                  `protobuf:"varint,3,opt,name=activity,proto3" json:"activity,omitempty"`     // 该平台的自动状态 constant.UserActivity*
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -886,6 +895,175 @@ func (x *PlatformDetail) GetStatus() int32 {
 	return 0
 }
 
+func (x *PlatformDetail) GetActivity() int32 {
+	if x != nil {
+		return x.Activity
+	}
+	return 0
+}
+
+// UserActivity 由通话、会议、日程自动产生的状态，来源结束时自动清除
+type UserActivity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activity      int32                  `protobuf:"varint,1,opt,name=activity,proto3" json:"activity,omitempty"`     // 自动状态 constant.UserActivity*
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`          // 来源 constant.UserActivitySource*
+	SourceID      string                 `protobuf:"bytes,3,opt,name=sourceID,proto3" json:"sourceID,omitempty"`      // 来源ID（callID/roomID/scheduleID）
+	PlatformID    int32                  `protobuf:"varint,4,opt,name=platformID,proto3" json:"platformID,omitempty"` // 产生该状态的平台，0表示不区分平台（如日程）
+	StartTime     int64                  `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"`   // 开始时间（毫秒时间戳）
+	EndTime       int64                  `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime,omitempty"`       // 预计结束时间（毫秒时间戳），0表示等待来源结束事件清除
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserActivity) Reset() {
+	*x = UserActivity{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserActivity) ProtoMessage() {}
+
+func (x *UserActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserActivity.ProtoReflect.Descriptor instead.
+func (*UserActivity) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserActivity) GetActivity() int32 {
+	if x != nil {
+		return x.Activity
+	}
+	return 0
+}
+
+func (x *UserActivity) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *UserActivity) GetSourceID() string {
+	if x != nil {
+		return x.SourceID
+	}
+	return ""
+}
+
+func (x *UserActivity) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *UserActivity) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *UserActivity) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// UserPresence 用户自定义状态（如“开会中”），与在线状态相互独立
+type UserPresence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`                  // 状态文字
+	Emoji         string                 `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`                // 状态表情
+	DoNotDisturb  bool                   `protobuf:"varint,3,opt,name=doNotDisturb,proto3" json:"doNotDisturb,omitempty"` // 免打扰，开启后不发送离线推送
+	ClearTime     int64                  `protobuf:"varint,4,opt,name=clearTime,proto3" json:"clearTime,omitempty"`       // 自动清除时间（毫秒时间戳），0表示不自动清除
+	UpdateTime    int64                  `protobuf:"varint,5,opt,name=updateTime,proto3" json:"updateTime,omitempty"`     // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPresence) Reset() {
+	*x = UserPresence{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPresence) ProtoMessage() {}
+
+func (x *UserPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPresence.ProtoReflect.Descriptor instead.
+func (*UserPresence) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserPresence) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *UserPresence) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *UserPresence) GetDoNotDisturb() bool {
+	if x != nil {
+		return x.DoNotDisturb
+	}
+	return false
+}
+
+func (x *UserPresence) GetClearTime() int64 {
+	if x != nil {
+		return x.ClearTime
+	}
+	return 0
+}
+
+func (x *UserPresence) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
 type UserInfoWithEx struct {
 	state            protoimpl.MessageState  `protogen:"open.v1"`
 	UserID           string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
@@ -903,7 +1081,7 @@ type UserInfoWithEx struct {
 
 func (x *UserInfoWithEx) Reset() {
 	*x = UserInfoWithEx{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[6]
+	mi := &file_sdkws_sdkws_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -915,7 +1093,7 @@ func (x *UserInfoWithEx) String() string {
 func (*UserInfoWithEx) ProtoMessage() {}
 
 func (x *UserInfoWithEx) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[6]
+	mi := &file_sdkws_sdkws_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,7 +1106,7 @@ func (x *UserInfoWithEx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfoWithEx.ProtoReflect.Descriptor instead.
 func (*UserInfoWithEx) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{6}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UserInfoWithEx) GetUserID() string {
@@ -1004,13 +1182,14 @@ type FriendInfo struct {
 	OperatorUserID string                 `protobuf:"bytes,6,opt,name=operatorUserID,proto3" json:"operatorUserID,omitempty"`
 	Ex             string                 `protobuf:"bytes,7,opt,name=ex,proto3" json:"ex,omitempty"`
 	IsPinned       bool                   `protobuf:"varint,8,opt,name=isPinned,proto3" json:"isPinned,omitempty"`
+	CategoryIDs    []string               `protobuf:"bytes,9,rep,name=categoryIDs,proto3" json:"categoryIDs,omitempty"` // 好友所属的分组ID列表
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *FriendInfo) Reset() {
 	*x = FriendInfo{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[7]
+	mi := &file_sdkws_sdkws_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1022,7 +1201,7 @@ func (x *FriendInfo) String() string {
 func (*FriendInfo) ProtoMessage() {}
 
 func (x *FriendInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[7]
+	mi := &file_sdkws_sdkws_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1035,7 +1214,7 @@ func (x *FriendInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendInfo.ProtoReflect.Descriptor instead.
 func (*FriendInfo) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{7}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *FriendInfo) GetOwnerUserID() string {
@@ -1094,6 +1273,13 @@ func (x *FriendInfo) GetIsPinned() bool {
 	return false
 }
 
+func (x *FriendInfo) GetCategoryIDs() []string {
+	if x != nil {
+		return x.CategoryIDs
+	}
+	return nil
+}
+
 type BlackInfo struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OwnerUserID    string                 `protobuf:"bytes,1,opt,name=ownerUserID,proto3" json:"ownerUserID,omitempty"`
@@ -1108,7 +1294,7 @@ type BlackInfo struct {
 
 func (x *BlackInfo) Reset() {
 	*x = BlackInfo{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[8]
+	mi := &file_sdkws_sdkws_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1120,7 +1306,7 @@ func (x *BlackInfo) String() string {
 func (*BlackInfo) ProtoMessage() {}
 
 func (x *BlackInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[8]
+	mi := &file_sdkws_sdkws_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1133,7 +1319,7 @@ func (x *BlackInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlackInfo.ProtoReflect.Descriptor instead.
 func (*BlackInfo) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{8}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BlackInfo) GetOwnerUserID() string {
@@ -1197,7 +1383,7 @@ type GroupRequest struct {
 
 func (x *GroupRequest) Reset() {
 	*x = GroupRequest{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[9]
+	mi := &file_sdkws_sdkws_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1209,7 +1395,7 @@ func (x *GroupRequest) String() string {
 func (*GroupRequest) ProtoMessage() {}
 
 func (x *GroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[9]
+	mi := &file_sdkws_sdkws_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +1408,7 @@ func (x *GroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupRequest.ProtoReflect.Descriptor instead.
 func (*GroupRequest) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{9}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GroupRequest) GetUserInfo() *PublicUserInfo {
@@ -1303,27 +1489,29 @@ func (x *GroupRequest) GetInviterUserID() string {
 }
 
 type FriendRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromUserID    string                 `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
-	FromNickname  string                 `protobuf:"bytes,2,opt,name=fromNickname,proto3" json:"fromNickname,omitempty"`
-	FromFaceURL   string                 `protobuf:"bytes,3,opt,name=fromFaceURL,proto3" json:"fromFaceURL,omitempty"`
-	ToUserID      string                 `protobuf:"bytes,4,opt,name=toUserID,proto3" json:"toUserID,omitempty"`
-	ToNickname    string                 `protobuf:"bytes,5,opt,name=toNickname,proto3" json:"toNickname,omitempty"`
-	ToFaceURL     string                 `protobuf:"bytes,6,opt,name=toFaceURL,proto3" json:"toFaceURL,omitempty"`
-	HandleResult  int32                  `protobuf:"varint,7,opt,name=handleResult,proto3" json:"handleResult,omitempty"`
-	ReqMsg        string                 `protobuf:"bytes,8,opt,name=reqMsg,proto3" json:"reqMsg,omitempty"`
-	CreateTime    int64                  `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime,omitempty"`
-	HandlerUserID string                 `protobuf:"bytes,10,opt,name=handlerUserID,proto3" json:"handlerUserID,omitempty"`
-	HandleMsg     string                 `protobuf:"bytes,11,opt,name=handleMsg,proto3" json:"handleMsg,omitempty"`
-	HandleTime    int64                  `protobuf:"varint,12,opt,name=handleTime,proto3" json:"handleTime,omitempty"`
-	Ex            string                 `protobuf:"bytes,13,opt,name=ex,proto3" json:"ex,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	FromUserID    string                  `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
+	FromNickname  string                  `protobuf:"bytes,2,opt,name=fromNickname,proto3" json:"fromNickname,omitempty"`
+	FromFaceURL   string                  `protobuf:"bytes,3,opt,name=fromFaceURL,proto3" json:"fromFaceURL,omitempty"`
+	ToUserID      string                  `protobuf:"bytes,4,opt,name=toUserID,proto3" json:"toUserID,omitempty"`
+	ToNickname    string                  `protobuf:"bytes,5,opt,name=toNickname,proto3" json:"toNickname,omitempty"`
+	ToFaceURL     string                  `protobuf:"bytes,6,opt,name=toFaceURL,proto3" json:"toFaceURL,omitempty"`
+	HandleResult  int32                   `protobuf:"varint,7,opt,name=handleResult,proto3" json:"handleResult,omitempty"`
+	ReqMsg        string                  `protobuf:"bytes,8,opt,name=reqMsg,proto3" json:"reqMsg,omitempty"`
+	CreateTime    int64                   `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	HandlerUserID string                  `protobuf:"bytes,10,opt,name=handlerUserID,proto3" json:"handlerUserID,omitempty"`
+	HandleMsg     string                  `protobuf:"bytes,11,opt,name=handleMsg,proto3" json:"handleMsg,omitempty"`
+	HandleTime    int64                   `protobuf:"varint,12,opt,name=handleTime,proto3" json:"handleTime,omitempty"`
+	Ex            string                  `protobuf:"bytes,13,opt,name=ex,proto3" json:"ex,omitempty"`
+	Messages      []*FriendRequestMessage `protobuf:"bytes,14,rep,name=messages,proto3" json:"messages,omitempty"`      // 申请留言记录，按时间升序
+	ExpireTime    int64                   `protobuf:"varint,15,opt,name=expireTime,proto3" json:"expireTime,omitempty"` // 过期时间（毫秒时间戳），0表示永不过期
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FriendRequest) Reset() {
 	*x = FriendRequest{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[10]
+	mi := &file_sdkws_sdkws_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1335,7 +1523,7 @@ func (x *FriendRequest) String() string {
 func (*FriendRequest) ProtoMessage() {}
 
 func (x *FriendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[10]
+	mi := &file_sdkws_sdkws_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,7 +1536,7 @@ func (x *FriendRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendRequest.ProtoReflect.Descriptor instead.
 func (*FriendRequest) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{10}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *FriendRequest) GetFromUserID() string {
@@ -1442,6 +1630,81 @@ func (x *FriendRequest) GetEx() string {
 	return ""
 }
 
+func (x *FriendRequest) GetMessages() []*FriendRequestMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *FriendRequest) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+// 好友申请留言（申请人与被申请人在处理申请前的对话）
+type FriendRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderUserID  string                 `protobuf:"bytes,1,opt,name=senderUserID,proto3" json:"senderUserID,omitempty"` // 留言发送者
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`           // 留言内容
+	CreateTime    int64                  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime,omitempty"`    // 留言时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendRequestMessage) Reset() {
+	*x = FriendRequestMessage{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRequestMessage) ProtoMessage() {}
+
+func (x *FriendRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRequestMessage.ProtoReflect.Descriptor instead.
+func (*FriendRequestMessage) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FriendRequestMessage) GetSenderUserID() string {
+	if x != nil {
+		return x.SenderUserID
+	}
+	return ""
+}
+
+func (x *FriendRequestMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *FriendRequestMessage) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
 type PullMessageBySeqsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
@@ -1453,7 +1716,7 @@ type PullMessageBySeqsReq struct {
 
 func (x *PullMessageBySeqsReq) Reset() {
 	*x = PullMessageBySeqsReq{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[11]
+	mi := &file_sdkws_sdkws_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1465,7 +1728,7 @@ func (x *PullMessageBySeqsReq) String() string {
 func (*PullMessageBySeqsReq) ProtoMessage() {}
 
 func (x *PullMessageBySeqsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[11]
+	mi := &file_sdkws_sdkws_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1478,7 +1741,7 @@ func (x *PullMessageBySeqsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullMessageBySeqsReq.ProtoReflect.Descriptor instead.
 func (*PullMessageBySeqsReq) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{11}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PullMessageBySeqsReq) GetUserID() string {
@@ -1514,7 +1777,7 @@ type SeqRange struct {
 
 func (x *SeqRange) Reset() {
 	*x = SeqRange{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[12]
+	mi := &file_sdkws_sdkws_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1526,7 +1789,7 @@ func (x *SeqRange) String() string {
 func (*SeqRange) ProtoMessage() {}
 
 func (x *SeqRange) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[12]
+	mi := &file_sdkws_sdkws_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1539,7 +1802,7 @@ func (x *SeqRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SeqRange.ProtoReflect.Descriptor instead.
 func (*SeqRange) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{12}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SeqRange) GetConversationID() string {
@@ -1581,7 +1844,7 @@ type PullMsgs struct {
 
 func (x *PullMsgs) Reset() {
 	*x = PullMsgs{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[13]
+	mi := &file_sdkws_sdkws_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1593,7 +1856,7 @@ func (x *PullMsgs) String() string {
 func (*PullMsgs) ProtoMessage() {}
 
 func (x *PullMsgs) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[13]
+	mi := &file_sdkws_sdkws_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1606,7 +1869,7 @@ func (x *PullMsgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullMsgs.ProtoReflect.Descriptor instead.
 func (*PullMsgs) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{13}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PullMsgs) GetMsgs() []*MsgData {
@@ -1640,7 +1903,7 @@ type PullMessageBySeqsResp struct {
 
 func (x *PullMessageBySeqsResp) Reset() {
 	*x = PullMessageBySeqsResp{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[14]
+	mi := &file_sdkws_sdkws_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1652,7 +1915,7 @@ func (x *PullMessageBySeqsResp) String() string {
 func (*PullMessageBySeqsResp) ProtoMessage() {}
 
 func (x *PullMessageBySeqsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[14]
+	mi := &file_sdkws_sdkws_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1665,7 +1928,7 @@ func (x *PullMessageBySeqsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullMessageBySeqsResp.ProtoReflect.Descriptor instead.
 func (*PullMessageBySeqsResp) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{14}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PullMessageBySeqsResp) GetMsgs() map[string]*PullMsgs {
@@ -1691,7 +1954,7 @@ type GetMaxSeqReq struct {
 
 func (x *GetMaxSeqReq) Reset() {
 	*x = GetMaxSeqReq{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[15]
+	mi := &file_sdkws_sdkws_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1703,7 +1966,7 @@ func (x *GetMaxSeqReq) String() string {
 func (*GetMaxSeqReq) ProtoMessage() {}
 
 func (x *GetMaxSeqReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[15]
+	mi := &file_sdkws_sdkws_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,7 +1979,7 @@ func (x *GetMaxSeqReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMaxSeqReq.ProtoReflect.Descriptor instead.
 func (*GetMaxSeqReq) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{15}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetMaxSeqReq) GetUserID() string {
@@ -1736,7 +1999,7 @@ type GetMaxSeqResp struct {
 
 func (x *GetMaxSeqResp) Reset() {
 	*x = GetMaxSeqResp{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[16]
+	mi := &file_sdkws_sdkws_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1748,7 +2011,7 @@ func (x *GetMaxSeqResp) String() string {
 func (*GetMaxSeqResp) ProtoMessage() {}
 
 func (x *GetMaxSeqResp) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[16]
+	mi := &file_sdkws_sdkws_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1761,7 +2024,7 @@ func (x *GetMaxSeqResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMaxSeqResp.ProtoReflect.Descriptor instead.
 func (*GetMaxSeqResp) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{16}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetMaxSeqResp) GetMaxSeqs() map[string]int64 {
@@ -1789,7 +2052,7 @@ type UserSendMsgResp struct {
 
 func (x *UserSendMsgResp) Reset() {
 	*x = UserSendMsgResp{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[17]
+	mi := &file_sdkws_sdkws_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1801,7 +2064,7 @@ func (x *UserSendMsgResp) String() string {
 func (*UserSendMsgResp) ProtoMessage() {}
 
 func (x *UserSendMsgResp) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[17]
+	mi := &file_sdkws_sdkws_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1814,7 +2077,7 @@ func (x *UserSendMsgResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSendMsgResp.ProtoReflect.Descriptor instead.
 func (*UserSendMsgResp) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{17}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UserSendMsgResp) GetServerMsgID() string {
@@ -1871,7 +2134,7 @@ type MsgData struct {
 
 func (x *MsgData) Reset() {
 	*x = MsgData{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[18]
+	mi := &file_sdkws_sdkws_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1883,7 +2146,7 @@ func (x *MsgData) String() string {
 func (*MsgData) ProtoMessage() {}
 
 func (x *MsgData) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[18]
+	mi := &file_sdkws_sdkws_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1896,7 +2159,7 @@ func (x *MsgData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgData.ProtoReflect.Descriptor instead.
 func (*MsgData) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{18}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MsgData) GetSendID() string {
@@ -2088,7 +2351,7 @@ type LikeInfo struct {
 
 func (x *LikeInfo) Reset() {
 	*x = LikeInfo{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[19]
+	mi := &file_sdkws_sdkws_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2100,7 +2363,7 @@ func (x *LikeInfo) String() string {
 func (*LikeInfo) ProtoMessage() {}
 
 func (x *LikeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[19]
+	mi := &file_sdkws_sdkws_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2113,7 +2376,7 @@ func (x *LikeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeInfo.ProtoReflect.Descriptor instead.
 func (*LikeInfo) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{19}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *LikeInfo) GetLikeCount() int64 {
@@ -2151,7 +2414,7 @@ type LikeUser struct {
 
 func (x *LikeUser) Reset() {
 	*x = LikeUser{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[20]
+	mi := &file_sdkws_sdkws_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2163,7 +2426,7 @@ func (x *LikeUser) String() string {
 func (*LikeUser) ProtoMessage() {}
 
 func (x *LikeUser) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[20]
+	mi := &file_sdkws_sdkws_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2176,7 +2439,7 @@ func (x *LikeUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeUser.ProtoReflect.Descriptor instead.
 func (*LikeUser) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{20}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *LikeUser) GetUserId() string {
@@ -2241,7 +2504,7 @@ type LikeMsgTips struct {
 
 func (x *LikeMsgTips) Reset() {
 	*x = LikeMsgTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[21]
+	mi := &file_sdkws_sdkws_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2253,7 +2516,7 @@ func (x *LikeMsgTips) String() string {
 func (*LikeMsgTips) ProtoMessage() {}
 
 func (x *LikeMsgTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[21]
+	mi := &file_sdkws_sdkws_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2266,7 +2529,7 @@ func (x *LikeMsgTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeMsgTips.ProtoReflect.Descriptor instead.
 func (*LikeMsgTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{21}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *LikeMsgTips) GetLikerUserID() string {
@@ -2373,7 +2636,7 @@ type MarkInfo struct {
 
 func (x *MarkInfo) Reset() {
 	*x = MarkInfo{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[22]
+	mi := &file_sdkws_sdkws_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2385,7 +2648,7 @@ func (x *MarkInfo) String() string {
 func (*MarkInfo) ProtoMessage() {}
 
 func (x *MarkInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[22]
+	mi := &file_sdkws_sdkws_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2398,7 +2661,7 @@ func (x *MarkInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkInfo.ProtoReflect.Descriptor instead.
 func (*MarkInfo) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{22}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *MarkInfo) GetIsMarked() bool {
@@ -2447,7 +2710,7 @@ type MarkMsgTips struct {
 
 func (x *MarkMsgTips) Reset() {
 	*x = MarkMsgTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[23]
+	mi := &file_sdkws_sdkws_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2459,7 +2722,7 @@ func (x *MarkMsgTips) String() string {
 func (*MarkMsgTips) ProtoMessage() {}
 
 func (x *MarkMsgTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[23]
+	mi := &file_sdkws_sdkws_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2472,7 +2735,7 @@ func (x *MarkMsgTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkMsgTips.ProtoReflect.Descriptor instead.
 func (*MarkMsgTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{23}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *MarkMsgTips) GetMarkerUserID() string {
@@ -2552,7 +2815,7 @@ type SpeechToTextInfo struct {
 
 func (x *SpeechToTextInfo) Reset() {
 	*x = SpeechToTextInfo{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[24]
+	mi := &file_sdkws_sdkws_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2564,7 +2827,7 @@ func (x *SpeechToTextInfo) String() string {
 func (*SpeechToTextInfo) ProtoMessage() {}
 
 func (x *SpeechToTextInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[24]
+	mi := &file_sdkws_sdkws_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2577,7 +2840,7 @@ func (x *SpeechToTextInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpeechToTextInfo.ProtoReflect.Descriptor instead.
 func (*SpeechToTextInfo) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{24}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *SpeechToTextInfo) GetRecognizedText() string {
@@ -2630,7 +2893,7 @@ type SpeechToTextMsgTips struct {
 
 func (x *SpeechToTextMsgTips) Reset() {
 	*x = SpeechToTextMsgTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[25]
+	mi := &file_sdkws_sdkws_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2642,7 +2905,7 @@ func (x *SpeechToTextMsgTips) String() string {
 func (*SpeechToTextMsgTips) ProtoMessage() {}
 
 func (x *SpeechToTextMsgTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[25]
+	mi := &file_sdkws_sdkws_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2655,7 +2918,7 @@ func (x *SpeechToTextMsgTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpeechToTextMsgTips.ProtoReflect.Descriptor instead.
 func (*SpeechToTextMsgTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{25}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *SpeechToTextMsgTips) GetConversationID() string {
@@ -2710,7 +2973,7 @@ type PushMessages struct {
 
 func (x *PushMessages) Reset() {
 	*x = PushMessages{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[26]
+	mi := &file_sdkws_sdkws_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2722,7 +2985,7 @@ func (x *PushMessages) String() string {
 func (*PushMessages) ProtoMessage() {}
 
 func (x *PushMessages) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[26]
+	mi := &file_sdkws_sdkws_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2735,7 +2998,7 @@ func (x *PushMessages) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushMessages.ProtoReflect.Descriptor instead.
 func (*PushMessages) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{26}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *PushMessages) GetMsgs() map[string]*PullMsgs {
@@ -2760,13 +3023,26 @@ type OfflinePushInfo struct {
 	IOSPushSound  string                 `protobuf:"bytes,4,opt,name=iOSPushSound,proto3" json:"iOSPushSound,omitempty"`
 	IOSBadgeCount bool                   `protobuf:"varint,5,opt,name=iOSBadgeCount,proto3" json:"iOSBadgeCount,omitempty"`
 	SignalInfo    string                 `protobuf:"bytes,6,opt,name=signalInfo,proto3" json:"signalInfo,omitempty"`
+	ThreadID      string                 `protobuf:"bytes,7,opt,name=threadID,proto3" json:"threadID,omitempty"`     // 通知分组（iOS thread-id），通常为 conversationID，Android 无对应字段
+	CollapseID    string                 `protobuf:"bytes,8,opt,name=collapseID,proto3" json:"collapseID,omitempty"` // 折叠ID，相同 collapseID 只展示最新一条（iOS apns-collapse-id / Android tag）
+	ImageURL      string                 `protobuf:"bytes,9,opt,name=imageURL,proto3" json:"imageURL,omitempty"`     // 通知大图
+	Category      string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`    // 通知类别（iOS category / Android click_action），决定展示的按钮
+	Actions       []*OfflinePushAction   `protobuf:"bytes,11,rep,name=actions,proto3" json:"actions,omitempty"`
+	TitleLocKey   string                 `protobuf:"bytes,12,opt,name=titleLocKey,proto3" json:"titleLocKey,omitempty"` // 标题本地化 key，设置后客户端按 key 渲染，title 作为兜底
+	TitleLocArgs  []string               `protobuf:"bytes,13,rep,name=titleLocArgs,proto3" json:"titleLocArgs,omitempty"`
+	BodyLocKey    string                 `protobuf:"bytes,14,opt,name=bodyLocKey,proto3" json:"bodyLocKey,omitempty"` // 内容本地化 key，desc 作为兜底
+	BodyLocArgs   []string               `protobuf:"bytes,15,rep,name=bodyLocArgs,proto3" json:"bodyLocArgs,omitempty"`
+	Priority      int32                  `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"`           // constant.OfflinePushPriority*
+	TimeSensitive bool                   `protobuf:"varint,17,opt,name=timeSensitive,proto3" json:"timeSensitive,omitempty"` // 时效性通知（如来电），可突破专注模式
+	Ttl           int64                  `protobuf:"varint,18,opt,name=ttl,proto3" json:"ttl,omitempty"`                     // 有效期（秒），0 表示厂商默认
+	Badge         int32                  `protobuf:"varint,19,opt,name=badge,proto3" json:"badge,omitempty"`                 // 推送时的角标值（未读汇总的 total），iOSBadgeCount 为 true 时生效
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OfflinePushInfo) Reset() {
 	*x = OfflinePushInfo{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[27]
+	mi := &file_sdkws_sdkws_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2778,7 +3054,7 @@ func (x *OfflinePushInfo) String() string {
 func (*OfflinePushInfo) ProtoMessage() {}
 
 func (x *OfflinePushInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[27]
+	mi := &file_sdkws_sdkws_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2791,7 +3067,7 @@ func (x *OfflinePushInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OfflinePushInfo.ProtoReflect.Descriptor instead.
 func (*OfflinePushInfo) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{27}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *OfflinePushInfo) GetTitle() string {
@@ -2836,6 +3112,182 @@ func (x *OfflinePushInfo) GetSignalInfo() string {
 	return ""
 }
 
+func (x *OfflinePushInfo) GetThreadID() string {
+	if x != nil {
+		return x.ThreadID
+	}
+	return ""
+}
+
+func (x *OfflinePushInfo) GetCollapseID() string {
+	if x != nil {
+		return x.CollapseID
+	}
+	return ""
+}
+
+func (x *OfflinePushInfo) GetImageURL() string {
+	if x != nil {
+		return x.ImageURL
+	}
+	return ""
+}
+
+func (x *OfflinePushInfo) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *OfflinePushInfo) GetActions() []*OfflinePushAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *OfflinePushInfo) GetTitleLocKey() string {
+	if x != nil {
+		return x.TitleLocKey
+	}
+	return ""
+}
+
+func (x *OfflinePushInfo) GetTitleLocArgs() []string {
+	if x != nil {
+		return x.TitleLocArgs
+	}
+	return nil
+}
+
+func (x *OfflinePushInfo) GetBodyLocKey() string {
+	if x != nil {
+		return x.BodyLocKey
+	}
+	return ""
+}
+
+func (x *OfflinePushInfo) GetBodyLocArgs() []string {
+	if x != nil {
+		return x.BodyLocArgs
+	}
+	return nil
+}
+
+func (x *OfflinePushInfo) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *OfflinePushInfo) GetTimeSensitive() bool {
+	if x != nil {
+		return x.TimeSensitive
+	}
+	return false
+}
+
+func (x *OfflinePushInfo) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *OfflinePushInfo) GetBadge() int32 {
+	if x != nil {
+		return x.Badge
+	}
+	return 0
+}
+
+// 离线推送通知按钮
+type OfflinePushAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActionID      string                 `protobuf:"bytes,1,opt,name=actionID,proto3" json:"actionID,omitempty"` // constant.OfflinePushAction*
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TitleLocKey   string                 `protobuf:"bytes,3,opt,name=titleLocKey,proto3" json:"titleLocKey,omitempty"`
+	Input         bool                   `protobuf:"varint,4,opt,name=input,proto3" json:"input,omitempty"`             // 是否带文本输入（如快捷回复）
+	Foreground    bool                   `protobuf:"varint,5,opt,name=foreground,proto3" json:"foreground,omitempty"`   // 点击后是否打开应用
+	Destructive   bool                   `protobuf:"varint,6,opt,name=destructive,proto3" json:"destructive,omitempty"` // 是否为破坏性操作（如拒接）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OfflinePushAction) Reset() {
+	*x = OfflinePushAction{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OfflinePushAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflinePushAction) ProtoMessage() {}
+
+func (x *OfflinePushAction) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflinePushAction.ProtoReflect.Descriptor instead.
+func (*OfflinePushAction) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *OfflinePushAction) GetActionID() string {
+	if x != nil {
+		return x.ActionID
+	}
+	return ""
+}
+
+func (x *OfflinePushAction) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OfflinePushAction) GetTitleLocKey() string {
+	if x != nil {
+		return x.TitleLocKey
+	}
+	return ""
+}
+
+func (x *OfflinePushAction) GetInput() bool {
+	if x != nil {
+		return x.Input
+	}
+	return false
+}
+
+func (x *OfflinePushAction) GetForeground() bool {
+	if x != nil {
+		return x.Foreground
+	}
+	return false
+}
+
+func (x *OfflinePushAction) GetDestructive() bool {
+	if x != nil {
+		return x.Destructive
+	}
+	return false
+}
+
 type TipsComm struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Detail        []byte                 `protobuf:"bytes,1,opt,name=detail,proto3" json:"detail,omitempty"`
@@ -2847,7 +3299,7 @@ type TipsComm struct {
 
 func (x *TipsComm) Reset() {
 	*x = TipsComm{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[28]
+	mi := &file_sdkws_sdkws_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2859,7 +3311,7 @@ func (x *TipsComm) String() string {
 func (*TipsComm) ProtoMessage() {}
 
 func (x *TipsComm) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[28]
+	mi := &file_sdkws_sdkws_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2872,7 +3324,7 @@ func (x *TipsComm) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TipsComm.ProtoReflect.Descriptor instead.
 func (*TipsComm) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{28}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *TipsComm) GetDetail() []byte {
@@ -2912,7 +3364,7 @@ type GroupCreatedTips struct {
 
 func (x *GroupCreatedTips) Reset() {
 	*x = GroupCreatedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[29]
+	mi := &file_sdkws_sdkws_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2924,7 +3376,7 @@ func (x *GroupCreatedTips) String() string {
 func (*GroupCreatedTips) ProtoMessage() {}
 
 func (x *GroupCreatedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[29]
+	mi := &file_sdkws_sdkws_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2937,7 +3389,7 @@ func (x *GroupCreatedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupCreatedTips.ProtoReflect.Descriptor instead.
 func (*GroupCreatedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{29}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GroupCreatedTips) GetGroup() *GroupInfo {
@@ -3003,7 +3455,7 @@ type GroupInfoSetTips struct {
 
 func (x *GroupInfoSetTips) Reset() {
 	*x = GroupInfoSetTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[30]
+	mi := &file_sdkws_sdkws_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3015,7 +3467,7 @@ func (x *GroupInfoSetTips) String() string {
 func (*GroupInfoSetTips) ProtoMessage() {}
 
 func (x *GroupInfoSetTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[30]
+	mi := &file_sdkws_sdkws_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3028,7 +3480,7 @@ func (x *GroupInfoSetTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupInfoSetTips.ProtoReflect.Descriptor instead.
 func (*GroupInfoSetTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{30}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GroupInfoSetTips) GetOpUser() *GroupMemberFullInfo {
@@ -3078,7 +3530,7 @@ type GroupInfoSetNameTips struct {
 
 func (x *GroupInfoSetNameTips) Reset() {
 	*x = GroupInfoSetNameTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[31]
+	mi := &file_sdkws_sdkws_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3090,7 +3542,7 @@ func (x *GroupInfoSetNameTips) String() string {
 func (*GroupInfoSetNameTips) ProtoMessage() {}
 
 func (x *GroupInfoSetNameTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[31]
+	mi := &file_sdkws_sdkws_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3103,7 +3555,7 @@ func (x *GroupInfoSetNameTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupInfoSetNameTips.ProtoReflect.Descriptor instead.
 func (*GroupInfoSetNameTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{31}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GroupInfoSetNameTips) GetOpUser() *GroupMemberFullInfo {
@@ -3146,7 +3598,7 @@ type GroupInfoSetAnnouncementTips struct {
 
 func (x *GroupInfoSetAnnouncementTips) Reset() {
 	*x = GroupInfoSetAnnouncementTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[32]
+	mi := &file_sdkws_sdkws_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3158,7 +3610,7 @@ func (x *GroupInfoSetAnnouncementTips) String() string {
 func (*GroupInfoSetAnnouncementTips) ProtoMessage() {}
 
 func (x *GroupInfoSetAnnouncementTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[32]
+	mi := &file_sdkws_sdkws_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3171,7 +3623,7 @@ func (x *GroupInfoSetAnnouncementTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupInfoSetAnnouncementTips.ProtoReflect.Descriptor instead.
 func (*GroupInfoSetAnnouncementTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{32}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GroupInfoSetAnnouncementTips) GetOpUser() *GroupMemberFullInfo {
@@ -3216,7 +3668,7 @@ type JoinGroupApplicationTips struct {
 
 func (x *JoinGroupApplicationTips) Reset() {
 	*x = JoinGroupApplicationTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[33]
+	mi := &file_sdkws_sdkws_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3228,7 +3680,7 @@ func (x *JoinGroupApplicationTips) String() string {
 func (*JoinGroupApplicationTips) ProtoMessage() {}
 
 func (x *JoinGroupApplicationTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[33]
+	mi := &file_sdkws_sdkws_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3241,7 +3693,7 @@ func (x *JoinGroupApplicationTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinGroupApplicationTips.ProtoReflect.Descriptor instead.
 func (*JoinGroupApplicationTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{33}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *JoinGroupApplicationTips) GetGroup() *GroupInfo {
@@ -3294,7 +3746,7 @@ type MemberQuitTips struct {
 
 func (x *MemberQuitTips) Reset() {
 	*x = MemberQuitTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[34]
+	mi := &file_sdkws_sdkws_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3306,7 +3758,7 @@ func (x *MemberQuitTips) String() string {
 func (*MemberQuitTips) ProtoMessage() {}
 
 func (x *MemberQuitTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[34]
+	mi := &file_sdkws_sdkws_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3319,7 +3771,7 @@ func (x *MemberQuitTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemberQuitTips.ProtoReflect.Descriptor instead.
 func (*MemberQuitTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{34}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *MemberQuitTips) GetGroup() *GroupInfo {
@@ -3372,7 +3824,7 @@ type GroupApplicationAcceptedTips struct {
 
 func (x *GroupApplicationAcceptedTips) Reset() {
 	*x = GroupApplicationAcceptedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[35]
+	mi := &file_sdkws_sdkws_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3384,7 +3836,7 @@ func (x *GroupApplicationAcceptedTips) String() string {
 func (*GroupApplicationAcceptedTips) ProtoMessage() {}
 
 func (x *GroupApplicationAcceptedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[35]
+	mi := &file_sdkws_sdkws_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3397,7 +3849,7 @@ func (x *GroupApplicationAcceptedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupApplicationAcceptedTips.ProtoReflect.Descriptor instead.
 func (*GroupApplicationAcceptedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{35}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GroupApplicationAcceptedTips) GetGroup() *GroupInfo {
@@ -3457,7 +3909,7 @@ type GroupApplicationRejectedTips struct {
 
 func (x *GroupApplicationRejectedTips) Reset() {
 	*x = GroupApplicationRejectedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[36]
+	mi := &file_sdkws_sdkws_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3469,7 +3921,7 @@ func (x *GroupApplicationRejectedTips) String() string {
 func (*GroupApplicationRejectedTips) ProtoMessage() {}
 
 func (x *GroupApplicationRejectedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[36]
+	mi := &file_sdkws_sdkws_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3482,7 +3934,7 @@ func (x *GroupApplicationRejectedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupApplicationRejectedTips.ProtoReflect.Descriptor instead.
 func (*GroupApplicationRejectedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{36}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GroupApplicationRejectedTips) GetGroup() *GroupInfo {
@@ -3544,7 +3996,7 @@ type GroupOwnerTransferredTips struct {
 
 func (x *GroupOwnerTransferredTips) Reset() {
 	*x = GroupOwnerTransferredTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[37]
+	mi := &file_sdkws_sdkws_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3556,7 +4008,7 @@ func (x *GroupOwnerTransferredTips) String() string {
 func (*GroupOwnerTransferredTips) ProtoMessage() {}
 
 func (x *GroupOwnerTransferredTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[37]
+	mi := &file_sdkws_sdkws_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3569,7 +4021,7 @@ func (x *GroupOwnerTransferredTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupOwnerTransferredTips.ProtoReflect.Descriptor instead.
 func (*GroupOwnerTransferredTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{37}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GroupOwnerTransferredTips) GetGroup() *GroupInfo {
@@ -3643,7 +4095,7 @@ type MemberKickedTips struct {
 
 func (x *MemberKickedTips) Reset() {
 	*x = MemberKickedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[38]
+	mi := &file_sdkws_sdkws_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3655,7 +4107,7 @@ func (x *MemberKickedTips) String() string {
 func (*MemberKickedTips) ProtoMessage() {}
 
 func (x *MemberKickedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[38]
+	mi := &file_sdkws_sdkws_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3668,7 +4120,7 @@ func (x *MemberKickedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemberKickedTips.ProtoReflect.Descriptor instead.
 func (*MemberKickedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{38}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *MemberKickedTips) GetGroup() *GroupInfo {
@@ -3729,7 +4181,7 @@ type MemberInvitedTips struct {
 
 func (x *MemberInvitedTips) Reset() {
 	*x = MemberInvitedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[39]
+	mi := &file_sdkws_sdkws_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3741,7 +4193,7 @@ func (x *MemberInvitedTips) String() string {
 func (*MemberInvitedTips) ProtoMessage() {}
 
 func (x *MemberInvitedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[39]
+	mi := &file_sdkws_sdkws_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3754,7 +4206,7 @@ func (x *MemberInvitedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemberInvitedTips.ProtoReflect.Descriptor instead.
 func (*MemberInvitedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{39}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *MemberInvitedTips) GetGroup() *GroupInfo {
@@ -3820,7 +4272,7 @@ type MemberEnterTips struct {
 
 func (x *MemberEnterTips) Reset() {
 	*x = MemberEnterTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[40]
+	mi := &file_sdkws_sdkws_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3832,7 +4284,7 @@ func (x *MemberEnterTips) String() string {
 func (*MemberEnterTips) ProtoMessage() {}
 
 func (x *MemberEnterTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[40]
+	mi := &file_sdkws_sdkws_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3845,7 +4297,7 @@ func (x *MemberEnterTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemberEnterTips.ProtoReflect.Descriptor instead.
 func (*MemberEnterTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{40}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *MemberEnterTips) GetGroup() *GroupInfo {
@@ -3894,7 +4346,7 @@ type GroupDismissedTips struct {
 
 func (x *GroupDismissedTips) Reset() {
 	*x = GroupDismissedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[41]
+	mi := &file_sdkws_sdkws_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3906,7 +4358,7 @@ func (x *GroupDismissedTips) String() string {
 func (*GroupDismissedTips) ProtoMessage() {}
 
 func (x *GroupDismissedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[41]
+	mi := &file_sdkws_sdkws_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3919,7 +4371,7 @@ func (x *GroupDismissedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupDismissedTips.ProtoReflect.Descriptor instead.
 func (*GroupDismissedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{41}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GroupDismissedTips) GetGroup() *GroupInfo {
@@ -3958,7 +4410,7 @@ type GroupMemberMutedTips struct {
 
 func (x *GroupMemberMutedTips) Reset() {
 	*x = GroupMemberMutedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[42]
+	mi := &file_sdkws_sdkws_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3970,7 +4422,7 @@ func (x *GroupMemberMutedTips) String() string {
 func (*GroupMemberMutedTips) ProtoMessage() {}
 
 func (x *GroupMemberMutedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[42]
+	mi := &file_sdkws_sdkws_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3983,7 +4435,7 @@ func (x *GroupMemberMutedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupMemberMutedTips.ProtoReflect.Descriptor instead.
 func (*GroupMemberMutedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{42}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *GroupMemberMutedTips) GetGroup() *GroupInfo {
@@ -4049,7 +4501,7 @@ type GroupMemberCancelMutedTips struct {
 
 func (x *GroupMemberCancelMutedTips) Reset() {
 	*x = GroupMemberCancelMutedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[43]
+	mi := &file_sdkws_sdkws_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4061,7 +4513,7 @@ func (x *GroupMemberCancelMutedTips) String() string {
 func (*GroupMemberCancelMutedTips) ProtoMessage() {}
 
 func (x *GroupMemberCancelMutedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[43]
+	mi := &file_sdkws_sdkws_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4074,7 +4526,7 @@ func (x *GroupMemberCancelMutedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupMemberCancelMutedTips.ProtoReflect.Descriptor instead.
 func (*GroupMemberCancelMutedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{43}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GroupMemberCancelMutedTips) GetGroup() *GroupInfo {
@@ -4132,7 +4584,7 @@ type GroupMutedTips struct {
 
 func (x *GroupMutedTips) Reset() {
 	*x = GroupMutedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[44]
+	mi := &file_sdkws_sdkws_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4144,7 +4596,7 @@ func (x *GroupMutedTips) String() string {
 func (*GroupMutedTips) ProtoMessage() {}
 
 func (x *GroupMutedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[44]
+	mi := &file_sdkws_sdkws_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4157,7 +4609,7 @@ func (x *GroupMutedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupMutedTips.ProtoReflect.Descriptor instead.
 func (*GroupMutedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{44}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GroupMutedTips) GetGroup() *GroupInfo {
@@ -4208,7 +4660,7 @@ type GroupCancelMutedTips struct {
 
 func (x *GroupCancelMutedTips) Reset() {
 	*x = GroupCancelMutedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[45]
+	mi := &file_sdkws_sdkws_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4220,7 +4672,7 @@ func (x *GroupCancelMutedTips) String() string {
 func (*GroupCancelMutedTips) ProtoMessage() {}
 
 func (x *GroupCancelMutedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[45]
+	mi := &file_sdkws_sdkws_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4233,7 +4685,7 @@ func (x *GroupCancelMutedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupCancelMutedTips.ProtoReflect.Descriptor instead.
 func (*GroupCancelMutedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{45}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GroupCancelMutedTips) GetGroup() *GroupInfo {
@@ -4286,7 +4738,7 @@ type GroupMemberInfoSetTips struct {
 
 func (x *GroupMemberInfoSetTips) Reset() {
 	*x = GroupMemberInfoSetTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[46]
+	mi := &file_sdkws_sdkws_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4298,7 +4750,7 @@ func (x *GroupMemberInfoSetTips) String() string {
 func (*GroupMemberInfoSetTips) ProtoMessage() {}
 
 func (x *GroupMemberInfoSetTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[46]
+	mi := &file_sdkws_sdkws_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4311,7 +4763,7 @@ func (x *GroupMemberInfoSetTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupMemberInfoSetTips.ProtoReflect.Descriptor instead.
 func (*GroupMemberInfoSetTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{46}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GroupMemberInfoSetTips) GetGroup() *GroupInfo {
@@ -4374,7 +4826,7 @@ type FriendApplication struct {
 
 func (x *FriendApplication) Reset() {
 	*x = FriendApplication{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[47]
+	mi := &file_sdkws_sdkws_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4386,7 +4838,7 @@ func (x *FriendApplication) String() string {
 func (*FriendApplication) ProtoMessage() {}
 
 func (x *FriendApplication) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[47]
+	mi := &file_sdkws_sdkws_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4399,7 +4851,7 @@ func (x *FriendApplication) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendApplication.ProtoReflect.Descriptor instead.
 func (*FriendApplication) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{47}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *FriendApplication) GetAddTime() int64 {
@@ -4433,7 +4885,7 @@ type FromToUserID struct {
 
 func (x *FromToUserID) Reset() {
 	*x = FromToUserID{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[48]
+	mi := &file_sdkws_sdkws_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4445,7 +4897,7 @@ func (x *FromToUserID) String() string {
 func (*FromToUserID) ProtoMessage() {}
 
 func (x *FromToUserID) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[48]
+	mi := &file_sdkws_sdkws_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4458,7 +4910,7 @@ func (x *FromToUserID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FromToUserID.ProtoReflect.Descriptor instead.
 func (*FromToUserID) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{48}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *FromToUserID) GetFromUserID() string {
@@ -4486,7 +4938,7 @@ type FriendApplicationTips struct {
 
 func (x *FriendApplicationTips) Reset() {
 	*x = FriendApplicationTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[49]
+	mi := &file_sdkws_sdkws_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4498,7 +4950,7 @@ func (x *FriendApplicationTips) String() string {
 func (*FriendApplicationTips) ProtoMessage() {}
 
 func (x *FriendApplicationTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[49]
+	mi := &file_sdkws_sdkws_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4511,7 +4963,7 @@ func (x *FriendApplicationTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendApplicationTips.ProtoReflect.Descriptor instead.
 func (*FriendApplicationTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{49}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *FriendApplicationTips) GetFromToUserID() *FromToUserID {
@@ -4542,7 +4994,7 @@ type FriendApplicationApprovedTips struct {
 
 func (x *FriendApplicationApprovedTips) Reset() {
 	*x = FriendApplicationApprovedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[50]
+	mi := &file_sdkws_sdkws_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4554,7 +5006,7 @@ func (x *FriendApplicationApprovedTips) String() string {
 func (*FriendApplicationApprovedTips) ProtoMessage() {}
 
 func (x *FriendApplicationApprovedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[50]
+	mi := &file_sdkws_sdkws_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4567,7 +5019,7 @@ func (x *FriendApplicationApprovedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendApplicationApprovedTips.ProtoReflect.Descriptor instead.
 func (*FriendApplicationApprovedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{50}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *FriendApplicationApprovedTips) GetFromToUserID() *FromToUserID {
@@ -4617,7 +5069,7 @@ type FriendApplicationRejectedTips struct {
 
 func (x *FriendApplicationRejectedTips) Reset() {
 	*x = FriendApplicationRejectedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[51]
+	mi := &file_sdkws_sdkws_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4629,7 +5081,7 @@ func (x *FriendApplicationRejectedTips) String() string {
 func (*FriendApplicationRejectedTips) ProtoMessage() {}
 
 func (x *FriendApplicationRejectedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[51]
+	mi := &file_sdkws_sdkws_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4642,7 +5094,7 @@ func (x *FriendApplicationRejectedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendApplicationRejectedTips.ProtoReflect.Descriptor instead.
 func (*FriendApplicationRejectedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{51}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *FriendApplicationRejectedTips) GetFromToUserID() *FromToUserID {
@@ -4666,6 +5118,120 @@ func (x *FriendApplicationRejectedTips) GetRequest() *FriendRequest {
 	return nil
 }
 
+// 好友申请新增留言通知，发送给申请双方
+type FriendApplicationRepliedTips struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromToUserID  *FromToUserID          `protobuf:"bytes,1,opt,name=fromToUserID,proto3" json:"fromToUserID,omitempty"` //from: initiator; to: receiver
+	Message       *FriendRequestMessage  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Request       *FriendRequest         `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendApplicationRepliedTips) Reset() {
+	*x = FriendApplicationRepliedTips{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendApplicationRepliedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendApplicationRepliedTips) ProtoMessage() {}
+
+func (x *FriendApplicationRepliedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendApplicationRepliedTips.ProtoReflect.Descriptor instead.
+func (*FriendApplicationRepliedTips) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FriendApplicationRepliedTips) GetFromToUserID() *FromToUserID {
+	if x != nil {
+		return x.FromToUserID
+	}
+	return nil
+}
+
+func (x *FriendApplicationRepliedTips) GetMessage() *FriendRequestMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *FriendApplicationRepliedTips) GetRequest() *FriendRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// 好友申请过期通知，发送给申请双方
+type FriendApplicationExpiredTips struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromToUserID  *FromToUserID          `protobuf:"bytes,1,opt,name=fromToUserID,proto3" json:"fromToUserID,omitempty"` //from: initiator; to: receiver
+	Request       *FriendRequest         `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendApplicationExpiredTips) Reset() {
+	*x = FriendApplicationExpiredTips{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendApplicationExpiredTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendApplicationExpiredTips) ProtoMessage() {}
+
+func (x *FriendApplicationExpiredTips) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendApplicationExpiredTips.ProtoReflect.Descriptor instead.
+func (*FriendApplicationExpiredTips) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *FriendApplicationExpiredTips) GetFromToUserID() *FromToUserID {
+	if x != nil {
+		return x.FromToUserID
+	}
+	return nil
+}
+
+func (x *FriendApplicationExpiredTips) GetRequest() *FriendRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
 // FromUserID  Added a friend ToUserID
 type FriendAddedTips struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -4680,7 +5246,7 @@ type FriendAddedTips struct {
 
 func (x *FriendAddedTips) Reset() {
 	*x = FriendAddedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[52]
+	mi := &file_sdkws_sdkws_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4692,7 +5258,7 @@ func (x *FriendAddedTips) String() string {
 func (*FriendAddedTips) ProtoMessage() {}
 
 func (x *FriendAddedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[52]
+	mi := &file_sdkws_sdkws_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4705,7 +5271,7 @@ func (x *FriendAddedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendAddedTips.ProtoReflect.Descriptor instead.
 func (*FriendAddedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{52}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *FriendAddedTips) GetFriend() *FriendInfo {
@@ -4755,7 +5321,7 @@ type FriendDeletedTips struct {
 
 func (x *FriendDeletedTips) Reset() {
 	*x = FriendDeletedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[53]
+	mi := &file_sdkws_sdkws_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4767,7 +5333,7 @@ func (x *FriendDeletedTips) String() string {
 func (*FriendDeletedTips) ProtoMessage() {}
 
 func (x *FriendDeletedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[53]
+	mi := &file_sdkws_sdkws_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4780,7 +5346,7 @@ func (x *FriendDeletedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendDeletedTips.ProtoReflect.Descriptor instead.
 func (*FriendDeletedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{53}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *FriendDeletedTips) GetFromToUserID() *FromToUserID {
@@ -4813,7 +5379,7 @@ type BlackAddedTips struct {
 
 func (x *BlackAddedTips) Reset() {
 	*x = BlackAddedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[54]
+	mi := &file_sdkws_sdkws_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4825,7 +5391,7 @@ func (x *BlackAddedTips) String() string {
 func (*BlackAddedTips) ProtoMessage() {}
 
 func (x *BlackAddedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[54]
+	mi := &file_sdkws_sdkws_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4838,7 +5404,7 @@ func (x *BlackAddedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlackAddedTips.ProtoReflect.Descriptor instead.
 func (*BlackAddedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{54}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *BlackAddedTips) GetFromToUserID() *FromToUserID {
@@ -4857,7 +5423,7 @@ type BlackDeletedTips struct {
 
 func (x *BlackDeletedTips) Reset() {
 	*x = BlackDeletedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[55]
+	mi := &file_sdkws_sdkws_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4869,7 +5435,7 @@ func (x *BlackDeletedTips) String() string {
 func (*BlackDeletedTips) ProtoMessage() {}
 
 func (x *BlackDeletedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[55]
+	mi := &file_sdkws_sdkws_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4882,7 +5448,7 @@ func (x *BlackDeletedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlackDeletedTips.ProtoReflect.Descriptor instead.
 func (*BlackDeletedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{55}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *BlackDeletedTips) GetFromToUserID() *FromToUserID {
@@ -4904,7 +5470,7 @@ type FriendInfoChangedTips struct {
 
 func (x *FriendInfoChangedTips) Reset() {
 	*x = FriendInfoChangedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[56]
+	mi := &file_sdkws_sdkws_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4916,7 +5482,7 @@ func (x *FriendInfoChangedTips) String() string {
 func (*FriendInfoChangedTips) ProtoMessage() {}
 
 func (x *FriendInfoChangedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[56]
+	mi := &file_sdkws_sdkws_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4929,7 +5495,7 @@ func (x *FriendInfoChangedTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendInfoChangedTips.ProtoReflect.Descriptor instead.
 func (*FriendInfoChangedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{56}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *FriendInfoChangedTips) GetFromToUserID() *FromToUserID {
@@ -4960,29 +5526,37 @@ func (x *FriendInfoChangedTips) GetFriendSortVersion() uint64 {
 	return 0
 }
 
-//////////////////////user/////////////////////
-type UserInfoUpdatedTips struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 好友分组变更通知（多端同步）
+type FriendCategoryChangedTips struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserID            string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`               // 分组所属用户ID
+	CategoryID        string                 `protobuf:"bytes,2,opt,name=categoryID,proto3" json:"categoryID,omitempty"`       // 分组ID
+	Action            string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`               // 操作类型 constant.FriendCategoryAction*
+	FriendUserIDs     []string               `protobuf:"bytes,4,rep,name=friendUserIDs,proto3" json:"friendUserIDs,omitempty"` // 变更的好友ID列表（friends_added/friends_removed时使用）
+	CategoryVersion   uint64                 `protobuf:"varint,5,opt,name=categoryVersion,proto3" json:"categoryVersion,omitempty"`
+	CategoryVersionID string                 `protobuf:"bytes,6,opt,name=categoryVersionID,proto3" json:"categoryVersionID,omitempty"`
+	FriendVersion     uint64                 `protobuf:"varint,7,opt,name=friendVersion,proto3" json:"friendVersion,omitempty"` // 好友所属分组变化时同时更新好友版本
+	FriendVersionID   string                 `protobuf:"bytes,8,opt,name=friendVersionID,proto3" json:"friendVersionID,omitempty"`
+	UpdateTime        int64                  `protobuf:"varint,9,opt,name=updateTime,proto3" json:"updateTime,omitempty"` // 更新时间
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *UserInfoUpdatedTips) Reset() {
-	*x = UserInfoUpdatedTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[57]
+func (x *FriendCategoryChangedTips) Reset() {
+	*x = FriendCategoryChangedTips{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserInfoUpdatedTips) String() string {
+func (x *FriendCategoryChangedTips) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserInfoUpdatedTips) ProtoMessage() {}
+func (*FriendCategoryChangedTips) ProtoMessage() {}
 
-func (x *UserInfoUpdatedTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[57]
+func (x *FriendCategoryChangedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4993,56 +5567,159 @@ func (x *UserInfoUpdatedTips) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserInfoUpdatedTips.ProtoReflect.Descriptor instead.
-func (*UserInfoUpdatedTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use FriendCategoryChangedTips.ProtoReflect.Descriptor instead.
+func (*FriendCategoryChangedTips) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *UserInfoUpdatedTips) GetUserID() string {
+func (x *FriendCategoryChangedTips) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-type UserStatusChangeTips struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromUserID    string                 `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
-	ToUserID      string                 `protobuf:"bytes,2,opt,name=toUserID,proto3" json:"toUserID,omitempty"`
-	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
-	PlatformID    int32                  `protobuf:"varint,4,opt,name=platformID,proto3" json:"platformID,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FriendCategoryChangedTips) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
 }
 
-func (x *UserStatusChangeTips) Reset() {
-	*x = UserStatusChangeTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[58]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *FriendCategoryChangedTips) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (x *UserStatusChangeTips) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FriendCategoryChangedTips) GetFriendUserIDs() []string {
+	if x != nil {
+		return x.FriendUserIDs
+	}
+	return nil
 }
 
-func (*UserStatusChangeTips) ProtoMessage() {}
+func (x *FriendCategoryChangedTips) GetCategoryVersion() uint64 {
+	if x != nil {
+		return x.CategoryVersion
+	}
+	return 0
+}
 
-func (x *UserStatusChangeTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[58]
+func (x *FriendCategoryChangedTips) GetCategoryVersionID() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CategoryVersionID
 	}
-	return mi.MessageOf(x)
+	return ""
+}
+
+func (x *FriendCategoryChangedTips) GetFriendVersion() uint64 {
+	if x != nil {
+		return x.FriendVersion
+	}
+	return 0
+}
+
+func (x *FriendCategoryChangedTips) GetFriendVersionID() string {
+	if x != nil {
+		return x.FriendVersionID
+	}
+	return ""
+}
+
+func (x *FriendCategoryChangedTips) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+//////////////////////user/////////////////////
+type UserInfoUpdatedTips struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserInfoUpdatedTips) Reset() {
+	*x = UserInfoUpdatedTips{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserInfoUpdatedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfoUpdatedTips) ProtoMessage() {}
+
+func (x *UserInfoUpdatedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfoUpdatedTips.ProtoReflect.Descriptor instead.
+func (*UserInfoUpdatedTips) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UserInfoUpdatedTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type UserStatusChangeTips struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserID    string                 `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
+	ToUserID      string                 `protobuf:"bytes,2,opt,name=toUserID,proto3" json:"toUserID,omitempty"`
+	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	PlatformID    int32                  `protobuf:"varint,4,opt,name=platformID,proto3" json:"platformID,omitempty"`
+	Presence      *UserPresence          `protobuf:"bytes,5,opt,name=presence,proto3" json:"presence,omitempty"`  // 自定义状态，为空表示未设置或已清除
+	Activity      int32                  `protobuf:"varint,6,opt,name=activity,proto3" json:"activity,omitempty"` // 自动状态 constant.UserActivity*，多个来源同时存在时取优先级最高的
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserStatusChangeTips) Reset() {
+	*x = UserStatusChangeTips{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStatusChangeTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStatusChangeTips) ProtoMessage() {}
+
+func (x *UserStatusChangeTips) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
 // Deprecated: Use UserStatusChangeTips.ProtoReflect.Descriptor instead.
 func (*UserStatusChangeTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{58}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *UserStatusChangeTips) GetFromUserID() string {
@@ -5073,6 +5750,259 @@ func (x *UserStatusChangeTips) GetPlatformID() int32 {
 	return 0
 }
 
+func (x *UserStatusChangeTips) GetPresence() *UserPresence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+func (x *UserStatusChangeTips) GetActivity() int32 {
+	if x != nil {
+		return x.Activity
+	}
+	return 0
+}
+
+// 账号停用/恢复通知，相关服务据此刷新缓存、隐藏或恢复该用户
+type UserAccountStatusTips struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	AccountStatus int32                  `protobuf:"varint,2,opt,name=accountStatus,proto3" json:"accountStatus,omitempty"` // 变更后的账号状态 constant.AccountStatus*
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	OpUserID      string                 `protobuf:"bytes,4,opt,name=opUserID,proto3" json:"opUserID,omitempty"`
+	OperationTime int64                  `protobuf:"varint,5,opt,name=operationTime,proto3" json:"operationTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserAccountStatusTips) Reset() {
+	*x = UserAccountStatusTips{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserAccountStatusTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAccountStatusTips) ProtoMessage() {}
+
+func (x *UserAccountStatusTips) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAccountStatusTips.ProtoReflect.Descriptor instead.
+func (*UserAccountStatusTips) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UserAccountStatusTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserAccountStatusTips) GetAccountStatus() int32 {
+	if x != nil {
+		return x.AccountStatus
+	}
+	return 0
+}
+
+func (x *UserAccountStatusTips) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UserAccountStatusTips) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *UserAccountStatusTips) GetOperationTime() int64 {
+	if x != nil {
+		return x.OperationTime
+	}
+	return 0
+}
+
+// 客户端配置变更通知，其他设备收到后增量同步
+type UserClientConfigChangedTips struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Keys          []string               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`              // 变更的配置项
+	PlatformID    int32                  `protobuf:"varint,3,opt,name=platformID,proto3" json:"platformID,omitempty"` // 变更值所属平台（scope=platform）
+	DeviceID      string                 `protobuf:"bytes,4,opt,name=deviceID,proto3" json:"deviceID,omitempty"`      // 变更值所属设备（scope=device）
+	Version       uint64                 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	VersionID     string                 `protobuf:"bytes,6,opt,name=versionID,proto3" json:"versionID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserClientConfigChangedTips) Reset() {
+	*x = UserClientConfigChangedTips{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserClientConfigChangedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserClientConfigChangedTips) ProtoMessage() {}
+
+func (x *UserClientConfigChangedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserClientConfigChangedTips.ProtoReflect.Descriptor instead.
+func (*UserClientConfigChangedTips) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UserClientConfigChangedTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserClientConfigChangedTips) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *UserClientConfigChangedTips) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *UserClientConfigChangedTips) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *UserClientConfigChangedTips) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UserClientConfigChangedTips) GetVersionID() string {
+	if x != nil {
+		return x.VersionID
+	}
+	return ""
+}
+
+// 会话（登录设备）被注销通知，被注销的客户端收到后应主动退出登录
+type SessionRevokedTips struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	SessionID     string                 `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID,omitempty"`    // 被注销的会话ID
+	PlatformID    int32                  `protobuf:"varint,3,opt,name=platformID,proto3" json:"platformID,omitempty"` // 被注销会话的平台
+	OpUserID      string                 `protobuf:"bytes,4,opt,name=opUserID,proto3" json:"opUserID,omitempty"`      // 操作者（本人或管理员）
+	RevokeTime    int64                  `protobuf:"varint,5,opt,name=revokeTime,proto3" json:"revokeTime,omitempty"` // 注销时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionRevokedTips) Reset() {
+	*x = SessionRevokedTips{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionRevokedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRevokedTips) ProtoMessage() {}
+
+func (x *SessionRevokedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRevokedTips.ProtoReflect.Descriptor instead.
+func (*SessionRevokedTips) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SessionRevokedTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SessionRevokedTips) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SessionRevokedTips) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *SessionRevokedTips) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *SessionRevokedTips) GetRevokeTime() int64 {
+	if x != nil {
+		return x.RevokeTime
+	}
+	return 0
+}
+
 type UserCommandAddTips struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FromUserID    string                 `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
@@ -5083,7 +6013,7 @@ type UserCommandAddTips struct {
 
 func (x *UserCommandAddTips) Reset() {
 	*x = UserCommandAddTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[59]
+	mi := &file_sdkws_sdkws_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5095,7 +6025,7 @@ func (x *UserCommandAddTips) String() string {
 func (*UserCommandAddTips) ProtoMessage() {}
 
 func (x *UserCommandAddTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[59]
+	mi := &file_sdkws_sdkws_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5108,7 +6038,7 @@ func (x *UserCommandAddTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserCommandAddTips.ProtoReflect.Descriptor instead.
 func (*UserCommandAddTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{59}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *UserCommandAddTips) GetFromUserID() string {
@@ -5135,7 +6065,7 @@ type UserCommandUpdateTips struct {
 
 func (x *UserCommandUpdateTips) Reset() {
 	*x = UserCommandUpdateTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[60]
+	mi := &file_sdkws_sdkws_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5147,7 +6077,7 @@ func (x *UserCommandUpdateTips) String() string {
 func (*UserCommandUpdateTips) ProtoMessage() {}
 
 func (x *UserCommandUpdateTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[60]
+	mi := &file_sdkws_sdkws_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5160,7 +6090,7 @@ func (x *UserCommandUpdateTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserCommandUpdateTips.ProtoReflect.Descriptor instead.
 func (*UserCommandUpdateTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{60}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *UserCommandUpdateTips) GetFromUserID() string {
@@ -5187,7 +6117,7 @@ type UserCommandDeleteTips struct {
 
 func (x *UserCommandDeleteTips) Reset() {
 	*x = UserCommandDeleteTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[61]
+	mi := &file_sdkws_sdkws_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5199,7 +6129,7 @@ func (x *UserCommandDeleteTips) String() string {
 func (*UserCommandDeleteTips) ProtoMessage() {}
 
 func (x *UserCommandDeleteTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[61]
+	mi := &file_sdkws_sdkws_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5212,7 +6142,7 @@ func (x *UserCommandDeleteTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserCommandDeleteTips.ProtoReflect.Descriptor instead.
 func (*UserCommandDeleteTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{61}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *UserCommandDeleteTips) GetFromUserID() string {
@@ -5239,7 +6169,7 @@ type UserEmojiAddTips struct {
 
 func (x *UserEmojiAddTips) Reset() {
 	*x = UserEmojiAddTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[62]
+	mi := &file_sdkws_sdkws_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5250,8 +6180,60 @@ func (x *UserEmojiAddTips) String() string {
 
 func (*UserEmojiAddTips) ProtoMessage() {}
 
-func (x *UserEmojiAddTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[62]
+func (x *UserEmojiAddTips) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEmojiAddTips.ProtoReflect.Descriptor instead.
+func (*UserEmojiAddTips) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UserEmojiAddTips) GetFromUserID() string {
+	if x != nil {
+		return x.FromUserID
+	}
+	return ""
+}
+
+func (x *UserEmojiAddTips) GetToUserID() string {
+	if x != nil {
+		return x.ToUserID
+	}
+	return ""
+}
+
+type UserEmojiDeleteTips struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserID    string                 `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
+	ToUserID      string                 `protobuf:"bytes,2,opt,name=toUserID,proto3" json:"toUserID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEmojiDeleteTips) Reset() {
+	*x = UserEmojiDeleteTips{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEmojiDeleteTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEmojiDeleteTips) ProtoMessage() {}
+
+func (x *UserEmojiDeleteTips) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5262,48 +6244,51 @@ func (x *UserEmojiAddTips) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserEmojiAddTips.ProtoReflect.Descriptor instead.
-func (*UserEmojiAddTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use UserEmojiDeleteTips.ProtoReflect.Descriptor instead.
+func (*UserEmojiDeleteTips) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *UserEmojiAddTips) GetFromUserID() string {
+func (x *UserEmojiDeleteTips) GetFromUserID() string {
 	if x != nil {
 		return x.FromUserID
 	}
 	return ""
 }
 
-func (x *UserEmojiAddTips) GetToUserID() string {
+func (x *UserEmojiDeleteTips) GetToUserID() string {
 	if x != nil {
 		return x.ToUserID
 	}
 	return ""
 }
 
-type UserEmojiDeleteTips struct {
+// 表情包变更通知（多端同步）
+type UserEmojiPackChangedTips struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FromUserID    string                 `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
 	ToUserID      string                 `protobuf:"bytes,2,opt,name=toUserID,proto3" json:"toUserID,omitempty"`
+	PackID        string                 `protobuf:"bytes,3,opt,name=packID,proto3" json:"packID,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // 操作类型 constant.EmojiPackAction*
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserEmojiDeleteTips) Reset() {
-	*x = UserEmojiDeleteTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[63]
+func (x *UserEmojiPackChangedTips) Reset() {
+	*x = UserEmojiPackChangedTips{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserEmojiDeleteTips) String() string {
+func (x *UserEmojiPackChangedTips) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserEmojiDeleteTips) ProtoMessage() {}
+func (*UserEmojiPackChangedTips) ProtoMessage() {}
 
-func (x *UserEmojiDeleteTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[63]
+func (x *UserEmojiPackChangedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5314,25 +6299,39 @@ func (x *UserEmojiDeleteTips) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserEmojiDeleteTips.ProtoReflect.Descriptor instead.
-func (*UserEmojiDeleteTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use UserEmojiPackChangedTips.ProtoReflect.Descriptor instead.
+func (*UserEmojiPackChangedTips) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *UserEmojiDeleteTips) GetFromUserID() string {
+func (x *UserEmojiPackChangedTips) GetFromUserID() string {
 	if x != nil {
 		return x.FromUserID
 	}
 	return ""
 }
 
-func (x *UserEmojiDeleteTips) GetToUserID() string {
+func (x *UserEmojiPackChangedTips) GetToUserID() string {
 	if x != nil {
 		return x.ToUserID
 	}
 	return ""
 }
 
+func (x *UserEmojiPackChangedTips) GetPackID() string {
+	if x != nil {
+		return x.PackID
+	}
+	return ""
+}
+
+func (x *UserEmojiPackChangedTips) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
 // 快捷回复刷新完成通知（常用回复统计完成后发送）
 type UserQuickReplyUpdateTips struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -5345,7 +6344,7 @@ type UserQuickReplyUpdateTips struct {
 
 func (x *UserQuickReplyUpdateTips) Reset() {
 	*x = UserQuickReplyUpdateTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[64]
+	mi := &file_sdkws_sdkws_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5357,7 +6356,7 @@ func (x *UserQuickReplyUpdateTips) String() string {
 func (*UserQuickReplyUpdateTips) ProtoMessage() {}
 
 func (x *UserQuickReplyUpdateTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[64]
+	mi := &file_sdkws_sdkws_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5370,7 +6369,7 @@ func (x *UserQuickReplyUpdateTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserQuickReplyUpdateTips.ProtoReflect.Descriptor instead.
 func (*UserQuickReplyUpdateTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{64}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *UserQuickReplyUpdateTips) GetFromUserID() string {
@@ -5406,7 +6405,7 @@ type UserAIQuickReplyUpdateTips struct {
 
 func (x *UserAIQuickReplyUpdateTips) Reset() {
 	*x = UserAIQuickReplyUpdateTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[65]
+	mi := &file_sdkws_sdkws_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5418,7 +6417,7 @@ func (x *UserAIQuickReplyUpdateTips) String() string {
 func (*UserAIQuickReplyUpdateTips) ProtoMessage() {}
 
 func (x *UserAIQuickReplyUpdateTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[65]
+	mi := &file_sdkws_sdkws_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5431,7 +6430,7 @@ func (x *UserAIQuickReplyUpdateTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAIQuickReplyUpdateTips.ProtoReflect.Descriptor instead.
 func (*UserAIQuickReplyUpdateTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{65}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *UserAIQuickReplyUpdateTips) GetFromUserID() string {
@@ -5455,21 +6454,76 @@ func (x *UserAIQuickReplyUpdateTips) GetUpdateTime() int64 {
 	return 0
 }
 
+// 快捷回复适用范围，conversationIDs 与 groupIDs 任一命中即适用
+type QuickReplyScope struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConversationIDs []string               `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+	GroupIDs        []string               `protobuf:"bytes,2,rep,name=groupIDs,proto3" json:"groupIDs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QuickReplyScope) Reset() {
+	*x = QuickReplyScope{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickReplyScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickReplyScope) ProtoMessage() {}
+
+func (x *QuickReplyScope) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickReplyScope.ProtoReflect.Descriptor instead.
+func (*QuickReplyScope) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *QuickReplyScope) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+func (x *QuickReplyScope) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
 // 快捷回复新增通知
 type UserQuickReplyAddTips struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FromUserID    string                 `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
 	ToUserID      string                 `protobuf:"bytes,2,opt,name=toUserID,proto3" json:"toUserID,omitempty"`
-	ReplyID       string                 `protobuf:"bytes,3,opt,name=replyID,proto3" json:"replyID,omitempty"`      // 回复ID
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`      // 回复内容
-	ReplyType     int32                  `protobuf:"varint,5,opt,name=replyType,proto3" json:"replyType,omitempty"` // 回复类型
+	ReplyID       string                 `protobuf:"bytes,3,opt,name=replyID,proto3" json:"replyID,omitempty"`        // 回复ID
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`        // 回复内容
+	ReplyType     int32                  `protobuf:"varint,5,opt,name=replyType,proto3" json:"replyType,omitempty"`   // 回复类型
+	IsTemplate    bool                   `protobuf:"varint,6,opt,name=isTemplate,proto3" json:"isTemplate,omitempty"` // 是否为模板
+	Scope         *QuickReplyScope       `protobuf:"bytes,7,opt,name=scope,proto3" json:"scope,omitempty"`            // 适用范围，为空表示所有会话
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserQuickReplyAddTips) Reset() {
 	*x = UserQuickReplyAddTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[66]
+	mi := &file_sdkws_sdkws_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5481,7 +6535,7 @@ func (x *UserQuickReplyAddTips) String() string {
 func (*UserQuickReplyAddTips) ProtoMessage() {}
 
 func (x *UserQuickReplyAddTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[66]
+	mi := &file_sdkws_sdkws_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5494,7 +6548,7 @@ func (x *UserQuickReplyAddTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserQuickReplyAddTips.ProtoReflect.Descriptor instead.
 func (*UserQuickReplyAddTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{66}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *UserQuickReplyAddTips) GetFromUserID() string {
@@ -5532,6 +6586,20 @@ func (x *UserQuickReplyAddTips) GetReplyType() int32 {
 	return 0
 }
 
+func (x *UserQuickReplyAddTips) GetIsTemplate() bool {
+	if x != nil {
+		return x.IsTemplate
+	}
+	return false
+}
+
+func (x *UserQuickReplyAddTips) GetScope() *QuickReplyScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
 // 快捷回复删除通知
 type UserQuickReplyDeleteTips struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -5544,7 +6612,7 @@ type UserQuickReplyDeleteTips struct {
 
 func (x *UserQuickReplyDeleteTips) Reset() {
 	*x = UserQuickReplyDeleteTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[67]
+	mi := &file_sdkws_sdkws_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5556,7 +6624,7 @@ func (x *UserQuickReplyDeleteTips) String() string {
 func (*UserQuickReplyDeleteTips) ProtoMessage() {}
 
 func (x *UserQuickReplyDeleteTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[67]
+	mi := &file_sdkws_sdkws_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5569,7 +6637,7 @@ func (x *UserQuickReplyDeleteTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserQuickReplyDeleteTips.ProtoReflect.Descriptor instead.
 func (*UserQuickReplyDeleteTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{67}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *UserQuickReplyDeleteTips) GetFromUserID() string {
@@ -5598,15 +6666,17 @@ type UserQuickReplyModifyTips struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FromUserID    string                 `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
 	ToUserID      string                 `protobuf:"bytes,2,opt,name=toUserID,proto3" json:"toUserID,omitempty"`
-	ReplyID       string                 `protobuf:"bytes,3,opt,name=replyID,proto3" json:"replyID,omitempty"` // 回复ID
-	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"` // 回复内容
+	ReplyID       string                 `protobuf:"bytes,3,opt,name=replyID,proto3" json:"replyID,omitempty"`        // 回复ID
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`        // 回复内容
+	IsTemplate    bool                   `protobuf:"varint,5,opt,name=isTemplate,proto3" json:"isTemplate,omitempty"` // 是否为模板
+	Scope         *QuickReplyScope       `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"`            // 适用范围，为空表示所有会话
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserQuickReplyModifyTips) Reset() {
 	*x = UserQuickReplyModifyTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[68]
+	mi := &file_sdkws_sdkws_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5618,7 +6688,7 @@ func (x *UserQuickReplyModifyTips) String() string {
 func (*UserQuickReplyModifyTips) ProtoMessage() {}
 
 func (x *UserQuickReplyModifyTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[68]
+	mi := &file_sdkws_sdkws_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5631,7 +6701,7 @@ func (x *UserQuickReplyModifyTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserQuickReplyModifyTips.ProtoReflect.Descriptor instead.
 func (*UserQuickReplyModifyTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{68}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *UserQuickReplyModifyTips) GetFromUserID() string {
@@ -5662,6 +6732,20 @@ func (x *UserQuickReplyModifyTips) GetContent() string {
 	return ""
 }
 
+func (x *UserQuickReplyModifyTips) GetIsTemplate() bool {
+	if x != nil {
+		return x.IsTemplate
+	}
+	return false
+}
+
+func (x *UserQuickReplyModifyTips) GetScope() *QuickReplyScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
 // 快捷回复置顶/取消置顶通知
 type UserQuickReplyPinTips struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -5676,7 +6760,7 @@ type UserQuickReplyPinTips struct {
 
 func (x *UserQuickReplyPinTips) Reset() {
 	*x = UserQuickReplyPinTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[69]
+	mi := &file_sdkws_sdkws_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5688,7 +6772,7 @@ func (x *UserQuickReplyPinTips) String() string {
 func (*UserQuickReplyPinTips) ProtoMessage() {}
 
 func (x *UserQuickReplyPinTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[69]
+	mi := &file_sdkws_sdkws_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5701,7 +6785,7 @@ func (x *UserQuickReplyPinTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserQuickReplyPinTips.ProtoReflect.Descriptor instead.
 func (*UserQuickReplyPinTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{69}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *UserQuickReplyPinTips) GetFromUserID() string {
@@ -5760,7 +6844,7 @@ type SummaryRecordAddTips struct {
 
 func (x *SummaryRecordAddTips) Reset() {
 	*x = SummaryRecordAddTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[70]
+	mi := &file_sdkws_sdkws_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5772,7 +6856,7 @@ func (x *SummaryRecordAddTips) String() string {
 func (*SummaryRecordAddTips) ProtoMessage() {}
 
 func (x *SummaryRecordAddTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[70]
+	mi := &file_sdkws_sdkws_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5785,7 +6869,7 @@ func (x *SummaryRecordAddTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SummaryRecordAddTips.ProtoReflect.Descriptor instead.
 func (*SummaryRecordAddTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{70}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *SummaryRecordAddTips) GetOperatorUserID() string {
@@ -5885,7 +6969,7 @@ type SummaryRecordDeleteTips struct {
 
 func (x *SummaryRecordDeleteTips) Reset() {
 	*x = SummaryRecordDeleteTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[71]
+	mi := &file_sdkws_sdkws_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5897,7 +6981,7 @@ func (x *SummaryRecordDeleteTips) String() string {
 func (*SummaryRecordDeleteTips) ProtoMessage() {}
 
 func (x *SummaryRecordDeleteTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[71]
+	mi := &file_sdkws_sdkws_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5910,7 +6994,7 @@ func (x *SummaryRecordDeleteTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SummaryRecordDeleteTips.ProtoReflect.Descriptor instead.
 func (*SummaryRecordDeleteTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{71}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *SummaryRecordDeleteTips) GetOperatorUserID() string {
@@ -5953,7 +7037,7 @@ type SummaryRecordFavoriteTips struct {
 
 func (x *SummaryRecordFavoriteTips) Reset() {
 	*x = SummaryRecordFavoriteTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[72]
+	mi := &file_sdkws_sdkws_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5965,7 +7049,7 @@ func (x *SummaryRecordFavoriteTips) String() string {
 func (*SummaryRecordFavoriteTips) ProtoMessage() {}
 
 func (x *SummaryRecordFavoriteTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[72]
+	mi := &file_sdkws_sdkws_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5978,7 +7062,7 @@ func (x *SummaryRecordFavoriteTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SummaryRecordFavoriteTips.ProtoReflect.Descriptor instead.
 func (*SummaryRecordFavoriteTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{72}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *SummaryRecordFavoriteTips) GetOperatorUserID() string {
@@ -6013,7 +7097,7 @@ type SummaryRecordPublishTips struct {
 
 func (x *SummaryRecordPublishTips) Reset() {
 	*x = SummaryRecordPublishTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[73]
+	mi := &file_sdkws_sdkws_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6025,7 +7109,7 @@ func (x *SummaryRecordPublishTips) String() string {
 func (*SummaryRecordPublishTips) ProtoMessage() {}
 
 func (x *SummaryRecordPublishTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[73]
+	mi := &file_sdkws_sdkws_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6038,7 +7122,7 @@ func (x *SummaryRecordPublishTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SummaryRecordPublishTips.ProtoReflect.Descriptor instead.
 func (*SummaryRecordPublishTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{73}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *SummaryRecordPublishTips) GetOperatorUserID() string {
@@ -6076,7 +7160,7 @@ type ScheduleNotificationRepeatInfo struct {
 
 func (x *ScheduleNotificationRepeatInfo) Reset() {
 	*x = ScheduleNotificationRepeatInfo{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[74]
+	mi := &file_sdkws_sdkws_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6088,7 +7172,7 @@ func (x *ScheduleNotificationRepeatInfo) String() string {
 func (*ScheduleNotificationRepeatInfo) ProtoMessage() {}
 
 func (x *ScheduleNotificationRepeatInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[74]
+	mi := &file_sdkws_sdkws_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6101,7 +7185,7 @@ func (x *ScheduleNotificationRepeatInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleNotificationRepeatInfo.ProtoReflect.Descriptor instead.
 func (*ScheduleNotificationRepeatInfo) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{74}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ScheduleNotificationRepeatInfo) GetEndDate() int64 {
@@ -6182,7 +7266,7 @@ type ScheduleNotificationAttendeeInfo struct {
 
 func (x *ScheduleNotificationAttendeeInfo) Reset() {
 	*x = ScheduleNotificationAttendeeInfo{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[75]
+	mi := &file_sdkws_sdkws_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6194,7 +7278,7 @@ func (x *ScheduleNotificationAttendeeInfo) String() string {
 func (*ScheduleNotificationAttendeeInfo) ProtoMessage() {}
 
 func (x *ScheduleNotificationAttendeeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[75]
+	mi := &file_sdkws_sdkws_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6207,7 +7291,7 @@ func (x *ScheduleNotificationAttendeeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleNotificationAttendeeInfo.ProtoReflect.Descriptor instead.
 func (*ScheduleNotificationAttendeeInfo) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{75}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *ScheduleNotificationAttendeeInfo) GetUserID() string {
@@ -6273,7 +7357,7 @@ type ScheduleNotificationMeetingSettings struct {
 
 func (x *ScheduleNotificationMeetingSettings) Reset() {
 	*x = ScheduleNotificationMeetingSettings{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[76]
+	mi := &file_sdkws_sdkws_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6285,7 +7369,7 @@ func (x *ScheduleNotificationMeetingSettings) String() string {
 func (*ScheduleNotificationMeetingSettings) ProtoMessage() {}
 
 func (x *ScheduleNotificationMeetingSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[76]
+	mi := &file_sdkws_sdkws_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6298,7 +7382,7 @@ func (x *ScheduleNotificationMeetingSettings) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ScheduleNotificationMeetingSettings.ProtoReflect.Descriptor instead.
 func (*ScheduleNotificationMeetingSettings) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{76}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *ScheduleNotificationMeetingSettings) GetEnablePassword() bool {
@@ -6425,7 +7509,7 @@ type ScheduleNotificationTips struct {
 
 func (x *ScheduleNotificationTips) Reset() {
 	*x = ScheduleNotificationTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[77]
+	mi := &file_sdkws_sdkws_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6437,7 +7521,7 @@ func (x *ScheduleNotificationTips) String() string {
 func (*ScheduleNotificationTips) ProtoMessage() {}
 
 func (x *ScheduleNotificationTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[77]
+	mi := &file_sdkws_sdkws_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6450,7 +7534,7 @@ func (x *ScheduleNotificationTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleNotificationTips.ProtoReflect.Descriptor instead.
 func (*ScheduleNotificationTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{77}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *ScheduleNotificationTips) GetOperatorUserID() string {
@@ -6674,7 +7758,7 @@ type ConversationUpdateTips struct {
 
 func (x *ConversationUpdateTips) Reset() {
 	*x = ConversationUpdateTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[78]
+	mi := &file_sdkws_sdkws_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6686,7 +7770,7 @@ func (x *ConversationUpdateTips) String() string {
 func (*ConversationUpdateTips) ProtoMessage() {}
 
 func (x *ConversationUpdateTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[78]
+	mi := &file_sdkws_sdkws_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6699,7 +7783,7 @@ func (x *ConversationUpdateTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversationUpdateTips.ProtoReflect.Descriptor instead.
 func (*ConversationUpdateTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{78}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *ConversationUpdateTips) GetUserID() string {
@@ -6728,7 +7812,7 @@ type ConversationSetPrivateTips struct {
 
 func (x *ConversationSetPrivateTips) Reset() {
 	*x = ConversationSetPrivateTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[79]
+	mi := &file_sdkws_sdkws_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6740,7 +7824,7 @@ func (x *ConversationSetPrivateTips) String() string {
 func (*ConversationSetPrivateTips) ProtoMessage() {}
 
 func (x *ConversationSetPrivateTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[79]
+	mi := &file_sdkws_sdkws_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6753,7 +7837,7 @@ func (x *ConversationSetPrivateTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversationSetPrivateTips.ProtoReflect.Descriptor instead.
 func (*ConversationSetPrivateTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{79}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *ConversationSetPrivateTips) GetRecvID() string {
@@ -6796,7 +7880,7 @@ type ConversationHasReadTips struct {
 
 func (x *ConversationHasReadTips) Reset() {
 	*x = ConversationHasReadTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[80]
+	mi := &file_sdkws_sdkws_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6808,7 +7892,7 @@ func (x *ConversationHasReadTips) String() string {
 func (*ConversationHasReadTips) ProtoMessage() {}
 
 func (x *ConversationHasReadTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[80]
+	mi := &file_sdkws_sdkws_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6821,7 +7905,7 @@ func (x *ConversationHasReadTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversationHasReadTips.ProtoReflect.Descriptor instead.
 func (*ConversationHasReadTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{80}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *ConversationHasReadTips) GetUserID() string {
@@ -6861,7 +7945,7 @@ type NotificationElem struct {
 
 func (x *NotificationElem) Reset() {
 	*x = NotificationElem{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[81]
+	mi := &file_sdkws_sdkws_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6873,7 +7957,7 @@ func (x *NotificationElem) String() string {
 func (*NotificationElem) ProtoMessage() {}
 
 func (x *NotificationElem) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[81]
+	mi := &file_sdkws_sdkws_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6886,7 +7970,7 @@ func (x *NotificationElem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotificationElem.ProtoReflect.Descriptor instead.
 func (*NotificationElem) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{81}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *NotificationElem) GetDetail() string {
@@ -6906,7 +7990,7 @@ type Seqs struct {
 
 func (x *Seqs) Reset() {
 	*x = Seqs{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[82]
+	mi := &file_sdkws_sdkws_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6918,7 +8002,7 @@ func (x *Seqs) String() string {
 func (*Seqs) ProtoMessage() {}
 
 func (x *Seqs) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[82]
+	mi := &file_sdkws_sdkws_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6931,7 +8015,7 @@ func (x *Seqs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Seqs.ProtoReflect.Descriptor instead.
 func (*Seqs) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{82}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *Seqs) GetSeqs() []int64 {
@@ -6952,7 +8036,7 @@ type DeleteMessageTips struct {
 
 func (x *DeleteMessageTips) Reset() {
 	*x = DeleteMessageTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[83]
+	mi := &file_sdkws_sdkws_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6964,7 +8048,7 @@ func (x *DeleteMessageTips) String() string {
 func (*DeleteMessageTips) ProtoMessage() {}
 
 func (x *DeleteMessageTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[83]
+	mi := &file_sdkws_sdkws_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6977,7 +8061,7 @@ func (x *DeleteMessageTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMessageTips.ProtoReflect.Descriptor instead.
 func (*DeleteMessageTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{83}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *DeleteMessageTips) GetOpUserID() string {
@@ -7016,7 +8100,7 @@ type RevokeMsgTips struct {
 
 func (x *RevokeMsgTips) Reset() {
 	*x = RevokeMsgTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[84]
+	mi := &file_sdkws_sdkws_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7028,7 +8112,7 @@ func (x *RevokeMsgTips) String() string {
 func (*RevokeMsgTips) ProtoMessage() {}
 
 func (x *RevokeMsgTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[84]
+	mi := &file_sdkws_sdkws_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7041,7 +8125,7 @@ func (x *RevokeMsgTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeMsgTips.ProtoReflect.Descriptor instead.
 func (*RevokeMsgTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{84}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *RevokeMsgTips) GetRevokerUserID() string {
@@ -7112,7 +8196,7 @@ type MessageRevokedContent struct {
 
 func (x *MessageRevokedContent) Reset() {
 	*x = MessageRevokedContent{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[85]
+	mi := &file_sdkws_sdkws_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7124,7 +8208,7 @@ func (x *MessageRevokedContent) String() string {
 func (*MessageRevokedContent) ProtoMessage() {}
 
 func (x *MessageRevokedContent) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[85]
+	mi := &file_sdkws_sdkws_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7137,7 +8221,7 @@ func (x *MessageRevokedContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageRevokedContent.ProtoReflect.Descriptor instead.
 func (*MessageRevokedContent) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{85}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *MessageRevokedContent) GetRevokerID() string {
@@ -7227,7 +8311,7 @@ type ClearConversationTips struct {
 
 func (x *ClearConversationTips) Reset() {
 	*x = ClearConversationTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[86]
+	mi := &file_sdkws_sdkws_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7239,7 +8323,7 @@ func (x *ClearConversationTips) String() string {
 func (*ClearConversationTips) ProtoMessage() {}
 
 func (x *ClearConversationTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[86]
+	mi := &file_sdkws_sdkws_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7252,7 +8336,7 @@ func (x *ClearConversationTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearConversationTips.ProtoReflect.Descriptor instead.
 func (*ClearConversationTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{86}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *ClearConversationTips) GetUserID() string {
@@ -7280,7 +8364,7 @@ type DeleteMsgsTips struct {
 
 func (x *DeleteMsgsTips) Reset() {
 	*x = DeleteMsgsTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[87]
+	mi := &file_sdkws_sdkws_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7292,7 +8376,7 @@ func (x *DeleteMsgsTips) String() string {
 func (*DeleteMsgsTips) ProtoMessage() {}
 
 func (x *DeleteMsgsTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[87]
+	mi := &file_sdkws_sdkws_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7305,7 +8389,7 @@ func (x *DeleteMsgsTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMsgsTips.ProtoReflect.Descriptor instead.
 func (*DeleteMsgsTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{87}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *DeleteMsgsTips) GetUserID() string {
@@ -7341,7 +8425,7 @@ type MarkAsReadTips struct {
 
 func (x *MarkAsReadTips) Reset() {
 	*x = MarkAsReadTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[88]
+	mi := &file_sdkws_sdkws_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7353,7 +8437,7 @@ func (x *MarkAsReadTips) String() string {
 func (*MarkAsReadTips) ProtoMessage() {}
 
 func (x *MarkAsReadTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[88]
+	mi := &file_sdkws_sdkws_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7366,7 +8450,7 @@ func (x *MarkAsReadTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkAsReadTips.ProtoReflect.Descriptor instead.
 func (*MarkAsReadTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{88}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *MarkAsReadTips) GetMarkAsReadUserID() string {
@@ -7412,7 +8496,7 @@ type GroupMsgReadUser struct {
 
 func (x *GroupMsgReadUser) Reset() {
 	*x = GroupMsgReadUser{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[89]
+	mi := &file_sdkws_sdkws_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7424,7 +8508,7 @@ func (x *GroupMsgReadUser) String() string {
 func (*GroupMsgReadUser) ProtoMessage() {}
 
 func (x *GroupMsgReadUser) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[89]
+	mi := &file_sdkws_sdkws_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7437,7 +8521,7 @@ func (x *GroupMsgReadUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupMsgReadUser.ProtoReflect.Descriptor instead.
 func (*GroupMsgReadUser) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{89}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *GroupMsgReadUser) GetUserID() string {
@@ -7492,7 +8576,7 @@ type SetAppBackgroundStatusReq struct {
 
 func (x *SetAppBackgroundStatusReq) Reset() {
 	*x = SetAppBackgroundStatusReq{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[90]
+	mi := &file_sdkws_sdkws_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7504,7 +8588,7 @@ func (x *SetAppBackgroundStatusReq) String() string {
 func (*SetAppBackgroundStatusReq) ProtoMessage() {}
 
 func (x *SetAppBackgroundStatusReq) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[90]
+	mi := &file_sdkws_sdkws_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7517,7 +8601,7 @@ func (x *SetAppBackgroundStatusReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAppBackgroundStatusReq.ProtoReflect.Descriptor instead.
 func (*SetAppBackgroundStatusReq) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{90}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *SetAppBackgroundStatusReq) GetUserID() string {
@@ -7542,7 +8626,7 @@ type SetAppBackgroundStatusResp struct {
 
 func (x *SetAppBackgroundStatusResp) Reset() {
 	*x = SetAppBackgroundStatusResp{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[91]
+	mi := &file_sdkws_sdkws_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7554,7 +8638,7 @@ func (x *SetAppBackgroundStatusResp) String() string {
 func (*SetAppBackgroundStatusResp) ProtoMessage() {}
 
 func (x *SetAppBackgroundStatusResp) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[91]
+	mi := &file_sdkws_sdkws_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7567,7 +8651,7 @@ func (x *SetAppBackgroundStatusResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAppBackgroundStatusResp.ProtoReflect.Descriptor instead.
 func (*SetAppBackgroundStatusResp) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{91}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{103}
 }
 
 type ProcessUserCommand struct {
@@ -7583,7 +8667,7 @@ type ProcessUserCommand struct {
 
 func (x *ProcessUserCommand) Reset() {
 	*x = ProcessUserCommand{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[92]
+	mi := &file_sdkws_sdkws_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7595,7 +8679,7 @@ func (x *ProcessUserCommand) String() string {
 func (*ProcessUserCommand) ProtoMessage() {}
 
 func (x *ProcessUserCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[92]
+	mi := &file_sdkws_sdkws_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7608,7 +8692,7 @@ func (x *ProcessUserCommand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProcessUserCommand.ProtoReflect.Descriptor instead.
 func (*ProcessUserCommand) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{92}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *ProcessUserCommand) GetUserID() string {
@@ -7656,7 +8740,7 @@ type RequestPagination struct {
 
 func (x *RequestPagination) Reset() {
 	*x = RequestPagination{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[93]
+	mi := &file_sdkws_sdkws_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7668,7 +8752,7 @@ func (x *RequestPagination) String() string {
 func (*RequestPagination) ProtoMessage() {}
 
 func (x *RequestPagination) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[93]
+	mi := &file_sdkws_sdkws_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7681,7 +8765,7 @@ func (x *RequestPagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestPagination.ProtoReflect.Descriptor instead.
 func (*RequestPagination) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{93}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *RequestPagination) GetPageNumber() int32 {
@@ -7710,7 +8794,7 @@ type FriendsInfoUpdateTips struct {
 
 func (x *FriendsInfoUpdateTips) Reset() {
 	*x = FriendsInfoUpdateTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[94]
+	mi := &file_sdkws_sdkws_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7722,7 +8806,7 @@ func (x *FriendsInfoUpdateTips) String() string {
 func (*FriendsInfoUpdateTips) ProtoMessage() {}
 
 func (x *FriendsInfoUpdateTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[94]
+	mi := &file_sdkws_sdkws_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7735,7 +8819,7 @@ func (x *FriendsInfoUpdateTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FriendsInfoUpdateTips.ProtoReflect.Descriptor instead.
 func (*FriendsInfoUpdateTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{94}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *FriendsInfoUpdateTips) GetFromToUserID() *FromToUserID {
@@ -7771,13 +8855,15 @@ type SubUserOnlineStatusElem struct {
 	UserID            string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	OnlinePlatformIDs []int32                `protobuf:"varint,2,rep,packed,name=onlinePlatformIDs,proto3" json:"onlinePlatformIDs,omitempty"`
 	PlatformDetails   map[int32]int32        `protobuf:"bytes,3,rep,name=platformDetails,proto3" json:"platformDetails,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 平台详细状态 platformID -> status
+	Presence          *UserPresence          `protobuf:"bytes,4,opt,name=presence,proto3" json:"presence,omitempty"`                                                                                           // 自定义状态
+	Activity          int32                  `protobuf:"varint,5,opt,name=activity,proto3" json:"activity,omitempty"`                                                                                          // 自动状态 constant.UserActivity*
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *SubUserOnlineStatusElem) Reset() {
 	*x = SubUserOnlineStatusElem{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[95]
+	mi := &file_sdkws_sdkws_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7789,7 +8875,7 @@ func (x *SubUserOnlineStatusElem) String() string {
 func (*SubUserOnlineStatusElem) ProtoMessage() {}
 
 func (x *SubUserOnlineStatusElem) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[95]
+	mi := &file_sdkws_sdkws_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7802,7 +8888,7 @@ func (x *SubUserOnlineStatusElem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubUserOnlineStatusElem.ProtoReflect.Descriptor instead.
 func (*SubUserOnlineStatusElem) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{95}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *SubUserOnlineStatusElem) GetUserID() string {
@@ -7826,6 +8912,20 @@ func (x *SubUserOnlineStatusElem) GetPlatformDetails() map[int32]int32 {
 	return nil
 }
 
+func (x *SubUserOnlineStatusElem) GetPresence() *UserPresence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+func (x *SubUserOnlineStatusElem) GetActivity() int32 {
+	if x != nil {
+		return x.Activity
+	}
+	return 0
+}
+
 type SubUserOnlineStatusTips struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Subscribers   []*SubUserOnlineStatusElem `protobuf:"bytes,1,rep,name=subscribers,proto3" json:"subscribers,omitempty"`
@@ -7835,7 +8935,7 @@ type SubUserOnlineStatusTips struct {
 
 func (x *SubUserOnlineStatusTips) Reset() {
 	*x = SubUserOnlineStatusTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[96]
+	mi := &file_sdkws_sdkws_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7847,7 +8947,7 @@ func (x *SubUserOnlineStatusTips) String() string {
 func (*SubUserOnlineStatusTips) ProtoMessage() {}
 
 func (x *SubUserOnlineStatusTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[96]
+	mi := &file_sdkws_sdkws_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7860,7 +8960,7 @@ func (x *SubUserOnlineStatusTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubUserOnlineStatusTips.ProtoReflect.Descriptor instead.
 func (*SubUserOnlineStatusTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{96}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *SubUserOnlineStatusTips) GetSubscribers() []*SubUserOnlineStatusElem {
@@ -7880,7 +8980,7 @@ type SubUserOnlineStatus struct {
 
 func (x *SubUserOnlineStatus) Reset() {
 	*x = SubUserOnlineStatus{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[97]
+	mi := &file_sdkws_sdkws_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7892,7 +8992,7 @@ func (x *SubUserOnlineStatus) String() string {
 func (*SubUserOnlineStatus) ProtoMessage() {}
 
 func (x *SubUserOnlineStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[97]
+	mi := &file_sdkws_sdkws_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7905,7 +9005,7 @@ func (x *SubUserOnlineStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubUserOnlineStatus.ProtoReflect.Descriptor instead.
 func (*SubUserOnlineStatus) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{97}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *SubUserOnlineStatus) GetSubscribeUserID() []string {
@@ -7935,7 +9035,7 @@ type StreamMsgTips struct {
 
 func (x *StreamMsgTips) Reset() {
 	*x = StreamMsgTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[98]
+	mi := &file_sdkws_sdkws_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7947,7 +9047,7 @@ func (x *StreamMsgTips) String() string {
 func (*StreamMsgTips) ProtoMessage() {}
 
 func (x *StreamMsgTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[98]
+	mi := &file_sdkws_sdkws_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7960,7 +9060,7 @@ func (x *StreamMsgTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamMsgTips.ProtoReflect.Descriptor instead.
 func (*StreamMsgTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{98}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *StreamMsgTips) GetConversationID() string {
@@ -7998,6 +9098,83 @@ func (x *StreamMsgTips) GetEnd() bool {
 	return false
 }
 
+// 会话草稿变更通知，其他设备收到后增量同步会话
+type ConversationDraftChangedTips struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserID         string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ConversationID string                 `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	DeviceID       string                 `protobuf:"bytes,3,opt,name=deviceID,proto3" json:"deviceID,omitempty"` // 修改草稿的设备
+	Cleared        bool                   `protobuf:"varint,4,opt,name=cleared,proto3" json:"cleared,omitempty"`  // 草稿被清除
+	UpdateTime     int64                  `protobuf:"varint,5,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConversationDraftChangedTips) Reset() {
+	*x = ConversationDraftChangedTips{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationDraftChangedTips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationDraftChangedTips) ProtoMessage() {}
+
+func (x *ConversationDraftChangedTips) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationDraftChangedTips.ProtoReflect.Descriptor instead.
+func (*ConversationDraftChangedTips) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ConversationDraftChangedTips) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ConversationDraftChangedTips) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *ConversationDraftChangedTips) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *ConversationDraftChangedTips) GetCleared() bool {
+	if x != nil {
+		return x.Cleared
+	}
+	return false
+}
+
+func (x *ConversationDraftChangedTips) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
 type ConversationDeleteTips struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	UserID          string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
@@ -8009,7 +9186,7 @@ type ConversationDeleteTips struct {
 
 func (x *ConversationDeleteTips) Reset() {
 	*x = ConversationDeleteTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[99]
+	mi := &file_sdkws_sdkws_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8021,7 +9198,7 @@ func (x *ConversationDeleteTips) String() string {
 func (*ConversationDeleteTips) ProtoMessage() {}
 
 func (x *ConversationDeleteTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[99]
+	mi := &file_sdkws_sdkws_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8034,7 +9211,7 @@ func (x *ConversationDeleteTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversationDeleteTips.ProtoReflect.Descriptor instead.
 func (*ConversationDeleteTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{99}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *ConversationDeleteTips) GetUserID() string {
@@ -8072,7 +9249,7 @@ type ConversationGroupChangeTips struct {
 
 func (x *ConversationGroupChangeTips) Reset() {
 	*x = ConversationGroupChangeTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[100]
+	mi := &file_sdkws_sdkws_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8084,7 +9261,7 @@ func (x *ConversationGroupChangeTips) String() string {
 func (*ConversationGroupChangeTips) ProtoMessage() {}
 
 func (x *ConversationGroupChangeTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[100]
+	mi := &file_sdkws_sdkws_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8097,7 +9274,7 @@ func (x *ConversationGroupChangeTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversationGroupChangeTips.ProtoReflect.Descriptor instead.
 func (*ConversationGroupChangeTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{100}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *ConversationGroupChangeTips) GetUserID() string {
@@ -8156,7 +9333,7 @@ type ScheduleGroupNotificationShareInfo struct {
 
 func (x *ScheduleGroupNotificationShareInfo) Reset() {
 	*x = ScheduleGroupNotificationShareInfo{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[101]
+	mi := &file_sdkws_sdkws_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8168,7 +9345,7 @@ func (x *ScheduleGroupNotificationShareInfo) String() string {
 func (*ScheduleGroupNotificationShareInfo) ProtoMessage() {}
 
 func (x *ScheduleGroupNotificationShareInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[101]
+	mi := &file_sdkws_sdkws_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8181,7 +9358,7 @@ func (x *ScheduleGroupNotificationShareInfo) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ScheduleGroupNotificationShareInfo.ProtoReflect.Descriptor instead.
 func (*ScheduleGroupNotificationShareInfo) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{101}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *ScheduleGroupNotificationShareInfo) GetUserID() string {
@@ -8235,7 +9412,7 @@ type ScheduleGroupChangeTips struct {
 
 func (x *ScheduleGroupChangeTips) Reset() {
 	*x = ScheduleGroupChangeTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[102]
+	mi := &file_sdkws_sdkws_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8247,7 +9424,7 @@ func (x *ScheduleGroupChangeTips) String() string {
 func (*ScheduleGroupChangeTips) ProtoMessage() {}
 
 func (x *ScheduleGroupChangeTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[102]
+	mi := &file_sdkws_sdkws_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8260,7 +9437,7 @@ func (x *ScheduleGroupChangeTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleGroupChangeTips.ProtoReflect.Descriptor instead.
 func (*ScheduleGroupChangeTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{102}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *ScheduleGroupChangeTips) GetUserID() string {
@@ -8373,7 +9550,7 @@ type ShareUserInfo struct {
 
 func (x *ShareUserInfo) Reset() {
 	*x = ShareUserInfo{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[103]
+	mi := &file_sdkws_sdkws_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8385,7 +9562,7 @@ func (x *ShareUserInfo) String() string {
 func (*ShareUserInfo) ProtoMessage() {}
 
 func (x *ShareUserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[103]
+	mi := &file_sdkws_sdkws_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8398,7 +9575,7 @@ func (x *ShareUserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShareUserInfo.ProtoReflect.Descriptor instead.
 func (*ShareUserInfo) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{103}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *ShareUserInfo) GetUserID() string {
@@ -8437,7 +9614,7 @@ type CreatorUserInfo struct {
 
 func (x *CreatorUserInfo) Reset() {
 	*x = CreatorUserInfo{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[104]
+	mi := &file_sdkws_sdkws_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8449,7 +9626,7 @@ func (x *CreatorUserInfo) String() string {
 func (*CreatorUserInfo) ProtoMessage() {}
 
 func (x *CreatorUserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[104]
+	mi := &file_sdkws_sdkws_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8462,7 +9639,7 @@ func (x *CreatorUserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatorUserInfo.ProtoReflect.Descriptor instead.
 func (*CreatorUserInfo) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{104}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *CreatorUserInfo) GetUserID() string {
@@ -8519,7 +9696,7 @@ type ChangeUserInfo struct {
 
 func (x *ChangeUserInfo) Reset() {
 	*x = ChangeUserInfo{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[105]
+	mi := &file_sdkws_sdkws_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8531,7 +9708,7 @@ func (x *ChangeUserInfo) String() string {
 func (*ChangeUserInfo) ProtoMessage() {}
 
 func (x *ChangeUserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[105]
+	mi := &file_sdkws_sdkws_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8544,7 +9721,7 @@ func (x *ChangeUserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeUserInfo.ProtoReflect.Descriptor instead.
 func (*ChangeUserInfo) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{105}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *ChangeUserInfo) GetUserID() string {
@@ -8587,7 +9764,7 @@ type ScheduleGroupShareElem struct {
 
 func (x *ScheduleGroupShareElem) Reset() {
 	*x = ScheduleGroupShareElem{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[106]
+	mi := &file_sdkws_sdkws_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8599,7 +9776,7 @@ func (x *ScheduleGroupShareElem) String() string {
 func (*ScheduleGroupShareElem) ProtoMessage() {}
 
 func (x *ScheduleGroupShareElem) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[106]
+	mi := &file_sdkws_sdkws_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8612,7 +9789,7 @@ func (x *ScheduleGroupShareElem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleGroupShareElem.ProtoReflect.Descriptor instead.
 func (*ScheduleGroupShareElem) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{106}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *ScheduleGroupShareElem) GetSharerUserID() string {
@@ -8685,6 +9862,107 @@ func (x *ScheduleGroupShareElem) GetShareUser() *ShareUserInfo {
 	return nil
 }
 
+// 表情包分享卡片消息内容（constant.EmojiPackShareMessage）
+type EmojiPackShareElem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareID       string                 `protobuf:"bytes,1,opt,name=shareID,proto3" json:"shareID,omitempty"` // 保存时使用
+	PackID        string                 `protobuf:"bytes,2,opt,name=packID,proto3" json:"packID,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CoverURL      string                 `protobuf:"bytes,4,opt,name=coverURL,proto3" json:"coverURL,omitempty"`
+	EmojiCount    int32                  `protobuf:"varint,5,opt,name=emojiCount,proto3" json:"emojiCount,omitempty"`
+	PreviewURLs   []string               `protobuf:"bytes,6,rep,name=previewURLs,proto3" json:"previewURLs,omitempty"` // 前几个表情的缩略图
+	ShareUser     *ShareUserInfo         `protobuf:"bytes,7,opt,name=shareUser,proto3" json:"shareUser,omitempty"`     // 分享者信息
+	Timestamp     int64                  `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmojiPackShareElem) Reset() {
+	*x = EmojiPackShareElem{}
+	mi := &file_sdkws_sdkws_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmojiPackShareElem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmojiPackShareElem) ProtoMessage() {}
+
+func (x *EmojiPackShareElem) ProtoReflect() protoreflect.Message {
+	mi := &file_sdkws_sdkws_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmojiPackShareElem.ProtoReflect.Descriptor instead.
+func (*EmojiPackShareElem) Descriptor() ([]byte, []int) {
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *EmojiPackShareElem) GetShareID() string {
+	if x != nil {
+		return x.ShareID
+	}
+	return ""
+}
+
+func (x *EmojiPackShareElem) GetPackID() string {
+	if x != nil {
+		return x.PackID
+	}
+	return ""
+}
+
+func (x *EmojiPackShareElem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmojiPackShareElem) GetCoverURL() string {
+	if x != nil {
+		return x.CoverURL
+	}
+	return ""
+}
+
+func (x *EmojiPackShareElem) GetEmojiCount() int32 {
+	if x != nil {
+		return x.EmojiCount
+	}
+	return 0
+}
+
+func (x *EmojiPackShareElem) GetPreviewURLs() []string {
+	if x != nil {
+		return x.PreviewURLs
+	}
+	return nil
+}
+
+func (x *EmojiPackShareElem) GetShareUser() *ShareUserInfo {
+	if x != nil {
+		return x.ShareUser
+	}
+	return nil
+}
+
+func (x *EmojiPackShareElem) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
 // 日程变更消息内容（schedule_change，用于 sc_ 通知会话消息）
 type ScheduleChangeElem struct {
 	state           protoimpl.MessageState               `protogen:"open.v1"`
@@ -8720,7 +9998,7 @@ type ScheduleChangeElem struct {
 
 func (x *ScheduleChangeElem) Reset() {
 	*x = ScheduleChangeElem{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[107]
+	mi := &file_sdkws_sdkws_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8732,7 +10010,7 @@ func (x *ScheduleChangeElem) String() string {
 func (*ScheduleChangeElem) ProtoMessage() {}
 
 func (x *ScheduleChangeElem) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[107]
+	mi := &file_sdkws_sdkws_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8745,7 +10023,7 @@ func (x *ScheduleChangeElem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleChangeElem.ProtoReflect.Descriptor instead.
 func (*ScheduleChangeElem) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{107}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *ScheduleChangeElem) GetMsgType() string {
@@ -8944,7 +10222,7 @@ type ScheduleReminderAckTips struct {
 
 func (x *ScheduleReminderAckTips) Reset() {
 	*x = ScheduleReminderAckTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[108]
+	mi := &file_sdkws_sdkws_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8956,7 +10234,7 @@ func (x *ScheduleReminderAckTips) String() string {
 func (*ScheduleReminderAckTips) ProtoMessage() {}
 
 func (x *ScheduleReminderAckTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[108]
+	mi := &file_sdkws_sdkws_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8969,7 +10247,7 @@ func (x *ScheduleReminderAckTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleReminderAckTips.ProtoReflect.Descriptor instead.
 func (*ScheduleReminderAckTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{108}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *ScheduleReminderAckTips) GetUserID() string {
@@ -9019,13 +10297,15 @@ type ConversationFoldNotificationTips struct {
 	FaceURL            string                 `protobuf:"bytes,7,opt,name=faceURL,proto3" json:"faceURL,omitempty"`                       // 头像URL（更新时）
 	Description        string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`               // 描述（更新时）
 	Timestamp          int64                  `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                  // 时间戳（创建/更新/删除/移入/移出时间）
+	ByRule             bool                   `protobuf:"varint,10,opt,name=byRule,proto3" json:"byRule,omitempty"`                       // 由智能折叠规则触发的移入/移出
+	ConversationIDs    []string               `protobuf:"bytes,11,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`      // 规则批量移入/移出的会话ID
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *ConversationFoldNotificationTips) Reset() {
 	*x = ConversationFoldNotificationTips{}
-	mi := &file_sdkws_sdkws_proto_msgTypes[109]
+	mi := &file_sdkws_sdkws_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9037,7 +10317,7 @@ func (x *ConversationFoldNotificationTips) String() string {
 func (*ConversationFoldNotificationTips) ProtoMessage() {}
 
 func (x *ConversationFoldNotificationTips) ProtoReflect() protoreflect.Message {
-	mi := &file_sdkws_sdkws_proto_msgTypes[109]
+	mi := &file_sdkws_sdkws_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9050,7 +10330,7 @@ func (x *ConversationFoldNotificationTips) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConversationFoldNotificationTips.ProtoReflect.Descriptor instead.
 func (*ConversationFoldNotificationTips) Descriptor() ([]byte, []int) {
-	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{109}
+	return file_sdkws_sdkws_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *ConversationFoldNotificationTips) GetUserID() string {
@@ -9116,6 +10396,20 @@ func (x *ConversationFoldNotificationTips) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *ConversationFoldNotificationTips) GetByRule() bool {
+	if x != nil {
+		return x.ByRule
+	}
+	return false
+}
+
+func (x *ConversationFoldNotificationTips) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
 var File_sdkws_sdkws_proto protoreflect.FileDescriptor
 
 const file_sdkws_sdkws_proto_rawDesc = "" +
@@ -9174,7 +10468,7 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x18\n" +
 	"\afaceURL\x18\x03 \x01(\tR\afaceURL\x12\x0e\n" +
-	"\x02ex\x18\x04 \x01(\tR\x02ex\"\xdc\x06\n" +
+	"\x02ex\x18\x04 \x01(\tR\x02ex\"\x82\a\n" +
 	"\bUserInfo\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x18\n" +
@@ -9207,12 +10501,31 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\x0edepartmentName\x18\x17 \x01(\tR\x0edepartmentName\x12 \n" +
 	"\vdeptAllName\x18\x18 \x01(\tR\vdeptAllName\x12\x1a\n" +
 	"\bjobTitle\x18\x19 \x01(\tR\bjobTitle\x12\x1a\n" +
-	"\bworkCode\x18\x1a \x01(\tR\bworkCode\"H\n" +
+	"\bworkCode\x18\x1a \x01(\tR\bworkCode\x12$\n" +
+	"\raccountStatus\x18\x1c \x01(\x05R\raccountStatus\"d\n" +
 	"\x0ePlatformDetail\x12\x1e\n" +
 	"\n" +
 	"platformID\x18\x01 \x01(\x05R\n" +
 	"platformID\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\x05R\x06status\"\xff\x03\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1a\n" +
+	"\bactivity\x18\x03 \x01(\x05R\bactivity\"\xb6\x01\n" +
+	"\fUserActivity\x12\x1a\n" +
+	"\bactivity\x18\x01 \x01(\x05R\bactivity\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1a\n" +
+	"\bsourceID\x18\x03 \x01(\tR\bsourceID\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x04 \x01(\x05R\n" +
+	"platformID\x12\x1c\n" +
+	"\tstartTime\x18\x05 \x01(\x03R\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x06 \x01(\x03R\aendTime\"\x9a\x01\n" +
+	"\fUserPresence\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x14\n" +
+	"\x05emoji\x18\x02 \x01(\tR\x05emoji\x12\"\n" +
+	"\fdoNotDisturb\x18\x03 \x01(\bR\fdoNotDisturb\x12\x1c\n" +
+	"\tclearTime\x18\x04 \x01(\x03R\tclearTime\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\x05 \x01(\x03R\n" +
+	"updateTime\"\xff\x03\n" +
 	"\x0eUserInfoWithEx\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x128\n" +
 	"\bnickname\x18\x02 \x01(\v2\x1c.openim.protobuf.StringValueR\bnickname\x126\n" +
@@ -9223,7 +10536,7 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\x0epinyinInitials\x18\x1b \x01(\v2\x1c.openim.protobuf.StringValueR\x0epinyinInitials\x124\n" +
 	"\x06status\x18\t \x01(\v2\x1c.openim.protobuf.StringValueR\x06status\x12:\n" +
 	"\tsignature\x18\n" +
-	" \x01(\v2\x1c.openim.protobuf.StringValueR\tsignature\"\x90\x02\n" +
+	" \x01(\v2\x1c.openim.protobuf.StringValueR\tsignature\"\xb2\x02\n" +
 	"\n" +
 	"FriendInfo\x12 \n" +
 	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\x16\n" +
@@ -9237,7 +10550,8 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\taddSource\x18\x05 \x01(\x05R\taddSource\x12&\n" +
 	"\x0eoperatorUserID\x18\x06 \x01(\tR\x0eoperatorUserID\x12\x0e\n" +
 	"\x02ex\x18\a \x01(\tR\x02ex\x12\x1a\n" +
-	"\bisPinned\x18\b \x01(\bR\bisPinned\"\xe7\x01\n" +
+	"\bisPinned\x18\b \x01(\bR\bisPinned\x12 \n" +
+	"\vcategoryIDs\x18\t \x03(\tR\vcategoryIDs\"\xe7\x01\n" +
 	"\tBlackInfo\x12 \n" +
 	"\vownerUserID\x18\x01 \x01(\tR\vownerUserID\x12\x1e\n" +
 	"\n" +
@@ -9263,7 +10577,7 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"joinSource\x18\n" +
 	" \x01(\x05R\n" +
 	"joinSource\x12$\n" +
-	"\rinviterUserID\x18\v \x01(\tR\rinviterUserID\"\x9f\x03\n" +
+	"\rinviterUserID\x18\v \x01(\tR\rinviterUserID\"\xff\x03\n" +
 	"\rFriendRequest\x12\x1e\n" +
 	"\n" +
 	"fromUserID\x18\x01 \x01(\tR\n" +
@@ -9286,7 +10600,17 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\n" +
 	"handleTime\x18\f \x01(\x03R\n" +
 	"handleTime\x12\x0e\n" +
-	"\x02ex\x18\r \x01(\tR\x02ex\"\x93\x01\n" +
+	"\x02ex\x18\r \x01(\tR\x02ex\x12>\n" +
+	"\bmessages\x18\x0e \x03(\v2\".openim.sdkws.FriendRequestMessageR\bmessages\x12\x1e\n" +
+	"\n" +
+	"expireTime\x18\x0f \x01(\x03R\n" +
+	"expireTime\"t\n" +
+	"\x14FriendRequestMessage\x12\"\n" +
+	"\fsenderUserID\x18\x01 \x01(\tR\fsenderUserID\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\x03 \x01(\x03R\n" +
+	"createTime\"\x93\x01\n" +
 	"\x14PullMessageBySeqsReq\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x124\n" +
 	"\tseqRanges\x18\x02 \x03(\v2\x16.openim.sdkws.SeqRangeR\tseqRanges\x12-\n" +
@@ -9419,7 +10743,7 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x16.openim.sdkws.PullMsgsR\x05value:\x028\x01\x1a[\n" +
 	"\x15NotificationMsgsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\v2\x16.openim.sdkws.PullMsgsR\x05value:\x028\x01\"\xb5\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.openim.sdkws.PullMsgsR\x05value:\x028\x01\"\xd6\x04\n" +
 	"\x0fOfflinePushInfo\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
 	"\x04desc\x18\x02 \x01(\tR\x04desc\x12\x0e\n" +
@@ -9428,7 +10752,34 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\riOSBadgeCount\x18\x05 \x01(\bR\riOSBadgeCount\x12\x1e\n" +
 	"\n" +
 	"signalInfo\x18\x06 \x01(\tR\n" +
-	"signalInfo\"d\n" +
+	"signalInfo\x12\x1a\n" +
+	"\bthreadID\x18\a \x01(\tR\bthreadID\x12\x1e\n" +
+	"\n" +
+	"collapseID\x18\b \x01(\tR\n" +
+	"collapseID\x12\x1a\n" +
+	"\bimageURL\x18\t \x01(\tR\bimageURL\x12\x1a\n" +
+	"\bcategory\x18\n" +
+	" \x01(\tR\bcategory\x129\n" +
+	"\aactions\x18\v \x03(\v2\x1f.openim.sdkws.OfflinePushActionR\aactions\x12 \n" +
+	"\vtitleLocKey\x18\f \x01(\tR\vtitleLocKey\x12\"\n" +
+	"\ftitleLocArgs\x18\r \x03(\tR\ftitleLocArgs\x12\x1e\n" +
+	"\n" +
+	"bodyLocKey\x18\x0e \x01(\tR\n" +
+	"bodyLocKey\x12 \n" +
+	"\vbodyLocArgs\x18\x0f \x03(\tR\vbodyLocArgs\x12\x1a\n" +
+	"\bpriority\x18\x10 \x01(\x05R\bpriority\x12$\n" +
+	"\rtimeSensitive\x18\x11 \x01(\bR\rtimeSensitive\x12\x10\n" +
+	"\x03ttl\x18\x12 \x01(\x03R\x03ttl\x12\x14\n" +
+	"\x05badge\x18\x13 \x01(\x05R\x05badge\"\xbf\x01\n" +
+	"\x11OfflinePushAction\x12\x1a\n" +
+	"\bactionID\x18\x01 \x01(\tR\bactionID\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vtitleLocKey\x18\x03 \x01(\tR\vtitleLocKey\x12\x14\n" +
+	"\x05input\x18\x04 \x01(\bR\x05input\x12\x1e\n" +
+	"\n" +
+	"foreground\x18\x05 \x01(\bR\n" +
+	"foreground\x12 \n" +
+	"\vdestructive\x18\x06 \x01(\bR\vdestructive\"d\n" +
 	"\bTipsComm\x12\x16\n" +
 	"\x06detail\x18\x01 \x01(\fR\x06detail\x12 \n" +
 	"\vdefaultTips\x18\x02 \x01(\tR\vdefaultTips\x12\x1e\n" +
@@ -9583,7 +10934,14 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\x1dFriendApplicationRejectedTips\x12>\n" +
 	"\ffromToUserID\x18\x01 \x01(\v2\x1a.openim.sdkws.FromToUserIDR\ffromToUserID\x12\x1c\n" +
 	"\thandleMsg\x18\x02 \x01(\tR\thandleMsg\x125\n" +
-	"\arequest\x18\x03 \x01(\v2\x1b.openim.sdkws.FriendRequestR\arequest\"\xef\x01\n" +
+	"\arequest\x18\x03 \x01(\v2\x1b.openim.sdkws.FriendRequestR\arequest\"\xd3\x01\n" +
+	"\x1cFriendApplicationRepliedTips\x12>\n" +
+	"\ffromToUserID\x18\x01 \x01(\v2\x1a.openim.sdkws.FromToUserIDR\ffromToUserID\x12<\n" +
+	"\amessage\x18\x02 \x01(\v2\".openim.sdkws.FriendRequestMessageR\amessage\x125\n" +
+	"\arequest\x18\x03 \x01(\v2\x1b.openim.sdkws.FriendRequestR\arequest\"\x95\x01\n" +
+	"\x1cFriendApplicationExpiredTips\x12>\n" +
+	"\ffromToUserID\x18\x01 \x01(\v2\x1a.openim.sdkws.FromToUserIDR\ffromToUserID\x125\n" +
+	"\arequest\x18\x02 \x01(\v2\x1b.openim.sdkws.FriendRequestR\arequest\"\xef\x01\n" +
 	"\x0fFriendAddedTips\x120\n" +
 	"\x06friend\x18\x01 \x01(\v2\x18.openim.sdkws.FriendInfoR\x06friend\x12$\n" +
 	"\roperationTime\x18\x02 \x01(\x03R\roperationTime\x124\n" +
@@ -9602,9 +10960,23 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\ffromToUserID\x18\x01 \x01(\v2\x1a.openim.sdkws.FromToUserIDR\ffromToUserID\x12$\n" +
 	"\rfriendVersion\x18\x02 \x01(\x04R\rfriendVersion\x12(\n" +
 	"\x0ffriendVersionID\x18\x03 \x01(\tR\x0ffriendVersionID\x12,\n" +
-	"\x11friendSortVersion\x18\x04 \x01(\x04R\x11friendSortVersion\"-\n" +
+	"\x11friendSortVersion\x18\x04 \x01(\x04R\x11friendSortVersion\"\xd9\x02\n" +
+	"\x19FriendCategoryChangedTips\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x02 \x01(\tR\n" +
+	"categoryID\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12$\n" +
+	"\rfriendUserIDs\x18\x04 \x03(\tR\rfriendUserIDs\x12(\n" +
+	"\x0fcategoryVersion\x18\x05 \x01(\x04R\x0fcategoryVersion\x12,\n" +
+	"\x11categoryVersionID\x18\x06 \x01(\tR\x11categoryVersionID\x12$\n" +
+	"\rfriendVersion\x18\a \x01(\x04R\rfriendVersion\x12(\n" +
+	"\x0ffriendVersionID\x18\b \x01(\tR\x0ffriendVersionID\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\t \x01(\x03R\n" +
+	"updateTime\"-\n" +
 	"\x13UserInfoUpdatedTips\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\"\x8a\x01\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"\xde\x01\n" +
 	"\x14UserStatusChangeTips\x12\x1e\n" +
 	"\n" +
 	"fromUserID\x18\x01 \x01(\tR\n" +
@@ -9613,7 +10985,34 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x1e\n" +
 	"\n" +
 	"platformID\x18\x04 \x01(\x05R\n" +
-	"platformID\"P\n" +
+	"platformID\x126\n" +
+	"\bpresence\x18\x05 \x01(\v2\x1a.openim.sdkws.UserPresenceR\bpresence\x12\x1a\n" +
+	"\bactivity\x18\x06 \x01(\x05R\bactivity\"\xaf\x01\n" +
+	"\x15UserAccountStatusTips\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12$\n" +
+	"\raccountStatus\x18\x02 \x01(\x05R\raccountStatus\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bopUserID\x18\x04 \x01(\tR\bopUserID\x12$\n" +
+	"\roperationTime\x18\x05 \x01(\x03R\roperationTime\"\xbd\x01\n" +
+	"\x1bUserClientConfigChangedTips\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x12\n" +
+	"\x04keys\x18\x02 \x03(\tR\x04keys\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x03 \x01(\x05R\n" +
+	"platformID\x12\x1a\n" +
+	"\bdeviceID\x18\x04 \x01(\tR\bdeviceID\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x04R\aversion\x12\x1c\n" +
+	"\tversionID\x18\x06 \x01(\tR\tversionID\"\xa6\x01\n" +
+	"\x12SessionRevokedTips\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
+	"\tsessionID\x18\x02 \x01(\tR\tsessionID\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x03 \x01(\x05R\n" +
+	"platformID\x12\x1a\n" +
+	"\bopUserID\x18\x04 \x01(\tR\bopUserID\x12\x1e\n" +
+	"\n" +
+	"revokeTime\x18\x05 \x01(\x03R\n" +
+	"revokeTime\"P\n" +
 	"\x12UserCommandAddTips\x12\x1e\n" +
 	"\n" +
 	"fromUserID\x18\x01 \x01(\tR\n" +
@@ -9638,7 +11037,14 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\n" +
 	"fromUserID\x18\x01 \x01(\tR\n" +
 	"fromUserID\x12\x1a\n" +
-	"\btoUserID\x18\x02 \x01(\tR\btoUserID\"x\n" +
+	"\btoUserID\x18\x02 \x01(\tR\btoUserID\"\x86\x01\n" +
+	"\x18UserEmojiPackChangedTips\x12\x1e\n" +
+	"\n" +
+	"fromUserID\x18\x01 \x01(\tR\n" +
+	"fromUserID\x12\x1a\n" +
+	"\btoUserID\x18\x02 \x01(\tR\btoUserID\x12\x16\n" +
+	"\x06packID\x18\x03 \x01(\tR\x06packID\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\"x\n" +
 	"\x18UserQuickReplyUpdateTips\x12\x1e\n" +
 	"\n" +
 	"fromUserID\x18\x01 \x01(\tR\n" +
@@ -9652,7 +11058,10 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\btoUserID\x18\x02 \x01(\tR\btoUserID\x12\x1e\n" +
 	"\n" +
 	"updateTime\x18\x03 \x01(\x03R\n" +
-	"updateTime\"\xa5\x01\n" +
+	"updateTime\"W\n" +
+	"\x0fQuickReplyScope\x12(\n" +
+	"\x0fconversationIDs\x18\x01 \x03(\tR\x0fconversationIDs\x12\x1a\n" +
+	"\bgroupIDs\x18\x02 \x03(\tR\bgroupIDs\"\xfa\x01\n" +
 	"\x15UserQuickReplyAddTips\x12\x1e\n" +
 	"\n" +
 	"fromUserID\x18\x01 \x01(\tR\n" +
@@ -9660,20 +11069,28 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\btoUserID\x18\x02 \x01(\tR\btoUserID\x12\x18\n" +
 	"\areplyID\x18\x03 \x01(\tR\areplyID\x12\x18\n" +
 	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1c\n" +
-	"\treplyType\x18\x05 \x01(\x05R\treplyType\"p\n" +
+	"\treplyType\x18\x05 \x01(\x05R\treplyType\x12\x1e\n" +
+	"\n" +
+	"isTemplate\x18\x06 \x01(\bR\n" +
+	"isTemplate\x123\n" +
+	"\x05scope\x18\a \x01(\v2\x1d.openim.sdkws.QuickReplyScopeR\x05scope\"p\n" +
 	"\x18UserQuickReplyDeleteTips\x12\x1e\n" +
 	"\n" +
 	"fromUserID\x18\x01 \x01(\tR\n" +
 	"fromUserID\x12\x1a\n" +
 	"\btoUserID\x18\x02 \x01(\tR\btoUserID\x12\x18\n" +
-	"\areplyID\x18\x03 \x01(\tR\areplyID\"\x8a\x01\n" +
+	"\areplyID\x18\x03 \x01(\tR\areplyID\"\xdf\x01\n" +
 	"\x18UserQuickReplyModifyTips\x12\x1e\n" +
 	"\n" +
 	"fromUserID\x18\x01 \x01(\tR\n" +
 	"fromUserID\x12\x1a\n" +
 	"\btoUserID\x18\x02 \x01(\tR\btoUserID\x12\x18\n" +
 	"\areplyID\x18\x03 \x01(\tR\areplyID\x12\x18\n" +
-	"\acontent\x18\x04 \x01(\tR\acontent\"\xa3\x01\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1e\n" +
+	"\n" +
+	"isTemplate\x18\x05 \x01(\bR\n" +
+	"isTemplate\x123\n" +
+	"\x05scope\x18\x06 \x01(\v2\x1d.openim.sdkws.QuickReplyScopeR\x05scope\"\xa3\x01\n" +
 	"\x15UserQuickReplyPinTips\x12\x1e\n" +
 	"\n" +
 	"fromUserID\x18\x01 \x01(\tR\n" +
@@ -9905,11 +11322,13 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\ffromToUserID\x18\x01 \x01(\v2\x1a.openim.sdkws.FromToUserIDR\ffromToUserID\x12\x1c\n" +
 	"\tfriendIDs\x18\x02 \x03(\tR\tfriendIDs\x12$\n" +
 	"\rfriendVersion\x18\x03 \x01(\x04R\rfriendVersion\x12(\n" +
-	"\x0ffriendVersionID\x18\x04 \x01(\tR\x0ffriendVersionID\"\x89\x02\n" +
+	"\x0ffriendVersionID\x18\x04 \x01(\tR\x0ffriendVersionID\"\xdd\x02\n" +
 	"\x17SubUserOnlineStatusElem\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12,\n" +
 	"\x11onlinePlatformIDs\x18\x02 \x03(\x05R\x11onlinePlatformIDs\x12d\n" +
-	"\x0fplatformDetails\x18\x03 \x03(\v2:.openim.sdkws.SubUserOnlineStatusElem.PlatformDetailsEntryR\x0fplatformDetails\x1aB\n" +
+	"\x0fplatformDetails\x18\x03 \x03(\v2:.openim.sdkws.SubUserOnlineStatusElem.PlatformDetailsEntryR\x0fplatformDetails\x126\n" +
+	"\bpresence\x18\x04 \x01(\v2\x1a.openim.sdkws.UserPresenceR\bpresence\x12\x1a\n" +
+	"\bactivity\x18\x05 \x01(\x05R\bactivity\x1aB\n" +
 	"\x14PlatformDetailsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"b\n" +
@@ -9925,7 +11344,15 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"startIndex\x18\x03 \x01(\x03R\n" +
 	"startIndex\x12\x18\n" +
 	"\apackets\x18\x04 \x03(\tR\apackets\x12\x10\n" +
-	"\x03end\x18\x05 \x01(\bR\x03end\"v\n" +
+	"\x03end\x18\x05 \x01(\bR\x03end\"\xb4\x01\n" +
+	"\x1cConversationDraftChangedTips\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12&\n" +
+	"\x0econversationID\x18\x02 \x01(\tR\x0econversationID\x12\x1a\n" +
+	"\bdeviceID\x18\x03 \x01(\tR\bdeviceID\x12\x18\n" +
+	"\acleared\x18\x04 \x01(\bR\acleared\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\x05 \x01(\x03R\n" +
+	"updateTime\"v\n" +
 	"\x16ConversationDeleteTips\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12(\n" +
 	"\x0fconversationIDs\x18\x02 \x03(\tR\x0fconversationIDs\x12\x1a\n" +
@@ -10004,7 +11431,18 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\ttimestamp\x18\b \x01(\x03R\ttimestamp\x12\x16\n" +
 	"\x06action\x18\t \x01(\tR\x06action\x129\n" +
 	"\tshareUser\x18\n" +
-	" \x01(\v2\x1b.openim.sdkws.ShareUserInfoR\tshareUser\"\x9d\t\n" +
+	" \x01(\v2\x1b.openim.sdkws.ShareUserInfoR\tshareUser\"\x91\x02\n" +
+	"\x12EmojiPackShareElem\x12\x18\n" +
+	"\ashareID\x18\x01 \x01(\tR\ashareID\x12\x16\n" +
+	"\x06packID\x18\x02 \x01(\tR\x06packID\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcoverURL\x18\x04 \x01(\tR\bcoverURL\x12\x1e\n" +
+	"\n" +
+	"emojiCount\x18\x05 \x01(\x05R\n" +
+	"emojiCount\x12 \n" +
+	"\vpreviewURLs\x18\x06 \x03(\tR\vpreviewURLs\x129\n" +
+	"\tshareUser\x18\a \x01(\v2\x1b.openim.sdkws.ShareUserInfoR\tshareUser\x12\x1c\n" +
+	"\ttimestamp\x18\b \x01(\x03R\ttimestamp\"\x9d\t\n" +
 	"\x12ScheduleChangeElem\x12\x18\n" +
 	"\amsgType\x18\x01 \x01(\tR\amsgType\x12\x16\n" +
 	"\x06action\x18\x02 \x01(\tR\x06action\x12#\n" +
@@ -10060,7 +11498,7 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\n" +
 	"platformID\x18\x04 \x01(\x05R\n" +
 	"platformID\x12\x18\n" +
-	"\aackTime\x18\x05 \x01(\x03R\aackTime\"\xbc\x02\n" +
+	"\aackTime\x18\x05 \x01(\x03R\aackTime\"\xfe\x02\n" +
 	" ConversationFoldNotificationTips\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12.\n" +
 	"\x12foldConversationID\x18\x02 \x01(\tR\x12foldConversationID\x12\x16\n" +
@@ -10070,7 +11508,10 @@ const file_sdkws_sdkws_proto_rawDesc = "" +
 	"\bfoldType\x18\x06 \x01(\x05R\bfoldType\x12\x18\n" +
 	"\afaceURL\x18\a \x01(\tR\afaceURL\x12 \n" +
 	"\vdescription\x18\b \x01(\tR\vdescription\x12\x1c\n" +
-	"\ttimestamp\x18\t \x01(\x03R\ttimestamp*0\n" +
+	"\ttimestamp\x18\t \x01(\x03R\ttimestamp\x12\x16\n" +
+	"\x06byRule\x18\n" +
+	" \x01(\bR\x06byRule\x12(\n" +
+	"\x0fconversationIDs\x18\v \x03(\tR\x0fconversationIDs*0\n" +
 	"\tPullOrder\x12\x10\n" +
 	"\fPullOrderAsc\x10\x00\x12\x11\n" +
 	"\rPullOrderDesc\x10\x01B%Z#github.com/openimsdk/protocol/sdkwsb\x06proto3"
@@ -10088,7 +11529,7 @@ func file_sdkws_sdkws_proto_rawDescGZIP() []byte {
 }
 
 var file_sdkws_sdkws_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_sdkws_sdkws_proto_msgTypes = make([]protoimpl.MessageInfo, 118)
+var file_sdkws_sdkws_proto_msgTypes = make([]protoimpl.MessageInfo, 132)
 var file_sdkws_sdkws_proto_goTypes = []any{
 	(PullOrder)(0),                              // 0: openim.sdkws.PullOrder
 	(*GroupInfo)(nil),                           // 1: openim.sdkws.GroupInfo
@@ -10097,239 +11538,265 @@ var file_sdkws_sdkws_proto_goTypes = []any{
 	(*PublicUserInfo)(nil),                      // 4: openim.sdkws.PublicUserInfo
 	(*UserInfo)(nil),                            // 5: openim.sdkws.UserInfo
 	(*PlatformDetail)(nil),                      // 6: openim.sdkws.PlatformDetail
-	(*UserInfoWithEx)(nil),                      // 7: openim.sdkws.UserInfoWithEx
-	(*FriendInfo)(nil),                          // 8: openim.sdkws.FriendInfo
-	(*BlackInfo)(nil),                           // 9: openim.sdkws.BlackInfo
-	(*GroupRequest)(nil),                        // 10: openim.sdkws.GroupRequest
-	(*FriendRequest)(nil),                       // 11: openim.sdkws.FriendRequest
-	(*PullMessageBySeqsReq)(nil),                // 12: openim.sdkws.PullMessageBySeqsReq
-	(*SeqRange)(nil),                            // 13: openim.sdkws.SeqRange
-	(*PullMsgs)(nil),                            // 14: openim.sdkws.PullMsgs
-	(*PullMessageBySeqsResp)(nil),               // 15: openim.sdkws.PullMessageBySeqsResp
-	(*GetMaxSeqReq)(nil),                        // 16: openim.sdkws.GetMaxSeqReq
-	(*GetMaxSeqResp)(nil),                       // 17: openim.sdkws.GetMaxSeqResp
-	(*UserSendMsgResp)(nil),                     // 18: openim.sdkws.UserSendMsgResp
-	(*MsgData)(nil),                             // 19: openim.sdkws.MsgData
-	(*LikeInfo)(nil),                            // 20: openim.sdkws.LikeInfo
-	(*LikeUser)(nil),                            // 21: openim.sdkws.LikeUser
-	(*LikeMsgTips)(nil),                         // 22: openim.sdkws.LikeMsgTips
-	(*MarkInfo)(nil),                            // 23: openim.sdkws.MarkInfo
-	(*MarkMsgTips)(nil),                         // 24: openim.sdkws.MarkMsgTips
-	(*SpeechToTextInfo)(nil),                    // 25: openim.sdkws.SpeechToTextInfo
-	(*SpeechToTextMsgTips)(nil),                 // 26: openim.sdkws.SpeechToTextMsgTips
-	(*PushMessages)(nil),                        // 27: openim.sdkws.PushMessages
-	(*OfflinePushInfo)(nil),                     // 28: openim.sdkws.OfflinePushInfo
-	(*TipsComm)(nil),                            // 29: openim.sdkws.TipsComm
-	(*GroupCreatedTips)(nil),                    // 30: openim.sdkws.GroupCreatedTips
-	(*GroupInfoSetTips)(nil),                    // 31: openim.sdkws.GroupInfoSetTips
-	(*GroupInfoSetNameTips)(nil),                // 32: openim.sdkws.GroupInfoSetNameTips
-	(*GroupInfoSetAnnouncementTips)(nil),        // 33: openim.sdkws.GroupInfoSetAnnouncementTips
-	(*JoinGroupApplicationTips)(nil),            // 34: openim.sdkws.JoinGroupApplicationTips
-	(*MemberQuitTips)(nil),                      // 35: openim.sdkws.MemberQuitTips
-	(*GroupApplicationAcceptedTips)(nil),        // 36: openim.sdkws.GroupApplicationAcceptedTips
-	(*GroupApplicationRejectedTips)(nil),        // 37: openim.sdkws.GroupApplicationRejectedTips
-	(*GroupOwnerTransferredTips)(nil),           // 38: openim.sdkws.GroupOwnerTransferredTips
-	(*MemberKickedTips)(nil),                    // 39: openim.sdkws.MemberKickedTips
-	(*MemberInvitedTips)(nil),                   // 40: openim.sdkws.MemberInvitedTips
-	(*MemberEnterTips)(nil),                     // 41: openim.sdkws.MemberEnterTips
-	(*GroupDismissedTips)(nil),                  // 42: openim.sdkws.GroupDismissedTips
-	(*GroupMemberMutedTips)(nil),                // 43: openim.sdkws.GroupMemberMutedTips
-	(*GroupMemberCancelMutedTips)(nil),          // 44: openim.sdkws.GroupMemberCancelMutedTips
-	(*GroupMutedTips)(nil),                      // 45: openim.sdkws.GroupMutedTips
-	(*GroupCancelMutedTips)(nil),                // 46: openim.sdkws.GroupCancelMutedTips
-	(*GroupMemberInfoSetTips)(nil),              // 47: openim.sdkws.GroupMemberInfoSetTips
-	(*FriendApplication)(nil),                   // 48: openim.sdkws.FriendApplication
-	(*FromToUserID)(nil),                        // 49: openim.sdkws.FromToUserID
-	(*FriendApplicationTips)(nil),               // 50: openim.sdkws.FriendApplicationTips
-	(*FriendApplicationApprovedTips)(nil),       // 51: openim.sdkws.FriendApplicationApprovedTips
-	(*FriendApplicationRejectedTips)(nil),       // 52: openim.sdkws.FriendApplicationRejectedTips
-	(*FriendAddedTips)(nil),                     // 53: openim.sdkws.FriendAddedTips
-	(*FriendDeletedTips)(nil),                   // 54: openim.sdkws.FriendDeletedTips
-	(*BlackAddedTips)(nil),                      // 55: openim.sdkws.BlackAddedTips
-	(*BlackDeletedTips)(nil),                    // 56: openim.sdkws.BlackDeletedTips
-	(*FriendInfoChangedTips)(nil),               // 57: openim.sdkws.FriendInfoChangedTips
-	(*UserInfoUpdatedTips)(nil),                 // 58: openim.sdkws.UserInfoUpdatedTips
-	(*UserStatusChangeTips)(nil),                // 59: openim.sdkws.UserStatusChangeTips
-	(*UserCommandAddTips)(nil),                  // 60: openim.sdkws.UserCommandAddTips
-	(*UserCommandUpdateTips)(nil),               // 61: openim.sdkws.UserCommandUpdateTips
-	(*UserCommandDeleteTips)(nil),               // 62: openim.sdkws.UserCommandDeleteTips
-	(*UserEmojiAddTips)(nil),                    // 63: openim.sdkws.UserEmojiAddTips
-	(*UserEmojiDeleteTips)(nil),                 // 64: openim.sdkws.UserEmojiDeleteTips
-	(*UserQuickReplyUpdateTips)(nil),            // 65: openim.sdkws.UserQuickReplyUpdateTips
-	(*UserAIQuickReplyUpdateTips)(nil),          // 66: openim.sdkws.UserAIQuickReplyUpdateTips
-	(*UserQuickReplyAddTips)(nil),               // 67: openim.sdkws.UserQuickReplyAddTips
-	(*UserQuickReplyDeleteTips)(nil),            // 68: openim.sdkws.UserQuickReplyDeleteTips
-	(*UserQuickReplyModifyTips)(nil),            // 69: openim.sdkws.UserQuickReplyModifyTips
-	(*UserQuickReplyPinTips)(nil),               // 70: openim.sdkws.UserQuickReplyPinTips
-	(*SummaryRecordAddTips)(nil),                // 71: openim.sdkws.SummaryRecordAddTips
-	(*SummaryRecordDeleteTips)(nil),             // 72: openim.sdkws.SummaryRecordDeleteTips
-	(*SummaryRecordFavoriteTips)(nil),           // 73: openim.sdkws.SummaryRecordFavoriteTips
-	(*SummaryRecordPublishTips)(nil),            // 74: openim.sdkws.SummaryRecordPublishTips
-	(*ScheduleNotificationRepeatInfo)(nil),      // 75: openim.sdkws.ScheduleNotificationRepeatInfo
-	(*ScheduleNotificationAttendeeInfo)(nil),    // 76: openim.sdkws.ScheduleNotificationAttendeeInfo
-	(*ScheduleNotificationMeetingSettings)(nil), // 77: openim.sdkws.ScheduleNotificationMeetingSettings
-	(*ScheduleNotificationTips)(nil),            // 78: openim.sdkws.ScheduleNotificationTips
-	(*ConversationUpdateTips)(nil),              // 79: openim.sdkws.ConversationUpdateTips
-	(*ConversationSetPrivateTips)(nil),          // 80: openim.sdkws.ConversationSetPrivateTips
-	(*ConversationHasReadTips)(nil),             // 81: openim.sdkws.ConversationHasReadTips
-	(*NotificationElem)(nil),                    // 82: openim.sdkws.NotificationElem
-	(*Seqs)(nil),                                // 83: openim.sdkws.seqs
-	(*DeleteMessageTips)(nil),                   // 84: openim.sdkws.DeleteMessageTips
-	(*RevokeMsgTips)(nil),                       // 85: openim.sdkws.RevokeMsgTips
-	(*MessageRevokedContent)(nil),               // 86: openim.sdkws.MessageRevokedContent
-	(*ClearConversationTips)(nil),               // 87: openim.sdkws.ClearConversationTips
-	(*DeleteMsgsTips)(nil),                      // 88: openim.sdkws.DeleteMsgsTips
-	(*MarkAsReadTips)(nil),                      // 89: openim.sdkws.MarkAsReadTips
-	(*GroupMsgReadUser)(nil),                    // 90: openim.sdkws.GroupMsgReadUser
-	(*SetAppBackgroundStatusReq)(nil),           // 91: openim.sdkws.SetAppBackgroundStatusReq
-	(*SetAppBackgroundStatusResp)(nil),          // 92: openim.sdkws.SetAppBackgroundStatusResp
-	(*ProcessUserCommand)(nil),                  // 93: openim.sdkws.ProcessUserCommand
-	(*RequestPagination)(nil),                   // 94: openim.sdkws.RequestPagination
-	(*FriendsInfoUpdateTips)(nil),               // 95: openim.sdkws.FriendsInfoUpdateTips
-	(*SubUserOnlineStatusElem)(nil),             // 96: openim.sdkws.SubUserOnlineStatusElem
-	(*SubUserOnlineStatusTips)(nil),             // 97: openim.sdkws.SubUserOnlineStatusTips
-	(*SubUserOnlineStatus)(nil),                 // 98: openim.sdkws.SubUserOnlineStatus
-	(*StreamMsgTips)(nil),                       // 99: openim.sdkws.StreamMsgTips
-	(*ConversationDeleteTips)(nil),              // 100: openim.sdkws.ConversationDeleteTips
-	(*ConversationGroupChangeTips)(nil),         // 101: openim.sdkws.ConversationGroupChangeTips
-	(*ScheduleGroupNotificationShareInfo)(nil),  // 102: openim.sdkws.ScheduleGroupNotificationShareInfo
-	(*ScheduleGroupChangeTips)(nil),             // 103: openim.sdkws.ScheduleGroupChangeTips
-	(*ShareUserInfo)(nil),                       // 104: openim.sdkws.ShareUserInfo
-	(*CreatorUserInfo)(nil),                     // 105: openim.sdkws.CreatorUserInfo
-	(*ChangeUserInfo)(nil),                      // 106: openim.sdkws.ChangeUserInfo
-	(*ScheduleGroupShareElem)(nil),              // 107: openim.sdkws.ScheduleGroupShareElem
-	(*ScheduleChangeElem)(nil),                  // 108: openim.sdkws.ScheduleChangeElem
-	(*ScheduleReminderAckTips)(nil),             // 109: openim.sdkws.ScheduleReminderAckTips
-	(*ConversationFoldNotificationTips)(nil),    // 110: openim.sdkws.ConversationFoldNotificationTips
-	nil,                                         // 111: openim.sdkws.PullMessageBySeqsResp.MsgsEntry
-	nil,                                         // 112: openim.sdkws.PullMessageBySeqsResp.NotificationMsgsEntry
-	nil,                                         // 113: openim.sdkws.GetMaxSeqResp.MaxSeqsEntry
-	nil,                                         // 114: openim.sdkws.GetMaxSeqResp.MinSeqsEntry
-	nil,                                         // 115: openim.sdkws.MsgData.OptionsEntry
-	nil,                                         // 116: openim.sdkws.PushMessages.MsgsEntry
-	nil,                                         // 117: openim.sdkws.PushMessages.NotificationMsgsEntry
-	nil,                                         // 118: openim.sdkws.SubUserOnlineStatusElem.PlatformDetailsEntry
-	(*wrapperspb.StringValue)(nil),              // 119: openim.protobuf.StringValue
-	(*wrapperspb.Int32Value)(nil),               // 120: openim.protobuf.Int32Value
+	(*UserActivity)(nil),                        // 7: openim.sdkws.UserActivity
+	(*UserPresence)(nil),                        // 8: openim.sdkws.UserPresence
+	(*UserInfoWithEx)(nil),                      // 9: openim.sdkws.UserInfoWithEx
+	(*FriendInfo)(nil),                          // 10: openim.sdkws.FriendInfo
+	(*BlackInfo)(nil),                           // 11: openim.sdkws.BlackInfo
+	(*GroupRequest)(nil),                        // 12: openim.sdkws.GroupRequest
+	(*FriendRequest)(nil),                       // 13: openim.sdkws.FriendRequest
+	(*FriendRequestMessage)(nil),                // 14: openim.sdkws.FriendRequestMessage
+	(*PullMessageBySeqsReq)(nil),                // 15: openim.sdkws.PullMessageBySeqsReq
+	(*SeqRange)(nil),                            // 16: openim.sdkws.SeqRange
+	(*PullMsgs)(nil),                            // 17: openim.sdkws.PullMsgs
+	(*PullMessageBySeqsResp)(nil),               // 18: openim.sdkws.PullMessageBySeqsResp
+	(*GetMaxSeqReq)(nil),                        // 19: openim.sdkws.GetMaxSeqReq
+	(*GetMaxSeqResp)(nil),                       // 20: openim.sdkws.GetMaxSeqResp
+	(*UserSendMsgResp)(nil),                     // 21: openim.sdkws.UserSendMsgResp
+	(*MsgData)(nil),                             // 22: openim.sdkws.MsgData
+	(*LikeInfo)(nil),                            // 23: openim.sdkws.LikeInfo
+	(*LikeUser)(nil),                            // 24: openim.sdkws.LikeUser
+	(*LikeMsgTips)(nil),                         // 25: openim.sdkws.LikeMsgTips
+	(*MarkInfo)(nil),                            // 26: openim.sdkws.MarkInfo
+	(*MarkMsgTips)(nil),                         // 27: openim.sdkws.MarkMsgTips
+	(*SpeechToTextInfo)(nil),                    // 28: openim.sdkws.SpeechToTextInfo
+	(*SpeechToTextMsgTips)(nil),                 // 29: openim.sdkws.SpeechToTextMsgTips
+	(*PushMessages)(nil),                        // 30: openim.sdkws.PushMessages
+	(*OfflinePushInfo)(nil),                     // 31: openim.sdkws.OfflinePushInfo
+	(*OfflinePushAction)(nil),                   // 32: openim.sdkws.OfflinePushAction
+	(*TipsComm)(nil),                            // 33: openim.sdkws.TipsComm
+	(*GroupCreatedTips)(nil),                    // 34: openim.sdkws.GroupCreatedTips
+	(*GroupInfoSetTips)(nil),                    // 35: openim.sdkws.GroupInfoSetTips
+	(*GroupInfoSetNameTips)(nil),                // 36: openim.sdkws.GroupInfoSetNameTips
+	(*GroupInfoSetAnnouncementTips)(nil),        // 37: openim.sdkws.GroupInfoSetAnnouncementTips
+	(*JoinGroupApplicationTips)(nil),            // 38: openim.sdkws.JoinGroupApplicationTips
+	(*MemberQuitTips)(nil),                      // 39: openim.sdkws.MemberQuitTips
+	(*GroupApplicationAcceptedTips)(nil),        // 40: openim.sdkws.GroupApplicationAcceptedTips
+	(*GroupApplicationRejectedTips)(nil),        // 41: openim.sdkws.GroupApplicationRejectedTips
+	(*GroupOwnerTransferredTips)(nil),           // 42: openim.sdkws.GroupOwnerTransferredTips
+	(*MemberKickedTips)(nil),                    // 43: openim.sdkws.MemberKickedTips
+	(*MemberInvitedTips)(nil),                   // 44: openim.sdkws.MemberInvitedTips
+	(*MemberEnterTips)(nil),                     // 45: openim.sdkws.MemberEnterTips
+	(*GroupDismissedTips)(nil),                  // 46: openim.sdkws.GroupDismissedTips
+	(*GroupMemberMutedTips)(nil),                // 47: openim.sdkws.GroupMemberMutedTips
+	(*GroupMemberCancelMutedTips)(nil),          // 48: openim.sdkws.GroupMemberCancelMutedTips
+	(*GroupMutedTips)(nil),                      // 49: openim.sdkws.GroupMutedTips
+	(*GroupCancelMutedTips)(nil),                // 50: openim.sdkws.GroupCancelMutedTips
+	(*GroupMemberInfoSetTips)(nil),              // 51: openim.sdkws.GroupMemberInfoSetTips
+	(*FriendApplication)(nil),                   // 52: openim.sdkws.FriendApplication
+	(*FromToUserID)(nil),                        // 53: openim.sdkws.FromToUserID
+	(*FriendApplicationTips)(nil),               // 54: openim.sdkws.FriendApplicationTips
+	(*FriendApplicationApprovedTips)(nil),       // 55: openim.sdkws.FriendApplicationApprovedTips
+	(*FriendApplicationRejectedTips)(nil),       // 56: openim.sdkws.FriendApplicationRejectedTips
+	(*FriendApplicationRepliedTips)(nil),        // 57: openim.sdkws.FriendApplicationRepliedTips
+	(*FriendApplicationExpiredTips)(nil),        // 58: openim.sdkws.FriendApplicationExpiredTips
+	(*FriendAddedTips)(nil),                     // 59: openim.sdkws.FriendAddedTips
+	(*FriendDeletedTips)(nil),                   // 60: openim.sdkws.FriendDeletedTips
+	(*BlackAddedTips)(nil),                      // 61: openim.sdkws.BlackAddedTips
+	(*BlackDeletedTips)(nil),                    // 62: openim.sdkws.BlackDeletedTips
+	(*FriendInfoChangedTips)(nil),               // 63: openim.sdkws.FriendInfoChangedTips
+	(*FriendCategoryChangedTips)(nil),           // 64: openim.sdkws.FriendCategoryChangedTips
+	(*UserInfoUpdatedTips)(nil),                 // 65: openim.sdkws.UserInfoUpdatedTips
+	(*UserStatusChangeTips)(nil),                // 66: openim.sdkws.UserStatusChangeTips
+	(*UserAccountStatusTips)(nil),               // 67: openim.sdkws.UserAccountStatusTips
+	(*UserClientConfigChangedTips)(nil),         // 68: openim.sdkws.UserClientConfigChangedTips
+	(*SessionRevokedTips)(nil),                  // 69: openim.sdkws.SessionRevokedTips
+	(*UserCommandAddTips)(nil),                  // 70: openim.sdkws.UserCommandAddTips
+	(*UserCommandUpdateTips)(nil),               // 71: openim.sdkws.UserCommandUpdateTips
+	(*UserCommandDeleteTips)(nil),               // 72: openim.sdkws.UserCommandDeleteTips
+	(*UserEmojiAddTips)(nil),                    // 73: openim.sdkws.UserEmojiAddTips
+	(*UserEmojiDeleteTips)(nil),                 // 74: openim.sdkws.UserEmojiDeleteTips
+	(*UserEmojiPackChangedTips)(nil),            // 75: openim.sdkws.UserEmojiPackChangedTips
+	(*UserQuickReplyUpdateTips)(nil),            // 76: openim.sdkws.UserQuickReplyUpdateTips
+	(*UserAIQuickReplyUpdateTips)(nil),          // 77: openim.sdkws.UserAIQuickReplyUpdateTips
+	(*QuickReplyScope)(nil),                     // 78: openim.sdkws.QuickReplyScope
+	(*UserQuickReplyAddTips)(nil),               // 79: openim.sdkws.UserQuickReplyAddTips
+	(*UserQuickReplyDeleteTips)(nil),            // 80: openim.sdkws.UserQuickReplyDeleteTips
+	(*UserQuickReplyModifyTips)(nil),            // 81: openim.sdkws.UserQuickReplyModifyTips
+	(*UserQuickReplyPinTips)(nil),               // 82: openim.sdkws.UserQuickReplyPinTips
+	(*SummaryRecordAddTips)(nil),                // 83: openim.sdkws.SummaryRecordAddTips
+	(*SummaryRecordDeleteTips)(nil),             // 84: openim.sdkws.SummaryRecordDeleteTips
+	(*SummaryRecordFavoriteTips)(nil),           // 85: openim.sdkws.SummaryRecordFavoriteTips
+	(*SummaryRecordPublishTips)(nil),            // 86: openim.sdkws.SummaryRecordPublishTips
+	(*ScheduleNotificationRepeatInfo)(nil),      // 87: openim.sdkws.ScheduleNotificationRepeatInfo
+	(*ScheduleNotificationAttendeeInfo)(nil),    // 88: openim.sdkws.ScheduleNotificationAttendeeInfo
+	(*ScheduleNotificationMeetingSettings)(nil), // 89: openim.sdkws.ScheduleNotificationMeetingSettings
+	(*ScheduleNotificationTips)(nil),            // 90: openim.sdkws.ScheduleNotificationTips
+	(*ConversationUpdateTips)(nil),              // 91: openim.sdkws.ConversationUpdateTips
+	(*ConversationSetPrivateTips)(nil),          // 92: openim.sdkws.ConversationSetPrivateTips
+	(*ConversationHasReadTips)(nil),             // 93: openim.sdkws.ConversationHasReadTips
+	(*NotificationElem)(nil),                    // 94: openim.sdkws.NotificationElem
+	(*Seqs)(nil),                                // 95: openim.sdkws.seqs
+	(*DeleteMessageTips)(nil),                   // 96: openim.sdkws.DeleteMessageTips
+	(*RevokeMsgTips)(nil),                       // 97: openim.sdkws.RevokeMsgTips
+	(*MessageRevokedContent)(nil),               // 98: openim.sdkws.MessageRevokedContent
+	(*ClearConversationTips)(nil),               // 99: openim.sdkws.ClearConversationTips
+	(*DeleteMsgsTips)(nil),                      // 100: openim.sdkws.DeleteMsgsTips
+	(*MarkAsReadTips)(nil),                      // 101: openim.sdkws.MarkAsReadTips
+	(*GroupMsgReadUser)(nil),                    // 102: openim.sdkws.GroupMsgReadUser
+	(*SetAppBackgroundStatusReq)(nil),           // 103: openim.sdkws.SetAppBackgroundStatusReq
+	(*SetAppBackgroundStatusResp)(nil),          // 104: openim.sdkws.SetAppBackgroundStatusResp
+	(*ProcessUserCommand)(nil),                  // 105: openim.sdkws.ProcessUserCommand
+	(*RequestPagination)(nil),                   // 106: openim.sdkws.RequestPagination
+	(*FriendsInfoUpdateTips)(nil),               // 107: openim.sdkws.FriendsInfoUpdateTips
+	(*SubUserOnlineStatusElem)(nil),             // 108: openim.sdkws.SubUserOnlineStatusElem
+	(*SubUserOnlineStatusTips)(nil),             // 109: openim.sdkws.SubUserOnlineStatusTips
+	(*SubUserOnlineStatus)(nil),                 // 110: openim.sdkws.SubUserOnlineStatus
+	(*StreamMsgTips)(nil),                       // 111: openim.sdkws.StreamMsgTips
+	(*ConversationDraftChangedTips)(nil),        // 112: openim.sdkws.ConversationDraftChangedTips
+	(*ConversationDeleteTips)(nil),              // 113: openim.sdkws.ConversationDeleteTips
+	(*ConversationGroupChangeTips)(nil),         // 114: openim.sdkws.ConversationGroupChangeTips
+	(*ScheduleGroupNotificationShareInfo)(nil),  // 115: openim.sdkws.ScheduleGroupNotificationShareInfo
+	(*ScheduleGroupChangeTips)(nil),             // 116: openim.sdkws.ScheduleGroupChangeTips
+	(*ShareUserInfo)(nil),                       // 117: openim.sdkws.ShareUserInfo
+	(*CreatorUserInfo)(nil),                     // 118: openim.sdkws.CreatorUserInfo
+	(*ChangeUserInfo)(nil),                      // 119: openim.sdkws.ChangeUserInfo
+	(*ScheduleGroupShareElem)(nil),              // 120: openim.sdkws.ScheduleGroupShareElem
+	(*EmojiPackShareElem)(nil),                  // 121: openim.sdkws.EmojiPackShareElem
+	(*ScheduleChangeElem)(nil),                  // 122: openim.sdkws.ScheduleChangeElem
+	(*ScheduleReminderAckTips)(nil),             // 123: openim.sdkws.ScheduleReminderAckTips
+	(*ConversationFoldNotificationTips)(nil),    // 124: openim.sdkws.ConversationFoldNotificationTips
+	nil,                                         // 125: openim.sdkws.PullMessageBySeqsResp.MsgsEntry
+	nil,                                         // 126: openim.sdkws.PullMessageBySeqsResp.NotificationMsgsEntry
+	nil,                                         // 127: openim.sdkws.GetMaxSeqResp.MaxSeqsEntry
+	nil,                                         // 128: openim.sdkws.GetMaxSeqResp.MinSeqsEntry
+	nil,                                         // 129: openim.sdkws.MsgData.OptionsEntry
+	nil,                                         // 130: openim.sdkws.PushMessages.MsgsEntry
+	nil,                                         // 131: openim.sdkws.PushMessages.NotificationMsgsEntry
+	nil,                                         // 132: openim.sdkws.SubUserOnlineStatusElem.PlatformDetailsEntry
+	(*wrapperspb.StringValue)(nil),              // 133: openim.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),               // 134: openim.protobuf.Int32Value
 }
 var file_sdkws_sdkws_proto_depIdxs = []int32{
-	119, // 0: openim.sdkws.GroupInfoForSet.ex:type_name -> openim.protobuf.StringValue
-	120, // 1: openim.sdkws.GroupInfoForSet.needVerification:type_name -> openim.protobuf.Int32Value
-	120, // 2: openim.sdkws.GroupInfoForSet.lookMemberInfo:type_name -> openim.protobuf.Int32Value
-	120, // 3: openim.sdkws.GroupInfoForSet.applyMemberFriend:type_name -> openim.protobuf.Int32Value
+	133, // 0: openim.sdkws.GroupInfoForSet.ex:type_name -> openim.protobuf.StringValue
+	134, // 1: openim.sdkws.GroupInfoForSet.needVerification:type_name -> openim.protobuf.Int32Value
+	134, // 2: openim.sdkws.GroupInfoForSet.lookMemberInfo:type_name -> openim.protobuf.Int32Value
+	134, // 3: openim.sdkws.GroupInfoForSet.applyMemberFriend:type_name -> openim.protobuf.Int32Value
 	6,   // 4: openim.sdkws.UserInfo.onlineStatus:type_name -> openim.sdkws.PlatformDetail
-	119, // 5: openim.sdkws.UserInfoWithEx.nickname:type_name -> openim.protobuf.StringValue
-	119, // 6: openim.sdkws.UserInfoWithEx.faceURL:type_name -> openim.protobuf.StringValue
-	119, // 7: openim.sdkws.UserInfoWithEx.ex:type_name -> openim.protobuf.StringValue
-	120, // 8: openim.sdkws.UserInfoWithEx.globalRecvMsgOpt:type_name -> openim.protobuf.Int32Value
-	119, // 9: openim.sdkws.UserInfoWithEx.pinyin:type_name -> openim.protobuf.StringValue
-	119, // 10: openim.sdkws.UserInfoWithEx.pinyinInitials:type_name -> openim.protobuf.StringValue
-	119, // 11: openim.sdkws.UserInfoWithEx.status:type_name -> openim.protobuf.StringValue
-	119, // 12: openim.sdkws.UserInfoWithEx.signature:type_name -> openim.protobuf.StringValue
+	133, // 5: openim.sdkws.UserInfoWithEx.nickname:type_name -> openim.protobuf.StringValue
+	133, // 6: openim.sdkws.UserInfoWithEx.faceURL:type_name -> openim.protobuf.StringValue
+	133, // 7: openim.sdkws.UserInfoWithEx.ex:type_name -> openim.protobuf.StringValue
+	134, // 8: openim.sdkws.UserInfoWithEx.globalRecvMsgOpt:type_name -> openim.protobuf.Int32Value
+	133, // 9: openim.sdkws.UserInfoWithEx.pinyin:type_name -> openim.protobuf.StringValue
+	133, // 10: openim.sdkws.UserInfoWithEx.pinyinInitials:type_name -> openim.protobuf.StringValue
+	133, // 11: openim.sdkws.UserInfoWithEx.status:type_name -> openim.protobuf.StringValue
+	133, // 12: openim.sdkws.UserInfoWithEx.signature:type_name -> openim.protobuf.StringValue
 	5,   // 13: openim.sdkws.FriendInfo.friendUser:type_name -> openim.sdkws.UserInfo
 	4,   // 14: openim.sdkws.BlackInfo.blackUserInfo:type_name -> openim.sdkws.PublicUserInfo
 	4,   // 15: openim.sdkws.GroupRequest.userInfo:type_name -> openim.sdkws.PublicUserInfo
 	1,   // 16: openim.sdkws.GroupRequest.groupInfo:type_name -> openim.sdkws.GroupInfo
-	13,  // 17: openim.sdkws.PullMessageBySeqsReq.seqRanges:type_name -> openim.sdkws.SeqRange
-	0,   // 18: openim.sdkws.PullMessageBySeqsReq.order:type_name -> openim.sdkws.PullOrder
-	19,  // 19: openim.sdkws.PullMsgs.Msgs:type_name -> openim.sdkws.MsgData
-	111, // 20: openim.sdkws.PullMessageBySeqsResp.msgs:type_name -> openim.sdkws.PullMessageBySeqsResp.MsgsEntry
-	112, // 21: openim.sdkws.PullMessageBySeqsResp.notificationMsgs:type_name -> openim.sdkws.PullMessageBySeqsResp.NotificationMsgsEntry
-	113, // 22: openim.sdkws.GetMaxSeqResp.maxSeqs:type_name -> openim.sdkws.GetMaxSeqResp.MaxSeqsEntry
-	114, // 23: openim.sdkws.GetMaxSeqResp.minSeqs:type_name -> openim.sdkws.GetMaxSeqResp.MinSeqsEntry
-	115, // 24: openim.sdkws.MsgData.options:type_name -> openim.sdkws.MsgData.OptionsEntry
-	28,  // 25: openim.sdkws.MsgData.offlinePushInfo:type_name -> openim.sdkws.OfflinePushInfo
-	20,  // 26: openim.sdkws.MsgData.likeInfo:type_name -> openim.sdkws.LikeInfo
-	23,  // 27: openim.sdkws.MsgData.markInfo:type_name -> openim.sdkws.MarkInfo
-	25,  // 28: openim.sdkws.MsgData.speechToTextInfo:type_name -> openim.sdkws.SpeechToTextInfo
-	21,  // 29: openim.sdkws.LikeInfo.like_users:type_name -> openim.sdkws.LikeUser
-	20,  // 30: openim.sdkws.LikeMsgTips.fullLikeInfo:type_name -> openim.sdkws.LikeInfo
-	25,  // 31: openim.sdkws.SpeechToTextMsgTips.speechToTextInfo:type_name -> openim.sdkws.SpeechToTextInfo
-	116, // 32: openim.sdkws.PushMessages.msgs:type_name -> openim.sdkws.PushMessages.MsgsEntry
-	117, // 33: openim.sdkws.PushMessages.notificationMsgs:type_name -> openim.sdkws.PushMessages.NotificationMsgsEntry
-	1,   // 34: openim.sdkws.GroupCreatedTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 35: openim.sdkws.GroupCreatedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	3,   // 36: openim.sdkws.GroupCreatedTips.memberList:type_name -> openim.sdkws.GroupMemberFullInfo
-	3,   // 37: openim.sdkws.GroupCreatedTips.groupOwnerUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	3,   // 38: openim.sdkws.GroupInfoSetTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 39: openim.sdkws.GroupInfoSetTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 40: openim.sdkws.GroupInfoSetNameTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 41: openim.sdkws.GroupInfoSetNameTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 42: openim.sdkws.GroupInfoSetAnnouncementTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 43: openim.sdkws.GroupInfoSetAnnouncementTips.group:type_name -> openim.sdkws.GroupInfo
-	1,   // 44: openim.sdkws.JoinGroupApplicationTips.group:type_name -> openim.sdkws.GroupInfo
-	4,   // 45: openim.sdkws.JoinGroupApplicationTips.applicant:type_name -> openim.sdkws.PublicUserInfo
-	10,  // 46: openim.sdkws.JoinGroupApplicationTips.request:type_name -> openim.sdkws.GroupRequest
-	1,   // 47: openim.sdkws.MemberQuitTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 48: openim.sdkws.MemberQuitTips.quitUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 49: openim.sdkws.GroupApplicationAcceptedTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 50: openim.sdkws.GroupApplicationAcceptedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	10,  // 51: openim.sdkws.GroupApplicationAcceptedTips.request:type_name -> openim.sdkws.GroupRequest
-	1,   // 52: openim.sdkws.GroupApplicationRejectedTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 53: openim.sdkws.GroupApplicationRejectedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	10,  // 54: openim.sdkws.GroupApplicationRejectedTips.request:type_name -> openim.sdkws.GroupRequest
-	1,   // 55: openim.sdkws.GroupOwnerTransferredTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 56: openim.sdkws.GroupOwnerTransferredTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	3,   // 57: openim.sdkws.GroupOwnerTransferredTips.newGroupOwner:type_name -> openim.sdkws.GroupMemberFullInfo
-	3,   // 58: openim.sdkws.GroupOwnerTransferredTips.oldGroupOwnerInfo:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 59: openim.sdkws.MemberKickedTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 60: openim.sdkws.MemberKickedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	3,   // 61: openim.sdkws.MemberKickedTips.kickedUserList:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 62: openim.sdkws.MemberInvitedTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 63: openim.sdkws.MemberInvitedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	3,   // 64: openim.sdkws.MemberInvitedTips.invitedUserList:type_name -> openim.sdkws.GroupMemberFullInfo
-	3,   // 65: openim.sdkws.MemberInvitedTips.inviterUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 66: openim.sdkws.MemberEnterTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 67: openim.sdkws.MemberEnterTips.entrantUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 68: openim.sdkws.GroupDismissedTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 69: openim.sdkws.GroupDismissedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 70: openim.sdkws.GroupMemberMutedTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 71: openim.sdkws.GroupMemberMutedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	3,   // 72: openim.sdkws.GroupMemberMutedTips.mutedUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 73: openim.sdkws.GroupMemberCancelMutedTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 74: openim.sdkws.GroupMemberCancelMutedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	3,   // 75: openim.sdkws.GroupMemberCancelMutedTips.mutedUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 76: openim.sdkws.GroupMutedTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 77: openim.sdkws.GroupMutedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 78: openim.sdkws.GroupCancelMutedTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 79: openim.sdkws.GroupCancelMutedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	1,   // 80: openim.sdkws.GroupMemberInfoSetTips.group:type_name -> openim.sdkws.GroupInfo
-	3,   // 81: openim.sdkws.GroupMemberInfoSetTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	3,   // 82: openim.sdkws.GroupMemberInfoSetTips.changedUser:type_name -> openim.sdkws.GroupMemberFullInfo
-	49,  // 83: openim.sdkws.FriendApplicationTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
-	11,  // 84: openim.sdkws.FriendApplicationTips.request:type_name -> openim.sdkws.FriendRequest
-	49,  // 85: openim.sdkws.FriendApplicationApprovedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
-	11,  // 86: openim.sdkws.FriendApplicationApprovedTips.request:type_name -> openim.sdkws.FriendRequest
-	49,  // 87: openim.sdkws.FriendApplicationRejectedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
-	11,  // 88: openim.sdkws.FriendApplicationRejectedTips.request:type_name -> openim.sdkws.FriendRequest
-	8,   // 89: openim.sdkws.FriendAddedTips.friend:type_name -> openim.sdkws.FriendInfo
-	4,   // 90: openim.sdkws.FriendAddedTips.opUser:type_name -> openim.sdkws.PublicUserInfo
-	49,  // 91: openim.sdkws.FriendDeletedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
-	49,  // 92: openim.sdkws.BlackAddedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
-	49,  // 93: openim.sdkws.BlackDeletedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
-	49,  // 94: openim.sdkws.FriendInfoChangedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
-	77,  // 95: openim.sdkws.ScheduleNotificationTips.meetingSettings:type_name -> openim.sdkws.ScheduleNotificationMeetingSettings
-	75,  // 96: openim.sdkws.ScheduleNotificationTips.repeatInfo:type_name -> openim.sdkws.ScheduleNotificationRepeatInfo
-	76,  // 97: openim.sdkws.ScheduleNotificationTips.attendees:type_name -> openim.sdkws.ScheduleNotificationAttendeeInfo
-	49,  // 98: openim.sdkws.FriendsInfoUpdateTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
-	118, // 99: openim.sdkws.SubUserOnlineStatusElem.platformDetails:type_name -> openim.sdkws.SubUserOnlineStatusElem.PlatformDetailsEntry
-	96,  // 100: openim.sdkws.SubUserOnlineStatusTips.subscribers:type_name -> openim.sdkws.SubUserOnlineStatusElem
-	102, // 101: openim.sdkws.ScheduleGroupChangeTips.shares:type_name -> openim.sdkws.ScheduleGroupNotificationShareInfo
-	104, // 102: openim.sdkws.ScheduleGroupShareElem.shareUser:type_name -> openim.sdkws.ShareUserInfo
-	105, // 103: openim.sdkws.ScheduleChangeElem.creator:type_name -> openim.sdkws.CreatorUserInfo
-	106, // 104: openim.sdkws.ScheduleChangeElem.changeUser:type_name -> openim.sdkws.ChangeUserInfo
-	75,  // 105: openim.sdkws.ScheduleChangeElem.repeatInfo:type_name -> openim.sdkws.ScheduleNotificationRepeatInfo
-	77,  // 106: openim.sdkws.ScheduleChangeElem.meetingSettings:type_name -> openim.sdkws.ScheduleNotificationMeetingSettings
-	76,  // 107: openim.sdkws.ScheduleChangeElem.attendees:type_name -> openim.sdkws.ScheduleNotificationAttendeeInfo
-	14,  // 108: openim.sdkws.PullMessageBySeqsResp.MsgsEntry.value:type_name -> openim.sdkws.PullMsgs
-	14,  // 109: openim.sdkws.PullMessageBySeqsResp.NotificationMsgsEntry.value:type_name -> openim.sdkws.PullMsgs
-	14,  // 110: openim.sdkws.PushMessages.MsgsEntry.value:type_name -> openim.sdkws.PullMsgs
-	14,  // 111: openim.sdkws.PushMessages.NotificationMsgsEntry.value:type_name -> openim.sdkws.PullMsgs
-	112, // [112:112] is the sub-list for method output_type
-	112, // [112:112] is the sub-list for method input_type
-	112, // [112:112] is the sub-list for extension type_name
-	112, // [112:112] is the sub-list for extension extendee
-	0,   // [0:112] is the sub-list for field type_name
+	14,  // 17: openim.sdkws.FriendRequest.messages:type_name -> openim.sdkws.FriendRequestMessage
+	16,  // 18: openim.sdkws.PullMessageBySeqsReq.seqRanges:type_name -> openim.sdkws.SeqRange
+	0,   // 19: openim.sdkws.PullMessageBySeqsReq.order:type_name -> openim.sdkws.PullOrder
+	22,  // 20: openim.sdkws.PullMsgs.Msgs:type_name -> openim.sdkws.MsgData
+	125, // 21: openim.sdkws.PullMessageBySeqsResp.msgs:type_name -> openim.sdkws.PullMessageBySeqsResp.MsgsEntry
+	126, // 22: openim.sdkws.PullMessageBySeqsResp.notificationMsgs:type_name -> openim.sdkws.PullMessageBySeqsResp.NotificationMsgsEntry
+	127, // 23: openim.sdkws.GetMaxSeqResp.maxSeqs:type_name -> openim.sdkws.GetMaxSeqResp.MaxSeqsEntry
+	128, // 24: openim.sdkws.GetMaxSeqResp.minSeqs:type_name -> openim.sdkws.GetMaxSeqResp.MinSeqsEntry
+	129, // 25: openim.sdkws.MsgData.options:type_name -> openim.sdkws.MsgData.OptionsEntry
+	31,  // 26: openim.sdkws.MsgData.offlinePushInfo:type_name -> openim.sdkws.OfflinePushInfo
+	23,  // 27: openim.sdkws.MsgData.likeInfo:type_name -> openim.sdkws.LikeInfo
+	26,  // 28: openim.sdkws.MsgData.markInfo:type_name -> openim.sdkws.MarkInfo
+	28,  // 29: openim.sdkws.MsgData.speechToTextInfo:type_name -> openim.sdkws.SpeechToTextInfo
+	24,  // 30: openim.sdkws.LikeInfo.like_users:type_name -> openim.sdkws.LikeUser
+	23,  // 31: openim.sdkws.LikeMsgTips.fullLikeInfo:type_name -> openim.sdkws.LikeInfo
+	28,  // 32: openim.sdkws.SpeechToTextMsgTips.speechToTextInfo:type_name -> openim.sdkws.SpeechToTextInfo
+	130, // 33: openim.sdkws.PushMessages.msgs:type_name -> openim.sdkws.PushMessages.MsgsEntry
+	131, // 34: openim.sdkws.PushMessages.notificationMsgs:type_name -> openim.sdkws.PushMessages.NotificationMsgsEntry
+	32,  // 35: openim.sdkws.OfflinePushInfo.actions:type_name -> openim.sdkws.OfflinePushAction
+	1,   // 36: openim.sdkws.GroupCreatedTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 37: openim.sdkws.GroupCreatedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	3,   // 38: openim.sdkws.GroupCreatedTips.memberList:type_name -> openim.sdkws.GroupMemberFullInfo
+	3,   // 39: openim.sdkws.GroupCreatedTips.groupOwnerUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	3,   // 40: openim.sdkws.GroupInfoSetTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 41: openim.sdkws.GroupInfoSetTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 42: openim.sdkws.GroupInfoSetNameTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 43: openim.sdkws.GroupInfoSetNameTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 44: openim.sdkws.GroupInfoSetAnnouncementTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 45: openim.sdkws.GroupInfoSetAnnouncementTips.group:type_name -> openim.sdkws.GroupInfo
+	1,   // 46: openim.sdkws.JoinGroupApplicationTips.group:type_name -> openim.sdkws.GroupInfo
+	4,   // 47: openim.sdkws.JoinGroupApplicationTips.applicant:type_name -> openim.sdkws.PublicUserInfo
+	12,  // 48: openim.sdkws.JoinGroupApplicationTips.request:type_name -> openim.sdkws.GroupRequest
+	1,   // 49: openim.sdkws.MemberQuitTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 50: openim.sdkws.MemberQuitTips.quitUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 51: openim.sdkws.GroupApplicationAcceptedTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 52: openim.sdkws.GroupApplicationAcceptedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	12,  // 53: openim.sdkws.GroupApplicationAcceptedTips.request:type_name -> openim.sdkws.GroupRequest
+	1,   // 54: openim.sdkws.GroupApplicationRejectedTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 55: openim.sdkws.GroupApplicationRejectedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	12,  // 56: openim.sdkws.GroupApplicationRejectedTips.request:type_name -> openim.sdkws.GroupRequest
+	1,   // 57: openim.sdkws.GroupOwnerTransferredTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 58: openim.sdkws.GroupOwnerTransferredTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	3,   // 59: openim.sdkws.GroupOwnerTransferredTips.newGroupOwner:type_name -> openim.sdkws.GroupMemberFullInfo
+	3,   // 60: openim.sdkws.GroupOwnerTransferredTips.oldGroupOwnerInfo:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 61: openim.sdkws.MemberKickedTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 62: openim.sdkws.MemberKickedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	3,   // 63: openim.sdkws.MemberKickedTips.kickedUserList:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 64: openim.sdkws.MemberInvitedTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 65: openim.sdkws.MemberInvitedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	3,   // 66: openim.sdkws.MemberInvitedTips.invitedUserList:type_name -> openim.sdkws.GroupMemberFullInfo
+	3,   // 67: openim.sdkws.MemberInvitedTips.inviterUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 68: openim.sdkws.MemberEnterTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 69: openim.sdkws.MemberEnterTips.entrantUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 70: openim.sdkws.GroupDismissedTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 71: openim.sdkws.GroupDismissedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 72: openim.sdkws.GroupMemberMutedTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 73: openim.sdkws.GroupMemberMutedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	3,   // 74: openim.sdkws.GroupMemberMutedTips.mutedUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 75: openim.sdkws.GroupMemberCancelMutedTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 76: openim.sdkws.GroupMemberCancelMutedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	3,   // 77: openim.sdkws.GroupMemberCancelMutedTips.mutedUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 78: openim.sdkws.GroupMutedTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 79: openim.sdkws.GroupMutedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 80: openim.sdkws.GroupCancelMutedTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 81: openim.sdkws.GroupCancelMutedTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	1,   // 82: openim.sdkws.GroupMemberInfoSetTips.group:type_name -> openim.sdkws.GroupInfo
+	3,   // 83: openim.sdkws.GroupMemberInfoSetTips.opUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	3,   // 84: openim.sdkws.GroupMemberInfoSetTips.changedUser:type_name -> openim.sdkws.GroupMemberFullInfo
+	53,  // 85: openim.sdkws.FriendApplicationTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
+	13,  // 86: openim.sdkws.FriendApplicationTips.request:type_name -> openim.sdkws.FriendRequest
+	53,  // 87: openim.sdkws.FriendApplicationApprovedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
+	13,  // 88: openim.sdkws.FriendApplicationApprovedTips.request:type_name -> openim.sdkws.FriendRequest
+	53,  // 89: openim.sdkws.FriendApplicationRejectedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
+	13,  // 90: openim.sdkws.FriendApplicationRejectedTips.request:type_name -> openim.sdkws.FriendRequest
+	53,  // 91: openim.sdkws.FriendApplicationRepliedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
+	14,  // 92: openim.sdkws.FriendApplicationRepliedTips.message:type_name -> openim.sdkws.FriendRequestMessage
+	13,  // 93: openim.sdkws.FriendApplicationRepliedTips.request:type_name -> openim.sdkws.FriendRequest
+	53,  // 94: openim.sdkws.FriendApplicationExpiredTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
+	13,  // 95: openim.sdkws.FriendApplicationExpiredTips.request:type_name -> openim.sdkws.FriendRequest
+	10,  // 96: openim.sdkws.FriendAddedTips.friend:type_name -> openim.sdkws.FriendInfo
+	4,   // 97: openim.sdkws.FriendAddedTips.opUser:type_name -> openim.sdkws.PublicUserInfo
+	53,  // 98: openim.sdkws.FriendDeletedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
+	53,  // 99: openim.sdkws.BlackAddedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
+	53,  // 100: openim.sdkws.BlackDeletedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
+	53,  // 101: openim.sdkws.FriendInfoChangedTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
+	8,   // 102: openim.sdkws.UserStatusChangeTips.presence:type_name -> openim.sdkws.UserPresence
+	78,  // 103: openim.sdkws.UserQuickReplyAddTips.scope:type_name -> openim.sdkws.QuickReplyScope
+	78,  // 104: openim.sdkws.UserQuickReplyModifyTips.scope:type_name -> openim.sdkws.QuickReplyScope
+	89,  // 105: openim.sdkws.ScheduleNotificationTips.meetingSettings:type_name -> openim.sdkws.ScheduleNotificationMeetingSettings
+	87,  // 106: openim.sdkws.ScheduleNotificationTips.repeatInfo:type_name -> openim.sdkws.ScheduleNotificationRepeatInfo
+	88,  // 107: openim.sdkws.ScheduleNotificationTips.attendees:type_name -> openim.sdkws.ScheduleNotificationAttendeeInfo
+	53,  // 108: openim.sdkws.FriendsInfoUpdateTips.fromToUserID:type_name -> openim.sdkws.FromToUserID
+	132, // 109: openim.sdkws.SubUserOnlineStatusElem.platformDetails:type_name -> openim.sdkws.SubUserOnlineStatusElem.PlatformDetailsEntry
+	8,   // 110: openim.sdkws.SubUserOnlineStatusElem.presence:type_name -> openim.sdkws.UserPresence
+	108, // 111: openim.sdkws.SubUserOnlineStatusTips.subscribers:type_name -> openim.sdkws.SubUserOnlineStatusElem
+	115, // 112: openim.sdkws.ScheduleGroupChangeTips.shares:type_name -> openim.sdkws.ScheduleGroupNotificationShareInfo
+	117, // 113: openim.sdkws.ScheduleGroupShareElem.shareUser:type_name -> openim.sdkws.ShareUserInfo
+	117, // 114: openim.sdkws.EmojiPackShareElem.shareUser:type_name -> openim.sdkws.ShareUserInfo
+	118, // 115: openim.sdkws.ScheduleChangeElem.creator:type_name -> openim.sdkws.CreatorUserInfo
+	119, // 116: openim.sdkws.ScheduleChangeElem.changeUser:type_name -> openim.sdkws.ChangeUserInfo
+	87,  // 117: openim.sdkws.ScheduleChangeElem.repeatInfo:type_name -> openim.sdkws.ScheduleNotificationRepeatInfo
+	89,  // 118: openim.sdkws.ScheduleChangeElem.meetingSettings:type_name -> openim.sdkws.ScheduleNotificationMeetingSettings
+	88,  // 119: openim.sdkws.ScheduleChangeElem.attendees:type_name -> openim.sdkws.ScheduleNotificationAttendeeInfo
+	17,  // 120: openim.sdkws.PullMessageBySeqsResp.MsgsEntry.value:type_name -> openim.sdkws.PullMsgs
+	17,  // 121: openim.sdkws.PullMessageBySeqsResp.NotificationMsgsEntry.value:type_name -> openim.sdkws.PullMsgs
+	17,  // 122: openim.sdkws.PushMessages.MsgsEntry.value:type_name -> openim.sdkws.PullMsgs
+	17,  // 123: openim.sdkws.PushMessages.NotificationMsgsEntry.value:type_name -> openim.sdkws.PullMsgs
+	124, // [124:124] is the sub-list for method output_type
+	124, // [124:124] is the sub-list for method input_type
+	124, // [124:124] is the sub-list for extension type_name
+	124, // [124:124] is the sub-list for extension extendee
+	0,   // [0:124] is the sub-list for field type_name
 }
 
 func init() { file_sdkws_sdkws_proto_init() }
@@ -10337,16 +11804,17 @@ func file_sdkws_sdkws_proto_init() {
 	if File_sdkws_sdkws_proto != nil {
 		return
 	}
-	file_sdkws_sdkws_proto_msgTypes[77].OneofWrappers = []any{}
-	file_sdkws_sdkws_proto_msgTypes[102].OneofWrappers = []any{}
-	file_sdkws_sdkws_proto_msgTypes[107].OneofWrappers = []any{}
+	file_sdkws_sdkws_proto_msgTypes[87].OneofWrappers = []any{}
+	file_sdkws_sdkws_proto_msgTypes[89].OneofWrappers = []any{}
+	file_sdkws_sdkws_proto_msgTypes[115].OneofWrappers = []any{}
+	file_sdkws_sdkws_proto_msgTypes[121].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sdkws_sdkws_proto_rawDesc), len(file_sdkws_sdkws_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   118,
+			NumMessages:   132,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,41 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdkws
+
+import "testing"
+
+func TestQuickReplyScopeMatch(t *testing.T) {
+	scope := &QuickReplyScope{ConversationIDs: []string{"si_a_b"}, GroupIDs: []string{"g1"}}
+	tests := []struct {
+		name           string
+		scope          *QuickReplyScope
+		conversationID string
+		groupID        string
+		want           bool
+	}{
+		{"nil scope", nil, "si_x_y", "", true},
+		{"empty scope", &QuickReplyScope{}, "si_x_y", "", true},
+		{"conversation hit", scope, "si_a_b", "", true},
+		{"group hit", scope, "sg_g1", "g1", true},
+		{"miss", scope, "si_x_y", "", false},
+		{"group miss", scope, "sg_g2", "g2", false},
+		{"empty ids do not match", &QuickReplyScope{ConversationIDs: []string{""}}, "", "", false},
+	}
+	for _, tt := range tests {
+		if got := tt.scope.Match(tt.conversationID, tt.groupID); got != tt.want {
+			t.Errorf("%s: Match(%q, %q) = %v, want %v", tt.name, tt.conversationID, tt.groupID, got, tt.want)
+		}
+	}
+}
@@ -16,6 +16,8 @@ package third
 
 import (
 	"errors"
+	"net/url"
+
 	"github.com/openimsdk/protocol/constant"
 )
 
@@ -76,3 +78,36 @@ func (x *SpeechToTextReq) Check() error {
 	}
 	return nil
 }
+
+func (x *WebPushSubscribeReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("UserID is empty")
+	}
+	if x.PlatformID != constant.WebPlatformID && x.PlatformID != constant.MiniWebPlatformID {
+		return errors.New("platformID is invalidate")
+	}
+	if x.Subscription == nil {
+		return errors.New("subscription is empty")
+	}
+	u, err := url.Parse(x.Subscription.Endpoint)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return errors.New("endpoint is invalid")
+	}
+	if x.Subscription.P256Dh == "" {
+		return errors.New("p256dh is empty")
+	}
+	if x.Subscription.Auth == "" {
+		return errors.New("auth is empty")
+	}
+	return nil
+}
+
+func (x *WebPushUnsubscribeReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("UserID is empty")
+	}
+	if x.PlatformID != constant.WebPlatformID && x.PlatformID != constant.MiniWebPlatformID {
+		return errors.New("platformID is invalidate")
+	}
+	return nil
+}
@@ -1548,6 +1548,452 @@ func (*SetAppBadgeResp) Descriptor() ([]byte, []int) {
 	return file_third_third_proto_rawDescGZIP(), []int{25}
 }
 
+// WebPushSubscription 浏览器 PushSubscription.toJSON() 的内容
+type WebPushSubscription struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint       string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint"`
+	P256Dh         string                 `protobuf:"bytes,2,opt,name=p256dh,proto3" json:"p256dh"`                  // 浏览器公钥，base64url
+	Auth           string                 `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth"`                      // 认证密钥，base64url
+	ExpirationTime int64                  `protobuf:"varint,4,opt,name=expirationTime,proto3" json:"expirationTime"` // 订阅过期时间（毫秒），0 表示不过期
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebPushSubscription) Reset() {
+	*x = WebPushSubscription{}
+	mi := &file_third_third_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebPushSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebPushSubscription) ProtoMessage() {}
+
+func (x *WebPushSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_third_third_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebPushSubscription.ProtoReflect.Descriptor instead.
+func (*WebPushSubscription) Descriptor() ([]byte, []int) {
+	return file_third_third_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WebPushSubscription) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WebPushSubscription) GetP256Dh() string {
+	if x != nil {
+		return x.P256Dh
+	}
+	return ""
+}
+
+func (x *WebPushSubscription) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
+func (x *WebPushSubscription) GetExpirationTime() int64 {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return 0
+}
+
+type WebPushSubscribeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	PlatformID    int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID"` // 仅支持 Web/MiniWeb
+	Subscription  *WebPushSubscription   `protobuf:"bytes,3,opt,name=subscription,proto3" json:"subscription"`
+	VapidKeyID    string                 `protobuf:"bytes,4,opt,name=vapidKeyID,proto3" json:"vapidKeyID"` // 订阅时使用的 VAPID 公钥ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebPushSubscribeReq) Reset() {
+	*x = WebPushSubscribeReq{}
+	mi := &file_third_third_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebPushSubscribeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebPushSubscribeReq) ProtoMessage() {}
+
+func (x *WebPushSubscribeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_third_third_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebPushSubscribeReq.ProtoReflect.Descriptor instead.
+func (*WebPushSubscribeReq) Descriptor() ([]byte, []int) {
+	return file_third_third_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WebPushSubscribeReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *WebPushSubscribeReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *WebPushSubscribeReq) GetSubscription() *WebPushSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *WebPushSubscribeReq) GetVapidKeyID() string {
+	if x != nil {
+		return x.VapidKeyID
+	}
+	return ""
+}
+
+type WebPushSubscribeResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebPushSubscribeResp) Reset() {
+	*x = WebPushSubscribeResp{}
+	mi := &file_third_third_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebPushSubscribeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebPushSubscribeResp) ProtoMessage() {}
+
+func (x *WebPushSubscribeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_third_third_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebPushSubscribeResp.ProtoReflect.Descriptor instead.
+func (*WebPushSubscribeResp) Descriptor() ([]byte, []int) {
+	return file_third_third_proto_rawDescGZIP(), []int{28}
+}
+
+type WebPushUnsubscribeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	PlatformID    int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID"`
+	Endpoint      string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint"` // 为空时删除该平台的全部订阅
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebPushUnsubscribeReq) Reset() {
+	*x = WebPushUnsubscribeReq{}
+	mi := &file_third_third_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebPushUnsubscribeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebPushUnsubscribeReq) ProtoMessage() {}
+
+func (x *WebPushUnsubscribeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_third_third_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebPushUnsubscribeReq.ProtoReflect.Descriptor instead.
+func (*WebPushUnsubscribeReq) Descriptor() ([]byte, []int) {
+	return file_third_third_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WebPushUnsubscribeReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *WebPushUnsubscribeReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *WebPushUnsubscribeReq) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type WebPushUnsubscribeResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebPushUnsubscribeResp) Reset() {
+	*x = WebPushUnsubscribeResp{}
+	mi := &file_third_third_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebPushUnsubscribeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebPushUnsubscribeResp) ProtoMessage() {}
+
+func (x *WebPushUnsubscribeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_third_third_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebPushUnsubscribeResp.ProtoReflect.Descriptor instead.
+func (*WebPushUnsubscribeResp) Descriptor() ([]byte, []int) {
+	return file_third_third_proto_rawDescGZIP(), []int{30}
+}
+
+type GetVapidPublicKeyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVapidPublicKeyReq) Reset() {
+	*x = GetVapidPublicKeyReq{}
+	mi := &file_third_third_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVapidPublicKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVapidPublicKeyReq) ProtoMessage() {}
+
+func (x *GetVapidPublicKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_third_third_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVapidPublicKeyReq.ProtoReflect.Descriptor instead.
+func (*GetVapidPublicKeyReq) Descriptor() ([]byte, []int) {
+	return file_third_third_proto_rawDescGZIP(), []int{31}
+}
+
+type GetVapidPublicKeyResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyID         string                 `protobuf:"bytes,1,opt,name=keyID,proto3" json:"keyID"`
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey"` // 未压缩的 P-256 公钥，base64url，作为 applicationServerKey
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVapidPublicKeyResp) Reset() {
+	*x = GetVapidPublicKeyResp{}
+	mi := &file_third_third_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVapidPublicKeyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVapidPublicKeyResp) ProtoMessage() {}
+
+func (x *GetVapidPublicKeyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_third_third_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVapidPublicKeyResp.ProtoReflect.Descriptor instead.
+func (*GetVapidPublicKeyResp) Descriptor() ([]byte, []int) {
+	return file_third_third_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetVapidPublicKeyResp) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *GetVapidPublicKeyResp) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+// RotateVapidKeyReq 生成新的 VAPID 密钥，旧密钥保留用于已有订阅，直到客户端重新订阅
+type RotateVapidKeyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateVapidKeyReq) Reset() {
+	*x = RotateVapidKeyReq{}
+	mi := &file_third_third_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateVapidKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateVapidKeyReq) ProtoMessage() {}
+
+func (x *RotateVapidKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_third_third_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateVapidKeyReq.ProtoReflect.Descriptor instead.
+func (*RotateVapidKeyReq) Descriptor() ([]byte, []int) {
+	return file_third_third_proto_rawDescGZIP(), []int{33}
+}
+
+type RotateVapidKeyResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyID         string                 `protobuf:"bytes,1,opt,name=keyID,proto3" json:"keyID"`
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateVapidKeyResp) Reset() {
+	*x = RotateVapidKeyResp{}
+	mi := &file_third_third_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateVapidKeyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateVapidKeyResp) ProtoMessage() {}
+
+func (x *RotateVapidKeyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_third_third_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateVapidKeyResp.ProtoReflect.Descriptor instead.
+func (*RotateVapidKeyResp) Descriptor() ([]byte, []int) {
+	return file_third_third_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RotateVapidKeyResp) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *RotateVapidKeyResp) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
 type FileURL struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename"`
@@ -1558,7 +2004,7 @@ type FileURL struct {
 
 func (x *FileURL) Reset() {
 	*x = FileURL{}
-	mi := &file_third_third_proto_msgTypes[26]
+	mi := &file_third_third_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1570,7 +2016,7 @@ func (x *FileURL) String() string {
 func (*FileURL) ProtoMessage() {}
 
 func (x *FileURL) ProtoReflect() protoreflect.Message {
-	mi := &file_third_third_proto_msgTypes[26]
+	mi := &file_third_third_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,7 +2029,7 @@ func (x *FileURL) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileURL.ProtoReflect.Descriptor instead.
 func (*FileURL) Descriptor() ([]byte, []int) {
-	return file_third_third_proto_rawDescGZIP(), []int{26}
+	return file_third_third_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *FileURL) GetFilename() string {
@@ -1613,7 +2059,7 @@ type UploadLogsReq struct {
 
 func (x *UploadLogsReq) Reset() {
 	*x = UploadLogsReq{}
-	mi := &file_third_third_proto_msgTypes[27]
+	mi := &file_third_third_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1625,7 +2071,7 @@ func (x *UploadLogsReq) String() string {
 func (*UploadLogsReq) ProtoMessage() {}
 
 func (x *UploadLogsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_third_third_proto_msgTypes[27]
+	mi := &file_third_third_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,7 +2084,7 @@ func (x *UploadLogsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadLogsReq.ProtoReflect.Descriptor instead.
 func (*UploadLogsReq) Descriptor() ([]byte, []int) {
-	return file_third_third_proto_rawDescGZIP(), []int{27}
+	return file_third_third_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *UploadLogsReq) GetPlatform() int32 {
@@ -1684,7 +2130,7 @@ type UploadLogsResp struct {
 
 func (x *UploadLogsResp) Reset() {
 	*x = UploadLogsResp{}
-	mi := &file_third_third_proto_msgTypes[28]
+	mi := &file_third_third_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1696,7 +2142,7 @@ func (x *UploadLogsResp) String() string {
 func (*UploadLogsResp) ProtoMessage() {}
 
 func (x *UploadLogsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_third_third_proto_msgTypes[28]
+	mi := &file_third_third_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1709,7 +2155,7 @@ func (x *UploadLogsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadLogsResp.ProtoReflect.Descriptor instead.
 func (*UploadLogsResp) Descriptor() ([]byte, []int) {
-	return file_third_third_proto_rawDescGZIP(), []int{28}
+	return file_third_third_proto_rawDescGZIP(), []int{37}
 }
 
 type DeleteLogsReq struct {
@@ -1721,7 +2167,7 @@ type DeleteLogsReq struct {
 
 func (x *DeleteLogsReq) Reset() {
 	*x = DeleteLogsReq{}
-	mi := &file_third_third_proto_msgTypes[29]
+	mi := &file_third_third_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1733,7 +2179,7 @@ func (x *DeleteLogsReq) String() string {
 func (*DeleteLogsReq) ProtoMessage() {}
 
 func (x *DeleteLogsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_third_third_proto_msgTypes[29]
+	mi := &file_third_third_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1746,7 +2192,7 @@ func (x *DeleteLogsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLogsReq.ProtoReflect.Descriptor instead.
 func (*DeleteLogsReq) Descriptor() ([]byte, []int) {
-	return file_third_third_proto_rawDescGZIP(), []int{29}
+	return file_third_third_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *DeleteLogsReq) GetLogIDs() []string {
@@ -1764,7 +2210,7 @@ type DeleteLogsResp struct {
 
 func (x *DeleteLogsResp) Reset() {
 	*x = DeleteLogsResp{}
-	mi := &file_third_third_proto_msgTypes[30]
+	mi := &file_third_third_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1776,7 +2222,7 @@ func (x *DeleteLogsResp) String() string {
 func (*DeleteLogsResp) ProtoMessage() {}
 
 func (x *DeleteLogsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_third_third_proto_msgTypes[30]
+	mi := &file_third_third_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1789,7 +2235,7 @@ func (x *DeleteLogsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLogsResp.ProtoReflect.Descriptor instead.
 func (*DeleteLogsResp) Descriptor() ([]byte, []int) {
-	return file_third_third_proto_rawDescGZIP(), []int{30}
+	return file_third_third_proto_rawDescGZIP(), []int{39}
 }
 
 type SearchLogsReq struct {
@@ -1804,7 +2250,7 @@ type SearchLogsReq struct {
 
 func (x *SearchLogsReq) Reset() {
 	*x = SearchLogsReq{}
-	mi := &file_third_third_proto_msgTypes[31]
+	mi := &file_third_third_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1816,7 +2262,7 @@ func (x *SearchLogsReq) String() string {
 func (*SearchLogsReq) ProtoMessage() {}
 
 func (x *SearchLogsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_third_third_proto_msgTypes[31]
+	mi := &file_third_third_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1829,7 +2275,7 @@ func (x *SearchLogsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchLogsReq.ProtoReflect.Descriptor instead.
 func (*SearchLogsReq) Descriptor() ([]byte, []int) {
-	return file_third_third_proto_rawDescGZIP(), []int{31}
+	return file_third_third_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *SearchLogsReq) GetKeyword() string {
@@ -1878,7 +2324,7 @@ type LogInfo struct {
 
 func (x *LogInfo) Reset() {
 	*x = LogInfo{}
-	mi := &file_third_third_proto_msgTypes[32]
+	mi := &file_third_third_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1890,7 +2336,7 @@ func (x *LogInfo) String() string {
 func (*LogInfo) ProtoMessage() {}
 
 func (x *LogInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_third_third_proto_msgTypes[32]
+	mi := &file_third_third_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1903,7 +2349,7 @@ func (x *LogInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogInfo.ProtoReflect.Descriptor instead.
 func (*LogInfo) Descriptor() ([]byte, []int) {
-	return file_third_third_proto_rawDescGZIP(), []int{32}
+	return file_third_third_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *LogInfo) GetUserID() string {
@@ -1986,7 +2432,7 @@ type SearchLogsResp struct {
 
 func (x *SearchLogsResp) Reset() {
 	*x = SearchLogsResp{}
-	mi := &file_third_third_proto_msgTypes[33]
+	mi := &file_third_third_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1998,7 +2444,7 @@ func (x *SearchLogsResp) String() string {
 func (*SearchLogsResp) ProtoMessage() {}
 
 func (x *SearchLogsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_third_third_proto_msgTypes[33]
+	mi := &file_third_third_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2011,7 +2457,7 @@ func (x *SearchLogsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchLogsResp.ProtoReflect.Descriptor instead.
 func (*SearchLogsResp) Descriptor() ([]byte, []int) {
-	return file_third_third_proto_rawDescGZIP(), []int{33}
+	return file_third_third_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SearchLogsResp) GetLogsInfos() []*LogInfo {
@@ -2038,7 +2484,7 @@ type SpeechToTextReq struct {
 
 func (x *SpeechToTextReq) Reset() {
 	*x = SpeechToTextReq{}
-	mi := &file_third_third_proto_msgTypes[34]
+	mi := &file_third_third_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2050,7 +2496,7 @@ func (x *SpeechToTextReq) String() string {
 func (*SpeechToTextReq) ProtoMessage() {}
 
 func (x *SpeechToTextReq) ProtoReflect() protoreflect.Message {
-	mi := &file_third_third_proto_msgTypes[34]
+	mi := &file_third_third_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2063,7 +2509,7 @@ func (x *SpeechToTextReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpeechToTextReq.ProtoReflect.Descriptor instead.
 func (*SpeechToTextReq) Descriptor() ([]byte, []int) {
-	return file_third_third_proto_rawDescGZIP(), []int{34}
+	return file_third_third_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *SpeechToTextReq) GetAudioURL() string {
@@ -2089,7 +2535,7 @@ type SpeechToTextResp struct {
 
 func (x *SpeechToTextResp) Reset() {
 	*x = SpeechToTextResp{}
-	mi := &file_third_third_proto_msgTypes[35]
+	mi := &file_third_third_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2101,7 +2547,7 @@ func (x *SpeechToTextResp) String() string {
 func (*SpeechToTextResp) ProtoMessage() {}
 
 func (x *SpeechToTextResp) ProtoReflect() protoreflect.Message {
-	mi := &file_third_third_proto_msgTypes[35]
+	mi := &file_third_third_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2114,7 +2560,7 @@ func (x *SpeechToTextResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpeechToTextResp.ProtoReflect.Descriptor instead.
 func (*SpeechToTextResp) Descriptor() ([]byte, []int) {
-	return file_third_third_proto_rawDescGZIP(), []int{35}
+	return file_third_third_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *SpeechToTextResp) GetRecognizedText() string {
@@ -2248,7 +2694,37 @@ const file_third_third_proto_rawDesc = "" +
 	"\x0eSetAppBadgeReq\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12&\n" +
 	"\x0eappUnreadCount\x18\x02 \x01(\x05R\x0eappUnreadCount\"\x11\n" +
-	"\x0fSetAppBadgeResp\"7\n" +
+	"\x0fSetAppBadgeResp\"\x85\x01\n" +
+	"\x13WebPushSubscription\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06p256dh\x18\x02 \x01(\tR\x06p256dh\x12\x12\n" +
+	"\x04auth\x18\x03 \x01(\tR\x04auth\x12&\n" +
+	"\x0eexpirationTime\x18\x04 \x01(\x03R\x0eexpirationTime\"\xb4\x01\n" +
+	"\x13WebPushSubscribeReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x02 \x01(\x05R\n" +
+	"platformID\x12E\n" +
+	"\fsubscription\x18\x03 \x01(\v2!.openim.third.WebPushSubscriptionR\fsubscription\x12\x1e\n" +
+	"\n" +
+	"vapidKeyID\x18\x04 \x01(\tR\n" +
+	"vapidKeyID\"\x16\n" +
+	"\x14WebPushSubscribeResp\"k\n" +
+	"\x15WebPushUnsubscribeReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x02 \x01(\x05R\n" +
+	"platformID\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\"\x18\n" +
+	"\x16WebPushUnsubscribeResp\"\x16\n" +
+	"\x14GetVapidPublicKeyReq\"K\n" +
+	"\x15GetVapidPublicKeyResp\x12\x14\n" +
+	"\x05keyID\x18\x01 \x01(\tR\x05keyID\x12\x1c\n" +
+	"\tpublicKey\x18\x02 \x01(\tR\tpublicKey\"\x13\n" +
+	"\x11RotateVapidKeyReq\"H\n" +
+	"\x12RotateVapidKeyResp\x12\x14\n" +
+	"\x05keyID\x18\x01 \x01(\tR\x05keyID\x12\x1c\n" +
+	"\tpublicKey\x18\x02 \x01(\tR\tpublicKey\"7\n" +
 	"\afileURL\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x10\n" +
 	"\x03URL\x18\x02 \x01(\tR\x03URL\"\xac\x01\n" +
@@ -2292,7 +2768,7 @@ const file_third_third_proto_rawDesc = "" +
 	"\baudioURL\x18\x01 \x01(\tR\baudioURL\x12\x1a\n" +
 	"\blanguage\x18\x02 \x01(\tR\blanguage\":\n" +
 	"\x10SpeechToTextResp\x12&\n" +
-	"\x0erecognizedText\x18\x01 \x01(\tR\x0erecognizedText2\xdb\t\n" +
+	"\x0erecognizedText\x18\x01 \x01(\tR\x0erecognizedText2\xca\f\n" +
 	"\x05third\x12D\n" +
 	"\tPartLimit\x12\x1a.openim.third.PartLimitReq\x1a\x1b.openim.third.PartLimitResp\x12A\n" +
 	"\bPartSize\x12\x19.openim.third.PartSizeReq\x1a\x1a.openim.third.PartSizeResp\x12n\n" +
@@ -2304,7 +2780,11 @@ const file_third_third_proto_rawDesc = "" +
 	"\x10CompleteFormData\x12!.openim.third.CompleteFormDataReq\x1a\".openim.third.CompleteFormDataResp\x12_\n" +
 	"\x12DeleteOutdatedData\x12#.openim.third.DeleteOutdatedDataReq\x1a$.openim.third.DeleteOutdatedDataResp\x12S\n" +
 	"\x0eFcmUpdateToken\x12\x1f.openim.third.FcmUpdateTokenReq\x1a .openim.third.FcmUpdateTokenResp\x12J\n" +
-	"\vSetAppBadge\x12\x1c.openim.third.SetAppBadgeReq\x1a\x1d.openim.third.SetAppBadgeResp\x12G\n" +
+	"\vSetAppBadge\x12\x1c.openim.third.SetAppBadgeReq\x1a\x1d.openim.third.SetAppBadgeResp\x12Y\n" +
+	"\x10WebPushSubscribe\x12!.openim.third.WebPushSubscribeReq\x1a\".openim.third.WebPushSubscribeResp\x12_\n" +
+	"\x12WebPushUnsubscribe\x12#.openim.third.WebPushUnsubscribeReq\x1a$.openim.third.WebPushUnsubscribeResp\x12\\\n" +
+	"\x11GetVapidPublicKey\x12\".openim.third.GetVapidPublicKeyReq\x1a#.openim.third.GetVapidPublicKeyResp\x12S\n" +
+	"\x0eRotateVapidKey\x12\x1f.openim.third.RotateVapidKeyReq\x1a .openim.third.RotateVapidKeyResp\x12G\n" +
 	"\n" +
 	"UploadLogs\x12\x1b.openim.third.UploadLogsReq\x1a\x1c.openim.third.UploadLogsResp\x12G\n" +
 	"\n" +
@@ -2325,7 +2805,7 @@ func file_third_third_proto_rawDescGZIP() []byte {
 	return file_third_third_proto_rawDescData
 }
 
-var file_third_third_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_third_third_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_third_third_proto_goTypes = []any{
 	(*KeyValues)(nil),                   // 0: openim.third.KeyValues
 	(*SignPart)(nil),                    // 1: openim.third.SignPart
@@ -2353,19 +2833,28 @@ var file_third_third_proto_goTypes = []any{
 	(*FcmUpdateTokenResp)(nil),          // 23: openim.third.FcmUpdateTokenResp
 	(*SetAppBadgeReq)(nil),              // 24: openim.third.SetAppBadgeReq
 	(*SetAppBadgeResp)(nil),             // 25: openim.third.SetAppBadgeResp
-	(*FileURL)(nil),                     // 26: openim.third.fileURL
-	(*UploadLogsReq)(nil),               // 27: openim.third.UploadLogsReq
-	(*UploadLogsResp)(nil),              // 28: openim.third.UploadLogsResp
-	(*DeleteLogsReq)(nil),               // 29: openim.third.DeleteLogsReq
-	(*DeleteLogsResp)(nil),              // 30: openim.third.DeleteLogsResp
-	(*SearchLogsReq)(nil),               // 31: openim.third.SearchLogsReq
-	(*LogInfo)(nil),                     // 32: openim.third.LogInfo
-	(*SearchLogsResp)(nil),              // 33: openim.third.SearchLogsResp
-	(*SpeechToTextReq)(nil),             // 34: openim.third.SpeechToTextReq
-	(*SpeechToTextResp)(nil),            // 35: openim.third.SpeechToTextResp
-	nil,                                 // 36: openim.third.AccessURLReq.QueryEntry
-	nil,                                 // 37: openim.third.InitiateFormDataResp.FormDataEntry
-	(*sdkws.RequestPagination)(nil),     // 38: openim.sdkws.RequestPagination
+	(*WebPushSubscription)(nil),         // 26: openim.third.WebPushSubscription
+	(*WebPushSubscribeReq)(nil),         // 27: openim.third.WebPushSubscribeReq
+	(*WebPushSubscribeResp)(nil),        // 28: openim.third.WebPushSubscribeResp
+	(*WebPushUnsubscribeReq)(nil),       // 29: openim.third.WebPushUnsubscribeReq
+	(*WebPushUnsubscribeResp)(nil),      // 30: openim.third.WebPushUnsubscribeResp
+	(*GetVapidPublicKeyReq)(nil),        // 31: openim.third.GetVapidPublicKeyReq
+	(*GetVapidPublicKeyResp)(nil),       // 32: openim.third.GetVapidPublicKeyResp
+	(*RotateVapidKeyReq)(nil),           // 33: openim.third.RotateVapidKeyReq
+	(*RotateVapidKeyResp)(nil),          // 34: openim.third.RotateVapidKeyResp
+	(*FileURL)(nil),                     // 35: openim.third.fileURL
+	(*UploadLogsReq)(nil),               // 36: openim.third.UploadLogsReq
+	(*UploadLogsResp)(nil),              // 37: openim.third.UploadLogsResp
+	(*DeleteLogsReq)(nil),               // 38: openim.third.DeleteLogsReq
+	(*DeleteLogsResp)(nil),              // 39: openim.third.DeleteLogsResp
+	(*SearchLogsReq)(nil),               // 40: openim.third.SearchLogsReq
+	(*LogInfo)(nil),                     // 41: openim.third.LogInfo
+	(*SearchLogsResp)(nil),              // 42: openim.third.SearchLogsResp
+	(*SpeechToTextReq)(nil),             // 43: openim.third.SpeechToTextReq
+	(*SpeechToTextResp)(nil),            // 44: openim.third.SpeechToTextResp
+	nil,                                 // 45: openim.third.AccessURLReq.QueryEntry
+	nil,                                 // 46: openim.third.InitiateFormDataResp.FormDataEntry
+	(*sdkws.RequestPagination)(nil),     // 47: openim.sdkws.RequestPagination
 }
 var file_third_third_proto_depIdxs = []int32{
 	0,  // 0: openim.third.SignPart.query:type_name -> openim.third.KeyValues
@@ -2378,47 +2867,56 @@ var file_third_third_proto_depIdxs = []int32{
 	0,  // 7: openim.third.AuthSignResp.query:type_name -> openim.third.KeyValues
 	0,  // 8: openim.third.AuthSignResp.header:type_name -> openim.third.KeyValues
 	1,  // 9: openim.third.AuthSignResp.parts:type_name -> openim.third.SignPart
-	36, // 10: openim.third.AccessURLReq.query:type_name -> openim.third.AccessURLReq.QueryEntry
+	45, // 10: openim.third.AccessURLReq.query:type_name -> openim.third.AccessURLReq.QueryEntry
 	0,  // 11: openim.third.InitiateFormDataResp.header:type_name -> openim.third.KeyValues
-	37, // 12: openim.third.InitiateFormDataResp.formData:type_name -> openim.third.InitiateFormDataResp.FormDataEntry
-	26, // 13: openim.third.UploadLogsReq.fileURLs:type_name -> openim.third.fileURL
-	38, // 14: openim.third.SearchLogsReq.pagination:type_name -> openim.sdkws.RequestPagination
-	32, // 15: openim.third.SearchLogsResp.logsInfos:type_name -> openim.third.LogInfo
-	3,  // 16: openim.third.third.PartLimit:input_type -> openim.third.PartLimitReq
-	5,  // 17: openim.third.third.PartSize:input_type -> openim.third.PartSizeReq
-	7,  // 18: openim.third.third.InitiateMultipartUpload:input_type -> openim.third.InitiateMultipartUploadReq
-	10, // 19: openim.third.third.AuthSign:input_type -> openim.third.AuthSignReq
-	12, // 20: openim.third.third.CompleteMultipartUpload:input_type -> openim.third.CompleteMultipartUploadReq
-	14, // 21: openim.third.third.AccessURL:input_type -> openim.third.AccessURLReq
-	16, // 22: openim.third.third.InitiateFormData:input_type -> openim.third.InitiateFormDataReq
-	18, // 23: openim.third.third.CompleteFormData:input_type -> openim.third.CompleteFormDataReq
-	20, // 24: openim.third.third.DeleteOutdatedData:input_type -> openim.third.DeleteOutdatedDataReq
-	22, // 25: openim.third.third.FcmUpdateToken:input_type -> openim.third.FcmUpdateTokenReq
-	24, // 26: openim.third.third.SetAppBadge:input_type -> openim.third.SetAppBadgeReq
-	27, // 27: openim.third.third.UploadLogs:input_type -> openim.third.UploadLogsReq
-	29, // 28: openim.third.third.DeleteLogs:input_type -> openim.third.DeleteLogsReq
-	31, // 29: openim.third.third.SearchLogs:input_type -> openim.third.SearchLogsReq
-	34, // 30: openim.third.third.SpeechToText:input_type -> openim.third.SpeechToTextReq
-	4,  // 31: openim.third.third.PartLimit:output_type -> openim.third.PartLimitResp
-	6,  // 32: openim.third.third.PartSize:output_type -> openim.third.PartSizeResp
-	9,  // 33: openim.third.third.InitiateMultipartUpload:output_type -> openim.third.InitiateMultipartUploadResp
-	11, // 34: openim.third.third.AuthSign:output_type -> openim.third.AuthSignResp
-	13, // 35: openim.third.third.CompleteMultipartUpload:output_type -> openim.third.CompleteMultipartUploadResp
-	15, // 36: openim.third.third.AccessURL:output_type -> openim.third.AccessURLResp
-	17, // 37: openim.third.third.InitiateFormData:output_type -> openim.third.InitiateFormDataResp
-	19, // 38: openim.third.third.CompleteFormData:output_type -> openim.third.CompleteFormDataResp
-	21, // 39: openim.third.third.DeleteOutdatedData:output_type -> openim.third.DeleteOutdatedDataResp
-	23, // 40: openim.third.third.FcmUpdateToken:output_type -> openim.third.FcmUpdateTokenResp
-	25, // 41: openim.third.third.SetAppBadge:output_type -> openim.third.SetAppBadgeResp
-	28, // 42: openim.third.third.UploadLogs:output_type -> openim.third.UploadLogsResp
-	30, // 43: openim.third.third.DeleteLogs:output_type -> openim.third.DeleteLogsResp
-	33, // 44: openim.third.third.SearchLogs:output_type -> openim.third.SearchLogsResp
-	35, // 45: openim.third.third.SpeechToText:output_type -> openim.third.SpeechToTextResp
-	31, // [31:46] is the sub-list for method output_type
-	16, // [16:31] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	46, // 12: openim.third.InitiateFormDataResp.formData:type_name -> openim.third.InitiateFormDataResp.FormDataEntry
+	26, // 13: openim.third.WebPushSubscribeReq.subscription:type_name -> openim.third.WebPushSubscription
+	35, // 14: openim.third.UploadLogsReq.fileURLs:type_name -> openim.third.fileURL
+	47, // 15: openim.third.SearchLogsReq.pagination:type_name -> openim.sdkws.RequestPagination
+	41, // 16: openim.third.SearchLogsResp.logsInfos:type_name -> openim.third.LogInfo
+	3,  // 17: openim.third.third.PartLimit:input_type -> openim.third.PartLimitReq
+	5,  // 18: openim.third.third.PartSize:input_type -> openim.third.PartSizeReq
+	7,  // 19: openim.third.third.InitiateMultipartUpload:input_type -> openim.third.InitiateMultipartUploadReq
+	10, // 20: openim.third.third.AuthSign:input_type -> openim.third.AuthSignReq
+	12, // 21: openim.third.third.CompleteMultipartUpload:input_type -> openim.third.CompleteMultipartUploadReq
+	14, // 22: openim.third.third.AccessURL:input_type -> openim.third.AccessURLReq
+	16, // 23: openim.third.third.InitiateFormData:input_type -> openim.third.InitiateFormDataReq
+	18, // 24: openim.third.third.CompleteFormData:input_type -> openim.third.CompleteFormDataReq
+	20, // 25: openim.third.third.DeleteOutdatedData:input_type -> openim.third.DeleteOutdatedDataReq
+	22, // 26: openim.third.third.FcmUpdateToken:input_type -> openim.third.FcmUpdateTokenReq
+	24, // 27: openim.third.third.SetAppBadge:input_type -> openim.third.SetAppBadgeReq
+	27, // 28: openim.third.third.WebPushSubscribe:input_type -> openim.third.WebPushSubscribeReq
+	29, // 29: openim.third.third.WebPushUnsubscribe:input_type -> openim.third.WebPushUnsubscribeReq
+	31, // 30: openim.third.third.GetVapidPublicKey:input_type -> openim.third.GetVapidPublicKeyReq
+	33, // 31: openim.third.third.RotateVapidKey:input_type -> openim.third.RotateVapidKeyReq
+	36, // 32: openim.third.third.UploadLogs:input_type -> openim.third.UploadLogsReq
+	38, // 33: openim.third.third.DeleteLogs:input_type -> openim.third.DeleteLogsReq
+	40, // 34: openim.third.third.SearchLogs:input_type -> openim.third.SearchLogsReq
+	43, // 35: openim.third.third.SpeechToText:input_type -> openim.third.SpeechToTextReq
+	4,  // 36: openim.third.third.PartLimit:output_type -> openim.third.PartLimitResp
+	6,  // 37: openim.third.third.PartSize:output_type -> openim.third.PartSizeResp
+	9,  // 38: openim.third.third.InitiateMultipartUpload:output_type -> openim.third.InitiateMultipartUploadResp
+	11, // 39: openim.third.third.AuthSign:output_type -> openim.third.AuthSignResp
+	13, // 40: openim.third.third.CompleteMultipartUpload:output_type -> openim.third.CompleteMultipartUploadResp
+	15, // 41: openim.third.third.AccessURL:output_type -> openim.third.AccessURLResp
+	17, // 42: openim.third.third.InitiateFormData:output_type -> openim.third.InitiateFormDataResp
+	19, // 43: openim.third.third.CompleteFormData:output_type -> openim.third.CompleteFormDataResp
+	21, // 44: openim.third.third.DeleteOutdatedData:output_type -> openim.third.DeleteOutdatedDataResp
+	23, // 45: openim.third.third.FcmUpdateToken:output_type -> openim.third.FcmUpdateTokenResp
+	25, // 46: openim.third.third.SetAppBadge:output_type -> openim.third.SetAppBadgeResp
+	28, // 47: openim.third.third.WebPushSubscribe:output_type -> openim.third.WebPushSubscribeResp
+	30, // 48: openim.third.third.WebPushUnsubscribe:output_type -> openim.third.WebPushUnsubscribeResp
+	32, // 49: openim.third.third.GetVapidPublicKey:output_type -> openim.third.GetVapidPublicKeyResp
+	34, // 50: openim.third.third.RotateVapidKey:output_type -> openim.third.RotateVapidKeyResp
+	37, // 51: openim.third.third.UploadLogs:output_type -> openim.third.UploadLogsResp
+	39, // 52: openim.third.third.DeleteLogs:output_type -> openim.third.DeleteLogsResp
+	42, // 53: openim.third.third.SearchLogs:output_type -> openim.third.SearchLogsResp
+	44, // 54: openim.third.third.SpeechToText:output_type -> openim.third.SpeechToTextResp
+	36, // [36:55] is the sub-list for method output_type
+	17, // [17:36] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_third_third_proto_init() }
@@ -2432,7 +2930,7 @@ func file_third_third_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_third_third_proto_rawDesc), len(file_third_third_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   38,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
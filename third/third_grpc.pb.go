@@ -44,6 +44,10 @@ const (
 	Third_DeleteOutdatedData_FullMethodName      = "/openim.third.third/DeleteOutdatedData"
 	Third_FcmUpdateToken_FullMethodName          = "/openim.third.third/FcmUpdateToken"
 	Third_SetAppBadge_FullMethodName             = "/openim.third.third/SetAppBadge"
+	Third_WebPushSubscribe_FullMethodName        = "/openim.third.third/WebPushSubscribe"
+	Third_WebPushUnsubscribe_FullMethodName      = "/openim.third.third/WebPushUnsubscribe"
+	Third_GetVapidPublicKey_FullMethodName       = "/openim.third.third/GetVapidPublicKey"
+	Third_RotateVapidKey_FullMethodName          = "/openim.third.third/RotateVapidKey"
 	Third_UploadLogs_FullMethodName              = "/openim.third.third/UploadLogs"
 	Third_DeleteLogs_FullMethodName              = "/openim.third.third/DeleteLogs"
 	Third_SearchLogs_FullMethodName              = "/openim.third.third/SearchLogs"
@@ -65,6 +69,11 @@ type ThirdClient interface {
 	DeleteOutdatedData(ctx context.Context, in *DeleteOutdatedDataReq, opts ...grpc.CallOption) (*DeleteOutdatedDataResp, error)
 	FcmUpdateToken(ctx context.Context, in *FcmUpdateTokenReq, opts ...grpc.CallOption) (*FcmUpdateTokenResp, error)
 	SetAppBadge(ctx context.Context, in *SetAppBadgeReq, opts ...grpc.CallOption) (*SetAppBadgeResp, error)
+	// Web Push
+	WebPushSubscribe(ctx context.Context, in *WebPushSubscribeReq, opts ...grpc.CallOption) (*WebPushSubscribeResp, error)
+	WebPushUnsubscribe(ctx context.Context, in *WebPushUnsubscribeReq, opts ...grpc.CallOption) (*WebPushUnsubscribeResp, error)
+	GetVapidPublicKey(ctx context.Context, in *GetVapidPublicKeyReq, opts ...grpc.CallOption) (*GetVapidPublicKeyResp, error)
+	RotateVapidKey(ctx context.Context, in *RotateVapidKeyReq, opts ...grpc.CallOption) (*RotateVapidKeyResp, error)
 	// Logs
 	UploadLogs(ctx context.Context, in *UploadLogsReq, opts ...grpc.CallOption) (*UploadLogsResp, error)
 	DeleteLogs(ctx context.Context, in *DeleteLogsReq, opts ...grpc.CallOption) (*DeleteLogsResp, error)
@@ -191,6 +200,46 @@ func (c *thirdClient) SetAppBadge(ctx context.Context, in *SetAppBadgeReq, opts
 	return out, nil
 }
 
+func (c *thirdClient) WebPushSubscribe(ctx context.Context, in *WebPushSubscribeReq, opts ...grpc.CallOption) (*WebPushSubscribeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebPushSubscribeResp)
+	err := c.cc.Invoke(ctx, Third_WebPushSubscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdClient) WebPushUnsubscribe(ctx context.Context, in *WebPushUnsubscribeReq, opts ...grpc.CallOption) (*WebPushUnsubscribeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebPushUnsubscribeResp)
+	err := c.cc.Invoke(ctx, Third_WebPushUnsubscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdClient) GetVapidPublicKey(ctx context.Context, in *GetVapidPublicKeyReq, opts ...grpc.CallOption) (*GetVapidPublicKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVapidPublicKeyResp)
+	err := c.cc.Invoke(ctx, Third_GetVapidPublicKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thirdClient) RotateVapidKey(ctx context.Context, in *RotateVapidKeyReq, opts ...grpc.CallOption) (*RotateVapidKeyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateVapidKeyResp)
+	err := c.cc.Invoke(ctx, Third_RotateVapidKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *thirdClient) UploadLogs(ctx context.Context, in *UploadLogsReq, opts ...grpc.CallOption) (*UploadLogsResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UploadLogsResp)
@@ -246,6 +295,11 @@ type ThirdServer interface {
 	DeleteOutdatedData(context.Context, *DeleteOutdatedDataReq) (*DeleteOutdatedDataResp, error)
 	FcmUpdateToken(context.Context, *FcmUpdateTokenReq) (*FcmUpdateTokenResp, error)
 	SetAppBadge(context.Context, *SetAppBadgeReq) (*SetAppBadgeResp, error)
+	// Web Push
+	WebPushSubscribe(context.Context, *WebPushSubscribeReq) (*WebPushSubscribeResp, error)
+	WebPushUnsubscribe(context.Context, *WebPushUnsubscribeReq) (*WebPushUnsubscribeResp, error)
+	GetVapidPublicKey(context.Context, *GetVapidPublicKeyReq) (*GetVapidPublicKeyResp, error)
+	RotateVapidKey(context.Context, *RotateVapidKeyReq) (*RotateVapidKeyResp, error)
 	// Logs
 	UploadLogs(context.Context, *UploadLogsReq) (*UploadLogsResp, error)
 	DeleteLogs(context.Context, *DeleteLogsReq) (*DeleteLogsResp, error)
@@ -295,6 +349,18 @@ func (UnimplementedThirdServer) FcmUpdateToken(context.Context, *FcmUpdateTokenR
 func (UnimplementedThirdServer) SetAppBadge(context.Context, *SetAppBadgeReq) (*SetAppBadgeResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetAppBadge not implemented")
 }
+func (UnimplementedThirdServer) WebPushSubscribe(context.Context, *WebPushSubscribeReq) (*WebPushSubscribeResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method WebPushSubscribe not implemented")
+}
+func (UnimplementedThirdServer) WebPushUnsubscribe(context.Context, *WebPushUnsubscribeReq) (*WebPushUnsubscribeResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method WebPushUnsubscribe not implemented")
+}
+func (UnimplementedThirdServer) GetVapidPublicKey(context.Context, *GetVapidPublicKeyReq) (*GetVapidPublicKeyResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVapidPublicKey not implemented")
+}
+func (UnimplementedThirdServer) RotateVapidKey(context.Context, *RotateVapidKeyReq) (*RotateVapidKeyResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateVapidKey not implemented")
+}
 func (UnimplementedThirdServer) UploadLogs(context.Context, *UploadLogsReq) (*UploadLogsResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method UploadLogs not implemented")
 }
@@ -526,6 +592,78 @@ func _Third_SetAppBadge_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Third_WebPushSubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebPushSubscribeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdServer).WebPushSubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Third_WebPushSubscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdServer).WebPushSubscribe(ctx, req.(*WebPushSubscribeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Third_WebPushUnsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebPushUnsubscribeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdServer).WebPushUnsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Third_WebPushUnsubscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdServer).WebPushUnsubscribe(ctx, req.(*WebPushUnsubscribeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Third_GetVapidPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVapidPublicKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdServer).GetVapidPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Third_GetVapidPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdServer).GetVapidPublicKey(ctx, req.(*GetVapidPublicKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Third_RotateVapidKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateVapidKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThirdServer).RotateVapidKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Third_RotateVapidKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThirdServer).RotateVapidKey(ctx, req.(*RotateVapidKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Third_UploadLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UploadLogsReq)
 	if err := dec(in); err != nil {
@@ -649,6 +787,22 @@ var Third_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetAppBadge",
 			Handler:    _Third_SetAppBadge_Handler,
 		},
+		{
+			MethodName: "WebPushSubscribe",
+			Handler:    _Third_WebPushSubscribe_Handler,
+		},
+		{
+			MethodName: "WebPushUnsubscribe",
+			Handler:    _Third_WebPushUnsubscribe_Handler,
+		},
+		{
+			MethodName: "GetVapidPublicKey",
+			Handler:    _Third_GetVapidPublicKey_Handler,
+		},
+		{
+			MethodName: "RotateVapidKey",
+			Handler:    _Third_RotateVapidKey_Handler,
+		},
 		{
 			MethodName: "UploadLogs",
 			Handler:    _Third_UploadLogs_Handler,
@@ -15,11 +15,19 @@
 package user
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/openimsdk/protocol/constant"
 	"github.com/openimsdk/protocol/util/datautil"
+	"github.com/openimsdk/protocol/wrapperspb"
+	toolsdatautil "github.com/openimsdk/tools/utils/datautil"
 )
 
 func (x *GetAllUserIDReq) Check() error {
@@ -315,3 +323,530 @@ func (x *UpdateAvatarReq) Check() error {
 func (x *GetAvatarUploadQuotaReq) Check() error {
 	return nil
 }
+
+func checkPrivacyAudience(name string, audience int32) error {
+	if audience < constant.PrivacyAudienceEveryone || audience > constant.PrivacyAudienceNobody {
+		return fmt.Errorf("%s audience is invalid", name)
+	}
+	return nil
+}
+
+func (x *GetUserPrivacySettingsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *SetUserPrivacySettingsReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	items := []struct {
+		name  string
+		value *wrapperspb.Int32Value
+	}{
+		{"friendRequest", x.FriendRequest},
+		{"onlineStatus", x.OnlineStatus},
+		{"lastSeen", x.LastSeen},
+		{"signature", x.Signature},
+		{"avatar", x.Avatar},
+		{"discoverable", x.Discoverable},
+	}
+	for _, item := range items {
+		if item.value == nil {
+			continue
+		}
+		if err := checkPrivacyAudience(item.name, item.value.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *CheckUserPrivacyReq) Check() error {
+	if x.ViewerUserID == "" {
+		return errors.New("viewerUserID is empty")
+	}
+	if len(x.OwnerUserIDs) == 0 {
+		return errors.New("ownerUserIDs is empty")
+	}
+	if len(x.OwnerUserIDs) > constant.ParamMaxLength {
+		return errors.New("too many OwnerUserIDs, need to be less than 1000")
+	}
+	if x.Item < constant.PrivacyItemFriendRequest || x.Item > constant.PrivacyItemDiscoverable {
+		return errors.New("item is invalid")
+	}
+	return nil
+}
+
+// Audience returns the audience configured for the given constant.PrivacyItem*.
+func (x *UserPrivacySettings) Audience(item int32) int32 {
+	switch item {
+	case constant.PrivacyItemFriendRequest:
+		return x.GetFriendRequest()
+	case constant.PrivacyItemOnlineStatus:
+		return x.GetOnlineStatus()
+	case constant.PrivacyItemLastSeen:
+		return x.GetLastSeen()
+	case constant.PrivacyItemSignature:
+		return x.GetSignature()
+	case constant.PrivacyItemAvatar:
+		return x.GetAvatar()
+	case constant.PrivacyItemDiscoverable:
+		return x.GetDiscoverable()
+	default:
+		return constant.PrivacyAudienceNobody
+	}
+}
+
+func (x *SetUserPresenceReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if err := x.Presence.Check(); err != nil {
+		return err
+	}
+	if x.Presence.Expired(time.Now().UnixMilli()) {
+		return errors.New("presence clearTime has passed")
+	}
+	return nil
+}
+
+func (x *ClearUserPresenceReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *GetUserPresenceReq) Check() error {
+	if len(x.UserIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	if len(x.UserIDs) > constant.MaxUsersStatusList {
+		return errors.New("too many UserIDs, need to be less than 500")
+	}
+	return nil
+}
+
+func (x *ClearExpiredUserPresenceReq) Check() error {
+	if x.Timestamp > time.Now().UnixMilli() {
+		return errors.New("request millisecond timestamp error")
+	}
+	if x.Limit <= 0 {
+		return errors.New("request limit error")
+	}
+	return nil
+}
+
+func (x *SetUserActivityReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return x.Activity.Check()
+}
+
+func (x *ClearUserActivityReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Source == "" {
+		return errors.New("source is empty")
+	}
+	if x.SourceID == "" {
+		return errors.New("sourceID is empty")
+	}
+	return nil
+}
+
+func (x *GetUserActivitiesReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+// CheckClientConfigValue reports whether value is a valid encoding for valueType.
+func CheckClientConfigValue(valueType int32, value string) error {
+	switch valueType {
+	case constant.ClientConfigTypeString:
+		return nil
+	case constant.ClientConfigTypeBool:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("value %q is not a bool, must be true or false", value)
+		}
+	case constant.ClientConfigTypeInt:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("value %q is not an int", value)
+		}
+	case constant.ClientConfigTypeJSON:
+		if !json.Valid([]byte(value)) {
+			return errors.New("value is not valid json")
+		}
+	default:
+		return errors.New("valueType is invalid")
+	}
+	return nil
+}
+
+func (x *ClientConfigSchema) Check() error {
+	if x.Key == "" {
+		return errors.New("key is empty")
+	}
+	switch x.Scope {
+	case constant.ClientConfigScopeGlobal, constant.ClientConfigScopePlatform, constant.ClientConfigScopeDevice:
+	default:
+		return errors.New("scope is invalid")
+	}
+	return CheckClientConfigValue(x.ValueType, x.DefaultValue)
+}
+
+func (x *RegisterClientConfigSchemasReq) Check() error {
+	if len(x.Schemas) == 0 {
+		return errors.New("schemas is empty")
+	}
+	keys := make(map[string]struct{}, len(x.Schemas))
+	for _, schema := range x.Schemas {
+		if err := schema.Check(); err != nil {
+			return err
+		}
+		if _, ok := keys[schema.Key]; ok {
+			return errors.New("duplicate key")
+		}
+		keys[schema.Key] = struct{}{}
+	}
+	return nil
+}
+
+func (x *GetTypedClientConfigReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *SetTypedClientConfigReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Key == "" {
+		return errors.New("key is empty")
+	}
+	return nil
+}
+
+func (x *DelTypedClientConfigReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Key == "" {
+		return errors.New("key is empty")
+	}
+	return nil
+}
+
+func (x *GetIncrementalClientConfigReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func checkEmojiIDs(emojiIDs []string) error {
+	if len(emojiIDs) == 0 {
+		return errors.New("emojiIDs is empty")
+	}
+	if len(emojiIDs) > constant.EmojiPackMaxEmojiNum {
+		return fmt.Errorf("too many emojis, need to be less than %d", constant.EmojiPackMaxEmojiNum)
+	}
+	if toolsdatautil.Duplicate(emojiIDs) {
+		return errors.New("duplicate emojiID")
+	}
+	return nil
+}
+
+func checkEmojiPackName(name string) error {
+	if name == "" {
+		return errors.New("name is empty")
+	}
+	if utf8.RuneCountInString(name) > constant.EmojiPackNameMaxLength {
+		return fmt.Errorf("name too long, need to be less than %d", constant.EmojiPackNameMaxLength)
+	}
+	return nil
+}
+
+func (x *CreateEmojiPackReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if err := checkEmojiPackName(x.Name); err != nil {
+		return err
+	}
+	if len(x.EmojiIDs) > 0 {
+		return checkEmojiIDs(x.EmojiIDs)
+	}
+	return nil
+}
+
+func (x *UpdateEmojiPackReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.PackID == "" {
+		return errors.New("packID is empty")
+	}
+	if x.Name != nil {
+		return checkEmojiPackName(x.Name.Value)
+	}
+	return nil
+}
+
+func (x *DeleteEmojiPackReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.PackID == "" {
+		return errors.New("packID is empty")
+	}
+	return nil
+}
+
+func (x *SortEmojiPacksReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if len(x.PackIDs) == 0 {
+		return errors.New("packIDs is empty")
+	}
+	if toolsdatautil.Duplicate(x.PackIDs) {
+		return errors.New("duplicate packID")
+	}
+	return nil
+}
+
+func (x *AddEmojisToPackReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.PackID == "" {
+		return errors.New("packID is empty")
+	}
+	return checkEmojiIDs(x.EmojiIDs)
+}
+
+func (x *RemoveEmojisFromPackReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.PackID == "" {
+		return errors.New("packID is empty")
+	}
+	return checkEmojiIDs(x.EmojiIDs)
+}
+
+func (x *SortPackEmojisReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.PackID == "" {
+		return errors.New("packID is empty")
+	}
+	return checkEmojiIDs(x.EmojiIDs)
+}
+
+func (x *GetEmojiPacksReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	return nil
+}
+
+func (x *ShareEmojiPackReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.PackID == "" {
+		return errors.New("packID is empty")
+	}
+	if len(x.RecvUserIDs) == 0 && len(x.GroupIDs) == 0 {
+		return errors.New("recvUserIDs and groupIDs are empty")
+	}
+	if len(x.RecvUserIDs)+len(x.GroupIDs) > constant.ParamMaxLength {
+		return errors.New("too many receivers, need to be less than 1000")
+	}
+	return nil
+}
+
+func (x *SaveSharedEmojiPackReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ShareID == "" {
+		return errors.New("shareID is empty")
+	}
+	return nil
+}
+
+func (x *EmojiPackManifest) Check() error {
+	if x.Version < 1 || x.Version > constant.EmojiPackManifestVersion {
+		return errors.New("manifest version is not supported")
+	}
+	if err := checkEmojiPackName(x.Name); err != nil {
+		return err
+	}
+	if len(x.Items) == 0 {
+		return errors.New("items is empty")
+	}
+	if len(x.Items) > constant.EmojiPackMaxEmojiNum {
+		return fmt.Errorf("too many emojis, need to be less than %d", constant.EmojiPackMaxEmojiNum)
+	}
+	fileNames := make(map[string]struct{}, len(x.Items))
+	for _, item := range x.Items {
+		if item.FileName == "" {
+			return errors.New("fileName is empty")
+		}
+		if item.Url == "" {
+			return fmt.Errorf("url of %s is empty", item.FileName)
+		}
+		if _, ok := fileNames[item.FileName]; ok {
+			return fmt.Errorf("duplicate fileName %s", item.FileName)
+		}
+		fileNames[item.FileName] = struct{}{}
+	}
+	if x.CoverFileName != "" {
+		if _, ok := fileNames[x.CoverFileName]; !ok {
+			return errors.New("coverFileName not found in items")
+		}
+	}
+	return nil
+}
+
+func (x *ImportEmojiPackReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.Manifest == nil {
+		return errors.New("manifest is empty")
+	}
+	return x.Manifest.Check()
+}
+
+func (x *ImportEmojiPackReq) Format() any {
+	if x.Manifest == nil {
+		return x
+	}
+	return fmt.Sprintf("userID is %s, manifest %s items len is %v", x.UserID, x.Manifest.Name, len(x.Manifest.Items))
+}
+
+func (x *ExportEmojiPackReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.PackID == "" {
+		return errors.New("packID is empty")
+	}
+	return nil
+}
+
+var quickReplyVariableRegexp = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.]+)\s*\}\}`)
+
+// QuickReplyVariables returns the distinct template variables referenced by content, in order of appearance.
+func QuickReplyVariables(content string) []string {
+	var variables []string
+	seen := make(map[string]struct{})
+	for _, match := range quickReplyVariableRegexp.FindAllStringSubmatch(content, -1) {
+		if _, ok := seen[match[1]]; ok {
+			continue
+		}
+		seen[match[1]] = struct{}{}
+		variables = append(variables, match[1])
+	}
+	return variables
+}
+
+// RenderQuickReplyContent replaces the template variables in content with values.
+// Variables without a value are left as is and returned in unresolved.
+func RenderQuickReplyContent(content string, values map[string]string) (string, []string) {
+	var unresolved []string
+	seen := make(map[string]struct{})
+	rendered := quickReplyVariableRegexp.ReplaceAllStringFunc(content, func(s string) string {
+		name := quickReplyVariableRegexp.FindStringSubmatch(s)[1]
+		if value, ok := values[name]; ok {
+			return value
+		}
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			unresolved = append(unresolved, name)
+		}
+		return s
+	})
+	return rendered, unresolved
+}
+
+func (x *RenderQuickReplyReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	if x.ReplyID == "" && x.Content == "" {
+		return errors.New("replyID and content are empty")
+	}
+	return nil
+}
+
+func checkAccountUserIDs(userIDs []string) error {
+	if len(userIDs) == 0 {
+		return errors.New("userIDs is empty")
+	}
+	if len(userIDs) > constant.ParamMaxLength {
+		return errors.New("too many userIDs, need to be less than 1000")
+	}
+	if toolsdatautil.Duplicate(userIDs) {
+		return errors.New("duplicate userID")
+	}
+	return nil
+}
+
+func (x *DeactivateUserReq) Check() error {
+	return checkAccountUserIDs(x.UserIDs)
+}
+
+func (x *ReactivateUserReq) Check() error {
+	return checkAccountUserIDs(x.UserIDs)
+}
+
+func (x *EraseUserReq) Check() error {
+	if x.UserID == "" {
+		return errors.New("userID is empty")
+	}
+	switch x.OwnerTransferRule {
+	case constant.EraseOwnerTransferAdminFirst, constant.EraseOwnerTransferDismiss, constant.EraseOwnerTransferReject:
+	default:
+		return errors.New("ownerTransferRule is invalid")
+	}
+	return nil
+}
+
+func (x *GetEraseUserJobReq) Check() error {
+	if x.JobID == "" {
+		return errors.New("jobID is empty")
+	}
+	return nil
+}
+
+// StepProgress computes the overall progress of the steps, 0-100.
+func (x *EraseUserJob) StepProgress() int32 {
+	if len(x.Steps) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, step := range x.Steps {
+		switch {
+		case step.Status == constant.EraseJobStatusSucceeded:
+			sum += 1
+		case step.Total > 0:
+			sum += float64(min(step.Done, step.Total)) / float64(step.Total)
+		}
+	}
+	return int32(sum * 100 / float64(len(x.Steps)))
+}
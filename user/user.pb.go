@@ -1406,27 +1406,29 @@ func (*UserRegisterResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{27}
 }
 
-type GetGlobalRecvMessageOptReq struct {
+// deactivateUserReq 停用账号：禁止登录、注销全部 token、不出现在搜索结果中，历史数据保留
+type DeactivateUserReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	UserIDs       []string               `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGlobalRecvMessageOptReq) Reset() {
-	*x = GetGlobalRecvMessageOptReq{}
+func (x *DeactivateUserReq) Reset() {
+	*x = DeactivateUserReq{}
 	mi := &file_user_user_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGlobalRecvMessageOptReq) String() string {
+func (x *DeactivateUserReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGlobalRecvMessageOptReq) ProtoMessage() {}
+func (*DeactivateUserReq) ProtoMessage() {}
 
-func (x *GetGlobalRecvMessageOptReq) ProtoReflect() protoreflect.Message {
+func (x *DeactivateUserReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1438,39 +1440,45 @@ func (x *GetGlobalRecvMessageOptReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGlobalRecvMessageOptReq.ProtoReflect.Descriptor instead.
-func (*GetGlobalRecvMessageOptReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeactivateUserReq.ProtoReflect.Descriptor instead.
+func (*DeactivateUserReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetGlobalRecvMessageOptReq) GetUserID() string {
+func (x *DeactivateUserReq) GetUserIDs() []string {
 	if x != nil {
-		return x.UserID
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *DeactivateUserReq) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-type GetGlobalRecvMessageOptResp struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	GlobalRecvMsgOpt int32                  `protobuf:"varint,1,opt,name=globalRecvMsgOpt,proto3" json:"globalRecvMsgOpt,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type DeactivateUserResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGlobalRecvMessageOptResp) Reset() {
-	*x = GetGlobalRecvMessageOptResp{}
+func (x *DeactivateUserResp) Reset() {
+	*x = DeactivateUserResp{}
 	mi := &file_user_user_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGlobalRecvMessageOptResp) String() string {
+func (x *DeactivateUserResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGlobalRecvMessageOptResp) ProtoMessage() {}
+func (*DeactivateUserResp) ProtoMessage() {}
 
-func (x *GetGlobalRecvMessageOptResp) ProtoReflect() protoreflect.Message {
+func (x *DeactivateUserResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1482,40 +1490,32 @@ func (x *GetGlobalRecvMessageOptResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGlobalRecvMessageOptResp.ProtoReflect.Descriptor instead.
-func (*GetGlobalRecvMessageOptResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeactivateUserResp.ProtoReflect.Descriptor instead.
+func (*DeactivateUserResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetGlobalRecvMessageOptResp) GetGlobalRecvMsgOpt() int32 {
-	if x != nil {
-		return x.GlobalRecvMsgOpt
-	}
-	return 0
-}
-
-type UserRegisterCountReq struct {
+type ReactivateUserReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	UserIDs       []string               `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserRegisterCountReq) Reset() {
-	*x = UserRegisterCountReq{}
+func (x *ReactivateUserReq) Reset() {
+	*x = ReactivateUserReq{}
 	mi := &file_user_user_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserRegisterCountReq) String() string {
+func (x *ReactivateUserReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRegisterCountReq) ProtoMessage() {}
+func (*ReactivateUserReq) ProtoMessage() {}
 
-func (x *UserRegisterCountReq) ProtoReflect() protoreflect.Message {
+func (x *ReactivateUserReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1527,48 +1527,38 @@ func (x *UserRegisterCountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRegisterCountReq.ProtoReflect.Descriptor instead.
-func (*UserRegisterCountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReactivateUserReq.ProtoReflect.Descriptor instead.
+func (*ReactivateUserReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UserRegisterCountReq) GetStart() int64 {
-	if x != nil {
-		return x.Start
-	}
-	return 0
-}
-
-func (x *UserRegisterCountReq) GetEnd() int64 {
+func (x *ReactivateUserReq) GetUserIDs() []string {
 	if x != nil {
-		return x.End
+		return x.UserIDs
 	}
-	return 0
+	return nil
 }
 
-type UserRegisterCountResp struct {
+type ReactivateUserResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Before        int64                  `protobuf:"varint,2,opt,name=before,proto3" json:"before,omitempty"`
-	Count         map[string]int64       `protobuf:"bytes,3,rep,name=count,proto3" json:"count,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserRegisterCountResp) Reset() {
-	*x = UserRegisterCountResp{}
+func (x *ReactivateUserResp) Reset() {
+	*x = ReactivateUserResp{}
 	mi := &file_user_user_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserRegisterCountResp) String() string {
+func (x *ReactivateUserResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRegisterCountResp) ProtoMessage() {}
+func (*ReactivateUserResp) ProtoMessage() {}
 
-func (x *UserRegisterCountResp) ProtoReflect() protoreflect.Message {
+func (x *ReactivateUserResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1580,55 +1570,35 @@ func (x *UserRegisterCountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRegisterCountResp.ProtoReflect.Descriptor instead.
-func (*UserRegisterCountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReactivateUserResp.ProtoReflect.Descriptor instead.
+func (*ReactivateUserResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UserRegisterCountResp) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *UserRegisterCountResp) GetBefore() int64 {
-	if x != nil {
-		return x.Before
-	}
-	return 0
-}
-
-func (x *UserRegisterCountResp) GetCount() map[string]int64 {
-	if x != nil {
-		return x.Count
-	}
-	return nil
-}
-
-type SubscribeOrCancelUsersStatusReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	UserIDs       []string               `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
-	Genre         int32                  `protobuf:"varint,3,opt,name=genre,proto3" json:"genre,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// eraseUserReq 异步注销账号（删除权）：匿名化用户资料、删除好友和黑名单、退出所有群
+type EraseUserReq struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserID            string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	OwnerTransferRule int32                  `protobuf:"varint,2,opt,name=ownerTransferRule,proto3" json:"ownerTransferRule,omitempty"` // 用户为群主时的处理方式 constant.EraseOwnerTransfer*
+	Reason            string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *SubscribeOrCancelUsersStatusReq) Reset() {
-	*x = SubscribeOrCancelUsersStatusReq{}
+func (x *EraseUserReq) Reset() {
+	*x = EraseUserReq{}
 	mi := &file_user_user_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeOrCancelUsersStatusReq) String() string {
+func (x *EraseUserReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeOrCancelUsersStatusReq) ProtoMessage() {}
+func (*EraseUserReq) ProtoMessage() {}
 
-func (x *SubscribeOrCancelUsersStatusReq) ProtoReflect() protoreflect.Message {
+func (x *EraseUserReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1640,53 +1610,53 @@ func (x *SubscribeOrCancelUsersStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeOrCancelUsersStatusReq.ProtoReflect.Descriptor instead.
-func (*SubscribeOrCancelUsersStatusReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use EraseUserReq.ProtoReflect.Descriptor instead.
+func (*EraseUserReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SubscribeOrCancelUsersStatusReq) GetUserID() string {
+func (x *EraseUserReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *SubscribeOrCancelUsersStatusReq) GetUserIDs() []string {
+func (x *EraseUserReq) GetOwnerTransferRule() int32 {
 	if x != nil {
-		return x.UserIDs
+		return x.OwnerTransferRule
 	}
-	return nil
+	return 0
 }
 
-func (x *SubscribeOrCancelUsersStatusReq) GetGenre() int32 {
+func (x *EraseUserReq) GetReason() string {
 	if x != nil {
-		return x.Genre
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type SubscribeOrCancelUsersStatusResp struct {
+type EraseUserResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusList    []*OnlineStatus        `protobuf:"bytes,1,rep,name=statusList,proto3" json:"statusList,omitempty"`
+	JobID         string                 `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubscribeOrCancelUsersStatusResp) Reset() {
-	*x = SubscribeOrCancelUsersStatusResp{}
+func (x *EraseUserResp) Reset() {
+	*x = EraseUserResp{}
 	mi := &file_user_user_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubscribeOrCancelUsersStatusResp) String() string {
+func (x *EraseUserResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeOrCancelUsersStatusResp) ProtoMessage() {}
+func (*EraseUserResp) ProtoMessage() {}
 
-func (x *SubscribeOrCancelUsersStatusResp) ProtoReflect() protoreflect.Message {
+func (x *EraseUserResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1698,39 +1668,43 @@ func (x *SubscribeOrCancelUsersStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeOrCancelUsersStatusResp.ProtoReflect.Descriptor instead.
-func (*SubscribeOrCancelUsersStatusResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use EraseUserResp.ProtoReflect.Descriptor instead.
+func (*EraseUserResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SubscribeOrCancelUsersStatusResp) GetStatusList() []*OnlineStatus {
+func (x *EraseUserResp) GetJobID() string {
 	if x != nil {
-		return x.StatusList
+		return x.JobID
 	}
-	return nil
+	return ""
 }
 
-type GetSubscribeUsersStatusReq struct {
+type EraseUserStep struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`      // constant.EraseStep*
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // constant.EraseJobStatus*
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`   // 需要处理的数量
+	Done          int64                  `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`     // 已处理的数量
+	ErrMsg        string                 `protobuf:"bytes,5,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSubscribeUsersStatusReq) Reset() {
-	*x = GetSubscribeUsersStatusReq{}
+func (x *EraseUserStep) Reset() {
+	*x = EraseUserStep{}
 	mi := &file_user_user_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubscribeUsersStatusReq) String() string {
+func (x *EraseUserStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubscribeUsersStatusReq) ProtoMessage() {}
+func (*EraseUserStep) ProtoMessage() {}
 
-func (x *GetSubscribeUsersStatusReq) ProtoReflect() protoreflect.Message {
+func (x *EraseUserStep) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1742,39 +1716,78 @@ func (x *GetSubscribeUsersStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubscribeUsersStatusReq.ProtoReflect.Descriptor instead.
-func (*GetSubscribeUsersStatusReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use EraseUserStep.ProtoReflect.Descriptor instead.
+func (*EraseUserStep) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetSubscribeUsersStatusReq) GetUserID() string {
+func (x *EraseUserStep) GetName() string {
 	if x != nil {
-		return x.UserID
+		return x.Name
 	}
 	return ""
 }
 
-type GetSubscribeUsersStatusResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusList    []*OnlineStatus        `protobuf:"bytes,1,rep,name=statusList,proto3" json:"statusList,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EraseUserStep) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
 }
 
-func (x *GetSubscribeUsersStatusResp) Reset() {
-	*x = GetSubscribeUsersStatusResp{}
+func (x *EraseUserStep) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *EraseUserStep) GetDone() int64 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *EraseUserStep) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type EraseUserJob struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	JobID             string                 `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	UserID            string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Status            int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`     // constant.EraseJobStatus*
+	Progress          int32                  `protobuf:"varint,4,opt,name=progress,proto3" json:"progress,omitempty"` // 总进度 0-100
+	Steps             []*EraseUserStep       `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	OwnerTransferRule int32                  `protobuf:"varint,6,opt,name=ownerTransferRule,proto3" json:"ownerTransferRule,omitempty"`
+	OpUserID          string                 `protobuf:"bytes,7,opt,name=opUserID,proto3" json:"opUserID,omitempty"`
+	Reason            string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreateTime        int64                  `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	UpdateTime        int64                  `protobuf:"varint,10,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+	FinishTime        int64                  `protobuf:"varint,11,opt,name=finishTime,proto3" json:"finishTime,omitempty"`
+	ErrMsg            string                 `protobuf:"bytes,12,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *EraseUserJob) Reset() {
+	*x = EraseUserJob{}
 	mi := &file_user_user_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSubscribeUsersStatusResp) String() string {
+func (x *EraseUserJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubscribeUsersStatusResp) ProtoMessage() {}
+func (*EraseUserJob) ProtoMessage() {}
 
-func (x *GetSubscribeUsersStatusResp) ProtoReflect() protoreflect.Message {
+func (x *EraseUserJob) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1786,41 +1799,116 @@ func (x *GetSubscribeUsersStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubscribeUsersStatusResp.ProtoReflect.Descriptor instead.
-func (*GetSubscribeUsersStatusResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use EraseUserJob.ProtoReflect.Descriptor instead.
+func (*EraseUserJob) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetSubscribeUsersStatusResp) GetStatusList() []*OnlineStatus {
+func (x *EraseUserJob) GetJobID() string {
 	if x != nil {
-		return x.StatusList
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *EraseUserJob) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *EraseUserJob) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *EraseUserJob) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *EraseUserJob) GetSteps() []*EraseUserStep {
+	if x != nil {
+		return x.Steps
 	}
 	return nil
 }
 
-// platformDetail 平台详细状态信息
-type PlatformDetail struct {
+func (x *EraseUserJob) GetOwnerTransferRule() int32 {
+	if x != nil {
+		return x.OwnerTransferRule
+	}
+	return 0
+}
+
+func (x *EraseUserJob) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *EraseUserJob) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EraseUserJob) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *EraseUserJob) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *EraseUserJob) GetFinishTime() int64 {
+	if x != nil {
+		return x.FinishTime
+	}
+	return 0
+}
+
+func (x *EraseUserJob) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type GetEraseUserJobReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlatformID    int32                  `protobuf:"varint,1,opt,name=platformID,proto3" json:"platformID,omitempty"` // 平台ID
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`         // 该平台的状态：0=离线, 1=在线, 2=离开
+	JobID         string                 `protobuf:"bytes,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlatformDetail) Reset() {
-	*x = PlatformDetail{}
+func (x *GetEraseUserJobReq) Reset() {
+	*x = GetEraseUserJobReq{}
 	mi := &file_user_user_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlatformDetail) String() string {
+func (x *GetEraseUserJobReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlatformDetail) ProtoMessage() {}
+func (*GetEraseUserJobReq) ProtoMessage() {}
 
-func (x *PlatformDetail) ProtoReflect() protoreflect.Message {
+func (x *GetEraseUserJobReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1832,49 +1920,39 @@ func (x *PlatformDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlatformDetail.ProtoReflect.Descriptor instead.
-func (*PlatformDetail) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEraseUserJobReq.ProtoReflect.Descriptor instead.
+func (*GetEraseUserJobReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *PlatformDetail) GetPlatformID() int32 {
+func (x *GetEraseUserJobReq) GetJobID() string {
 	if x != nil {
-		return x.PlatformID
+		return x.JobID
 	}
-	return 0
-}
-
-func (x *PlatformDetail) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
+	return ""
 }
 
-type OnlineStatus struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	UserID               string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Status               int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                            // 整体状态（兼容旧版本）
-	PlatformIDs          []int32                `protobuf:"varint,3,rep,packed,name=platformIDs,proto3" json:"platformIDs,omitempty"`           // 平台ID列表（兼容旧版本）
-	DetailPlatformStatus []*PlatformDetail      `protobuf:"bytes,4,rep,name=detailPlatformStatus,proto3" json:"detailPlatformStatus,omitempty"` // 每个平台的详细状态（新增）
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type GetEraseUserJobResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *EraseUserJob          `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OnlineStatus) Reset() {
-	*x = OnlineStatus{}
+func (x *GetEraseUserJobResp) Reset() {
+	*x = GetEraseUserJobResp{}
 	mi := &file_user_user_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OnlineStatus) String() string {
+func (x *GetEraseUserJobResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OnlineStatus) ProtoMessage() {}
+func (*GetEraseUserJobResp) ProtoMessage() {}
 
-func (x *OnlineStatus) ProtoReflect() protoreflect.Message {
+func (x *GetEraseUserJobResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1886,61 +1964,39 @@ func (x *OnlineStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OnlineStatus.ProtoReflect.Descriptor instead.
-func (*OnlineStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetEraseUserJobResp.ProtoReflect.Descriptor instead.
+func (*GetEraseUserJobResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *OnlineStatus) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *OnlineStatus) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *OnlineStatus) GetPlatformIDs() []int32 {
-	if x != nil {
-		return x.PlatformIDs
-	}
-	return nil
-}
-
-func (x *OnlineStatus) GetDetailPlatformStatus() []*PlatformDetail {
+func (x *GetEraseUserJobResp) GetJob() *EraseUserJob {
 	if x != nil {
-		return x.DetailPlatformStatus
+		return x.Job
 	}
 	return nil
 }
 
-type GetUserStatusReq struct {
+type GetGlobalRecvMessageOptReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	UserIDs       []string               `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserStatusReq) Reset() {
-	*x = GetUserStatusReq{}
+func (x *GetGlobalRecvMessageOptReq) Reset() {
+	*x = GetGlobalRecvMessageOptReq{}
 	mi := &file_user_user_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserStatusReq) String() string {
+func (x *GetGlobalRecvMessageOptReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserStatusReq) ProtoMessage() {}
+func (*GetGlobalRecvMessageOptReq) ProtoMessage() {}
 
-func (x *GetUserStatusReq) ProtoReflect() protoreflect.Message {
+func (x *GetGlobalRecvMessageOptReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1952,46 +2008,39 @@ func (x *GetUserStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserStatusReq.ProtoReflect.Descriptor instead.
-func (*GetUserStatusReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGlobalRecvMessageOptReq.ProtoReflect.Descriptor instead.
+func (*GetGlobalRecvMessageOptReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetUserStatusReq) GetUserID() string {
+func (x *GetGlobalRecvMessageOptReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *GetUserStatusReq) GetUserIDs() []string {
-	if x != nil {
-		return x.UserIDs
-	}
-	return nil
-}
-
-type GetUserStatusResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusList    []*OnlineStatus        `protobuf:"bytes,1,rep,name=statusList,proto3" json:"statusList,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetGlobalRecvMessageOptResp struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GlobalRecvMsgOpt int32                  `protobuf:"varint,1,opt,name=globalRecvMsgOpt,proto3" json:"globalRecvMsgOpt,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetUserStatusResp) Reset() {
-	*x = GetUserStatusResp{}
+func (x *GetGlobalRecvMessageOptResp) Reset() {
+	*x = GetGlobalRecvMessageOptResp{}
 	mi := &file_user_user_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserStatusResp) String() string {
+func (x *GetGlobalRecvMessageOptResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserStatusResp) ProtoMessage() {}
+func (*GetGlobalRecvMessageOptResp) ProtoMessage() {}
 
-func (x *GetUserStatusResp) ProtoReflect() protoreflect.Message {
+func (x *GetGlobalRecvMessageOptResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2003,43 +2052,40 @@ func (x *GetUserStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserStatusResp.ProtoReflect.Descriptor instead.
-func (*GetUserStatusResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGlobalRecvMessageOptResp.ProtoReflect.Descriptor instead.
+func (*GetGlobalRecvMessageOptResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetUserStatusResp) GetStatusList() []*OnlineStatus {
+func (x *GetGlobalRecvMessageOptResp) GetGlobalRecvMsgOpt() int32 {
 	if x != nil {
-		return x.StatusList
+		return x.GlobalRecvMsgOpt
 	}
-	return nil
+	return 0
 }
 
-type SetUserStatusReq struct {
+type UserRegisterCountReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	PlatformID    int32                  `protobuf:"varint,3,opt,name=platformID,proto3" json:"platformID,omitempty"`
-	ConnID        string                 `protobuf:"bytes,4,opt,name=connID,proto3" json:"connID,omitempty"`
-	IsBackground  bool                   `protobuf:"varint,5,opt,name=isBackground,proto3" json:"isBackground,omitempty"`
+	Start         int64                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int64                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetUserStatusReq) Reset() {
-	*x = SetUserStatusReq{}
+func (x *UserRegisterCountReq) Reset() {
+	*x = UserRegisterCountReq{}
 	mi := &file_user_user_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetUserStatusReq) String() string {
+func (x *UserRegisterCountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetUserStatusReq) ProtoMessage() {}
+func (*UserRegisterCountReq) ProtoMessage() {}
 
-func (x *SetUserStatusReq) ProtoReflect() protoreflect.Message {
+func (x *UserRegisterCountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2051,66 +2097,48 @@ func (x *SetUserStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetUserStatusReq.ProtoReflect.Descriptor instead.
-func (*SetUserStatusReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserRegisterCountReq.ProtoReflect.Descriptor instead.
+func (*UserRegisterCountReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SetUserStatusReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *SetUserStatusReq) GetStatus() int32 {
+func (x *UserRegisterCountReq) GetStart() int64 {
 	if x != nil {
-		return x.Status
+		return x.Start
 	}
 	return 0
 }
 
-func (x *SetUserStatusReq) GetPlatformID() int32 {
+func (x *UserRegisterCountReq) GetEnd() int64 {
 	if x != nil {
-		return x.PlatformID
+		return x.End
 	}
 	return 0
 }
 
-func (x *SetUserStatusReq) GetConnID() string {
-	if x != nil {
-		return x.ConnID
-	}
-	return ""
-}
-
-func (x *SetUserStatusReq) GetIsBackground() bool {
-	if x != nil {
-		return x.IsBackground
-	}
-	return false
-}
-
-type SetUserStatusResp struct {
+type UserRegisterCountResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Before        int64                  `protobuf:"varint,2,opt,name=before,proto3" json:"before,omitempty"`
+	Count         map[string]int64       `protobuf:"bytes,3,rep,name=count,proto3" json:"count,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetUserStatusResp) Reset() {
-	*x = SetUserStatusResp{}
+func (x *UserRegisterCountResp) Reset() {
+	*x = UserRegisterCountResp{}
 	mi := &file_user_user_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetUserStatusResp) String() string {
+func (x *UserRegisterCountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetUserStatusResp) ProtoMessage() {}
+func (*UserRegisterCountResp) ProtoMessage() {}
 
-func (x *SetUserStatusResp) ProtoReflect() protoreflect.Message {
+func (x *UserRegisterCountResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2122,35 +2150,55 @@ func (x *SetUserStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetUserStatusResp.ProtoReflect.Descriptor instead.
-func (*SetUserStatusResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserRegisterCountResp.ProtoReflect.Descriptor instead.
+func (*UserRegisterCountResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{41}
 }
 
-type UserOnlineStatus struct {
+func (x *UserRegisterCountResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *UserRegisterCountResp) GetBefore() int64 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+func (x *UserRegisterCountResp) GetCount() map[string]int64 {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type SubscribeOrCancelUsersStatusReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	ConnID        string                 `protobuf:"bytes,2,opt,name=connID,proto3" json:"connID,omitempty"`
-	Online        []int32                `protobuf:"varint,3,rep,packed,name=online,proto3" json:"online,omitempty"`
-	Offline       []int32                `protobuf:"varint,4,rep,packed,name=offline,proto3" json:"offline,omitempty"`
+	UserIDs       []string               `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
+	Genre         int32                  `protobuf:"varint,3,opt,name=genre,proto3" json:"genre,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserOnlineStatus) Reset() {
-	*x = UserOnlineStatus{}
+func (x *SubscribeOrCancelUsersStatusReq) Reset() {
+	*x = SubscribeOrCancelUsersStatusReq{}
 	mi := &file_user_user_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserOnlineStatus) String() string {
+func (x *SubscribeOrCancelUsersStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserOnlineStatus) ProtoMessage() {}
+func (*SubscribeOrCancelUsersStatusReq) ProtoMessage() {}
 
-func (x *UserOnlineStatus) ProtoReflect() protoreflect.Message {
+func (x *SubscribeOrCancelUsersStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2162,60 +2210,53 @@ func (x *UserOnlineStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserOnlineStatus.ProtoReflect.Descriptor instead.
-func (*UserOnlineStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeOrCancelUsersStatusReq.ProtoReflect.Descriptor instead.
+func (*SubscribeOrCancelUsersStatusReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UserOnlineStatus) GetUserID() string {
+func (x *SubscribeOrCancelUsersStatusReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *UserOnlineStatus) GetConnID() string {
-	if x != nil {
-		return x.ConnID
-	}
-	return ""
-}
-
-func (x *UserOnlineStatus) GetOnline() []int32 {
+func (x *SubscribeOrCancelUsersStatusReq) GetUserIDs() []string {
 	if x != nil {
-		return x.Online
+		return x.UserIDs
 	}
 	return nil
 }
 
-func (x *UserOnlineStatus) GetOffline() []int32 {
+func (x *SubscribeOrCancelUsersStatusReq) GetGenre() int32 {
 	if x != nil {
-		return x.Offline
+		return x.Genre
 	}
-	return nil
+	return 0
 }
 
-type SetUserOnlineStatusReq struct {
+type SubscribeOrCancelUsersStatusResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        []*UserOnlineStatus    `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
+	StatusList    []*OnlineStatus        `protobuf:"bytes,1,rep,name=statusList,proto3" json:"statusList,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetUserOnlineStatusReq) Reset() {
-	*x = SetUserOnlineStatusReq{}
+func (x *SubscribeOrCancelUsersStatusResp) Reset() {
+	*x = SubscribeOrCancelUsersStatusResp{}
 	mi := &file_user_user_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetUserOnlineStatusReq) String() string {
+func (x *SubscribeOrCancelUsersStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetUserOnlineStatusReq) ProtoMessage() {}
+func (*SubscribeOrCancelUsersStatusResp) ProtoMessage() {}
 
-func (x *SetUserOnlineStatusReq) ProtoReflect() protoreflect.Message {
+func (x *SubscribeOrCancelUsersStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2227,38 +2268,39 @@ func (x *SetUserOnlineStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetUserOnlineStatusReq.ProtoReflect.Descriptor instead.
-func (*SetUserOnlineStatusReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeOrCancelUsersStatusResp.ProtoReflect.Descriptor instead.
+func (*SubscribeOrCancelUsersStatusResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *SetUserOnlineStatusReq) GetStatus() []*UserOnlineStatus {
+func (x *SubscribeOrCancelUsersStatusResp) GetStatusList() []*OnlineStatus {
 	if x != nil {
-		return x.Status
+		return x.StatusList
 	}
 	return nil
 }
 
-type SetUserOnlineStatusResp struct {
+type GetSubscribeUsersStatusReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetUserOnlineStatusResp) Reset() {
-	*x = SetUserOnlineStatusResp{}
+func (x *GetSubscribeUsersStatusReq) Reset() {
+	*x = GetSubscribeUsersStatusReq{}
 	mi := &file_user_user_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetUserOnlineStatusResp) String() string {
+func (x *GetSubscribeUsersStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetUserOnlineStatusResp) ProtoMessage() {}
+func (*GetSubscribeUsersStatusReq) ProtoMessage() {}
 
-func (x *SetUserOnlineStatusResp) ProtoReflect() protoreflect.Message {
+func (x *GetSubscribeUsersStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2270,36 +2312,39 @@ func (x *SetUserOnlineStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetUserOnlineStatusResp.ProtoReflect.Descriptor instead.
-func (*SetUserOnlineStatusResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSubscribeUsersStatusReq.ProtoReflect.Descriptor instead.
+func (*GetSubscribeUsersStatusReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{44}
 }
 
-type ProcessUserCommandAddReq struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	UserID        string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Type          int32                   `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	Uuid          string                  `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Value         *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Ex            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex,omitempty"`
+func (x *GetSubscribeUsersStatusReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetSubscribeUsersStatusResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusList    []*OnlineStatus        `protobuf:"bytes,1,rep,name=statusList,proto3" json:"statusList,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessUserCommandAddReq) Reset() {
-	*x = ProcessUserCommandAddReq{}
+func (x *GetSubscribeUsersStatusResp) Reset() {
+	*x = GetSubscribeUsersStatusResp{}
 	mi := &file_user_user_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessUserCommandAddReq) String() string {
+func (x *GetSubscribeUsersStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessUserCommandAddReq) ProtoMessage() {}
+func (*GetSubscribeUsersStatusResp) ProtoMessage() {}
 
-func (x *ProcessUserCommandAddReq) ProtoReflect() protoreflect.Message {
+func (x *GetSubscribeUsersStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2311,66 +2356,42 @@ func (x *ProcessUserCommandAddReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessUserCommandAddReq.ProtoReflect.Descriptor instead.
-func (*ProcessUserCommandAddReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSubscribeUsersStatusResp.ProtoReflect.Descriptor instead.
+func (*GetSubscribeUsersStatusResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ProcessUserCommandAddReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *ProcessUserCommandAddReq) GetType() int32 {
-	if x != nil {
-		return x.Type
-	}
-	return 0
-}
-
-func (x *ProcessUserCommandAddReq) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *ProcessUserCommandAddReq) GetValue() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Value
-	}
-	return nil
-}
-
-func (x *ProcessUserCommandAddReq) GetEx() *wrapperspb.StringValue {
+func (x *GetSubscribeUsersStatusResp) GetStatusList() []*OnlineStatus {
 	if x != nil {
-		return x.Ex
+		return x.StatusList
 	}
 	return nil
 }
 
-type ProcessUserCommandAddResp struct {
+// platformDetail 平台详细状态信息
+type PlatformDetail struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlatformID    int32                  `protobuf:"varint,1,opt,name=platformID,proto3" json:"platformID,omitempty"` // 平台ID
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`         // 该平台的状态：0=离线, 1=在线, 2=离开
+	Activity      int32                  `protobuf:"varint,3,opt,name=activity,proto3" json:"activity,omitempty"`     // 该平台的自动状态 constant.UserActivity*
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessUserCommandAddResp) Reset() {
-	*x = ProcessUserCommandAddResp{}
+func (x *PlatformDetail) Reset() {
+	*x = PlatformDetail{}
 	mi := &file_user_user_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessUserCommandAddResp) String() string {
+func (x *PlatformDetail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessUserCommandAddResp) ProtoMessage() {}
+func (*PlatformDetail) ProtoMessage() {}
 
-func (x *ProcessUserCommandAddResp) ProtoReflect() protoreflect.Message {
+func (x *PlatformDetail) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2382,34 +2403,59 @@ func (x *ProcessUserCommandAddResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessUserCommandAddResp.ProtoReflect.Descriptor instead.
-func (*ProcessUserCommandAddResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlatformDetail.ProtoReflect.Descriptor instead.
+func (*PlatformDetail) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{46}
 }
 
-type ProcessUserCommandDeleteReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	Uuid          string                 `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PlatformDetail) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
 }
 
-func (x *ProcessUserCommandDeleteReq) Reset() {
-	*x = ProcessUserCommandDeleteReq{}
+func (x *PlatformDetail) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PlatformDetail) GetActivity() int32 {
+	if x != nil {
+		return x.Activity
+	}
+	return 0
+}
+
+type OnlineStatus struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserID               string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Status               int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                            // 整体状态（兼容旧版本）
+	PlatformIDs          []int32                `protobuf:"varint,3,rep,packed,name=platformIDs,proto3" json:"platformIDs,omitempty"`           // 平台ID列表（兼容旧版本）
+	DetailPlatformStatus []*PlatformDetail      `protobuf:"bytes,4,rep,name=detailPlatformStatus,proto3" json:"detailPlatformStatus,omitempty"` // 每个平台的详细状态（新增）
+	Restricted           bool                   `protobuf:"varint,5,opt,name=restricted,proto3" json:"restricted,omitempty"`                    // 受隐私设置限制，对查询者隐藏真实状态（此时 status 为离线）
+	Presence             *sdkws.UserPresence    `protobuf:"bytes,6,opt,name=presence,proto3" json:"presence,omitempty"`                         // 自定义状态
+	Activity             int32                  `protobuf:"varint,7,opt,name=activity,proto3" json:"activity,omitempty"`                        // 自动状态 constant.UserActivity*，取所有来源中优先级最高的
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *OnlineStatus) Reset() {
+	*x = OnlineStatus{}
 	mi := &file_user_user_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessUserCommandDeleteReq) String() string {
+func (x *OnlineStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessUserCommandDeleteReq) ProtoMessage() {}
+func (*OnlineStatus) ProtoMessage() {}
 
-func (x *ProcessUserCommandDeleteReq) ProtoReflect() protoreflect.Message {
+func (x *OnlineStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2421,52 +2467,82 @@ func (x *ProcessUserCommandDeleteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessUserCommandDeleteReq.ProtoReflect.Descriptor instead.
-func (*ProcessUserCommandDeleteReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use OnlineStatus.ProtoReflect.Descriptor instead.
+func (*OnlineStatus) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ProcessUserCommandDeleteReq) GetUserID() string {
+func (x *OnlineStatus) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *ProcessUserCommandDeleteReq) GetType() int32 {
+func (x *OnlineStatus) GetStatus() int32 {
 	if x != nil {
-		return x.Type
+		return x.Status
 	}
 	return 0
 }
 
-func (x *ProcessUserCommandDeleteReq) GetUuid() string {
+func (x *OnlineStatus) GetPlatformIDs() []int32 {
 	if x != nil {
-		return x.Uuid
+		return x.PlatformIDs
 	}
-	return ""
+	return nil
 }
 
-type ProcessUserCommandDeleteResp struct {
+func (x *OnlineStatus) GetDetailPlatformStatus() []*PlatformDetail {
+	if x != nil {
+		return x.DetailPlatformStatus
+	}
+	return nil
+}
+
+func (x *OnlineStatus) GetRestricted() bool {
+	if x != nil {
+		return x.Restricted
+	}
+	return false
+}
+
+func (x *OnlineStatus) GetPresence() *sdkws.UserPresence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+func (x *OnlineStatus) GetActivity() int32 {
+	if x != nil {
+		return x.Activity
+	}
+	return 0
+}
+
+type GetUserStatusReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	UserIDs       []string               `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessUserCommandDeleteResp) Reset() {
-	*x = ProcessUserCommandDeleteResp{}
+func (x *GetUserStatusReq) Reset() {
+	*x = GetUserStatusReq{}
 	mi := &file_user_user_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessUserCommandDeleteResp) String() string {
+func (x *GetUserStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessUserCommandDeleteResp) ProtoMessage() {}
+func (*GetUserStatusReq) ProtoMessage() {}
 
-func (x *ProcessUserCommandDeleteResp) ProtoReflect() protoreflect.Message {
+func (x *GetUserStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2478,36 +2554,46 @@ func (x *ProcessUserCommandDeleteResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessUserCommandDeleteResp.ProtoReflect.Descriptor instead.
-func (*ProcessUserCommandDeleteResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserStatusReq.ProtoReflect.Descriptor instead.
+func (*GetUserStatusReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{48}
 }
 
-type ProcessUserCommandUpdateReq struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	UserID        string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Type          int32                   `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	Uuid          string                  `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Value         *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Ex            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex,omitempty"`
+func (x *GetUserStatusReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetUserStatusReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type GetUserStatusResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StatusList    []*OnlineStatus        `protobuf:"bytes,1,rep,name=statusList,proto3" json:"statusList,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessUserCommandUpdateReq) Reset() {
-	*x = ProcessUserCommandUpdateReq{}
+func (x *GetUserStatusResp) Reset() {
+	*x = GetUserStatusResp{}
 	mi := &file_user_user_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessUserCommandUpdateReq) String() string {
+func (x *GetUserStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessUserCommandUpdateReq) ProtoMessage() {}
+func (*GetUserStatusResp) ProtoMessage() {}
 
-func (x *ProcessUserCommandUpdateReq) ProtoReflect() protoreflect.Message {
+func (x *GetUserStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2519,66 +2605,43 @@ func (x *ProcessUserCommandUpdateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessUserCommandUpdateReq.ProtoReflect.Descriptor instead.
-func (*ProcessUserCommandUpdateReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserStatusResp.ProtoReflect.Descriptor instead.
+func (*GetUserStatusResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ProcessUserCommandUpdateReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *ProcessUserCommandUpdateReq) GetType() int32 {
+func (x *GetUserStatusResp) GetStatusList() []*OnlineStatus {
 	if x != nil {
-		return x.Type
+		return x.StatusList
 	}
-	return 0
+	return nil
 }
 
-func (x *ProcessUserCommandUpdateReq) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *ProcessUserCommandUpdateReq) GetValue() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Value
-	}
-	return nil
-}
-
-func (x *ProcessUserCommandUpdateReq) GetEx() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Ex
-	}
-	return nil
-}
-
-type ProcessUserCommandUpdateResp struct {
+type SetUserStatusReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	PlatformID    int32                  `protobuf:"varint,3,opt,name=platformID,proto3" json:"platformID,omitempty"`
+	ConnID        string                 `protobuf:"bytes,4,opt,name=connID,proto3" json:"connID,omitempty"`
+	IsBackground  bool                   `protobuf:"varint,5,opt,name=isBackground,proto3" json:"isBackground,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessUserCommandUpdateResp) Reset() {
-	*x = ProcessUserCommandUpdateResp{}
+func (x *SetUserStatusReq) Reset() {
+	*x = SetUserStatusReq{}
 	mi := &file_user_user_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessUserCommandUpdateResp) String() string {
+func (x *SetUserStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessUserCommandUpdateResp) ProtoMessage() {}
+func (*SetUserStatusReq) ProtoMessage() {}
 
-func (x *ProcessUserCommandUpdateResp) ProtoReflect() protoreflect.Message {
+func (x *SetUserStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2590,33 +2653,66 @@ func (x *ProcessUserCommandUpdateResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessUserCommandUpdateResp.ProtoReflect.Descriptor instead.
-func (*ProcessUserCommandUpdateResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetUserStatusReq.ProtoReflect.Descriptor instead.
+func (*SetUserStatusReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{50}
 }
 
-type ProcessUserCommandGetReq struct {
+func (x *SetUserStatusReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetUserStatusReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SetUserStatusReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *SetUserStatusReq) GetConnID() string {
+	if x != nil {
+		return x.ConnID
+	}
+	return ""
+}
+
+func (x *SetUserStatusReq) GetIsBackground() bool {
+	if x != nil {
+		return x.IsBackground
+	}
+	return false
+}
+
+type SetUserStatusResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessUserCommandGetReq) Reset() {
-	*x = ProcessUserCommandGetReq{}
+func (x *SetUserStatusResp) Reset() {
+	*x = SetUserStatusResp{}
 	mi := &file_user_user_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessUserCommandGetReq) String() string {
+func (x *SetUserStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessUserCommandGetReq) ProtoMessage() {}
+func (*SetUserStatusResp) ProtoMessage() {}
 
-func (x *ProcessUserCommandGetReq) ProtoReflect() protoreflect.Message {
+func (x *SetUserStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2628,50 +2724,35 @@ func (x *ProcessUserCommandGetReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessUserCommandGetReq.ProtoReflect.Descriptor instead.
-func (*ProcessUserCommandGetReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetUserStatusResp.ProtoReflect.Descriptor instead.
+func (*SetUserStatusResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ProcessUserCommandGetReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *ProcessUserCommandGetReq) GetType() int32 {
-	if x != nil {
-		return x.Type
-	}
-	return 0
-}
-
-type CommandInfoResp struct {
+type UserOnlineStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	CreateTime    int64                  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime,omitempty"`
-	Uuid          string                 `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Ex            string                 `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex,omitempty"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ConnID        string                 `protobuf:"bytes,2,opt,name=connID,proto3" json:"connID,omitempty"`
+	Online        []int32                `protobuf:"varint,3,rep,packed,name=online,proto3" json:"online,omitempty"`
+	Offline       []int32                `protobuf:"varint,4,rep,packed,name=offline,proto3" json:"offline,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommandInfoResp) Reset() {
-	*x = CommandInfoResp{}
+func (x *UserOnlineStatus) Reset() {
+	*x = UserOnlineStatus{}
 	mi := &file_user_user_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommandInfoResp) String() string {
+func (x *UserOnlineStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommandInfoResp) ProtoMessage() {}
+func (*UserOnlineStatus) ProtoMessage() {}
 
-func (x *CommandInfoResp) ProtoReflect() protoreflect.Message {
+func (x *UserOnlineStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2683,67 +2764,60 @@ func (x *CommandInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommandInfoResp.ProtoReflect.Descriptor instead.
-func (*CommandInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserOnlineStatus.ProtoReflect.Descriptor instead.
+func (*UserOnlineStatus) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CommandInfoResp) GetType() int32 {
-	if x != nil {
-		return x.Type
-	}
-	return 0
-}
-
-func (x *CommandInfoResp) GetCreateTime() int64 {
+func (x *UserOnlineStatus) GetUserID() string {
 	if x != nil {
-		return x.CreateTime
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-func (x *CommandInfoResp) GetUuid() string {
+func (x *UserOnlineStatus) GetConnID() string {
 	if x != nil {
-		return x.Uuid
+		return x.ConnID
 	}
 	return ""
 }
 
-func (x *CommandInfoResp) GetValue() string {
+func (x *UserOnlineStatus) GetOnline() []int32 {
 	if x != nil {
-		return x.Value
+		return x.Online
 	}
-	return ""
+	return nil
 }
 
-func (x *CommandInfoResp) GetEx() string {
+func (x *UserOnlineStatus) GetOffline() []int32 {
 	if x != nil {
-		return x.Ex
+		return x.Offline
 	}
-	return ""
+	return nil
 }
 
-type ProcessUserCommandGetResp struct {
+type SetUserOnlineStatusReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommandResp   []*CommandInfoResp     `protobuf:"bytes,1,rep,name=CommandResp,proto3" json:"CommandResp,omitempty"`
+	Status        []*UserOnlineStatus    `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessUserCommandGetResp) Reset() {
-	*x = ProcessUserCommandGetResp{}
+func (x *SetUserOnlineStatusReq) Reset() {
+	*x = SetUserOnlineStatusReq{}
 	mi := &file_user_user_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessUserCommandGetResp) String() string {
+func (x *SetUserOnlineStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessUserCommandGetResp) ProtoMessage() {}
+func (*SetUserOnlineStatusReq) ProtoMessage() {}
 
-func (x *ProcessUserCommandGetResp) ProtoReflect() protoreflect.Message {
+func (x *SetUserOnlineStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2755,39 +2829,38 @@ func (x *ProcessUserCommandGetResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessUserCommandGetResp.ProtoReflect.Descriptor instead.
-func (*ProcessUserCommandGetResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetUserOnlineStatusReq.ProtoReflect.Descriptor instead.
+func (*SetUserOnlineStatusReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ProcessUserCommandGetResp) GetCommandResp() []*CommandInfoResp {
+func (x *SetUserOnlineStatusReq) GetStatus() []*UserOnlineStatus {
 	if x != nil {
-		return x.CommandResp
+		return x.Status
 	}
 	return nil
 }
 
-type ProcessUserCommandGetAllReq struct {
+type SetUserOnlineStatusResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessUserCommandGetAllReq) Reset() {
-	*x = ProcessUserCommandGetAllReq{}
+func (x *SetUserOnlineStatusResp) Reset() {
+	*x = SetUserOnlineStatusResp{}
 	mi := &file_user_user_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessUserCommandGetAllReq) String() string {
+func (x *SetUserOnlineStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessUserCommandGetAllReq) ProtoMessage() {}
+func (*SetUserOnlineStatusResp) ProtoMessage() {}
 
-func (x *ProcessUserCommandGetAllReq) ProtoReflect() protoreflect.Message {
+func (x *SetUserOnlineStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2799,43 +2872,36 @@ func (x *ProcessUserCommandGetAllReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessUserCommandGetAllReq.ProtoReflect.Descriptor instead.
-func (*ProcessUserCommandGetAllReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetUserOnlineStatusResp.ProtoReflect.Descriptor instead.
+func (*SetUserOnlineStatusResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ProcessUserCommandGetAllReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-type AllCommandInfoResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
-	CreateTime    int64                  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime,omitempty"`
-	Uuid          string                 `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Ex            string                 `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex,omitempty"`
+type ProcessUserCommandAddReq struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	UserID        string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Type          int32                   `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Uuid          string                  `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Value         *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Ex            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AllCommandInfoResp) Reset() {
-	*x = AllCommandInfoResp{}
+func (x *ProcessUserCommandAddReq) Reset() {
+	*x = ProcessUserCommandAddReq{}
 	mi := &file_user_user_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllCommandInfoResp) String() string {
+func (x *ProcessUserCommandAddReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllCommandInfoResp) ProtoMessage() {}
+func (*ProcessUserCommandAddReq) ProtoMessage() {}
 
-func (x *AllCommandInfoResp) ProtoReflect() protoreflect.Message {
+func (x *ProcessUserCommandAddReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2847,67 +2913,66 @@ func (x *AllCommandInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllCommandInfoResp.ProtoReflect.Descriptor instead.
-func (*AllCommandInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessUserCommandAddReq.ProtoReflect.Descriptor instead.
+func (*ProcessUserCommandAddReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *AllCommandInfoResp) GetType() int32 {
+func (x *ProcessUserCommandAddReq) GetUserID() string {
 	if x != nil {
-		return x.Type
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-func (x *AllCommandInfoResp) GetCreateTime() int64 {
+func (x *ProcessUserCommandAddReq) GetType() int32 {
 	if x != nil {
-		return x.CreateTime
+		return x.Type
 	}
 	return 0
 }
 
-func (x *AllCommandInfoResp) GetUuid() string {
+func (x *ProcessUserCommandAddReq) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-func (x *AllCommandInfoResp) GetValue() string {
+func (x *ProcessUserCommandAddReq) GetValue() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Value
 	}
-	return ""
+	return nil
 }
 
-func (x *AllCommandInfoResp) GetEx() string {
+func (x *ProcessUserCommandAddReq) GetEx() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Ex
 	}
-	return ""
+	return nil
 }
 
-type ProcessUserCommandGetAllResp struct {
+type ProcessUserCommandAddResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommandResp   []*AllCommandInfoResp  `protobuf:"bytes,1,rep,name=CommandResp,proto3" json:"CommandResp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessUserCommandGetAllResp) Reset() {
-	*x = ProcessUserCommandGetAllResp{}
+func (x *ProcessUserCommandAddResp) Reset() {
+	*x = ProcessUserCommandAddResp{}
 	mi := &file_user_user_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessUserCommandGetAllResp) String() string {
+func (x *ProcessUserCommandAddResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessUserCommandGetAllResp) ProtoMessage() {}
+func (*ProcessUserCommandAddResp) ProtoMessage() {}
 
-func (x *ProcessUserCommandGetAllResp) ProtoReflect() protoreflect.Message {
+func (x *ProcessUserCommandAddResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2919,42 +2984,34 @@ func (x *ProcessUserCommandGetAllResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessUserCommandGetAllResp.ProtoReflect.Descriptor instead.
-func (*ProcessUserCommandGetAllResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessUserCommandAddResp.ProtoReflect.Descriptor instead.
+func (*ProcessUserCommandAddResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ProcessUserCommandGetAllResp) GetCommandResp() []*AllCommandInfoResp {
-	if x != nil {
-		return x.CommandResp
-	}
-	return nil
-}
-
-type AddNotificationAccountReq struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserID         string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	NickName       string                 `protobuf:"bytes,2,opt,name=nickName,proto3" json:"nickName,omitempty"`
-	FaceURL        string                 `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL,omitempty"`
-	AppMangerLevel int32                  `protobuf:"varint,4,opt,name=appMangerLevel,proto3" json:"appMangerLevel,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ProcessUserCommandDeleteReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Uuid          string                 `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddNotificationAccountReq) Reset() {
-	*x = AddNotificationAccountReq{}
+func (x *ProcessUserCommandDeleteReq) Reset() {
+	*x = ProcessUserCommandDeleteReq{}
 	mi := &file_user_user_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddNotificationAccountReq) String() string {
+func (x *ProcessUserCommandDeleteReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddNotificationAccountReq) ProtoMessage() {}
+func (*ProcessUserCommandDeleteReq) ProtoMessage() {}
 
-func (x *AddNotificationAccountReq) ProtoReflect() protoreflect.Message {
+func (x *ProcessUserCommandDeleteReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2966,63 +3023,52 @@ func (x *AddNotificationAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddNotificationAccountReq.ProtoReflect.Descriptor instead.
-func (*AddNotificationAccountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessUserCommandDeleteReq.ProtoReflect.Descriptor instead.
+func (*ProcessUserCommandDeleteReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AddNotificationAccountReq) GetUserID() string {
+func (x *ProcessUserCommandDeleteReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *AddNotificationAccountReq) GetNickName() string {
+func (x *ProcessUserCommandDeleteReq) GetType() int32 {
 	if x != nil {
-		return x.NickName
+		return x.Type
 	}
-	return ""
+	return 0
 }
 
-func (x *AddNotificationAccountReq) GetFaceURL() string {
+func (x *ProcessUserCommandDeleteReq) GetUuid() string {
 	if x != nil {
-		return x.FaceURL
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *AddNotificationAccountReq) GetAppMangerLevel() int32 {
-	if x != nil {
-		return x.AppMangerLevel
-	}
-	return 0
-}
-
-type AddNotificationAccountResp struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserID         string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	FaceURL        string                 `protobuf:"bytes,2,opt,name=faceURL,proto3" json:"faceURL,omitempty"`
-	NickName       string                 `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
-	AppMangerLevel int32                  `protobuf:"varint,4,opt,name=appMangerLevel,proto3" json:"appMangerLevel,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ProcessUserCommandDeleteResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddNotificationAccountResp) Reset() {
-	*x = AddNotificationAccountResp{}
+func (x *ProcessUserCommandDeleteResp) Reset() {
+	*x = ProcessUserCommandDeleteResp{}
 	mi := &file_user_user_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddNotificationAccountResp) String() string {
+func (x *ProcessUserCommandDeleteResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddNotificationAccountResp) ProtoMessage() {}
+func (*ProcessUserCommandDeleteResp) ProtoMessage() {}
 
-func (x *AddNotificationAccountResp) ProtoReflect() protoreflect.Message {
+func (x *ProcessUserCommandDeleteResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3034,62 +3080,36 @@ func (x *AddNotificationAccountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddNotificationAccountResp.ProtoReflect.Descriptor instead.
-func (*AddNotificationAccountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessUserCommandDeleteResp.ProtoReflect.Descriptor instead.
+func (*ProcessUserCommandDeleteResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *AddNotificationAccountResp) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *AddNotificationAccountResp) GetFaceURL() string {
-	if x != nil {
-		return x.FaceURL
-	}
-	return ""
-}
-
-func (x *AddNotificationAccountResp) GetNickName() string {
-	if x != nil {
-		return x.NickName
-	}
-	return ""
-}
-
-func (x *AddNotificationAccountResp) GetAppMangerLevel() int32 {
-	if x != nil {
-		return x.AppMangerLevel
-	}
-	return 0
-}
-
-type UpdateNotificationAccountInfoReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	FaceURL       string                 `protobuf:"bytes,2,opt,name=faceURL,proto3" json:"faceURL,omitempty"`
-	NickName      string                 `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
+type ProcessUserCommandUpdateReq struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	UserID        string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Type          int32                   `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Uuid          string                  `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Value         *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Ex            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateNotificationAccountInfoReq) Reset() {
-	*x = UpdateNotificationAccountInfoReq{}
+func (x *ProcessUserCommandUpdateReq) Reset() {
+	*x = ProcessUserCommandUpdateReq{}
 	mi := &file_user_user_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateNotificationAccountInfoReq) String() string {
+func (x *ProcessUserCommandUpdateReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNotificationAccountInfoReq) ProtoMessage() {}
+func (*ProcessUserCommandUpdateReq) ProtoMessage() {}
 
-func (x *UpdateNotificationAccountInfoReq) ProtoReflect() protoreflect.Message {
+func (x *ProcessUserCommandUpdateReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3101,52 +3121,66 @@ func (x *UpdateNotificationAccountInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNotificationAccountInfoReq.ProtoReflect.Descriptor instead.
-func (*UpdateNotificationAccountInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessUserCommandUpdateReq.ProtoReflect.Descriptor instead.
+func (*ProcessUserCommandUpdateReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *UpdateNotificationAccountInfoReq) GetUserID() string {
+func (x *ProcessUserCommandUpdateReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *UpdateNotificationAccountInfoReq) GetFaceURL() string {
+func (x *ProcessUserCommandUpdateReq) GetType() int32 {
 	if x != nil {
-		return x.FaceURL
+		return x.Type
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateNotificationAccountInfoReq) GetNickName() string {
+func (x *ProcessUserCommandUpdateReq) GetUuid() string {
 	if x != nil {
-		return x.NickName
+		return x.Uuid
 	}
 	return ""
 }
 
-type UpdateNotificationAccountInfoResp struct {
+func (x *ProcessUserCommandUpdateReq) GetValue() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ProcessUserCommandUpdateReq) GetEx() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Ex
+	}
+	return nil
+}
+
+type ProcessUserCommandUpdateResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateNotificationAccountInfoResp) Reset() {
-	*x = UpdateNotificationAccountInfoResp{}
+func (x *ProcessUserCommandUpdateResp) Reset() {
+	*x = ProcessUserCommandUpdateResp{}
 	mi := &file_user_user_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateNotificationAccountInfoResp) String() string {
+func (x *ProcessUserCommandUpdateResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateNotificationAccountInfoResp) ProtoMessage() {}
+func (*ProcessUserCommandUpdateResp) ProtoMessage() {}
 
-func (x *UpdateNotificationAccountInfoResp) ProtoReflect() protoreflect.Message {
+func (x *ProcessUserCommandUpdateResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3158,34 +3192,33 @@ func (x *UpdateNotificationAccountInfoResp) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateNotificationAccountInfoResp.ProtoReflect.Descriptor instead.
-func (*UpdateNotificationAccountInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessUserCommandUpdateResp.ProtoReflect.Descriptor instead.
+func (*ProcessUserCommandUpdateResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{60}
 }
 
-type SearchNotificationAccountReq struct {
-	state           protoimpl.MessageState   `protogen:"open.v1"`
-	Keyword         string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
-	AppManagerLevel *int32                   `protobuf:"varint,2,opt,name=appManagerLevel,proto3,oneof" json:"appManagerLevel,omitempty"`
-	Pagination      *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ProcessUserCommandGetReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Type          int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchNotificationAccountReq) Reset() {
-	*x = SearchNotificationAccountReq{}
+func (x *ProcessUserCommandGetReq) Reset() {
+	*x = ProcessUserCommandGetReq{}
 	mi := &file_user_user_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchNotificationAccountReq) String() string {
+func (x *ProcessUserCommandGetReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchNotificationAccountReq) ProtoMessage() {}
+func (*ProcessUserCommandGetReq) ProtoMessage() {}
 
-func (x *SearchNotificationAccountReq) ProtoReflect() protoreflect.Message {
+func (x *ProcessUserCommandGetReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3197,56 +3230,50 @@ func (x *SearchNotificationAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchNotificationAccountReq.ProtoReflect.Descriptor instead.
-func (*SearchNotificationAccountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessUserCommandGetReq.ProtoReflect.Descriptor instead.
+func (*ProcessUserCommandGetReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *SearchNotificationAccountReq) GetKeyword() string {
+func (x *ProcessUserCommandGetReq) GetUserID() string {
 	if x != nil {
-		return x.Keyword
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *SearchNotificationAccountReq) GetAppManagerLevel() int32 {
-	if x != nil && x.AppManagerLevel != nil {
-		return *x.AppManagerLevel
-	}
-	return 0
-}
-
-func (x *SearchNotificationAccountReq) GetPagination() *sdkws.RequestPagination {
+func (x *ProcessUserCommandGetReq) GetType() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.Type
 	}
-	return nil
+	return 0
 }
 
-type NotificationAccountInfo struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	UserID         string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	FaceURL        string                 `protobuf:"bytes,2,opt,name=faceURL,proto3" json:"faceURL,omitempty"`
-	NickName       string                 `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
-	AppMangerLevel int32                  `protobuf:"varint,4,opt,name=appMangerLevel,proto3" json:"appMangerLevel,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CommandInfoResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	Uuid          string                 `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Ex            string                 `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NotificationAccountInfo) Reset() {
-	*x = NotificationAccountInfo{}
+func (x *CommandInfoResp) Reset() {
+	*x = CommandInfoResp{}
 	mi := &file_user_user_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NotificationAccountInfo) String() string {
+func (x *CommandInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationAccountInfo) ProtoMessage() {}
+func (*CommandInfoResp) ProtoMessage() {}
 
-func (x *NotificationAccountInfo) ProtoReflect() protoreflect.Message {
+func (x *CommandInfoResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3258,61 +3285,67 @@ func (x *NotificationAccountInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationAccountInfo.ProtoReflect.Descriptor instead.
-func (*NotificationAccountInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use CommandInfoResp.ProtoReflect.Descriptor instead.
+func (*CommandInfoResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *NotificationAccountInfo) GetUserID() string {
+func (x *CommandInfoResp) GetType() int32 {
 	if x != nil {
-		return x.UserID
+		return x.Type
 	}
-	return ""
+	return 0
 }
 
-func (x *NotificationAccountInfo) GetFaceURL() string {
+func (x *CommandInfoResp) GetCreateTime() int64 {
 	if x != nil {
-		return x.FaceURL
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *CommandInfoResp) GetUuid() string {
+	if x != nil {
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *NotificationAccountInfo) GetNickName() string {
+func (x *CommandInfoResp) GetValue() string {
 	if x != nil {
-		return x.NickName
+		return x.Value
 	}
 	return ""
 }
 
-func (x *NotificationAccountInfo) GetAppMangerLevel() int32 {
+func (x *CommandInfoResp) GetEx() string {
 	if x != nil {
-		return x.AppMangerLevel
+		return x.Ex
 	}
-	return 0
+	return ""
 }
 
-type SearchNotificationAccountResp struct {
-	state                protoimpl.MessageState     `protogen:"open.v1"`
-	Total                int64                      `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	NotificationAccounts []*NotificationAccountInfo `protobuf:"bytes,2,rep,name=notificationAccounts,proto3" json:"notificationAccounts,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type ProcessUserCommandGetResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommandResp   []*CommandInfoResp     `protobuf:"bytes,1,rep,name=CommandResp,proto3" json:"CommandResp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchNotificationAccountResp) Reset() {
-	*x = SearchNotificationAccountResp{}
+func (x *ProcessUserCommandGetResp) Reset() {
+	*x = ProcessUserCommandGetResp{}
 	mi := &file_user_user_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchNotificationAccountResp) String() string {
+func (x *ProcessUserCommandGetResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchNotificationAccountResp) ProtoMessage() {}
+func (*ProcessUserCommandGetResp) ProtoMessage() {}
 
-func (x *SearchNotificationAccountResp) ProtoReflect() protoreflect.Message {
+func (x *ProcessUserCommandGetResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3324,46 +3357,39 @@ func (x *SearchNotificationAccountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchNotificationAccountResp.ProtoReflect.Descriptor instead.
-func (*SearchNotificationAccountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessUserCommandGetResp.ProtoReflect.Descriptor instead.
+func (*ProcessUserCommandGetResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *SearchNotificationAccountResp) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *SearchNotificationAccountResp) GetNotificationAccounts() []*NotificationAccountInfo {
+func (x *ProcessUserCommandGetResp) GetCommandResp() []*CommandInfoResp {
 	if x != nil {
-		return x.NotificationAccounts
+		return x.CommandResp
 	}
 	return nil
 }
 
-type GetNotificationAccountReq struct {
+type ProcessUserCommandGetAllReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNotificationAccountReq) Reset() {
-	*x = GetNotificationAccountReq{}
+func (x *ProcessUserCommandGetAllReq) Reset() {
+	*x = ProcessUserCommandGetAllReq{}
 	mi := &file_user_user_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNotificationAccountReq) String() string {
+func (x *ProcessUserCommandGetAllReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotificationAccountReq) ProtoMessage() {}
+func (*ProcessUserCommandGetAllReq) ProtoMessage() {}
 
-func (x *GetNotificationAccountReq) ProtoReflect() protoreflect.Message {
+func (x *ProcessUserCommandGetAllReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3375,39 +3401,43 @@ func (x *GetNotificationAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotificationAccountReq.ProtoReflect.Descriptor instead.
-func (*GetNotificationAccountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessUserCommandGetAllReq.ProtoReflect.Descriptor instead.
+func (*ProcessUserCommandGetAllReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetNotificationAccountReq) GetUserID() string {
+func (x *ProcessUserCommandGetAllReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-type GetNotificationAccountResp struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Account       *NotificationAccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+type AllCommandInfoResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	Uuid          string                 `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Ex            string                 `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNotificationAccountResp) Reset() {
-	*x = GetNotificationAccountResp{}
+func (x *AllCommandInfoResp) Reset() {
+	*x = AllCommandInfoResp{}
 	mi := &file_user_user_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNotificationAccountResp) String() string {
+func (x *AllCommandInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotificationAccountResp) ProtoMessage() {}
+func (*AllCommandInfoResp) ProtoMessage() {}
 
-func (x *GetNotificationAccountResp) ProtoReflect() protoreflect.Message {
+func (x *AllCommandInfoResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3419,40 +3449,67 @@ func (x *GetNotificationAccountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotificationAccountResp.ProtoReflect.Descriptor instead.
-func (*GetNotificationAccountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AllCommandInfoResp.ProtoReflect.Descriptor instead.
+func (*AllCommandInfoResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetNotificationAccountResp) GetAccount() *NotificationAccountInfo {
+func (x *AllCommandInfoResp) GetType() int32 {
 	if x != nil {
-		return x.Account
+		return x.Type
 	}
-	return nil
+	return 0
 }
 
-type SortQueryReq struct {
+func (x *AllCommandInfoResp) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *AllCommandInfoResp) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *AllCommandInfoResp) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AllCommandInfoResp) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type ProcessUserCommandGetAllResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Asc           bool                   `protobuf:"varint,1,opt,name=asc,proto3" json:"asc,omitempty"`
-	UserIDName    map[string]string      `protobuf:"bytes,2,rep,name=userIDName,proto3" json:"userIDName,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CommandResp   []*AllCommandInfoResp  `protobuf:"bytes,1,rep,name=CommandResp,proto3" json:"CommandResp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SortQueryReq) Reset() {
-	*x = SortQueryReq{}
+func (x *ProcessUserCommandGetAllResp) Reset() {
+	*x = ProcessUserCommandGetAllResp{}
 	mi := &file_user_user_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SortQueryReq) String() string {
+func (x *ProcessUserCommandGetAllResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SortQueryReq) ProtoMessage() {}
+func (*ProcessUserCommandGetAllResp) ProtoMessage() {}
 
-func (x *SortQueryReq) ProtoReflect() protoreflect.Message {
+func (x *ProcessUserCommandGetAllResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3464,46 +3521,42 @@ func (x *SortQueryReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SortQueryReq.ProtoReflect.Descriptor instead.
-func (*SortQueryReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProcessUserCommandGetAllResp.ProtoReflect.Descriptor instead.
+func (*ProcessUserCommandGetAllResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *SortQueryReq) GetAsc() bool {
-	if x != nil {
-		return x.Asc
-	}
-	return false
-}
-
-func (x *SortQueryReq) GetUserIDName() map[string]string {
+func (x *ProcessUserCommandGetAllResp) GetCommandResp() []*AllCommandInfoResp {
 	if x != nil {
-		return x.UserIDName
+		return x.CommandResp
 	}
 	return nil
 }
 
-type SortQueryResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*sdkws.UserInfo      `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AddNotificationAccountReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserID         string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	NickName       string                 `protobuf:"bytes,2,opt,name=nickName,proto3" json:"nickName,omitempty"`
+	FaceURL        string                 `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL,omitempty"`
+	AppMangerLevel int32                  `protobuf:"varint,4,opt,name=appMangerLevel,proto3" json:"appMangerLevel,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SortQueryResp) Reset() {
-	*x = SortQueryResp{}
+func (x *AddNotificationAccountReq) Reset() {
+	*x = AddNotificationAccountReq{}
 	mi := &file_user_user_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SortQueryResp) String() string {
+func (x *AddNotificationAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SortQueryResp) ProtoMessage() {}
+func (*AddNotificationAccountReq) ProtoMessage() {}
 
-func (x *SortQueryResp) ProtoReflect() protoreflect.Message {
+func (x *AddNotificationAccountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3515,39 +3568,63 @@ func (x *SortQueryResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SortQueryResp.ProtoReflect.Descriptor instead.
-func (*SortQueryResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddNotificationAccountReq.ProtoReflect.Descriptor instead.
+func (*AddNotificationAccountReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *SortQueryResp) GetUsers() []*sdkws.UserInfo {
+func (x *AddNotificationAccountReq) GetUserID() string {
 	if x != nil {
-		return x.Users
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-type GetAllOnlineUsersReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cursor        uint64                 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AddNotificationAccountReq) GetNickName() string {
+	if x != nil {
+		return x.NickName
+	}
+	return ""
 }
 
-func (x *GetAllOnlineUsersReq) Reset() {
-	*x = GetAllOnlineUsersReq{}
+func (x *AddNotificationAccountReq) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *AddNotificationAccountReq) GetAppMangerLevel() int32 {
+	if x != nil {
+		return x.AppMangerLevel
+	}
+	return 0
+}
+
+type AddNotificationAccountResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserID         string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	FaceURL        string                 `protobuf:"bytes,2,opt,name=faceURL,proto3" json:"faceURL,omitempty"`
+	NickName       string                 `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
+	AppMangerLevel int32                  `protobuf:"varint,4,opt,name=appMangerLevel,proto3" json:"appMangerLevel,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddNotificationAccountResp) Reset() {
+	*x = AddNotificationAccountResp{}
 	mi := &file_user_user_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllOnlineUsersReq) String() string {
+func (x *AddNotificationAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllOnlineUsersReq) ProtoMessage() {}
+func (*AddNotificationAccountResp) ProtoMessage() {}
 
-func (x *GetAllOnlineUsersReq) ProtoReflect() protoreflect.Message {
+func (x *AddNotificationAccountResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3559,40 +3636,62 @@ func (x *GetAllOnlineUsersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllOnlineUsersReq.ProtoReflect.Descriptor instead.
-func (*GetAllOnlineUsersReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddNotificationAccountResp.ProtoReflect.Descriptor instead.
+func (*AddNotificationAccountResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetAllOnlineUsersReq) GetCursor() uint64 {
+func (x *AddNotificationAccountResp) GetUserID() string {
 	if x != nil {
-		return x.Cursor
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AddNotificationAccountResp) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *AddNotificationAccountResp) GetNickName() string {
+	if x != nil {
+		return x.NickName
+	}
+	return ""
+}
+
+func (x *AddNotificationAccountResp) GetAppMangerLevel() int32 {
+	if x != nil {
+		return x.AppMangerLevel
 	}
 	return 0
 }
 
-type GetAllOnlineUsersResp struct {
+type UpdateNotificationAccountInfoReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StatusList    []*OnlineStatus        `protobuf:"bytes,1,rep,name=StatusList,proto3" json:"StatusList,omitempty"`
-	NextCursor    uint64                 `protobuf:"varint,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	FaceURL       string                 `protobuf:"bytes,2,opt,name=faceURL,proto3" json:"faceURL,omitempty"`
+	NickName      string                 `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllOnlineUsersResp) Reset() {
-	*x = GetAllOnlineUsersResp{}
+func (x *UpdateNotificationAccountInfoReq) Reset() {
+	*x = UpdateNotificationAccountInfoReq{}
 	mi := &file_user_user_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllOnlineUsersResp) String() string {
+func (x *UpdateNotificationAccountInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllOnlineUsersResp) ProtoMessage() {}
+func (*UpdateNotificationAccountInfoReq) ProtoMessage() {}
 
-func (x *GetAllOnlineUsersResp) ProtoReflect() protoreflect.Message {
+func (x *UpdateNotificationAccountInfoReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3604,46 +3703,52 @@ func (x *GetAllOnlineUsersResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllOnlineUsersResp.ProtoReflect.Descriptor instead.
-func (*GetAllOnlineUsersResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateNotificationAccountInfoReq.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationAccountInfoReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetAllOnlineUsersResp) GetStatusList() []*OnlineStatus {
+func (x *UpdateNotificationAccountInfoReq) GetUserID() string {
 	if x != nil {
-		return x.StatusList
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllOnlineUsersResp) GetNextCursor() uint64 {
+func (x *UpdateNotificationAccountInfoReq) GetFaceURL() string {
 	if x != nil {
-		return x.NextCursor
+		return x.FaceURL
 	}
-	return 0
+	return ""
 }
 
-type GetUserClientConfigReq struct {
+func (x *UpdateNotificationAccountInfoReq) GetNickName() string {
+	if x != nil {
+		return x.NickName
+	}
+	return ""
+}
+
+type UpdateNotificationAccountInfoResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserClientConfigReq) Reset() {
-	*x = GetUserClientConfigReq{}
+func (x *UpdateNotificationAccountInfoResp) Reset() {
+	*x = UpdateNotificationAccountInfoResp{}
 	mi := &file_user_user_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserClientConfigReq) String() string {
+func (x *UpdateNotificationAccountInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserClientConfigReq) ProtoMessage() {}
+func (*UpdateNotificationAccountInfoResp) ProtoMessage() {}
 
-func (x *GetUserClientConfigReq) ProtoReflect() protoreflect.Message {
+func (x *UpdateNotificationAccountInfoResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3655,39 +3760,34 @@ func (x *GetUserClientConfigReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserClientConfigReq.ProtoReflect.Descriptor instead.
-func (*GetUserClientConfigReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateNotificationAccountInfoResp.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationAccountInfoResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetUserClientConfigReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-type GetUserClientConfigResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Configs       map[string]string      `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SearchNotificationAccountReq struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	Keyword         string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	AppManagerLevel *int32                   `protobuf:"varint,2,opt,name=appManagerLevel,proto3,oneof" json:"appManagerLevel,omitempty"`
+	Pagination      *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetUserClientConfigResp) Reset() {
-	*x = GetUserClientConfigResp{}
+func (x *SearchNotificationAccountReq) Reset() {
+	*x = SearchNotificationAccountReq{}
 	mi := &file_user_user_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserClientConfigResp) String() string {
+func (x *SearchNotificationAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserClientConfigResp) ProtoMessage() {}
+func (*SearchNotificationAccountReq) ProtoMessage() {}
 
-func (x *GetUserClientConfigResp) ProtoReflect() protoreflect.Message {
+func (x *SearchNotificationAccountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3699,40 +3799,56 @@ func (x *GetUserClientConfigResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserClientConfigResp.ProtoReflect.Descriptor instead.
-func (*GetUserClientConfigResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchNotificationAccountReq.ProtoReflect.Descriptor instead.
+func (*SearchNotificationAccountReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetUserClientConfigResp) GetConfigs() map[string]string {
+func (x *SearchNotificationAccountReq) GetKeyword() string {
 	if x != nil {
-		return x.Configs
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchNotificationAccountReq) GetAppManagerLevel() int32 {
+	if x != nil && x.AppManagerLevel != nil {
+		return *x.AppManagerLevel
+	}
+	return 0
+}
+
+func (x *SearchNotificationAccountReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-type SetUserClientConfigReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Configs       map[string]string      `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type NotificationAccountInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserID         string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	FaceURL        string                 `protobuf:"bytes,2,opt,name=faceURL,proto3" json:"faceURL,omitempty"`
+	NickName       string                 `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
+	AppMangerLevel int32                  `protobuf:"varint,4,opt,name=appMangerLevel,proto3" json:"appMangerLevel,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SetUserClientConfigReq) Reset() {
-	*x = SetUserClientConfigReq{}
+func (x *NotificationAccountInfo) Reset() {
+	*x = NotificationAccountInfo{}
 	mi := &file_user_user_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetUserClientConfigReq) String() string {
+func (x *NotificationAccountInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetUserClientConfigReq) ProtoMessage() {}
+func (*NotificationAccountInfo) ProtoMessage() {}
 
-func (x *SetUserClientConfigReq) ProtoReflect() protoreflect.Message {
+func (x *NotificationAccountInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3744,45 +3860,61 @@ func (x *SetUserClientConfigReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetUserClientConfigReq.ProtoReflect.Descriptor instead.
-func (*SetUserClientConfigReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use NotificationAccountInfo.ProtoReflect.Descriptor instead.
+func (*NotificationAccountInfo) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *SetUserClientConfigReq) GetUserID() string {
+func (x *NotificationAccountInfo) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *SetUserClientConfigReq) GetConfigs() map[string]string {
+func (x *NotificationAccountInfo) GetFaceURL() string {
 	if x != nil {
-		return x.Configs
+		return x.FaceURL
 	}
-	return nil
+	return ""
 }
 
-type SetUserClientConfigResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *NotificationAccountInfo) GetNickName() string {
+	if x != nil {
+		return x.NickName
+	}
+	return ""
 }
 
-func (x *SetUserClientConfigResp) Reset() {
-	*x = SetUserClientConfigResp{}
+func (x *NotificationAccountInfo) GetAppMangerLevel() int32 {
+	if x != nil {
+		return x.AppMangerLevel
+	}
+	return 0
+}
+
+type SearchNotificationAccountResp struct {
+	state                protoimpl.MessageState     `protogen:"open.v1"`
+	Total                int64                      `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	NotificationAccounts []*NotificationAccountInfo `protobuf:"bytes,2,rep,name=notificationAccounts,proto3" json:"notificationAccounts,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SearchNotificationAccountResp) Reset() {
+	*x = SearchNotificationAccountResp{}
 	mi := &file_user_user_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetUserClientConfigResp) String() string {
+func (x *SearchNotificationAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetUserClientConfigResp) ProtoMessage() {}
+func (*SearchNotificationAccountResp) ProtoMessage() {}
 
-func (x *SetUserClientConfigResp) ProtoReflect() protoreflect.Message {
+func (x *SearchNotificationAccountResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3794,33 +3926,46 @@ func (x *SetUserClientConfigResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetUserClientConfigResp.ProtoReflect.Descriptor instead.
-func (*SetUserClientConfigResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchNotificationAccountResp.ProtoReflect.Descriptor instead.
+func (*SearchNotificationAccountResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{73}
 }
 
-type DelUserClientConfigReq struct {
+func (x *SearchNotificationAccountResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchNotificationAccountResp) GetNotificationAccounts() []*NotificationAccountInfo {
+	if x != nil {
+		return x.NotificationAccounts
+	}
+	return nil
+}
+
+type GetNotificationAccountReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Keys          []string               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DelUserClientConfigReq) Reset() {
-	*x = DelUserClientConfigReq{}
+func (x *GetNotificationAccountReq) Reset() {
+	*x = GetNotificationAccountReq{}
 	mi := &file_user_user_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DelUserClientConfigReq) String() string {
+func (x *GetNotificationAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelUserClientConfigReq) ProtoMessage() {}
+func (*GetNotificationAccountReq) ProtoMessage() {}
 
-func (x *DelUserClientConfigReq) ProtoReflect() protoreflect.Message {
+func (x *GetNotificationAccountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3832,45 +3977,39 @@ func (x *DelUserClientConfigReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelUserClientConfigReq.ProtoReflect.Descriptor instead.
-func (*DelUserClientConfigReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNotificationAccountReq.ProtoReflect.Descriptor instead.
+func (*GetNotificationAccountReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *DelUserClientConfigReq) GetUserID() string {
+func (x *GetNotificationAccountReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *DelUserClientConfigReq) GetKeys() []string {
-	if x != nil {
-		return x.Keys
-	}
-	return nil
+type GetNotificationAccountResp struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Account       *NotificationAccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type DelUserClientConfigResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DelUserClientConfigResp) Reset() {
-	*x = DelUserClientConfigResp{}
+func (x *GetNotificationAccountResp) Reset() {
+	*x = GetNotificationAccountResp{}
 	mi := &file_user_user_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DelUserClientConfigResp) String() string {
+func (x *GetNotificationAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelUserClientConfigResp) ProtoMessage() {}
+func (*GetNotificationAccountResp) ProtoMessage() {}
 
-func (x *DelUserClientConfigResp) ProtoReflect() protoreflect.Message {
+func (x *GetNotificationAccountResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3882,34 +4021,40 @@ func (x *DelUserClientConfigResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelUserClientConfigResp.ProtoReflect.Descriptor instead.
-func (*DelUserClientConfigResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNotificationAccountResp.ProtoReflect.Descriptor instead.
+func (*GetNotificationAccountResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{75}
 }
 
-type PageUserClientConfigReq struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	UserID        string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Key           string                   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Pagination    *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (x *GetNotificationAccountResp) GetAccount() *NotificationAccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type SortQueryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asc           bool                   `protobuf:"varint,1,opt,name=asc,proto3" json:"asc,omitempty"`
+	UserIDName    map[string]string      `protobuf:"bytes,2,rep,name=userIDName,proto3" json:"userIDName,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PageUserClientConfigReq) Reset() {
-	*x = PageUserClientConfigReq{}
+func (x *SortQueryReq) Reset() {
+	*x = SortQueryReq{}
 	mi := &file_user_user_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PageUserClientConfigReq) String() string {
+func (x *SortQueryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageUserClientConfigReq) ProtoMessage() {}
+func (*SortQueryReq) ProtoMessage() {}
 
-func (x *PageUserClientConfigReq) ProtoReflect() protoreflect.Message {
+func (x *SortQueryReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3921,54 +4066,46 @@ func (x *PageUserClientConfigReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageUserClientConfigReq.ProtoReflect.Descriptor instead.
-func (*PageUserClientConfigReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SortQueryReq.ProtoReflect.Descriptor instead.
+func (*SortQueryReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *PageUserClientConfigReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *PageUserClientConfigReq) GetKey() string {
+func (x *SortQueryReq) GetAsc() bool {
 	if x != nil {
-		return x.Key
+		return x.Asc
 	}
-	return ""
+	return false
 }
 
-func (x *PageUserClientConfigReq) GetPagination() *sdkws.RequestPagination {
+func (x *SortQueryReq) GetUserIDName() map[string]string {
 	if x != nil {
-		return x.Pagination
+		return x.UserIDName
 	}
 	return nil
 }
 
-type PageUserClientConfigResp struct {
+type SortQueryResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Configs       []*ClientConfig        `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty"`
+	Users         []*sdkws.UserInfo      `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PageUserClientConfigResp) Reset() {
-	*x = PageUserClientConfigResp{}
+func (x *SortQueryResp) Reset() {
+	*x = SortQueryResp{}
 	mi := &file_user_user_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PageUserClientConfigResp) String() string {
+func (x *SortQueryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PageUserClientConfigResp) ProtoMessage() {}
+func (*SortQueryResp) ProtoMessage() {}
 
-func (x *PageUserClientConfigResp) ProtoReflect() protoreflect.Message {
+func (x *SortQueryResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3980,48 +4117,39 @@ func (x *PageUserClientConfigResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PageUserClientConfigResp.ProtoReflect.Descriptor instead.
-func (*PageUserClientConfigResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SortQueryResp.ProtoReflect.Descriptor instead.
+func (*SortQueryResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *PageUserClientConfigResp) GetTotal() int64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *PageUserClientConfigResp) GetConfigs() []*ClientConfig {
+func (x *SortQueryResp) GetUsers() []*sdkws.UserInfo {
 	if x != nil {
-		return x.Configs
+		return x.Users
 	}
 	return nil
 }
 
-type ClientConfig struct {
+type GetAllOnlineUsersReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	UserID        string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
-	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Cursor        uint64                 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClientConfig) Reset() {
-	*x = ClientConfig{}
+func (x *GetAllOnlineUsersReq) Reset() {
+	*x = GetAllOnlineUsersReq{}
 	mi := &file_user_user_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClientConfig) String() string {
+func (x *GetAllOnlineUsersReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientConfig) ProtoMessage() {}
+func (*GetAllOnlineUsersReq) ProtoMessage() {}
 
-func (x *ClientConfig) ProtoReflect() protoreflect.Message {
+func (x *GetAllOnlineUsersReq) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4033,65 +4161,40 @@ func (x *ClientConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientConfig.ProtoReflect.Descriptor instead.
-func (*ClientConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllOnlineUsersReq.ProtoReflect.Descriptor instead.
+func (*GetAllOnlineUsersReq) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ClientConfig) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *ClientConfig) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *ClientConfig) GetValue() string {
+func (x *GetAllOnlineUsersReq) GetCursor() uint64 {
 	if x != nil {
-		return x.Value
+		return x.Cursor
 	}
-	return ""
+	return 0
 }
 
-type SaveUserEmojiReq struct {
+type GetAllOnlineUsersResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EmojiID       string                 `protobuf:"bytes,1,opt,name=emojiID,proto3" json:"emojiID,omitempty"`
-	EmojiName     string                 `protobuf:"bytes,2,opt,name=emojiName,proto3" json:"emojiName,omitempty"`
-	EmojiType     int32                  `protobuf:"varint,3,opt,name=emojiType,proto3" json:"emojiType,omitempty"`
-	EmojiURL      string                 `protobuf:"bytes,4,opt,name=emojiURL,proto3" json:"emojiURL,omitempty"`
-	EmojiPath     string                 `protobuf:"bytes,5,opt,name=emojiPath,proto3" json:"emojiPath,omitempty"`
-	EmojiWidth    int32                  `protobuf:"varint,6,opt,name=emojiWidth,proto3" json:"emojiWidth,omitempty"`
-	EmojiHeight   int32                  `protobuf:"varint,7,opt,name=emojiHeight,proto3" json:"emojiHeight,omitempty"`
-	EmojiSize     int64                  `protobuf:"varint,8,opt,name=emojiSize,proto3" json:"emojiSize,omitempty"`
-	Duration      int64                  `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
-	Category      string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
-	ThumbnailURL  string                 `protobuf:"bytes,11,opt,name=thumbnailURL,proto3" json:"thumbnailURL,omitempty"`
-	IsAnimated    bool                   `protobuf:"varint,12,opt,name=isAnimated,proto3" json:"isAnimated,omitempty"`
-	Ex            string                 `protobuf:"bytes,13,opt,name=ex,proto3" json:"ex,omitempty"`
+	StatusList    []*OnlineStatus        `protobuf:"bytes,1,rep,name=StatusList,proto3" json:"StatusList,omitempty"`
+	NextCursor    uint64                 `protobuf:"varint,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveUserEmojiReq) Reset() {
-	*x = SaveUserEmojiReq{}
+func (x *GetAllOnlineUsersResp) Reset() {
+	*x = GetAllOnlineUsersResp{}
 	mi := &file_user_user_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveUserEmojiReq) String() string {
+func (x *GetAllOnlineUsersResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveUserEmojiReq) ProtoMessage() {}
+func (*GetAllOnlineUsersResp) ProtoMessage() {}
 
-func (x *SaveUserEmojiReq) ProtoReflect() protoreflect.Message {
+func (x *GetAllOnlineUsersResp) ProtoReflect() protoreflect.Message {
 	mi := &file_user_user_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4103,123 +4206,91 @@ func (x *SaveUserEmojiReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveUserEmojiReq.ProtoReflect.Descriptor instead.
-func (*SaveUserEmojiReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllOnlineUsersResp.ProtoReflect.Descriptor instead.
+func (*GetAllOnlineUsersResp) Descriptor() ([]byte, []int) {
 	return file_user_user_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *SaveUserEmojiReq) GetEmojiID() string {
-	if x != nil {
-		return x.EmojiID
-	}
-	return ""
-}
-
-func (x *SaveUserEmojiReq) GetEmojiName() string {
+func (x *GetAllOnlineUsersResp) GetStatusList() []*OnlineStatus {
 	if x != nil {
-		return x.EmojiName
+		return x.StatusList
 	}
-	return ""
+	return nil
 }
 
-func (x *SaveUserEmojiReq) GetEmojiType() int32 {
+func (x *GetAllOnlineUsersResp) GetNextCursor() uint64 {
 	if x != nil {
-		return x.EmojiType
+		return x.NextCursor
 	}
 	return 0
 }
 
-func (x *SaveUserEmojiReq) GetEmojiURL() string {
-	if x != nil {
-		return x.EmojiURL
-	}
-	return ""
+type GetUserClientConfigReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveUserEmojiReq) GetEmojiPath() string {
-	if x != nil {
-		return x.EmojiPath
-	}
-	return ""
+func (x *GetUserClientConfigReq) Reset() {
+	*x = GetUserClientConfigReq{}
+	mi := &file_user_user_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveUserEmojiReq) GetEmojiWidth() int32 {
-	if x != nil {
-		return x.EmojiWidth
-	}
-	return 0
+func (x *GetUserClientConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SaveUserEmojiReq) GetEmojiHeight() int32 {
-	if x != nil {
-		return x.EmojiHeight
-	}
-	return 0
-}
+func (*GetUserClientConfigReq) ProtoMessage() {}
 
-func (x *SaveUserEmojiReq) GetEmojiSize() int64 {
+func (x *GetUserClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[80]
 	if x != nil {
-		return x.EmojiSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SaveUserEmojiReq) GetDuration() int64 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
+// Deprecated: Use GetUserClientConfigReq.ProtoReflect.Descriptor instead.
+func (*GetUserClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *SaveUserEmojiReq) GetCategory() string {
+func (x *GetUserClientConfigReq) GetUserID() string {
 	if x != nil {
-		return x.Category
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *SaveUserEmojiReq) GetThumbnailURL() string {
-	if x != nil {
-		return x.ThumbnailURL
-	}
-	return ""
+type GetUserClientConfigResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       map[string]string      `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveUserEmojiReq) GetIsAnimated() bool {
-	if x != nil {
-		return x.IsAnimated
-	}
-	return false
-}
-
-func (x *SaveUserEmojiReq) GetEx() string {
-	if x != nil {
-		return x.Ex
-	}
-	return ""
-}
-
-type SaveUserEmojiResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SaveUserEmojiResp) Reset() {
-	*x = SaveUserEmojiResp{}
-	mi := &file_user_user_proto_msgTypes[80]
+func (x *GetUserClientConfigResp) Reset() {
+	*x = GetUserClientConfigResp{}
+	mi := &file_user_user_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveUserEmojiResp) String() string {
+func (x *GetUserClientConfigResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveUserEmojiResp) ProtoMessage() {}
+func (*GetUserClientConfigResp) ProtoMessage() {}
 
-func (x *SaveUserEmojiResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[80]
+func (x *GetUserClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4230,33 +4301,41 @@ func (x *SaveUserEmojiResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveUserEmojiResp.ProtoReflect.Descriptor instead.
-func (*SaveUserEmojiResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use GetUserClientConfigResp.ProtoReflect.Descriptor instead.
+func (*GetUserClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{81}
 }
 
-type DeleteUserEmojiReq struct {
+func (x *GetUserClientConfigResp) GetConfigs() map[string]string {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type SetUserClientConfigReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EmojiID       string                 `protobuf:"bytes,1,opt,name=emojiID,proto3" json:"emojiID,omitempty"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Configs       map[string]string      `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserEmojiReq) Reset() {
-	*x = DeleteUserEmojiReq{}
-	mi := &file_user_user_proto_msgTypes[81]
+func (x *SetUserClientConfigReq) Reset() {
+	*x = SetUserClientConfigReq{}
+	mi := &file_user_user_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserEmojiReq) String() string {
+func (x *SetUserClientConfigReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserEmojiReq) ProtoMessage() {}
+func (*SetUserClientConfigReq) ProtoMessage() {}
 
-func (x *DeleteUserEmojiReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[81]
+func (x *SetUserClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4267,39 +4346,46 @@ func (x *DeleteUserEmojiReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserEmojiReq.ProtoReflect.Descriptor instead.
-func (*DeleteUserEmojiReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use SetUserClientConfigReq.ProtoReflect.Descriptor instead.
+func (*SetUserClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *DeleteUserEmojiReq) GetEmojiID() string {
+func (x *SetUserClientConfigReq) GetUserID() string {
 	if x != nil {
-		return x.EmojiID
+		return x.UserID
 	}
 	return ""
 }
 
-type DeleteUserEmojiResp struct {
+func (x *SetUserClientConfigReq) GetConfigs() map[string]string {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type SetUserClientConfigResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserEmojiResp) Reset() {
-	*x = DeleteUserEmojiResp{}
-	mi := &file_user_user_proto_msgTypes[82]
+func (x *SetUserClientConfigResp) Reset() {
+	*x = SetUserClientConfigResp{}
+	mi := &file_user_user_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserEmojiResp) String() string {
+func (x *SetUserClientConfigResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserEmojiResp) ProtoMessage() {}
+func (*SetUserClientConfigResp) ProtoMessage() {}
 
-func (x *DeleteUserEmojiResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[82]
+func (x *SetUserClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4310,33 +4396,34 @@ func (x *DeleteUserEmojiResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserEmojiResp.ProtoReflect.Descriptor instead.
-func (*DeleteUserEmojiResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use SetUserClientConfigResp.ProtoReflect.Descriptor instead.
+func (*SetUserClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{83}
 }
 
-type GetUserEmojiReq struct {
+type DelUserClientConfigReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EmojiID       string                 `protobuf:"bytes,1,opt,name=emojiID,proto3" json:"emojiID,omitempty"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Keys          []string               `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserEmojiReq) Reset() {
-	*x = GetUserEmojiReq{}
-	mi := &file_user_user_proto_msgTypes[83]
+func (x *DelUserClientConfigReq) Reset() {
+	*x = DelUserClientConfigReq{}
+	mi := &file_user_user_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserEmojiReq) String() string {
+func (x *DelUserClientConfigReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserEmojiReq) ProtoMessage() {}
+func (*DelUserClientConfigReq) ProtoMessage() {}
 
-func (x *GetUserEmojiReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[83]
+func (x *DelUserClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4347,54 +4434,46 @@ func (x *GetUserEmojiReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserEmojiReq.ProtoReflect.Descriptor instead.
-func (*GetUserEmojiReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use DelUserClientConfigReq.ProtoReflect.Descriptor instead.
+func (*DelUserClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetUserEmojiReq) GetEmojiID() string {
+func (x *DelUserClientConfigReq) GetUserID() string {
 	if x != nil {
-		return x.EmojiID
+		return x.UserID
 	}
 	return ""
 }
 
-type GetUserEmojiResp struct {
+func (x *DelUserClientConfigReq) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type DelUserClientConfigResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EmojiID       string                 `protobuf:"bytes,1,opt,name=emojiID,proto3" json:"emojiID,omitempty"`
-	EmojiName     string                 `protobuf:"bytes,2,opt,name=emojiName,proto3" json:"emojiName,omitempty"`
-	EmojiType     int32                  `protobuf:"varint,3,opt,name=emojiType,proto3" json:"emojiType,omitempty"`
-	EmojiURL      string                 `protobuf:"bytes,4,opt,name=emojiURL,proto3" json:"emojiURL,omitempty"`
-	EmojiPath     string                 `protobuf:"bytes,5,opt,name=emojiPath,proto3" json:"emojiPath,omitempty"`
-	EmojiWidth    int32                  `protobuf:"varint,6,opt,name=emojiWidth,proto3" json:"emojiWidth,omitempty"`
-	EmojiHeight   int32                  `protobuf:"varint,7,opt,name=emojiHeight,proto3" json:"emojiHeight,omitempty"`
-	EmojiSize     int64                  `protobuf:"varint,8,opt,name=emojiSize,proto3" json:"emojiSize,omitempty"`
-	Duration      int64                  `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
-	Category      string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
-	ThumbnailURL  string                 `protobuf:"bytes,11,opt,name=thumbnailURL,proto3" json:"thumbnailURL,omitempty"`
-	IsAnimated    bool                   `protobuf:"varint,12,opt,name=isAnimated,proto3" json:"isAnimated,omitempty"`
-	CreateTime    int64                  `protobuf:"varint,13,opt,name=createTime,proto3" json:"createTime,omitempty"`
-	UpdateTime    int64                  `protobuf:"varint,14,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
-	Ex            string                 `protobuf:"bytes,15,opt,name=ex,proto3" json:"ex,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserEmojiResp) Reset() {
-	*x = GetUserEmojiResp{}
-	mi := &file_user_user_proto_msgTypes[84]
+func (x *DelUserClientConfigResp) Reset() {
+	*x = DelUserClientConfigResp{}
+	mi := &file_user_user_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserEmojiResp) String() string {
+func (x *DelUserClientConfigResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserEmojiResp) ProtoMessage() {}
+func (*DelUserClientConfigResp) ProtoMessage() {}
 
-func (x *GetUserEmojiResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[84]
+func (x *DelUserClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4405,138 +4484,4219 @@ func (x *GetUserEmojiResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserEmojiResp.ProtoReflect.Descriptor instead.
-func (*GetUserEmojiResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use DelUserClientConfigResp.ProtoReflect.Descriptor instead.
+func (*DelUserClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetUserEmojiResp) GetEmojiID() string {
-	if x != nil {
-		return x.EmojiID
-	}
-	return ""
+type PageUserClientConfigReq struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	UserID        string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Key           string                   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Pagination    *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserEmojiResp) GetEmojiName() string {
-	if x != nil {
-		return x.EmojiName
-	}
-	return ""
+func (x *PageUserClientConfigReq) Reset() {
+	*x = PageUserClientConfigReq{}
+	mi := &file_user_user_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserEmojiResp) GetEmojiType() int32 {
-	if x != nil {
-		return x.EmojiType
-	}
-	return 0
+func (x *PageUserClientConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetUserEmojiResp) GetEmojiURL() string {
+func (*PageUserClientConfigReq) ProtoMessage() {}
+
+func (x *PageUserClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[86]
 	if x != nil {
-		return x.EmojiURL
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetUserEmojiResp) GetEmojiPath() string {
-	if x != nil {
-		return x.EmojiPath
-	}
-	return ""
+// Deprecated: Use PageUserClientConfigReq.ProtoReflect.Descriptor instead.
+func (*PageUserClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetUserEmojiResp) GetEmojiWidth() int32 {
+func (x *PageUserClientConfigReq) GetUserID() string {
 	if x != nil {
-		return x.EmojiWidth
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-func (x *GetUserEmojiResp) GetEmojiHeight() int32 {
+func (x *PageUserClientConfigReq) GetKey() string {
 	if x != nil {
-		return x.EmojiHeight
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *GetUserEmojiResp) GetEmojiSize() int64 {
+func (x *PageUserClientConfigReq) GetPagination() *sdkws.RequestPagination {
 	if x != nil {
-		return x.EmojiSize
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (x *GetUserEmojiResp) GetDuration() int64 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
+type PageUserClientConfigResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Configs       []*ClientConfig        `protobuf:"bytes,2,rep,name=configs,proto3" json:"configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserEmojiResp) GetCategory() string {
-	if x != nil {
-		return x.Category
+func (x *PageUserClientConfigResp) Reset() {
+	*x = PageUserClientConfigResp{}
+	mi := &file_user_user_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageUserClientConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageUserClientConfigResp) ProtoMessage() {}
+
+func (x *PageUserClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageUserClientConfigResp.ProtoReflect.Descriptor instead.
+func (*PageUserClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *PageUserClientConfigResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PageUserClientConfigResp) GetConfigs() []*ClientConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type ClientConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	UserID        string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientConfig) Reset() {
+	*x = ClientConfig{}
+	mi := &file_user_user_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientConfig) ProtoMessage() {}
+
+func (x *ClientConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientConfig.ProtoReflect.Descriptor instead.
+func (*ClientConfig) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ClientConfig) GetKey() string {
+	if x != nil {
+		return x.Key
 	}
 	return ""
 }
 
-func (x *GetUserEmojiResp) GetThumbnailURL() string {
+func (x *ClientConfig) GetUserID() string {
 	if x != nil {
-		return x.ThumbnailURL
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *GetUserEmojiResp) GetIsAnimated() bool {
+func (x *ClientConfig) GetValue() string {
 	if x != nil {
-		return x.IsAnimated
+		return x.Value
 	}
-	return false
+	return ""
 }
 
-func (x *GetUserEmojiResp) GetCreateTime() int64 {
+// clientConfigSchema 客户端配置项定义，只有注册过的 key 可以通过带类型的接口读写
+type ClientConfigSchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ValueType     int32                  `protobuf:"varint,2,opt,name=valueType,proto3" json:"valueType,omitempty"`      // 值类型 constant.ClientConfigType*
+	DefaultValue  string                 `protobuf:"bytes,3,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"` // 默认值，按 valueType 编码
+	Scope         int32                  `protobuf:"varint,4,opt,name=scope,proto3" json:"scope,omitempty"`              // 作用域 constant.ClientConfigScope*
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	UpdateTime    int64                  `protobuf:"varint,6,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientConfigSchema) Reset() {
+	*x = ClientConfigSchema{}
+	mi := &file_user_user_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientConfigSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientConfigSchema) ProtoMessage() {}
+
+func (x *ClientConfigSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[89]
 	if x != nil {
-		return x.CreateTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientConfigSchema.ProtoReflect.Descriptor instead.
+func (*ClientConfigSchema) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ClientConfigSchema) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ClientConfigSchema) GetValueType() int32 {
+	if x != nil {
+		return x.ValueType
+	}
+	return 0
+}
+
+func (x *ClientConfigSchema) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *ClientConfigSchema) GetScope() int32 {
+	if x != nil {
+		return x.Scope
+	}
+	return 0
+}
+
+func (x *ClientConfigSchema) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ClientConfigSchema) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
 	}
 	return 0
 }
 
-func (x *GetUserEmojiResp) GetUpdateTime() int64 {
+type RegisterClientConfigSchemasReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schemas       []*ClientConfigSchema  `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterClientConfigSchemasReq) Reset() {
+	*x = RegisterClientConfigSchemasReq{}
+	mi := &file_user_user_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterClientConfigSchemasReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterClientConfigSchemasReq) ProtoMessage() {}
+
+func (x *RegisterClientConfigSchemasReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterClientConfigSchemasReq.ProtoReflect.Descriptor instead.
+func (*RegisterClientConfigSchemasReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RegisterClientConfigSchemasReq) GetSchemas() []*ClientConfigSchema {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+type RegisterClientConfigSchemasResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterClientConfigSchemasResp) Reset() {
+	*x = RegisterClientConfigSchemasResp{}
+	mi := &file_user_user_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterClientConfigSchemasResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterClientConfigSchemasResp) ProtoMessage() {}
+
+func (x *RegisterClientConfigSchemasResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterClientConfigSchemasResp.ProtoReflect.Descriptor instead.
+func (*RegisterClientConfigSchemasResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{91}
+}
+
+type GetClientConfigSchemasReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []string               `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"` // 为空时返回全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientConfigSchemasReq) Reset() {
+	*x = GetClientConfigSchemasReq{}
+	mi := &file_user_user_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientConfigSchemasReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientConfigSchemasReq) ProtoMessage() {}
+
+func (x *GetClientConfigSchemasReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientConfigSchemasReq.ProtoReflect.Descriptor instead.
+func (*GetClientConfigSchemasReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetClientConfigSchemasReq) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type GetClientConfigSchemasResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schemas       []*ClientConfigSchema  `protobuf:"bytes,1,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientConfigSchemasResp) Reset() {
+	*x = GetClientConfigSchemasResp{}
+	mi := &file_user_user_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientConfigSchemasResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientConfigSchemasResp) ProtoMessage() {}
+
+func (x *GetClientConfigSchemasResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientConfigSchemasResp.ProtoReflect.Descriptor instead.
+func (*GetClientConfigSchemasResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetClientConfigSchemasResp) GetSchemas() []*ClientConfigSchema {
+	if x != nil {
+		return x.Schemas
+	}
+	return nil
+}
+
+// typedClientConfig 用户的配置值，platformID/deviceID 按 key 的作用域填写
+type TypedClientConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // 按 valueType 编码的值
+	ValueType     int32                  `protobuf:"varint,3,opt,name=valueType,proto3" json:"valueType,omitempty"`
+	Scope         int32                  `protobuf:"varint,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	PlatformID    int32                  `protobuf:"varint,5,opt,name=platformID,proto3" json:"platformID,omitempty"` // scope=platform 时有效
+	DeviceID      string                 `protobuf:"bytes,6,opt,name=deviceID,proto3" json:"deviceID,omitempty"`      // scope=device 时有效
+	Version       uint64                 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`       // 该值的版本，每次修改递增
+	UpdateTime    int64                  `protobuf:"varint,8,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,9,opt,name=isDefault,proto3" json:"isDefault,omitempty"` // 用户未设置，返回的是默认值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypedClientConfig) Reset() {
+	*x = TypedClientConfig{}
+	mi := &file_user_user_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypedClientConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypedClientConfig) ProtoMessage() {}
+
+func (x *TypedClientConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypedClientConfig.ProtoReflect.Descriptor instead.
+func (*TypedClientConfig) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *TypedClientConfig) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TypedClientConfig) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TypedClientConfig) GetValueType() int32 {
+	if x != nil {
+		return x.ValueType
+	}
+	return 0
+}
+
+func (x *TypedClientConfig) GetScope() int32 {
+	if x != nil {
+		return x.Scope
+	}
+	return 0
+}
+
+func (x *TypedClientConfig) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *TypedClientConfig) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *TypedClientConfig) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TypedClientConfig) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *TypedClientConfig) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type GetTypedClientConfigReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlatformID    int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID,omitempty"`
+	DeviceID      string                 `protobuf:"bytes,3,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	Keys          []string               `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"` // 为空时返回全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTypedClientConfigReq) Reset() {
+	*x = GetTypedClientConfigReq{}
+	mi := &file_user_user_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTypedClientConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTypedClientConfigReq) ProtoMessage() {}
+
+func (x *GetTypedClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTypedClientConfigReq.ProtoReflect.Descriptor instead.
+func (*GetTypedClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetTypedClientConfigReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetTypedClientConfigReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *GetTypedClientConfigReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *GetTypedClientConfigReq) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type GetTypedClientConfigResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*TypedClientConfig   `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTypedClientConfigResp) Reset() {
+	*x = GetTypedClientConfigResp{}
+	mi := &file_user_user_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTypedClientConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTypedClientConfigResp) ProtoMessage() {}
+
+func (x *GetTypedClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTypedClientConfigResp.ProtoReflect.Descriptor instead.
+func (*GetTypedClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetTypedClientConfigResp) GetConfigs() []*TypedClientConfig {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+// setTypedClientConfigReq 设置配置值，ifVersion 不为空时仅当当前版本等于 ifVersion 才写入（0 表示尚未设置）
+type SetTypedClientConfigReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	PlatformID    int32                  `protobuf:"varint,4,opt,name=platformID,proto3" json:"platformID,omitempty"`
+	DeviceID      string                 `protobuf:"bytes,5,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	IfVersion     *uint64                `protobuf:"varint,6,opt,name=ifVersion,proto3,oneof" json:"ifVersion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTypedClientConfigReq) Reset() {
+	*x = SetTypedClientConfigReq{}
+	mi := &file_user_user_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTypedClientConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTypedClientConfigReq) ProtoMessage() {}
+
+func (x *SetTypedClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTypedClientConfigReq.ProtoReflect.Descriptor instead.
+func (*SetTypedClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SetTypedClientConfigReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetTypedClientConfigReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetTypedClientConfigReq) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SetTypedClientConfigReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *SetTypedClientConfigReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *SetTypedClientConfigReq) GetIfVersion() uint64 {
+	if x != nil && x.IfVersion != nil {
+		return *x.IfVersion
+	}
+	return 0
+}
+
+type SetTypedClientConfigResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *TypedClientConfig     `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"` // 写入后的值；版本冲突时返回错误
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTypedClientConfigResp) Reset() {
+	*x = SetTypedClientConfigResp{}
+	mi := &file_user_user_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTypedClientConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTypedClientConfigResp) ProtoMessage() {}
+
+func (x *SetTypedClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTypedClientConfigResp.ProtoReflect.Descriptor instead.
+func (*SetTypedClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SetTypedClientConfigResp) GetConfig() *TypedClientConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type DelTypedClientConfigReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	PlatformID    int32                  `protobuf:"varint,3,opt,name=platformID,proto3" json:"platformID,omitempty"`
+	DeviceID      string                 `protobuf:"bytes,4,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	IfVersion     *uint64                `protobuf:"varint,5,opt,name=ifVersion,proto3,oneof" json:"ifVersion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DelTypedClientConfigReq) Reset() {
+	*x = DelTypedClientConfigReq{}
+	mi := &file_user_user_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelTypedClientConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelTypedClientConfigReq) ProtoMessage() {}
+
+func (x *DelTypedClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelTypedClientConfigReq.ProtoReflect.Descriptor instead.
+func (*DelTypedClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DelTypedClientConfigReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DelTypedClientConfigReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DelTypedClientConfigReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *DelTypedClientConfigReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *DelTypedClientConfigReq) GetIfVersion() uint64 {
+	if x != nil && x.IfVersion != nil {
+		return *x.IfVersion
+	}
+	return 0
+}
+
+type DelTypedClientConfigResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DelTypedClientConfigResp) Reset() {
+	*x = DelTypedClientConfigResp{}
+	mi := &file_user_user_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelTypedClientConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelTypedClientConfigResp) ProtoMessage() {}
+
+func (x *DelTypedClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelTypedClientConfigResp.ProtoReflect.Descriptor instead.
+func (*DelTypedClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{100}
+}
+
+type GetIncrementalClientConfigReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	VersionID     string                 `protobuf:"bytes,2,opt,name=versionID,proto3" json:"versionID,omitempty"`
+	Version       uint64                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	PlatformID    int32                  `protobuf:"varint,4,opt,name=platformID,proto3" json:"platformID,omitempty"`
+	DeviceID      string                 `protobuf:"bytes,5,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncrementalClientConfigReq) Reset() {
+	*x = GetIncrementalClientConfigReq{}
+	mi := &file_user_user_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncrementalClientConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncrementalClientConfigReq) ProtoMessage() {}
+
+func (x *GetIncrementalClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncrementalClientConfigReq.ProtoReflect.Descriptor instead.
+func (*GetIncrementalClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetIncrementalClientConfigReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetIncrementalClientConfigReq) GetVersionID() string {
+	if x != nil {
+		return x.VersionID
+	}
+	return ""
+}
+
+func (x *GetIncrementalClientConfigReq) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetIncrementalClientConfigReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+func (x *GetIncrementalClientConfigReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+type GetIncrementalClientConfigResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       uint64                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	VersionID     string                 `protobuf:"bytes,2,opt,name=versionID,proto3" json:"versionID,omitempty"`
+	Full          bool                   `protobuf:"varint,3,opt,name=full,proto3" json:"full,omitempty"`
+	Delete        []string               `protobuf:"bytes,4,rep,name=delete,proto3" json:"delete,omitempty"`
+	Insert        []*TypedClientConfig   `protobuf:"bytes,5,rep,name=insert,proto3" json:"insert,omitempty"`
+	Update        []*TypedClientConfig   `protobuf:"bytes,6,rep,name=update,proto3" json:"update,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncrementalClientConfigResp) Reset() {
+	*x = GetIncrementalClientConfigResp{}
+	mi := &file_user_user_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncrementalClientConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncrementalClientConfigResp) ProtoMessage() {}
+
+func (x *GetIncrementalClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncrementalClientConfigResp.ProtoReflect.Descriptor instead.
+func (*GetIncrementalClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetIncrementalClientConfigResp) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetIncrementalClientConfigResp) GetVersionID() string {
+	if x != nil {
+		return x.VersionID
+	}
+	return ""
+}
+
+func (x *GetIncrementalClientConfigResp) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+func (x *GetIncrementalClientConfigResp) GetDelete() []string {
+	if x != nil {
+		return x.Delete
+	}
+	return nil
+}
+
+func (x *GetIncrementalClientConfigResp) GetInsert() []*TypedClientConfig {
+	if x != nil {
+		return x.Insert
+	}
+	return nil
+}
+
+func (x *GetIncrementalClientConfigResp) GetUpdate() []*TypedClientConfig {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+type SaveUserEmojiReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmojiID       string                 `protobuf:"bytes,1,opt,name=emojiID,proto3" json:"emojiID,omitempty"`
+	EmojiName     string                 `protobuf:"bytes,2,opt,name=emojiName,proto3" json:"emojiName,omitempty"`
+	EmojiType     int32                  `protobuf:"varint,3,opt,name=emojiType,proto3" json:"emojiType,omitempty"`
+	EmojiURL      string                 `protobuf:"bytes,4,opt,name=emojiURL,proto3" json:"emojiURL,omitempty"`
+	EmojiPath     string                 `protobuf:"bytes,5,opt,name=emojiPath,proto3" json:"emojiPath,omitempty"`
+	EmojiWidth    int32                  `protobuf:"varint,6,opt,name=emojiWidth,proto3" json:"emojiWidth,omitempty"`
+	EmojiHeight   int32                  `protobuf:"varint,7,opt,name=emojiHeight,proto3" json:"emojiHeight,omitempty"`
+	EmojiSize     int64                  `protobuf:"varint,8,opt,name=emojiSize,proto3" json:"emojiSize,omitempty"`
+	Duration      int64                  `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
+	Category      string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
+	ThumbnailURL  string                 `protobuf:"bytes,11,opt,name=thumbnailURL,proto3" json:"thumbnailURL,omitempty"`
+	IsAnimated    bool                   `protobuf:"varint,12,opt,name=isAnimated,proto3" json:"isAnimated,omitempty"`
+	Ex            string                 `protobuf:"bytes,13,opt,name=ex,proto3" json:"ex,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveUserEmojiReq) Reset() {
+	*x = SaveUserEmojiReq{}
+	mi := &file_user_user_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveUserEmojiReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveUserEmojiReq) ProtoMessage() {}
+
+func (x *SaveUserEmojiReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveUserEmojiReq.ProtoReflect.Descriptor instead.
+func (*SaveUserEmojiReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SaveUserEmojiReq) GetEmojiID() string {
+	if x != nil {
+		return x.EmojiID
+	}
+	return ""
+}
+
+func (x *SaveUserEmojiReq) GetEmojiName() string {
+	if x != nil {
+		return x.EmojiName
+	}
+	return ""
+}
+
+func (x *SaveUserEmojiReq) GetEmojiType() int32 {
+	if x != nil {
+		return x.EmojiType
+	}
+	return 0
+}
+
+func (x *SaveUserEmojiReq) GetEmojiURL() string {
+	if x != nil {
+		return x.EmojiURL
+	}
+	return ""
+}
+
+func (x *SaveUserEmojiReq) GetEmojiPath() string {
+	if x != nil {
+		return x.EmojiPath
+	}
+	return ""
+}
+
+func (x *SaveUserEmojiReq) GetEmojiWidth() int32 {
+	if x != nil {
+		return x.EmojiWidth
+	}
+	return 0
+}
+
+func (x *SaveUserEmojiReq) GetEmojiHeight() int32 {
+	if x != nil {
+		return x.EmojiHeight
+	}
+	return 0
+}
+
+func (x *SaveUserEmojiReq) GetEmojiSize() int64 {
+	if x != nil {
+		return x.EmojiSize
+	}
+	return 0
+}
+
+func (x *SaveUserEmojiReq) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *SaveUserEmojiReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SaveUserEmojiReq) GetThumbnailURL() string {
+	if x != nil {
+		return x.ThumbnailURL
+	}
+	return ""
+}
+
+func (x *SaveUserEmojiReq) GetIsAnimated() bool {
+	if x != nil {
+		return x.IsAnimated
+	}
+	return false
+}
+
+func (x *SaveUserEmojiReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type SaveUserEmojiResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveUserEmojiResp) Reset() {
+	*x = SaveUserEmojiResp{}
+	mi := &file_user_user_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveUserEmojiResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveUserEmojiResp) ProtoMessage() {}
+
+func (x *SaveUserEmojiResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveUserEmojiResp.ProtoReflect.Descriptor instead.
+func (*SaveUserEmojiResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{104}
+}
+
+type DeleteUserEmojiReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmojiID       string                 `protobuf:"bytes,1,opt,name=emojiID,proto3" json:"emojiID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserEmojiReq) Reset() {
+	*x = DeleteUserEmojiReq{}
+	mi := &file_user_user_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserEmojiReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserEmojiReq) ProtoMessage() {}
+
+func (x *DeleteUserEmojiReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserEmojiReq.ProtoReflect.Descriptor instead.
+func (*DeleteUserEmojiReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DeleteUserEmojiReq) GetEmojiID() string {
+	if x != nil {
+		return x.EmojiID
+	}
+	return ""
+}
+
+type DeleteUserEmojiResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserEmojiResp) Reset() {
+	*x = DeleteUserEmojiResp{}
+	mi := &file_user_user_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserEmojiResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserEmojiResp) ProtoMessage() {}
+
+func (x *DeleteUserEmojiResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserEmojiResp.ProtoReflect.Descriptor instead.
+func (*DeleteUserEmojiResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{106}
+}
+
+type GetUserEmojiReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmojiID       string                 `protobuf:"bytes,1,opt,name=emojiID,proto3" json:"emojiID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserEmojiReq) Reset() {
+	*x = GetUserEmojiReq{}
+	mi := &file_user_user_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserEmojiReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserEmojiReq) ProtoMessage() {}
+
+func (x *GetUserEmojiReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserEmojiReq.ProtoReflect.Descriptor instead.
+func (*GetUserEmojiReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetUserEmojiReq) GetEmojiID() string {
+	if x != nil {
+		return x.EmojiID
+	}
+	return ""
+}
+
+type GetUserEmojiResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmojiID       string                 `protobuf:"bytes,1,opt,name=emojiID,proto3" json:"emojiID,omitempty"`
+	EmojiName     string                 `protobuf:"bytes,2,opt,name=emojiName,proto3" json:"emojiName,omitempty"`
+	EmojiType     int32                  `protobuf:"varint,3,opt,name=emojiType,proto3" json:"emojiType,omitempty"`
+	EmojiURL      string                 `protobuf:"bytes,4,opt,name=emojiURL,proto3" json:"emojiURL,omitempty"`
+	EmojiPath     string                 `protobuf:"bytes,5,opt,name=emojiPath,proto3" json:"emojiPath,omitempty"`
+	EmojiWidth    int32                  `protobuf:"varint,6,opt,name=emojiWidth,proto3" json:"emojiWidth,omitempty"`
+	EmojiHeight   int32                  `protobuf:"varint,7,opt,name=emojiHeight,proto3" json:"emojiHeight,omitempty"`
+	EmojiSize     int64                  `protobuf:"varint,8,opt,name=emojiSize,proto3" json:"emojiSize,omitempty"`
+	Duration      int64                  `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
+	Category      string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`
+	ThumbnailURL  string                 `protobuf:"bytes,11,opt,name=thumbnailURL,proto3" json:"thumbnailURL,omitempty"`
+	IsAnimated    bool                   `protobuf:"varint,12,opt,name=isAnimated,proto3" json:"isAnimated,omitempty"`
+	CreateTime    int64                  `protobuf:"varint,13,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	UpdateTime    int64                  `protobuf:"varint,14,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+	Ex            string                 `protobuf:"bytes,15,opt,name=ex,proto3" json:"ex,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserEmojiResp) Reset() {
+	*x = GetUserEmojiResp{}
+	mi := &file_user_user_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserEmojiResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserEmojiResp) ProtoMessage() {}
+
+func (x *GetUserEmojiResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserEmojiResp.ProtoReflect.Descriptor instead.
+func (*GetUserEmojiResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetUserEmojiResp) GetEmojiID() string {
+	if x != nil {
+		return x.EmojiID
+	}
+	return ""
+}
+
+func (x *GetUserEmojiResp) GetEmojiName() string {
+	if x != nil {
+		return x.EmojiName
+	}
+	return ""
+}
+
+func (x *GetUserEmojiResp) GetEmojiType() int32 {
+	if x != nil {
+		return x.EmojiType
+	}
+	return 0
+}
+
+func (x *GetUserEmojiResp) GetEmojiURL() string {
+	if x != nil {
+		return x.EmojiURL
+	}
+	return ""
+}
+
+func (x *GetUserEmojiResp) GetEmojiPath() string {
+	if x != nil {
+		return x.EmojiPath
+	}
+	return ""
+}
+
+func (x *GetUserEmojiResp) GetEmojiWidth() int32 {
+	if x != nil {
+		return x.EmojiWidth
+	}
+	return 0
+}
+
+func (x *GetUserEmojiResp) GetEmojiHeight() int32 {
+	if x != nil {
+		return x.EmojiHeight
+	}
+	return 0
+}
+
+func (x *GetUserEmojiResp) GetEmojiSize() int64 {
+	if x != nil {
+		return x.EmojiSize
+	}
+	return 0
+}
+
+func (x *GetUserEmojiResp) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *GetUserEmojiResp) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GetUserEmojiResp) GetThumbnailURL() string {
+	if x != nil {
+		return x.ThumbnailURL
+	}
+	return ""
+}
+
+func (x *GetUserEmojiResp) GetIsAnimated() bool {
+	if x != nil {
+		return x.IsAnimated
+	}
+	return false
+}
+
+func (x *GetUserEmojiResp) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *GetUserEmojiResp) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *GetUserEmojiResp) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type GetAllUserEmojisReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllUserEmojisReq) Reset() {
+	*x = GetAllUserEmojisReq{}
+	mi := &file_user_user_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllUserEmojisReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllUserEmojisReq) ProtoMessage() {}
+
+func (x *GetAllUserEmojisReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllUserEmojisReq.ProtoReflect.Descriptor instead.
+func (*GetAllUserEmojisReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetAllUserEmojisReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetAllUserEmojisResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emojis        []*GetUserEmojiResp    `protobuf:"bytes,1,rep,name=emojis,proto3" json:"emojis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllUserEmojisResp) Reset() {
+	*x = GetAllUserEmojisResp{}
+	mi := &file_user_user_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllUserEmojisResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllUserEmojisResp) ProtoMessage() {}
+
+func (x *GetAllUserEmojisResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllUserEmojisResp.ProtoReflect.Descriptor instead.
+func (*GetAllUserEmojisResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetAllUserEmojisResp) GetEmojis() []*GetUserEmojiResp {
+	if x != nil {
+		return x.Emojis
+	}
+	return nil
+}
+
+// emojiPack 表情包，按 emojiIDs 顺序展示
+type EmojiPack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackID        string                 `protobuf:"bytes,1,opt,name=packID,proto3" json:"packID,omitempty"`
+	OwnerUserID   string                 `protobuf:"bytes,2,opt,name=ownerUserID,proto3" json:"ownerUserID,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CoverURL      string                 `protobuf:"bytes,4,opt,name=coverURL,proto3" json:"coverURL,omitempty"`
+	EmojiIDs      []string               `protobuf:"bytes,5,rep,name=emojiIDs,proto3" json:"emojiIDs,omitempty"`         // 包内表情，按显示顺序
+	Sort          int32                  `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`                // 在用户表情包列表中的位置
+	SourcePackID  string                 `protobuf:"bytes,7,opt,name=sourcePackID,proto3" json:"sourcePackID,omitempty"` // 从他人分享保存时的来源表情包ID
+	SourceUserID  string                 `protobuf:"bytes,8,opt,name=sourceUserID,proto3" json:"sourceUserID,omitempty"` // 来源表情包的所有者
+	CreateTime    int64                  `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	UpdateTime    int64                  `protobuf:"varint,10,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+	Ex            string                 `protobuf:"bytes,11,opt,name=ex,proto3" json:"ex,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmojiPack) Reset() {
+	*x = EmojiPack{}
+	mi := &file_user_user_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmojiPack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmojiPack) ProtoMessage() {}
+
+func (x *EmojiPack) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmojiPack.ProtoReflect.Descriptor instead.
+func (*EmojiPack) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *EmojiPack) GetPackID() string {
+	if x != nil {
+		return x.PackID
+	}
+	return ""
+}
+
+func (x *EmojiPack) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *EmojiPack) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmojiPack) GetCoverURL() string {
+	if x != nil {
+		return x.CoverURL
+	}
+	return ""
+}
+
+func (x *EmojiPack) GetEmojiIDs() []string {
+	if x != nil {
+		return x.EmojiIDs
+	}
+	return nil
+}
+
+func (x *EmojiPack) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *EmojiPack) GetSourcePackID() string {
+	if x != nil {
+		return x.SourcePackID
+	}
+	return ""
+}
+
+func (x *EmojiPack) GetSourceUserID() string {
+	if x != nil {
+		return x.SourceUserID
+	}
+	return ""
+}
+
+func (x *EmojiPack) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *EmojiPack) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *EmojiPack) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type CreateEmojiPackReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CoverURL      string                 `protobuf:"bytes,3,opt,name=coverURL,proto3" json:"coverURL,omitempty"`
+	EmojiIDs      []string               `protobuf:"bytes,4,rep,name=emojiIDs,proto3" json:"emojiIDs,omitempty"` // 已保存的表情
+	Ex            string                 `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmojiPackReq) Reset() {
+	*x = CreateEmojiPackReq{}
+	mi := &file_user_user_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmojiPackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmojiPackReq) ProtoMessage() {}
+
+func (x *CreateEmojiPackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmojiPackReq.ProtoReflect.Descriptor instead.
+func (*CreateEmojiPackReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *CreateEmojiPackReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreateEmojiPackReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEmojiPackReq) GetCoverURL() string {
+	if x != nil {
+		return x.CoverURL
+	}
+	return ""
+}
+
+func (x *CreateEmojiPackReq) GetEmojiIDs() []string {
+	if x != nil {
+		return x.EmojiIDs
+	}
+	return nil
+}
+
+func (x *CreateEmojiPackReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type CreateEmojiPackResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pack          *EmojiPack             `protobuf:"bytes,1,opt,name=pack,proto3" json:"pack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmojiPackResp) Reset() {
+	*x = CreateEmojiPackResp{}
+	mi := &file_user_user_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmojiPackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmojiPackResp) ProtoMessage() {}
+
+func (x *CreateEmojiPackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmojiPackResp.ProtoReflect.Descriptor instead.
+func (*CreateEmojiPackResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CreateEmojiPackResp) GetPack() *EmojiPack {
+	if x != nil {
+		return x.Pack
+	}
+	return nil
+}
+
+type UpdateEmojiPackReq struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	UserID        string                  `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PackID        string                  `protobuf:"bytes,2,opt,name=packID,proto3" json:"packID,omitempty"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CoverURL      *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=coverURL,proto3" json:"coverURL,omitempty"`
+	Ex            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmojiPackReq) Reset() {
+	*x = UpdateEmojiPackReq{}
+	mi := &file_user_user_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmojiPackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmojiPackReq) ProtoMessage() {}
+
+func (x *UpdateEmojiPackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmojiPackReq.ProtoReflect.Descriptor instead.
+func (*UpdateEmojiPackReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *UpdateEmojiPackReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UpdateEmojiPackReq) GetPackID() string {
+	if x != nil {
+		return x.PackID
+	}
+	return ""
+}
+
+func (x *UpdateEmojiPackReq) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateEmojiPackReq) GetCoverURL() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CoverURL
+	}
+	return nil
+}
+
+func (x *UpdateEmojiPackReq) GetEx() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Ex
+	}
+	return nil
+}
+
+type UpdateEmojiPackResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmojiPackResp) Reset() {
+	*x = UpdateEmojiPackResp{}
+	mi := &file_user_user_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmojiPackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmojiPackResp) ProtoMessage() {}
+
+func (x *UpdateEmojiPackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmojiPackResp.ProtoReflect.Descriptor instead.
+func (*UpdateEmojiPackResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{115}
+}
+
+type DeleteEmojiPackReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PackID        string                 `protobuf:"bytes,2,opt,name=packID,proto3" json:"packID,omitempty"`
+	DeleteEmojis  bool                   `protobuf:"varint,3,opt,name=deleteEmojis,proto3" json:"deleteEmojis,omitempty"` // 同时删除包内表情（不删除仍被其他表情包引用的表情）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmojiPackReq) Reset() {
+	*x = DeleteEmojiPackReq{}
+	mi := &file_user_user_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmojiPackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmojiPackReq) ProtoMessage() {}
+
+func (x *DeleteEmojiPackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmojiPackReq.ProtoReflect.Descriptor instead.
+func (*DeleteEmojiPackReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *DeleteEmojiPackReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DeleteEmojiPackReq) GetPackID() string {
+	if x != nil {
+		return x.PackID
+	}
+	return ""
+}
+
+func (x *DeleteEmojiPackReq) GetDeleteEmojis() bool {
+	if x != nil {
+		return x.DeleteEmojis
+	}
+	return false
+}
+
+type DeleteEmojiPackResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmojiPackResp) Reset() {
+	*x = DeleteEmojiPackResp{}
+	mi := &file_user_user_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmojiPackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmojiPackResp) ProtoMessage() {}
+
+func (x *DeleteEmojiPackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmojiPackResp.ProtoReflect.Descriptor instead.
+func (*DeleteEmojiPackResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{117}
+}
+
+// sortEmojiPacksReq 按 packIDs 的顺序重排用户的表情包
+type SortEmojiPacksReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PackIDs       []string               `protobuf:"bytes,2,rep,name=packIDs,proto3" json:"packIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortEmojiPacksReq) Reset() {
+	*x = SortEmojiPacksReq{}
+	mi := &file_user_user_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortEmojiPacksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortEmojiPacksReq) ProtoMessage() {}
+
+func (x *SortEmojiPacksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortEmojiPacksReq.ProtoReflect.Descriptor instead.
+func (*SortEmojiPacksReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *SortEmojiPacksReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SortEmojiPacksReq) GetPackIDs() []string {
+	if x != nil {
+		return x.PackIDs
+	}
+	return nil
+}
+
+type SortEmojiPacksResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortEmojiPacksResp) Reset() {
+	*x = SortEmojiPacksResp{}
+	mi := &file_user_user_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortEmojiPacksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortEmojiPacksResp) ProtoMessage() {}
+
+func (x *SortEmojiPacksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortEmojiPacksResp.ProtoReflect.Descriptor instead.
+func (*SortEmojiPacksResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{119}
+}
+
+type AddEmojisToPackReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PackID        string                 `protobuf:"bytes,2,opt,name=packID,proto3" json:"packID,omitempty"`
+	EmojiIDs      []string               `protobuf:"bytes,3,rep,name=emojiIDs,proto3" json:"emojiIDs,omitempty"` // 追加到末尾
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEmojisToPackReq) Reset() {
+	*x = AddEmojisToPackReq{}
+	mi := &file_user_user_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEmojisToPackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEmojisToPackReq) ProtoMessage() {}
+
+func (x *AddEmojisToPackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEmojisToPackReq.ProtoReflect.Descriptor instead.
+func (*AddEmojisToPackReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *AddEmojisToPackReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AddEmojisToPackReq) GetPackID() string {
+	if x != nil {
+		return x.PackID
+	}
+	return ""
+}
+
+func (x *AddEmojisToPackReq) GetEmojiIDs() []string {
+	if x != nil {
+		return x.EmojiIDs
+	}
+	return nil
+}
+
+type AddEmojisToPackResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddEmojisToPackResp) Reset() {
+	*x = AddEmojisToPackResp{}
+	mi := &file_user_user_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddEmojisToPackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEmojisToPackResp) ProtoMessage() {}
+
+func (x *AddEmojisToPackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEmojisToPackResp.ProtoReflect.Descriptor instead.
+func (*AddEmojisToPackResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{121}
+}
+
+type RemoveEmojisFromPackReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PackID        string                 `protobuf:"bytes,2,opt,name=packID,proto3" json:"packID,omitempty"`
+	EmojiIDs      []string               `protobuf:"bytes,3,rep,name=emojiIDs,proto3" json:"emojiIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEmojisFromPackReq) Reset() {
+	*x = RemoveEmojisFromPackReq{}
+	mi := &file_user_user_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEmojisFromPackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEmojisFromPackReq) ProtoMessage() {}
+
+func (x *RemoveEmojisFromPackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEmojisFromPackReq.ProtoReflect.Descriptor instead.
+func (*RemoveEmojisFromPackReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *RemoveEmojisFromPackReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RemoveEmojisFromPackReq) GetPackID() string {
+	if x != nil {
+		return x.PackID
+	}
+	return ""
+}
+
+func (x *RemoveEmojisFromPackReq) GetEmojiIDs() []string {
+	if x != nil {
+		return x.EmojiIDs
+	}
+	return nil
+}
+
+type RemoveEmojisFromPackResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEmojisFromPackResp) Reset() {
+	*x = RemoveEmojisFromPackResp{}
+	mi := &file_user_user_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEmojisFromPackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEmojisFromPackResp) ProtoMessage() {}
+
+func (x *RemoveEmojisFromPackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEmojisFromPackResp.ProtoReflect.Descriptor instead.
+func (*RemoveEmojisFromPackResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{123}
+}
+
+// sortPackEmojisReq 按 emojiIDs 的顺序重排包内表情，需包含包内全部表情
+type SortPackEmojisReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PackID        string                 `protobuf:"bytes,2,opt,name=packID,proto3" json:"packID,omitempty"`
+	EmojiIDs      []string               `protobuf:"bytes,3,rep,name=emojiIDs,proto3" json:"emojiIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortPackEmojisReq) Reset() {
+	*x = SortPackEmojisReq{}
+	mi := &file_user_user_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortPackEmojisReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortPackEmojisReq) ProtoMessage() {}
+
+func (x *SortPackEmojisReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortPackEmojisReq.ProtoReflect.Descriptor instead.
+func (*SortPackEmojisReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SortPackEmojisReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SortPackEmojisReq) GetPackID() string {
+	if x != nil {
+		return x.PackID
+	}
+	return ""
+}
+
+func (x *SortPackEmojisReq) GetEmojiIDs() []string {
+	if x != nil {
+		return x.EmojiIDs
+	}
+	return nil
+}
+
+type SortPackEmojisResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortPackEmojisResp) Reset() {
+	*x = SortPackEmojisResp{}
+	mi := &file_user_user_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortPackEmojisResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortPackEmojisResp) ProtoMessage() {}
+
+func (x *SortPackEmojisResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortPackEmojisResp.ProtoReflect.Descriptor instead.
+func (*SortPackEmojisResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{125}
+}
+
+type GetEmojiPacksReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PackIDs       []string               `protobuf:"bytes,2,rep,name=packIDs,proto3" json:"packIDs,omitempty"` // 为空时返回用户全部表情包
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmojiPacksReq) Reset() {
+	*x = GetEmojiPacksReq{}
+	mi := &file_user_user_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmojiPacksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmojiPacksReq) ProtoMessage() {}
+
+func (x *GetEmojiPacksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmojiPacksReq.ProtoReflect.Descriptor instead.
+func (*GetEmojiPacksReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetEmojiPacksReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetEmojiPacksReq) GetPackIDs() []string {
+	if x != nil {
+		return x.PackIDs
+	}
+	return nil
+}
+
+type GetEmojiPacksResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Packs         []*EmojiPack           `protobuf:"bytes,1,rep,name=packs,proto3" json:"packs,omitempty"`
+	Emojis        []*GetUserEmojiResp    `protobuf:"bytes,2,rep,name=emojis,proto3" json:"emojis,omitempty"` // 包内引用的表情详情
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmojiPacksResp) Reset() {
+	*x = GetEmojiPacksResp{}
+	mi := &file_user_user_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmojiPacksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmojiPacksResp) ProtoMessage() {}
+
+func (x *GetEmojiPacksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmojiPacksResp.ProtoReflect.Descriptor instead.
+func (*GetEmojiPacksResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetEmojiPacksResp) GetPacks() []*EmojiPack {
+	if x != nil {
+		return x.Packs
+	}
+	return nil
+}
+
+func (x *GetEmojiPacksResp) GetEmojis() []*GetUserEmojiResp {
+	if x != nil {
+		return x.Emojis
+	}
+	return nil
+}
+
+// shareEmojiPackReq 以卡片消息（constant.EmojiPackShareMessage）分享表情包给用户或群
+type ShareEmojiPackReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PackID        string                 `protobuf:"bytes,2,opt,name=packID,proto3" json:"packID,omitempty"`
+	RecvUserIDs   []string               `protobuf:"bytes,3,rep,name=recvUserIDs,proto3" json:"recvUserIDs,omitempty"`
+	GroupIDs      []string               `protobuf:"bytes,4,rep,name=groupIDs,proto3" json:"groupIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareEmojiPackReq) Reset() {
+	*x = ShareEmojiPackReq{}
+	mi := &file_user_user_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareEmojiPackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareEmojiPackReq) ProtoMessage() {}
+
+func (x *ShareEmojiPackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareEmojiPackReq.ProtoReflect.Descriptor instead.
+func (*ShareEmojiPackReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ShareEmojiPackReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ShareEmojiPackReq) GetPackID() string {
+	if x != nil {
+		return x.PackID
+	}
+	return ""
+}
+
+func (x *ShareEmojiPackReq) GetRecvUserIDs() []string {
+	if x != nil {
+		return x.RecvUserIDs
+	}
+	return nil
+}
+
+func (x *ShareEmojiPackReq) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+type ShareEmojiPackResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareID       string                 `protobuf:"bytes,1,opt,name=shareID,proto3" json:"shareID,omitempty"` // 卡片中携带，接收方凭此保存
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareEmojiPackResp) Reset() {
+	*x = ShareEmojiPackResp{}
+	mi := &file_user_user_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareEmojiPackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareEmojiPackResp) ProtoMessage() {}
+
+func (x *ShareEmojiPackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareEmojiPackResp.ProtoReflect.Descriptor instead.
+func (*ShareEmojiPackResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ShareEmojiPackResp) GetShareID() string {
+	if x != nil {
+		return x.ShareID
+	}
+	return ""
+}
+
+// saveSharedEmojiPackReq 接收方保存分享的表情包，表情会复制到接收方名下
+type SaveSharedEmojiPackReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ShareID       string                 `protobuf:"bytes,2,opt,name=shareID,proto3" json:"shareID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSharedEmojiPackReq) Reset() {
+	*x = SaveSharedEmojiPackReq{}
+	mi := &file_user_user_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSharedEmojiPackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSharedEmojiPackReq) ProtoMessage() {}
+
+func (x *SaveSharedEmojiPackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSharedEmojiPackReq.ProtoReflect.Descriptor instead.
+func (*SaveSharedEmojiPackReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SaveSharedEmojiPackReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SaveSharedEmojiPackReq) GetShareID() string {
+	if x != nil {
+		return x.ShareID
+	}
+	return ""
+}
+
+type SaveSharedEmojiPackResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pack          *EmojiPack             `protobuf:"bytes,1,opt,name=pack,proto3" json:"pack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSharedEmojiPackResp) Reset() {
+	*x = SaveSharedEmojiPackResp{}
+	mi := &file_user_user_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSharedEmojiPackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSharedEmojiPackResp) ProtoMessage() {}
+
+func (x *SaveSharedEmojiPackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSharedEmojiPackResp.ProtoReflect.Descriptor instead.
+func (*SaveSharedEmojiPackResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SaveSharedEmojiPackResp) GetPack() *EmojiPack {
+	if x != nil {
+		return x.Pack
+	}
+	return nil
+}
+
+// emojiPackManifestItem 清单中的单个表情，url 为 third 分片上传完成后返回的地址
+type EmojiPackManifestItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"` // zip 内的文件名
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EmojiName     string                 `protobuf:"bytes,3,opt,name=emojiName,proto3" json:"emojiName,omitempty"`
+	EmojiType     int32                  `protobuf:"varint,4,opt,name=emojiType,proto3" json:"emojiType,omitempty"`
+	Width         int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Size          int64                  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	Duration      int64                  `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	IsAnimated    bool                   `protobuf:"varint,9,opt,name=isAnimated,proto3" json:"isAnimated,omitempty"`
+	ThumbnailURL  string                 `protobuf:"bytes,10,opt,name=thumbnailURL,proto3" json:"thumbnailURL,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmojiPackManifestItem) Reset() {
+	*x = EmojiPackManifestItem{}
+	mi := &file_user_user_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmojiPackManifestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmojiPackManifestItem) ProtoMessage() {}
+
+func (x *EmojiPackManifestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmojiPackManifestItem.ProtoReflect.Descriptor instead.
+func (*EmojiPackManifestItem) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *EmojiPackManifestItem) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *EmojiPackManifestItem) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *EmojiPackManifestItem) GetEmojiName() string {
+	if x != nil {
+		return x.EmojiName
+	}
+	return ""
+}
+
+func (x *EmojiPackManifestItem) GetEmojiType() int32 {
+	if x != nil {
+		return x.EmojiType
+	}
+	return 0
+}
+
+func (x *EmojiPackManifestItem) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *EmojiPackManifestItem) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *EmojiPackManifestItem) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *EmojiPackManifestItem) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *EmojiPackManifestItem) GetIsAnimated() bool {
+	if x != nil {
+		return x.IsAnimated
+	}
+	return false
+}
+
+func (x *EmojiPackManifestItem) GetThumbnailURL() string {
+	if x != nil {
+		return x.ThumbnailURL
+	}
+	return ""
+}
+
+// emojiPackManifest 表情包导入/导出清单（对应 zip 中的 manifest.json）
+type EmojiPackManifest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Version       int32                    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // 清单格式版本 constant.EmojiPackManifestVersion
+	Name          string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CoverFileName string                   `protobuf:"bytes,3,opt,name=coverFileName,proto3" json:"coverFileName,omitempty"` // 封面对应的 items.fileName
+	Items         []*EmojiPackManifestItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`                 // 按显示顺序
+	Ex            string                   `protobuf:"bytes,5,opt,name=ex,proto3" json:"ex,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmojiPackManifest) Reset() {
+	*x = EmojiPackManifest{}
+	mi := &file_user_user_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmojiPackManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmojiPackManifest) ProtoMessage() {}
+
+func (x *EmojiPackManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmojiPackManifest.ProtoReflect.Descriptor instead.
+func (*EmojiPackManifest) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *EmojiPackManifest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *EmojiPackManifest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmojiPackManifest) GetCoverFileName() string {
+	if x != nil {
+		return x.CoverFileName
+	}
+	return ""
+}
+
+func (x *EmojiPackManifest) GetItems() []*EmojiPackManifestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *EmojiPackManifest) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type ImportEmojiPackReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Manifest      *EmojiPackManifest     `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEmojiPackReq) Reset() {
+	*x = ImportEmojiPackReq{}
+	mi := &file_user_user_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEmojiPackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEmojiPackReq) ProtoMessage() {}
+
+func (x *ImportEmojiPackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEmojiPackReq.ProtoReflect.Descriptor instead.
+func (*ImportEmojiPackReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ImportEmojiPackReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ImportEmojiPackReq) GetManifest() *EmojiPackManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+type ImportEmojiPackResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pack          *EmojiPack             `protobuf:"bytes,1,opt,name=pack,proto3" json:"pack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEmojiPackResp) Reset() {
+	*x = ImportEmojiPackResp{}
+	mi := &file_user_user_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEmojiPackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEmojiPackResp) ProtoMessage() {}
+
+func (x *ImportEmojiPackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEmojiPackResp.ProtoReflect.Descriptor instead.
+func (*ImportEmojiPackResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ImportEmojiPackResp) GetPack() *EmojiPack {
+	if x != nil {
+		return x.Pack
+	}
+	return nil
+}
+
+type ExportEmojiPackReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PackID        string                 `protobuf:"bytes,2,opt,name=packID,proto3" json:"packID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEmojiPackReq) Reset() {
+	*x = ExportEmojiPackReq{}
+	mi := &file_user_user_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEmojiPackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEmojiPackReq) ProtoMessage() {}
+
+func (x *ExportEmojiPackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEmojiPackReq.ProtoReflect.Descriptor instead.
+func (*ExportEmojiPackReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ExportEmojiPackReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ExportEmojiPackReq) GetPackID() string {
+	if x != nil {
+		return x.PackID
+	}
+	return ""
+}
+
+type ExportEmojiPackResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      *EmojiPackManifest     `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEmojiPackResp) Reset() {
+	*x = ExportEmojiPackResp{}
+	mi := &file_user_user_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEmojiPackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEmojiPackResp) ProtoMessage() {}
+
+func (x *ExportEmojiPackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEmojiPackResp.ProtoReflect.Descriptor instead.
+func (*ExportEmojiPackResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ExportEmojiPackResp) GetManifest() *EmojiPackManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+// 快捷回复信息
+type QuickReplyInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplyID       string                 `protobuf:"bytes,1,opt,name=replyID,proto3" json:"replyID,omitempty"`         // 回复ID
+	OwnerUserID   string                 `protobuf:"bytes,2,opt,name=ownerUserID,proto3" json:"ownerUserID,omitempty"` // 用户ID
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`         // 回复内容
+	ReplyType     int32                  `protobuf:"varint,4,opt,name=replyType,proto3" json:"replyType,omitempty"`    // 回复类型: 0-常用, 1-AI推荐, 2-自定义
+	UseCount      int64                  `protobuf:"varint,5,opt,name=useCount,proto3" json:"useCount,omitempty"`      // 使用次数
+	IsPinned      bool                   `protobuf:"varint,6,opt,name=isPinned,proto3" json:"isPinned,omitempty"`      // 是否置顶
+	PinTime       int64                  `protobuf:"varint,7,opt,name=pinTime,proto3" json:"pinTime,omitempty"`        // 置顶时间
+	SortOrder     int32                  `protobuf:"varint,8,opt,name=sortOrder,proto3" json:"sortOrder,omitempty"`    // 排序顺序
+	CreateTime    int64                  `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime,omitempty"`  // 创建时间
+	UpdateTime    int64                  `protobuf:"varint,10,opt,name=updateTime,proto3" json:"updateTime,omitempty"` // 更新时间
+	Ex            string                 `protobuf:"bytes,11,opt,name=ex,proto3" json:"ex,omitempty"`                  // 扩展字段
+	IsTemplate    bool                   `protobuf:"varint,12,opt,name=isTemplate,proto3" json:"isTemplate,omitempty"` // content 是否为模板，模板变量形如 {{friend.nickname}}
+	Variables     []string               `protobuf:"bytes,13,rep,name=variables,proto3" json:"variables,omitempty"`    // content 中引用的模板变量（服务端解析填充）
+	Scope         *sdkws.QuickReplyScope `protobuf:"bytes,14,opt,name=scope,proto3" json:"scope,omitempty"`            // 适用范围，为空表示所有会话
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuickReplyInfo) Reset() {
+	*x = QuickReplyInfo{}
+	mi := &file_user_user_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickReplyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickReplyInfo) ProtoMessage() {}
+
+func (x *QuickReplyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickReplyInfo.ProtoReflect.Descriptor instead.
+func (*QuickReplyInfo) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *QuickReplyInfo) GetReplyID() string {
+	if x != nil {
+		return x.ReplyID
+	}
+	return ""
+}
+
+func (x *QuickReplyInfo) GetOwnerUserID() string {
+	if x != nil {
+		return x.OwnerUserID
+	}
+	return ""
+}
+
+func (x *QuickReplyInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *QuickReplyInfo) GetReplyType() int32 {
+	if x != nil {
+		return x.ReplyType
+	}
+	return 0
+}
+
+func (x *QuickReplyInfo) GetUseCount() int64 {
+	if x != nil {
+		return x.UseCount
+	}
+	return 0
+}
+
+func (x *QuickReplyInfo) GetIsPinned() bool {
+	if x != nil {
+		return x.IsPinned
+	}
+	return false
+}
+
+func (x *QuickReplyInfo) GetPinTime() int64 {
+	if x != nil {
+		return x.PinTime
+	}
+	return 0
+}
+
+func (x *QuickReplyInfo) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *QuickReplyInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *QuickReplyInfo) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *QuickReplyInfo) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *QuickReplyInfo) GetIsTemplate() bool {
+	if x != nil {
+		return x.IsTemplate
+	}
+	return false
+}
+
+func (x *QuickReplyInfo) GetVariables() []string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *QuickReplyInfo) GetScope() *sdkws.QuickReplyScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+// 快捷回复刷新状态
+type QuickReplyRefreshStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserID            string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`                       // 用户ID
+	IsRefreshing      bool                   `protobuf:"varint,2,opt,name=isRefreshing,proto3" json:"isRefreshing,omitempty"`          // 是否正在刷新
+	LastRefreshTime   int64                  `protobuf:"varint,3,opt,name=lastRefreshTime,proto3" json:"lastRefreshTime,omitempty"`    // 上次刷新时间（Unix毫秒）
+	RefreshByPlatform string                 `protobuf:"bytes,4,opt,name=refreshByPlatform,proto3" json:"refreshByPlatform,omitempty"` // 正在刷新的平台
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *QuickReplyRefreshStatus) Reset() {
+	*x = QuickReplyRefreshStatus{}
+	mi := &file_user_user_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickReplyRefreshStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickReplyRefreshStatus) ProtoMessage() {}
+
+func (x *QuickReplyRefreshStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickReplyRefreshStatus.ProtoReflect.Descriptor instead.
+func (*QuickReplyRefreshStatus) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *QuickReplyRefreshStatus) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *QuickReplyRefreshStatus) GetIsRefreshing() bool {
+	if x != nil {
+		return x.IsRefreshing
+	}
+	return false
+}
+
+func (x *QuickReplyRefreshStatus) GetLastRefreshTime() int64 {
+	if x != nil {
+		return x.LastRefreshTime
+	}
+	return 0
+}
+
+func (x *QuickReplyRefreshStatus) GetRefreshByPlatform() string {
+	if x != nil {
+		return x.RefreshByPlatform
+	}
+	return ""
+}
+
+// 获取快捷回复列表请求
+type GetQuickRepliesReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserID         string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ReplyType      int32                  `protobuf:"varint,2,opt,name=replyType,proto3" json:"replyType,omitempty"`          // 可选，-1表示获取所有类型
+	ConversationID string                 `protobuf:"bytes,3,opt,name=conversationID,proto3" json:"conversationID,omitempty"` // 可选，只返回适用于该会话的回复（含未限定范围的回复）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetQuickRepliesReq) Reset() {
+	*x = GetQuickRepliesReq{}
+	mi := &file_user_user_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuickRepliesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuickRepliesReq) ProtoMessage() {}
+
+func (x *GetQuickRepliesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuickRepliesReq.ProtoReflect.Descriptor instead.
+func (*GetQuickRepliesReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetQuickRepliesReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetQuickRepliesReq) GetReplyType() int32 {
+	if x != nil {
+		return x.ReplyType
+	}
+	return 0
+}
+
+func (x *GetQuickRepliesReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+type GetQuickRepliesResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Replies         []*QuickReplyInfo      `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies,omitempty"`
+	LastRefreshTime int64                  `protobuf:"varint,2,opt,name=lastRefreshTime,proto3" json:"lastRefreshTime,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetQuickRepliesResp) Reset() {
+	*x = GetQuickRepliesResp{}
+	mi := &file_user_user_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuickRepliesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuickRepliesResp) ProtoMessage() {}
+
+func (x *GetQuickRepliesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuickRepliesResp.ProtoReflect.Descriptor instead.
+func (*GetQuickRepliesResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *GetQuickRepliesResp) GetReplies() []*QuickReplyInfo {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *GetQuickRepliesResp) GetLastRefreshTime() int64 {
+	if x != nil {
+		return x.LastRefreshTime
+	}
+	return 0
+}
+
+// 同步快捷回复请求（SDK调用）
+type SyncQuickRepliesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncQuickRepliesReq) Reset() {
+	*x = SyncQuickRepliesReq{}
+	mi := &file_user_user_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncQuickRepliesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncQuickRepliesReq) ProtoMessage() {}
+
+func (x *SyncQuickRepliesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncQuickRepliesReq.ProtoReflect.Descriptor instead.
+func (*SyncQuickRepliesReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *SyncQuickRepliesReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type SyncQuickRepliesResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Replies         []*QuickReplyInfo      `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies,omitempty"`
+	LastRefreshTime int64                  `protobuf:"varint,2,opt,name=lastRefreshTime,proto3" json:"lastRefreshTime,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SyncQuickRepliesResp) Reset() {
+	*x = SyncQuickRepliesResp{}
+	mi := &file_user_user_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncQuickRepliesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncQuickRepliesResp) ProtoMessage() {}
+
+func (x *SyncQuickRepliesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncQuickRepliesResp.ProtoReflect.Descriptor instead.
+func (*SyncQuickRepliesResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *SyncQuickRepliesResp) GetReplies() []*QuickReplyInfo {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *SyncQuickRepliesResp) GetLastRefreshTime() int64 {
+	if x != nil {
+		return x.LastRefreshTime
+	}
+	return 0
+}
+
+// 添加/更新快捷回复请求
+type UpsertQuickReplyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reply         *QuickReplyInfo        `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertQuickReplyReq) Reset() {
+	*x = UpsertQuickReplyReq{}
+	mi := &file_user_user_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertQuickReplyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertQuickReplyReq) ProtoMessage() {}
+
+func (x *UpsertQuickReplyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertQuickReplyReq.ProtoReflect.Descriptor instead.
+func (*UpsertQuickReplyReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *UpsertQuickReplyReq) GetReply() *QuickReplyInfo {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+type UpsertQuickReplyResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reply         *QuickReplyInfo        `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertQuickReplyResp) Reset() {
+	*x = UpsertQuickReplyResp{}
+	mi := &file_user_user_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertQuickReplyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertQuickReplyResp) ProtoMessage() {}
+
+func (x *UpsertQuickReplyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertQuickReplyResp.ProtoReflect.Descriptor instead.
+func (*UpsertQuickReplyResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *UpsertQuickReplyResp) GetReply() *QuickReplyInfo {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+// 删除快捷回复请求
+type DeleteQuickReplyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ReplyID       string                 `protobuf:"bytes,2,opt,name=replyID,proto3" json:"replyID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuickReplyReq) Reset() {
+	*x = DeleteQuickReplyReq{}
+	mi := &file_user_user_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuickReplyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuickReplyReq) ProtoMessage() {}
+
+func (x *DeleteQuickReplyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuickReplyReq.ProtoReflect.Descriptor instead.
+func (*DeleteQuickReplyReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *DeleteQuickReplyReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DeleteQuickReplyReq) GetReplyID() string {
+	if x != nil {
+		return x.ReplyID
+	}
+	return ""
+}
+
+type DeleteQuickReplyResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteQuickReplyResp) Reset() {
+	*x = DeleteQuickReplyResp{}
+	mi := &file_user_user_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteQuickReplyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteQuickReplyResp) ProtoMessage() {}
+
+func (x *DeleteQuickReplyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteQuickReplyResp.ProtoReflect.Descriptor instead.
+func (*DeleteQuickReplyResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{147}
+}
+
+// 置顶快捷回复请求
+type PinQuickReplyReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ReplyID       string                 `protobuf:"bytes,2,opt,name=replyID,proto3" json:"replyID,omitempty"`
+	IsPinned      bool                   `protobuf:"varint,3,opt,name=isPinned,proto3" json:"isPinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinQuickReplyReq) Reset() {
+	*x = PinQuickReplyReq{}
+	mi := &file_user_user_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinQuickReplyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinQuickReplyReq) ProtoMessage() {}
+
+func (x *PinQuickReplyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinQuickReplyReq.ProtoReflect.Descriptor instead.
+func (*PinQuickReplyReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *PinQuickReplyReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *PinQuickReplyReq) GetReplyID() string {
+	if x != nil {
+		return x.ReplyID
+	}
+	return ""
+}
+
+func (x *PinQuickReplyReq) GetIsPinned() bool {
+	if x != nil {
+		return x.IsPinned
+	}
+	return false
+}
+
+type PinQuickReplyResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinQuickReplyResp) Reset() {
+	*x = PinQuickReplyResp{}
+	mi := &file_user_user_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinQuickReplyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinQuickReplyResp) ProtoMessage() {}
+
+func (x *PinQuickReplyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinQuickReplyResp.ProtoReflect.Descriptor instead.
+func (*PinQuickReplyResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{149}
+}
+
+// 刷新常用回复请求
+// 一周刷新一次，一端刷新其他端等待同步
+type RefreshFrequentRepliesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	PlatformID    int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID,omitempty"` // 发起刷新的平台ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshFrequentRepliesReq) Reset() {
+	*x = RefreshFrequentRepliesReq{}
+	mi := &file_user_user_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshFrequentRepliesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshFrequentRepliesReq) ProtoMessage() {}
+
+func (x *RefreshFrequentRepliesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshFrequentRepliesReq.ProtoReflect.Descriptor instead.
+func (*RefreshFrequentRepliesReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *RefreshFrequentRepliesReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RefreshFrequentRepliesReq) GetPlatformID() int32 {
+	if x != nil {
+		return x.PlatformID
+	}
+	return 0
+}
+
+type RefreshFrequentRepliesResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NeedRefresh     bool                   `protobuf:"varint,1,opt,name=needRefresh,proto3" json:"needRefresh,omitempty"`         // 是否需要刷新（false表示其他端正在刷新或未到刷新周期）
+	IsRefreshing    bool                   `protobuf:"varint,2,opt,name=isRefreshing,proto3" json:"isRefreshing,omitempty"`       // 是否有其他端正在刷新
+	LastRefreshTime int64                  `protobuf:"varint,3,opt,name=lastRefreshTime,proto3" json:"lastRefreshTime,omitempty"` // 上次刷新时间
+	Replies         []*QuickReplyInfo      `protobuf:"bytes,4,rep,name=replies,proto3" json:"replies,omitempty"`                  // 如果不需要刷新，返回当前列表
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RefreshFrequentRepliesResp) Reset() {
+	*x = RefreshFrequentRepliesResp{}
+	mi := &file_user_user_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshFrequentRepliesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshFrequentRepliesResp) ProtoMessage() {}
+
+func (x *RefreshFrequentRepliesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshFrequentRepliesResp.ProtoReflect.Descriptor instead.
+func (*RefreshFrequentRepliesResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *RefreshFrequentRepliesResp) GetNeedRefresh() bool {
+	if x != nil {
+		return x.NeedRefresh
+	}
+	return false
+}
+
+func (x *RefreshFrequentRepliesResp) GetIsRefreshing() bool {
+	if x != nil {
+		return x.IsRefreshing
+	}
+	return false
+}
+
+func (x *RefreshFrequentRepliesResp) GetLastRefreshTime() int64 {
+	if x != nil {
+		return x.LastRefreshTime
+	}
+	return 0
+}
+
+func (x *RefreshFrequentRepliesResp) GetReplies() []*QuickReplyInfo {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+// 提交刷新结果请求（刷新完成后调用）
+type SubmitRefreshResultReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Replies       []*QuickReplyInfo      `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies,omitempty"` // 刷新后的常用回复列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRefreshResultReq) Reset() {
+	*x = SubmitRefreshResultReq{}
+	mi := &file_user_user_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRefreshResultReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRefreshResultReq) ProtoMessage() {}
+
+func (x *SubmitRefreshResultReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRefreshResultReq.ProtoReflect.Descriptor instead.
+func (*SubmitRefreshResultReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *SubmitRefreshResultReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SubmitRefreshResultReq) GetReplies() []*QuickReplyInfo {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+type SubmitRefreshResultResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshTime   int64                  `protobuf:"varint,1,opt,name=refreshTime,proto3" json:"refreshTime,omitempty"` // 刷新完成时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRefreshResultResp) Reset() {
+	*x = SubmitRefreshResultResp{}
+	mi := &file_user_user_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRefreshResultResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRefreshResultResp) ProtoMessage() {}
+
+func (x *SubmitRefreshResultResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRefreshResultResp.ProtoReflect.Descriptor instead.
+func (*SubmitRefreshResultResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *SubmitRefreshResultResp) GetRefreshTime() int64 {
+	if x != nil {
+		return x.RefreshTime
+	}
+	return 0
+}
+
+// 获取刷新状态请求
+type GetRefreshStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefreshStatusReq) Reset() {
+	*x = GetRefreshStatusReq{}
+	mi := &file_user_user_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefreshStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefreshStatusReq) ProtoMessage() {}
+
+func (x *GetRefreshStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefreshStatusReq.ProtoReflect.Descriptor instead.
+func (*GetRefreshStatusReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *GetRefreshStatusReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetRefreshStatusResp struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Status        *QuickReplyRefreshStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefreshStatusResp) Reset() {
+	*x = GetRefreshStatusResp{}
+	mi := &file_user_user_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefreshStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefreshStatusResp) ProtoMessage() {}
+
+func (x *GetRefreshStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefreshStatusResp.ProtoReflect.Descriptor instead.
+func (*GetRefreshStatusResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *GetRefreshStatusResp) GetStatus() *QuickReplyRefreshStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// 批量更新AI快捷回复请求
+type BatchUpsertAIQuickRepliesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Replies       []*QuickReplyInfo      `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies,omitempty"` // AI生成的快捷回复列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpsertAIQuickRepliesReq) Reset() {
+	*x = BatchUpsertAIQuickRepliesReq{}
+	mi := &file_user_user_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpsertAIQuickRepliesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpsertAIQuickRepliesReq) ProtoMessage() {}
+
+func (x *BatchUpsertAIQuickRepliesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpsertAIQuickRepliesReq.ProtoReflect.Descriptor instead.
+func (*BatchUpsertAIQuickRepliesReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *BatchUpsertAIQuickRepliesReq) GetUserID() string {
 	if x != nil {
-		return x.UpdateTime
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-func (x *GetUserEmojiResp) GetEx() string {
+func (x *BatchUpsertAIQuickRepliesReq) GetReplies() []*QuickReplyInfo {
 	if x != nil {
-		return x.Ex
+		return x.Replies
 	}
-	return ""
+	return nil
 }
 
-type GetAllUserEmojisReq struct {
+type BatchUpsertAIQuickRepliesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	UpdateTime    int64                  `protobuf:"varint,1,opt,name=updateTime,proto3" json:"updateTime,omitempty"` // 更新完成时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllUserEmojisReq) Reset() {
-	*x = GetAllUserEmojisReq{}
-	mi := &file_user_user_proto_msgTypes[85]
+func (x *BatchUpsertAIQuickRepliesResp) Reset() {
+	*x = BatchUpsertAIQuickRepliesResp{}
+	mi := &file_user_user_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllUserEmojisReq) String() string {
+func (x *BatchUpsertAIQuickRepliesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllUserEmojisReq) ProtoMessage() {}
+func (*BatchUpsertAIQuickRepliesResp) ProtoMessage() {}
 
-func (x *GetAllUserEmojisReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[85]
+func (x *BatchUpsertAIQuickRepliesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4547,40 +8707,45 @@ func (x *GetAllUserEmojisReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllUserEmojisReq.ProtoReflect.Descriptor instead.
-func (*GetAllUserEmojisReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use BatchUpsertAIQuickRepliesResp.ProtoReflect.Descriptor instead.
+func (*BatchUpsertAIQuickRepliesResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *GetAllUserEmojisReq) GetUserID() string {
+func (x *BatchUpsertAIQuickRepliesResp) GetUpdateTime() int64 {
 	if x != nil {
-		return x.UserID
+		return x.UpdateTime
 	}
-	return ""
+	return 0
 }
 
-type GetAllUserEmojisResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Emojis        []*GetUserEmojiResp    `protobuf:"bytes,1,rep,name=emojis,proto3" json:"emojis,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 渲染快捷回复模板请求（预览），replyID 为空时渲染 content
+type RenderQuickReplyReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserID         string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ReplyID        string                 `protobuf:"bytes,2,opt,name=replyID,proto3" json:"replyID,omitempty"`
+	Content        string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	ConversationID string                 `protobuf:"bytes,4,opt,name=conversationID,proto3" json:"conversationID,omitempty"`                                                           // 用于解析 friend.* / group.* 变量
+	Values         map[string]string      `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 调用方指定的变量值，优先于服务端解析结果
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetAllUserEmojisResp) Reset() {
-	*x = GetAllUserEmojisResp{}
-	mi := &file_user_user_proto_msgTypes[86]
+func (x *RenderQuickReplyReq) Reset() {
+	*x = RenderQuickReplyReq{}
+	mi := &file_user_user_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllUserEmojisResp) String() string {
+func (x *RenderQuickReplyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllUserEmojisResp) ProtoMessage() {}
+func (*RenderQuickReplyReq) ProtoMessage() {}
 
-func (x *GetAllUserEmojisResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[86]
+func (x *RenderQuickReplyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4591,51 +8756,70 @@ func (x *GetAllUserEmojisResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllUserEmojisResp.ProtoReflect.Descriptor instead.
-func (*GetAllUserEmojisResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use RenderQuickReplyReq.ProtoReflect.Descriptor instead.
+func (*RenderQuickReplyReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *GetAllUserEmojisResp) GetEmojis() []*GetUserEmojiResp {
+func (x *RenderQuickReplyReq) GetUserID() string {
 	if x != nil {
-		return x.Emojis
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RenderQuickReplyReq) GetReplyID() string {
+	if x != nil {
+		return x.ReplyID
+	}
+	return ""
+}
+
+func (x *RenderQuickReplyReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *RenderQuickReplyReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *RenderQuickReplyReq) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
 	}
 	return nil
 }
 
-// 快捷回复信息
-type QuickReplyInfo struct {
+type RenderQuickReplyResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ReplyID       string                 `protobuf:"bytes,1,opt,name=replyID,proto3" json:"replyID,omitempty"`         // 回复ID
-	OwnerUserID   string                 `protobuf:"bytes,2,opt,name=ownerUserID,proto3" json:"ownerUserID,omitempty"` // 用户ID
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`         // 回复内容
-	ReplyType     int32                  `protobuf:"varint,4,opt,name=replyType,proto3" json:"replyType,omitempty"`    // 回复类型: 0-常用, 1-AI推荐, 2-自定义
-	UseCount      int64                  `protobuf:"varint,5,opt,name=useCount,proto3" json:"useCount,omitempty"`      // 使用次数
-	IsPinned      bool                   `protobuf:"varint,6,opt,name=isPinned,proto3" json:"isPinned,omitempty"`      // 是否置顶
-	PinTime       int64                  `protobuf:"varint,7,opt,name=pinTime,proto3" json:"pinTime,omitempty"`        // 置顶时间
-	SortOrder     int32                  `protobuf:"varint,8,opt,name=sortOrder,proto3" json:"sortOrder,omitempty"`    // 排序顺序
-	CreateTime    int64                  `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime,omitempty"`  // 创建时间
-	UpdateTime    int64                  `protobuf:"varint,10,opt,name=updateTime,proto3" json:"updateTime,omitempty"` // 更新时间
-	Ex            string                 `protobuf:"bytes,11,opt,name=ex,proto3" json:"ex,omitempty"`                  // 扩展字段
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`                                                                         // 渲染后的内容
+	Values        map[string]string      `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 实际使用的变量值
+	Unresolved    []string               `protobuf:"bytes,3,rep,name=unresolved,proto3" json:"unresolved,omitempty"`                                                                   // 无法解析的变量，原样保留在 content 中
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QuickReplyInfo) Reset() {
-	*x = QuickReplyInfo{}
-	mi := &file_user_user_proto_msgTypes[87]
+func (x *RenderQuickReplyResp) Reset() {
+	*x = RenderQuickReplyResp{}
+	mi := &file_user_user_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuickReplyInfo) String() string {
+func (x *RenderQuickReplyResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuickReplyInfo) ProtoMessage() {}
+func (*RenderQuickReplyResp) ProtoMessage() {}
 
-func (x *QuickReplyInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[87]
+func (x *RenderQuickReplyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4646,114 +8830,133 @@ func (x *QuickReplyInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuickReplyInfo.ProtoReflect.Descriptor instead.
-func (*QuickReplyInfo) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use RenderQuickReplyResp.ProtoReflect.Descriptor instead.
+func (*RenderQuickReplyResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *QuickReplyInfo) GetReplyID() string {
+func (x *RenderQuickReplyResp) GetContent() string {
 	if x != nil {
-		return x.ReplyID
+		return x.Content
 	}
 	return ""
 }
 
-func (x *QuickReplyInfo) GetOwnerUserID() string {
+func (x *RenderQuickReplyResp) GetValues() map[string]string {
 	if x != nil {
-		return x.OwnerUserID
+		return x.Values
 	}
-	return ""
+	return nil
 }
 
-func (x *QuickReplyInfo) GetContent() string {
+func (x *RenderQuickReplyResp) GetUnresolved() []string {
 	if x != nil {
-		return x.Content
+		return x.Unresolved
 	}
-	return ""
+	return nil
 }
 
-func (x *QuickReplyInfo) GetReplyType() int32 {
-	if x != nil {
-		return x.ReplyType
-	}
-	return 0
+// SignatureInfo 签名信息
+type SignatureInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                  // 签名ID
+	Icon          string                 `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`              // 图标URL或表情
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`              // 签名文本
+	CreateTime    int64                  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime,omitempty"` // 创建时间（Unix毫秒时间戳）
+	UpdateTime    int64                  `protobuf:"varint,5,opt,name=updateTime,proto3" json:"updateTime,omitempty"` // 更新时间（Unix毫秒时间戳）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QuickReplyInfo) GetUseCount() int64 {
+func (x *SignatureInfo) Reset() {
+	*x = SignatureInfo{}
+	mi := &file_user_user_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignatureInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignatureInfo) ProtoMessage() {}
+
+func (x *SignatureInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[160]
 	if x != nil {
-		return x.UseCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *QuickReplyInfo) GetIsPinned() bool {
+// Deprecated: Use SignatureInfo.ProtoReflect.Descriptor instead.
+func (*SignatureInfo) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *SignatureInfo) GetId() string {
 	if x != nil {
-		return x.IsPinned
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *QuickReplyInfo) GetPinTime() int64 {
+func (x *SignatureInfo) GetIcon() string {
 	if x != nil {
-		return x.PinTime
+		return x.Icon
 	}
-	return 0
+	return ""
 }
 
-func (x *QuickReplyInfo) GetSortOrder() int32 {
+func (x *SignatureInfo) GetText() string {
 	if x != nil {
-		return x.SortOrder
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-func (x *QuickReplyInfo) GetCreateTime() int64 {
+func (x *SignatureInfo) GetCreateTime() int64 {
 	if x != nil {
 		return x.CreateTime
 	}
 	return 0
 }
 
-func (x *QuickReplyInfo) GetUpdateTime() int64 {
+func (x *SignatureInfo) GetUpdateTime() int64 {
 	if x != nil {
 		return x.UpdateTime
 	}
 	return 0
 }
 
-func (x *QuickReplyInfo) GetEx() string {
-	if x != nil {
-		return x.Ex
-	}
-	return ""
-}
-
-// 快捷回复刷新状态
-type QuickReplyRefreshStatus struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	UserID            string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`                       // 用户ID
-	IsRefreshing      bool                   `protobuf:"varint,2,opt,name=isRefreshing,proto3" json:"isRefreshing,omitempty"`          // 是否正在刷新
-	LastRefreshTime   int64                  `protobuf:"varint,3,opt,name=lastRefreshTime,proto3" json:"lastRefreshTime,omitempty"`    // 上次刷新时间（Unix毫秒）
-	RefreshByPlatform string                 `protobuf:"bytes,4,opt,name=refreshByPlatform,proto3" json:"refreshByPlatform,omitempty"` // 正在刷新的平台
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// GetSignatureListReq 获取签名列表请求
+type GetSignatureListReq struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 可选：分页参数
+	Pagination    *sdkws.RequestPagination `protobuf:"bytes,1,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QuickReplyRefreshStatus) Reset() {
-	*x = QuickReplyRefreshStatus{}
-	mi := &file_user_user_proto_msgTypes[88]
+func (x *GetSignatureListReq) Reset() {
+	*x = GetSignatureListReq{}
+	mi := &file_user_user_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QuickReplyRefreshStatus) String() string {
+func (x *GetSignatureListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuickReplyRefreshStatus) ProtoMessage() {}
+func (*GetSignatureListReq) ProtoMessage() {}
 
-func (x *QuickReplyRefreshStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[88]
+func (x *GetSignatureListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4764,63 +8967,94 @@ func (x *QuickReplyRefreshStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QuickReplyRefreshStatus.ProtoReflect.Descriptor instead.
-func (*QuickReplyRefreshStatus) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use GetSignatureListReq.ProtoReflect.Descriptor instead.
+func (*GetSignatureListReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *QuickReplyRefreshStatus) GetUserID() string {
+func (x *GetSignatureListReq) GetPagination() *sdkws.RequestPagination {
 	if x != nil {
-		return x.UserID
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (x *QuickReplyRefreshStatus) GetIsRefreshing() bool {
+// GetSignatureListResp 获取签名列表响应
+type GetSignatureListResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signatures    []*SignatureInfo       `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"` // 签名列表
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`          // 总数（如果分页）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignatureListResp) Reset() {
+	*x = GetSignatureListResp{}
+	mi := &file_user_user_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignatureListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignatureListResp) ProtoMessage() {}
+
+func (x *GetSignatureListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[162]
 	if x != nil {
-		return x.IsRefreshing
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *QuickReplyRefreshStatus) GetLastRefreshTime() int64 {
+// Deprecated: Use GetSignatureListResp.ProtoReflect.Descriptor instead.
+func (*GetSignatureListResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *GetSignatureListResp) GetSignatures() []*SignatureInfo {
 	if x != nil {
-		return x.LastRefreshTime
+		return x.Signatures
 	}
-	return 0
+	return nil
 }
 
-func (x *QuickReplyRefreshStatus) GetRefreshByPlatform() string {
+func (x *GetSignatureListResp) GetTotal() int32 {
 	if x != nil {
-		return x.RefreshByPlatform
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-// 获取快捷回复列表请求
-type GetQuickRepliesReq struct {
+// updateAvatarReq 更新用户头像请求（文件需先上传至 OSS，再提交 faceURL）
+type UpdateAvatarReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	ReplyType     int32                  `protobuf:"varint,2,opt,name=replyType,proto3" json:"replyType,omitempty"` // 可选，-1表示获取所有类型
+	FaceURL       string                 `protobuf:"bytes,1,opt,name=faceURL,proto3" json:"face_url"` // 头像 URL（必填）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetQuickRepliesReq) Reset() {
-	*x = GetQuickRepliesReq{}
-	mi := &file_user_user_proto_msgTypes[89]
+func (x *UpdateAvatarReq) Reset() {
+	*x = UpdateAvatarReq{}
+	mi := &file_user_user_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetQuickRepliesReq) String() string {
+func (x *UpdateAvatarReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetQuickRepliesReq) ProtoMessage() {}
+func (*UpdateAvatarReq) ProtoMessage() {}
 
-func (x *GetQuickRepliesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[89]
+func (x *UpdateAvatarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4831,48 +9065,44 @@ func (x *GetQuickRepliesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetQuickRepliesReq.ProtoReflect.Descriptor instead.
-func (*GetQuickRepliesReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use UpdateAvatarReq.ProtoReflect.Descriptor instead.
+func (*UpdateAvatarReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *GetQuickRepliesReq) GetUserID() string {
+func (x *UpdateAvatarReq) GetFaceURL() string {
 	if x != nil {
-		return x.UserID
+		return x.FaceURL
 	}
 	return ""
 }
 
-func (x *GetQuickRepliesReq) GetReplyType() int32 {
-	if x != nil {
-		return x.ReplyType
-	}
-	return 0
-}
-
-type GetQuickRepliesResp struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Replies         []*QuickReplyInfo      `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies,omitempty"`
-	LastRefreshTime int64                  `protobuf:"varint,2,opt,name=lastRefreshTime,proto3" json:"lastRefreshTime,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// updateAvatarResp 更新用户头像响应
+type UpdateAvatarResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FaceURL       string                 `protobuf:"bytes,1,opt,name=faceURL,proto3" json:"face_url"`          // 更新后的头像 URL
+	UploadCount   int32                  `protobuf:"varint,2,opt,name=uploadCount,proto3" json:"upload_count"` // 当前用户已上传/修改次数
+	UploadLimit   int32                  `protobuf:"varint,3,opt,name=uploadLimit,proto3" json:"upload_limit"` // 系统允许的最大次数（0 表示不限制）
+	Remaining     int32                  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining"`     // 剩余可上传次数（uploadLimit=0 时为 -1）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetQuickRepliesResp) Reset() {
-	*x = GetQuickRepliesResp{}
-	mi := &file_user_user_proto_msgTypes[90]
+func (x *UpdateAvatarResp) Reset() {
+	*x = UpdateAvatarResp{}
+	mi := &file_user_user_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetQuickRepliesResp) String() string {
+func (x *UpdateAvatarResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetQuickRepliesResp) ProtoMessage() {}
+func (*UpdateAvatarResp) ProtoMessage() {}
 
-func (x *GetQuickRepliesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[90]
+func (x *UpdateAvatarResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4883,48 +9113,61 @@ func (x *GetQuickRepliesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetQuickRepliesResp.ProtoReflect.Descriptor instead.
-func (*GetQuickRepliesResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use UpdateAvatarResp.ProtoReflect.Descriptor instead.
+func (*UpdateAvatarResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *GetQuickRepliesResp) GetReplies() []*QuickReplyInfo {
+func (x *UpdateAvatarResp) GetFaceURL() string {
 	if x != nil {
-		return x.Replies
+		return x.FaceURL
 	}
-	return nil
+	return ""
 }
 
-func (x *GetQuickRepliesResp) GetLastRefreshTime() int64 {
+func (x *UpdateAvatarResp) GetUploadCount() int32 {
 	if x != nil {
-		return x.LastRefreshTime
+		return x.UploadCount
 	}
 	return 0
 }
 
-// 同步快捷回复请求（SDK调用）
-type SyncQuickRepliesReq struct {
+func (x *UpdateAvatarResp) GetUploadLimit() int32 {
+	if x != nil {
+		return x.UploadLimit
+	}
+	return 0
+}
+
+func (x *UpdateAvatarResp) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+// getAvatarUploadQuotaReq 获取头像上传配额请求
+type GetAvatarUploadQuotaReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncQuickRepliesReq) Reset() {
-	*x = SyncQuickRepliesReq{}
-	mi := &file_user_user_proto_msgTypes[91]
+func (x *GetAvatarUploadQuotaReq) Reset() {
+	*x = GetAvatarUploadQuotaReq{}
+	mi := &file_user_user_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncQuickRepliesReq) String() string {
+func (x *GetAvatarUploadQuotaReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncQuickRepliesReq) ProtoMessage() {}
+func (*GetAvatarUploadQuotaReq) ProtoMessage() {}
 
-func (x *SyncQuickRepliesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[91]
+func (x *GetAvatarUploadQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4935,41 +9178,36 @@ func (x *SyncQuickRepliesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncQuickRepliesReq.ProtoReflect.Descriptor instead.
-func (*SyncQuickRepliesReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{91}
-}
-
-func (x *SyncQuickRepliesReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
+// Deprecated: Use GetAvatarUploadQuotaReq.ProtoReflect.Descriptor instead.
+func (*GetAvatarUploadQuotaReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{165}
 }
 
-type SyncQuickRepliesResp struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Replies         []*QuickReplyInfo      `protobuf:"bytes,1,rep,name=replies,proto3" json:"replies,omitempty"`
-	LastRefreshTime int64                  `protobuf:"varint,2,opt,name=lastRefreshTime,proto3" json:"lastRefreshTime,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// getAvatarUploadQuotaResp 获取头像上传配额响应
+type GetAvatarUploadQuotaResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadCount   int32                  `protobuf:"varint,1,opt,name=uploadCount,proto3" json:"upload_count"` // 当前用户已上传/修改次数
+	UploadLimit   int32                  `protobuf:"varint,2,opt,name=uploadLimit,proto3" json:"upload_limit"` // 系统允许的最大次数（0 表示不限制）
+	Remaining     int32                  `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining"`     // 剩余可上传次数（uploadLimit=0 时为 -1）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncQuickRepliesResp) Reset() {
-	*x = SyncQuickRepliesResp{}
-	mi := &file_user_user_proto_msgTypes[92]
+func (x *GetAvatarUploadQuotaResp) Reset() {
+	*x = GetAvatarUploadQuotaResp{}
+	mi := &file_user_user_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncQuickRepliesResp) String() string {
+func (x *GetAvatarUploadQuotaResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncQuickRepliesResp) ProtoMessage() {}
+func (*GetAvatarUploadQuotaResp) ProtoMessage() {}
 
-func (x *SyncQuickRepliesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[92]
+func (x *GetAvatarUploadQuotaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4980,48 +9218,56 @@ func (x *SyncQuickRepliesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncQuickRepliesResp.ProtoReflect.Descriptor instead.
-func (*SyncQuickRepliesResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use GetAvatarUploadQuotaResp.ProtoReflect.Descriptor instead.
+func (*GetAvatarUploadQuotaResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *SyncQuickRepliesResp) GetReplies() []*QuickReplyInfo {
+func (x *GetAvatarUploadQuotaResp) GetUploadCount() int32 {
 	if x != nil {
-		return x.Replies
+		return x.UploadCount
 	}
-	return nil
+	return 0
 }
 
-func (x *SyncQuickRepliesResp) GetLastRefreshTime() int64 {
+func (x *GetAvatarUploadQuotaResp) GetUploadLimit() int32 {
 	if x != nil {
-		return x.LastRefreshTime
+		return x.UploadLimit
 	}
 	return 0
 }
 
-// 添加/更新快捷回复请求
-type UpsertQuickReplyReq struct {
+func (x *GetAvatarUploadQuotaResp) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+// setUserPresenceReq 设置自定义状态，变更通过 UserStatusChangeNotification 推送给订阅者
+type SetUserPresenceReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reply         *QuickReplyInfo        `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Presence      *sdkws.UserPresence    `protobuf:"bytes,2,opt,name=presence,proto3" json:"presence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertQuickReplyReq) Reset() {
-	*x = UpsertQuickReplyReq{}
-	mi := &file_user_user_proto_msgTypes[93]
+func (x *SetUserPresenceReq) Reset() {
+	*x = SetUserPresenceReq{}
+	mi := &file_user_user_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertQuickReplyReq) String() string {
+func (x *SetUserPresenceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertQuickReplyReq) ProtoMessage() {}
+func (*SetUserPresenceReq) ProtoMessage() {}
 
-func (x *UpsertQuickReplyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[93]
+func (x *SetUserPresenceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5032,40 +9278,46 @@ func (x *UpsertQuickReplyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertQuickReplyReq.ProtoReflect.Descriptor instead.
-func (*UpsertQuickReplyReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use SetUserPresenceReq.ProtoReflect.Descriptor instead.
+func (*SetUserPresenceReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *UpsertQuickReplyReq) GetReply() *QuickReplyInfo {
+func (x *SetUserPresenceReq) GetUserID() string {
 	if x != nil {
-		return x.Reply
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SetUserPresenceReq) GetPresence() *sdkws.UserPresence {
+	if x != nil {
+		return x.Presence
 	}
 	return nil
 }
 
-type UpsertQuickReplyResp struct {
+type SetUserPresenceResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reply         *QuickReplyInfo        `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertQuickReplyResp) Reset() {
-	*x = UpsertQuickReplyResp{}
-	mi := &file_user_user_proto_msgTypes[94]
+func (x *SetUserPresenceResp) Reset() {
+	*x = SetUserPresenceResp{}
+	mi := &file_user_user_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertQuickReplyResp) String() string {
+func (x *SetUserPresenceResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertQuickReplyResp) ProtoMessage() {}
+func (*SetUserPresenceResp) ProtoMessage() {}
 
-func (x *UpsertQuickReplyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[94]
+func (x *SetUserPresenceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5076,42 +9328,33 @@ func (x *UpsertQuickReplyResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertQuickReplyResp.ProtoReflect.Descriptor instead.
-func (*UpsertQuickReplyResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{94}
-}
-
-func (x *UpsertQuickReplyResp) GetReply() *QuickReplyInfo {
-	if x != nil {
-		return x.Reply
-	}
-	return nil
+// Deprecated: Use SetUserPresenceResp.ProtoReflect.Descriptor instead.
+func (*SetUserPresenceResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{168}
 }
 
-// 删除快捷回复请求
-type DeleteQuickReplyReq struct {
+type ClearUserPresenceReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	ReplyID       string                 `protobuf:"bytes,2,opt,name=replyID,proto3" json:"replyID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteQuickReplyReq) Reset() {
-	*x = DeleteQuickReplyReq{}
-	mi := &file_user_user_proto_msgTypes[95]
+func (x *ClearUserPresenceReq) Reset() {
+	*x = ClearUserPresenceReq{}
+	mi := &file_user_user_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteQuickReplyReq) String() string {
+func (x *ClearUserPresenceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteQuickReplyReq) ProtoMessage() {}
+func (*ClearUserPresenceReq) ProtoMessage() {}
 
-func (x *DeleteQuickReplyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[95]
+func (x *ClearUserPresenceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5122,46 +9365,39 @@ func (x *DeleteQuickReplyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteQuickReplyReq.ProtoReflect.Descriptor instead.
-func (*DeleteQuickReplyReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use ClearUserPresenceReq.ProtoReflect.Descriptor instead.
+func (*ClearUserPresenceReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *DeleteQuickReplyReq) GetUserID() string {
+func (x *ClearUserPresenceReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *DeleteQuickReplyReq) GetReplyID() string {
-	if x != nil {
-		return x.ReplyID
-	}
-	return ""
-}
-
-type DeleteQuickReplyResp struct {
+type ClearUserPresenceResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteQuickReplyResp) Reset() {
-	*x = DeleteQuickReplyResp{}
-	mi := &file_user_user_proto_msgTypes[96]
+func (x *ClearUserPresenceResp) Reset() {
+	*x = ClearUserPresenceResp{}
+	mi := &file_user_user_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteQuickReplyResp) String() string {
+func (x *ClearUserPresenceResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteQuickReplyResp) ProtoMessage() {}
-
-func (x *DeleteQuickReplyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[96]
+func (*ClearUserPresenceResp) ProtoMessage() {}
+
+func (x *ClearUserPresenceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5172,36 +9408,33 @@ func (x *DeleteQuickReplyResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteQuickReplyResp.ProtoReflect.Descriptor instead.
-func (*DeleteQuickReplyResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use ClearUserPresenceResp.ProtoReflect.Descriptor instead.
+func (*ClearUserPresenceResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{170}
 }
 
-// 置顶快捷回复请求
-type PinQuickReplyReq struct {
+type GetUserPresenceReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	ReplyID       string                 `protobuf:"bytes,2,opt,name=replyID,proto3" json:"replyID,omitempty"`
-	IsPinned      bool                   `protobuf:"varint,3,opt,name=isPinned,proto3" json:"isPinned,omitempty"`
+	UserIDs       []string               `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PinQuickReplyReq) Reset() {
-	*x = PinQuickReplyReq{}
-	mi := &file_user_user_proto_msgTypes[97]
+func (x *GetUserPresenceReq) Reset() {
+	*x = GetUserPresenceReq{}
+	mi := &file_user_user_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PinQuickReplyReq) String() string {
+func (x *GetUserPresenceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PinQuickReplyReq) ProtoMessage() {}
+func (*GetUserPresenceReq) ProtoMessage() {}
 
-func (x *PinQuickReplyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[97]
+func (x *GetUserPresenceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5212,53 +9445,40 @@ func (x *PinQuickReplyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PinQuickReplyReq.ProtoReflect.Descriptor instead.
-func (*PinQuickReplyReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{97}
-}
-
-func (x *PinQuickReplyReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *PinQuickReplyReq) GetReplyID() string {
-	if x != nil {
-		return x.ReplyID
-	}
-	return ""
+// Deprecated: Use GetUserPresenceReq.ProtoReflect.Descriptor instead.
+func (*GetUserPresenceReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *PinQuickReplyReq) GetIsPinned() bool {
+func (x *GetUserPresenceReq) GetUserIDs() []string {
 	if x != nil {
-		return x.IsPinned
+		return x.UserIDs
 	}
-	return false
+	return nil
 }
 
-type PinQuickReplyResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GetUserPresenceResp struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Presences     map[string]*sdkws.UserPresence `protobuf:"bytes,1,rep,name=presences,proto3" json:"presences,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // userID -> presence，未设置的用户不返回
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PinQuickReplyResp) Reset() {
-	*x = PinQuickReplyResp{}
-	mi := &file_user_user_proto_msgTypes[98]
+func (x *GetUserPresenceResp) Reset() {
+	*x = GetUserPresenceResp{}
+	mi := &file_user_user_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PinQuickReplyResp) String() string {
+func (x *GetUserPresenceResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PinQuickReplyResp) ProtoMessage() {}
+func (*GetUserPresenceResp) ProtoMessage() {}
 
-func (x *PinQuickReplyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[98]
+func (x *GetUserPresenceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5269,36 +9489,42 @@ func (x *PinQuickReplyResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PinQuickReplyResp.ProtoReflect.Descriptor instead.
-func (*PinQuickReplyResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use GetUserPresenceResp.ProtoReflect.Descriptor instead.
+func (*GetUserPresenceResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{172}
 }
 
-// 刷新常用回复请求
-// 一周刷新一次，一端刷新其他端等待同步
-type RefreshFrequentRepliesReq struct {
+func (x *GetUserPresenceResp) GetPresences() map[string]*sdkws.UserPresence {
+	if x != nil {
+		return x.Presences
+	}
+	return nil
+}
+
+// setUserActivityReq 由通话、会议、日程服务在生命周期事件中调用，变更通过 UserStatusChangeNotification 推送给订阅者
+type SetUserActivityReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	PlatformID    int32                  `protobuf:"varint,2,opt,name=platformID,proto3" json:"platformID,omitempty"` // 发起刷新的平台ID
+	Activity      *sdkws.UserActivity    `protobuf:"bytes,2,opt,name=activity,proto3" json:"activity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshFrequentRepliesReq) Reset() {
-	*x = RefreshFrequentRepliesReq{}
-	mi := &file_user_user_proto_msgTypes[99]
+func (x *SetUserActivityReq) Reset() {
+	*x = SetUserActivityReq{}
+	mi := &file_user_user_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshFrequentRepliesReq) String() string {
+func (x *SetUserActivityReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshFrequentRepliesReq) ProtoMessage() {}
+func (*SetUserActivityReq) ProtoMessage() {}
 
-func (x *RefreshFrequentRepliesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[99]
+func (x *SetUserActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5309,50 +9535,46 @@ func (x *RefreshFrequentRepliesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshFrequentRepliesReq.ProtoReflect.Descriptor instead.
-func (*RefreshFrequentRepliesReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use SetUserActivityReq.ProtoReflect.Descriptor instead.
+func (*SetUserActivityReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *RefreshFrequentRepliesReq) GetUserID() string {
+func (x *SetUserActivityReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *RefreshFrequentRepliesReq) GetPlatformID() int32 {
+func (x *SetUserActivityReq) GetActivity() *sdkws.UserActivity {
 	if x != nil {
-		return x.PlatformID
+		return x.Activity
 	}
-	return 0
+	return nil
 }
 
-type RefreshFrequentRepliesResp struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	NeedRefresh     bool                   `protobuf:"varint,1,opt,name=needRefresh,proto3" json:"needRefresh,omitempty"`         // 是否需要刷新（false表示其他端正在刷新或未到刷新周期）
-	IsRefreshing    bool                   `protobuf:"varint,2,opt,name=isRefreshing,proto3" json:"isRefreshing,omitempty"`       // 是否有其他端正在刷新
-	LastRefreshTime int64                  `protobuf:"varint,3,opt,name=lastRefreshTime,proto3" json:"lastRefreshTime,omitempty"` // 上次刷新时间
-	Replies         []*QuickReplyInfo      `protobuf:"bytes,4,rep,name=replies,proto3" json:"replies,omitempty"`                  // 如果不需要刷新，返回当前列表
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SetUserActivityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshFrequentRepliesResp) Reset() {
-	*x = RefreshFrequentRepliesResp{}
-	mi := &file_user_user_proto_msgTypes[100]
+func (x *SetUserActivityResp) Reset() {
+	*x = SetUserActivityResp{}
+	mi := &file_user_user_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshFrequentRepliesResp) String() string {
+func (x *SetUserActivityResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshFrequentRepliesResp) ProtoMessage() {}
+func (*SetUserActivityResp) ProtoMessage() {}
 
-func (x *RefreshFrequentRepliesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[100]
+func (x *SetUserActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5363,63 +9585,36 @@ func (x *RefreshFrequentRepliesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshFrequentRepliesResp.ProtoReflect.Descriptor instead.
-func (*RefreshFrequentRepliesResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{100}
-}
-
-func (x *RefreshFrequentRepliesResp) GetNeedRefresh() bool {
-	if x != nil {
-		return x.NeedRefresh
-	}
-	return false
-}
-
-func (x *RefreshFrequentRepliesResp) GetIsRefreshing() bool {
-	if x != nil {
-		return x.IsRefreshing
-	}
-	return false
-}
-
-func (x *RefreshFrequentRepliesResp) GetLastRefreshTime() int64 {
-	if x != nil {
-		return x.LastRefreshTime
-	}
-	return 0
-}
-
-func (x *RefreshFrequentRepliesResp) GetReplies() []*QuickReplyInfo {
-	if x != nil {
-		return x.Replies
-	}
-	return nil
+// Deprecated: Use SetUserActivityResp.ProtoReflect.Descriptor instead.
+func (*SetUserActivityResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{174}
 }
 
-// 提交刷新结果请求（刷新完成后调用）
-type SubmitRefreshResultReq struct {
+// clearUserActivityReq 来源结束时清除对应的自动状态
+type ClearUserActivityReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Replies       []*QuickReplyInfo      `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies,omitempty"` // 刷新后的常用回复列表
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`     // 来源 constant.UserActivitySource*
+	SourceID      string                 `protobuf:"bytes,3,opt,name=sourceID,proto3" json:"sourceID,omitempty"` // 来源ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitRefreshResultReq) Reset() {
-	*x = SubmitRefreshResultReq{}
-	mi := &file_user_user_proto_msgTypes[101]
+func (x *ClearUserActivityReq) Reset() {
+	*x = ClearUserActivityReq{}
+	mi := &file_user_user_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitRefreshResultReq) String() string {
+func (x *ClearUserActivityReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitRefreshResultReq) ProtoMessage() {}
+func (*ClearUserActivityReq) ProtoMessage() {}
 
-func (x *SubmitRefreshResultReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[101]
+func (x *ClearUserActivityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5430,47 +9625,53 @@ func (x *SubmitRefreshResultReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitRefreshResultReq.ProtoReflect.Descriptor instead.
-func (*SubmitRefreshResultReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use ClearUserActivityReq.ProtoReflect.Descriptor instead.
+func (*ClearUserActivityReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *SubmitRefreshResultReq) GetUserID() string {
+func (x *ClearUserActivityReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *SubmitRefreshResultReq) GetReplies() []*QuickReplyInfo {
+func (x *ClearUserActivityReq) GetSource() string {
 	if x != nil {
-		return x.Replies
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-type SubmitRefreshResultResp struct {
+func (x *ClearUserActivityReq) GetSourceID() string {
+	if x != nil {
+		return x.SourceID
+	}
+	return ""
+}
+
+type ClearUserActivityResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RefreshTime   int64                  `protobuf:"varint,1,opt,name=refreshTime,proto3" json:"refreshTime,omitempty"` // 刷新完成时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitRefreshResultResp) Reset() {
-	*x = SubmitRefreshResultResp{}
-	mi := &file_user_user_proto_msgTypes[102]
+func (x *ClearUserActivityResp) Reset() {
+	*x = ClearUserActivityResp{}
+	mi := &file_user_user_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitRefreshResultResp) String() string {
+func (x *ClearUserActivityResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitRefreshResultResp) ProtoMessage() {}
+func (*ClearUserActivityResp) ProtoMessage() {}
 
-func (x *SubmitRefreshResultResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[102]
+func (x *ClearUserActivityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5481,41 +9682,33 @@ func (x *SubmitRefreshResultResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitRefreshResultResp.ProtoReflect.Descriptor instead.
-func (*SubmitRefreshResultResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{102}
-}
-
-func (x *SubmitRefreshResultResp) GetRefreshTime() int64 {
-	if x != nil {
-		return x.RefreshTime
-	}
-	return 0
+// Deprecated: Use ClearUserActivityResp.ProtoReflect.Descriptor instead.
+func (*ClearUserActivityResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{176}
 }
 
-// 获取刷新状态请求
-type GetRefreshStatusReq struct {
+type GetUserActivitiesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRefreshStatusReq) Reset() {
-	*x = GetRefreshStatusReq{}
-	mi := &file_user_user_proto_msgTypes[103]
+func (x *GetUserActivitiesReq) Reset() {
+	*x = GetUserActivitiesReq{}
+	mi := &file_user_user_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRefreshStatusReq) String() string {
+func (x *GetUserActivitiesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRefreshStatusReq) ProtoMessage() {}
+func (*GetUserActivitiesReq) ProtoMessage() {}
 
-func (x *GetRefreshStatusReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[103]
+func (x *GetUserActivitiesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5526,40 +9719,40 @@ func (x *GetRefreshStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRefreshStatusReq.ProtoReflect.Descriptor instead.
-func (*GetRefreshStatusReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use GetUserActivitiesReq.ProtoReflect.Descriptor instead.
+func (*GetUserActivitiesReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *GetRefreshStatusReq) GetUserID() string {
+func (x *GetUserActivitiesReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-type GetRefreshStatusResp struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Status        *QuickReplyRefreshStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+type GetUserActivitiesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activities    []*sdkws.UserActivity  `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRefreshStatusResp) Reset() {
-	*x = GetRefreshStatusResp{}
-	mi := &file_user_user_proto_msgTypes[104]
+func (x *GetUserActivitiesResp) Reset() {
+	*x = GetUserActivitiesResp{}
+	mi := &file_user_user_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRefreshStatusResp) String() string {
+func (x *GetUserActivitiesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRefreshStatusResp) ProtoMessage() {}
+func (*GetUserActivitiesResp) ProtoMessage() {}
 
-func (x *GetRefreshStatusResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[104]
+func (x *GetUserActivitiesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5570,42 +9763,42 @@ func (x *GetRefreshStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRefreshStatusResp.ProtoReflect.Descriptor instead.
-func (*GetRefreshStatusResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use GetUserActivitiesResp.ProtoReflect.Descriptor instead.
+func (*GetUserActivitiesResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *GetRefreshStatusResp) GetStatus() *QuickReplyRefreshStatus {
+func (x *GetUserActivitiesResp) GetActivities() []*sdkws.UserActivity {
 	if x != nil {
-		return x.Status
+		return x.Activities
 	}
 	return nil
 }
 
-// 批量更新AI快捷回复请求
-type BatchUpsertAIQuickRepliesReq struct {
+// clearExpiredUserPresenceReq 由定时任务调用，清除 clearTime 早于 timestamp 的自定义状态以及 endTime 早于 timestamp 的自动状态
+type ClearExpiredUserPresenceReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Replies       []*QuickReplyInfo      `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies,omitempty"` // AI生成的快捷回复列表
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchUpsertAIQuickRepliesReq) Reset() {
-	*x = BatchUpsertAIQuickRepliesReq{}
-	mi := &file_user_user_proto_msgTypes[105]
+func (x *ClearExpiredUserPresenceReq) Reset() {
+	*x = ClearExpiredUserPresenceReq{}
+	mi := &file_user_user_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchUpsertAIQuickRepliesReq) String() string {
+func (x *ClearExpiredUserPresenceReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchUpsertAIQuickRepliesReq) ProtoMessage() {}
+func (*ClearExpiredUserPresenceReq) ProtoMessage() {}
 
-func (x *BatchUpsertAIQuickRepliesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[105]
+func (x *ClearExpiredUserPresenceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5616,47 +9809,47 @@ func (x *BatchUpsertAIQuickRepliesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchUpsertAIQuickRepliesReq.ProtoReflect.Descriptor instead.
-func (*BatchUpsertAIQuickRepliesReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use ClearExpiredUserPresenceReq.ProtoReflect.Descriptor instead.
+func (*ClearExpiredUserPresenceReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *BatchUpsertAIQuickRepliesReq) GetUserID() string {
+func (x *ClearExpiredUserPresenceReq) GetTimestamp() int64 {
 	if x != nil {
-		return x.UserID
+		return x.Timestamp
 	}
-	return ""
+	return 0
 }
 
-func (x *BatchUpsertAIQuickRepliesReq) GetReplies() []*QuickReplyInfo {
+func (x *ClearExpiredUserPresenceReq) GetLimit() int32 {
 	if x != nil {
-		return x.Replies
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type BatchUpsertAIQuickRepliesResp struct {
+type ClearExpiredUserPresenceResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UpdateTime    int64                  `protobuf:"varint,1,opt,name=updateTime,proto3" json:"updateTime,omitempty"` // 更新完成时间
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchUpsertAIQuickRepliesResp) Reset() {
-	*x = BatchUpsertAIQuickRepliesResp{}
-	mi := &file_user_user_proto_msgTypes[106]
+func (x *ClearExpiredUserPresenceResp) Reset() {
+	*x = ClearExpiredUserPresenceResp{}
+	mi := &file_user_user_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchUpsertAIQuickRepliesResp) String() string {
+func (x *ClearExpiredUserPresenceResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchUpsertAIQuickRepliesResp) ProtoMessage() {}
+func (*ClearExpiredUserPresenceResp) ProtoMessage() {}
 
-func (x *BatchUpsertAIQuickRepliesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[106]
+func (x *ClearExpiredUserPresenceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5667,45 +9860,46 @@ func (x *BatchUpsertAIQuickRepliesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchUpsertAIQuickRepliesResp.ProtoReflect.Descriptor instead.
-func (*BatchUpsertAIQuickRepliesResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use ClearExpiredUserPresenceResp.ProtoReflect.Descriptor instead.
+func (*ClearExpiredUserPresenceResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *BatchUpsertAIQuickRepliesResp) GetUpdateTime() int64 {
+func (x *ClearExpiredUserPresenceResp) GetCount() int32 {
 	if x != nil {
-		return x.UpdateTime
+		return x.Count
 	}
 	return 0
 }
 
-// SignatureInfo 签名信息
-type SignatureInfo struct {
+// UserPrivacySettings 用户隐私设置，每一项取值为可见范围 constant.PrivacyAudience*
+type UserPrivacySettings struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                  // 签名ID
-	Icon          string                 `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`              // 图标URL或表情
-	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`              // 签名文本
-	CreateTime    int64                  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime,omitempty"` // 创建时间（Unix毫秒时间戳）
-	UpdateTime    int64                  `protobuf:"varint,5,opt,name=updateTime,proto3" json:"updateTime,omitempty"` // 更新时间（Unix毫秒时间戳）
+	FriendRequest int32                  `protobuf:"varint,1,opt,name=friendRequest,proto3" json:"friendRequest,omitempty"` // 谁可以向我发起好友申请
+	OnlineStatus  int32                  `protobuf:"varint,2,opt,name=onlineStatus,proto3" json:"onlineStatus,omitempty"`   // 谁可以订阅/查看我的在线状态
+	LastSeen      int32                  `protobuf:"varint,3,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`           // 谁可以看到我的最后在线时间
+	Signature     int32                  `protobuf:"varint,4,opt,name=signature,proto3" json:"signature,omitempty"`         // 谁可以看到我的签名
+	Avatar        int32                  `protobuf:"varint,5,opt,name=avatar,proto3" json:"avatar,omitempty"`               // 谁可以看到我的头像
+	Discoverable  int32                  `protobuf:"varint,6,opt,name=discoverable,proto3" json:"discoverable,omitempty"`   // 谁可以在好友推荐中看到我，PrivacyAudienceNobody 表示不出现在推荐中
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SignatureInfo) Reset() {
-	*x = SignatureInfo{}
-	mi := &file_user_user_proto_msgTypes[107]
+func (x *UserPrivacySettings) Reset() {
+	*x = UserPrivacySettings{}
+	mi := &file_user_user_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SignatureInfo) String() string {
+func (x *UserPrivacySettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignatureInfo) ProtoMessage() {}
+func (*UserPrivacySettings) ProtoMessage() {}
 
-func (x *SignatureInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[107]
+func (x *UserPrivacySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5716,70 +9910,75 @@ func (x *SignatureInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignatureInfo.ProtoReflect.Descriptor instead.
-func (*SignatureInfo) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use UserPrivacySettings.ProtoReflect.Descriptor instead.
+func (*UserPrivacySettings) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *SignatureInfo) GetId() string {
+func (x *UserPrivacySettings) GetFriendRequest() int32 {
 	if x != nil {
-		return x.Id
+		return x.FriendRequest
+	}
+	return 0
+}
+
+func (x *UserPrivacySettings) GetOnlineStatus() int32 {
+	if x != nil {
+		return x.OnlineStatus
 	}
-	return ""
+	return 0
 }
 
-func (x *SignatureInfo) GetIcon() string {
+func (x *UserPrivacySettings) GetLastSeen() int32 {
 	if x != nil {
-		return x.Icon
+		return x.LastSeen
 	}
-	return ""
+	return 0
 }
 
-func (x *SignatureInfo) GetText() string {
+func (x *UserPrivacySettings) GetSignature() int32 {
 	if x != nil {
-		return x.Text
+		return x.Signature
 	}
-	return ""
+	return 0
 }
 
-func (x *SignatureInfo) GetCreateTime() int64 {
+func (x *UserPrivacySettings) GetAvatar() int32 {
 	if x != nil {
-		return x.CreateTime
+		return x.Avatar
 	}
 	return 0
 }
 
-func (x *SignatureInfo) GetUpdateTime() int64 {
+func (x *UserPrivacySettings) GetDiscoverable() int32 {
 	if x != nil {
-		return x.UpdateTime
+		return x.Discoverable
 	}
 	return 0
 }
 
-// GetSignatureListReq 获取签名列表请求
-type GetSignatureListReq struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 可选：分页参数
-	Pagination    *sdkws.RequestPagination `protobuf:"bytes,1,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+type GetUserPrivacySettingsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignatureListReq) Reset() {
-	*x = GetSignatureListReq{}
-	mi := &file_user_user_proto_msgTypes[108]
+func (x *GetUserPrivacySettingsReq) Reset() {
+	*x = GetUserPrivacySettingsReq{}
+	mi := &file_user_user_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignatureListReq) String() string {
+func (x *GetUserPrivacySettingsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignatureListReq) ProtoMessage() {}
+func (*GetUserPrivacySettingsReq) ProtoMessage() {}
 
-func (x *GetSignatureListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[108]
+func (x *GetUserPrivacySettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5790,42 +9989,40 @@ func (x *GetSignatureListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignatureListReq.ProtoReflect.Descriptor instead.
-func (*GetSignatureListReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use GetUserPrivacySettingsReq.ProtoReflect.Descriptor instead.
+func (*GetUserPrivacySettingsReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *GetSignatureListReq) GetPagination() *sdkws.RequestPagination {
+func (x *GetUserPrivacySettingsReq) GetUserID() string {
 	if x != nil {
-		return x.Pagination
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-// GetSignatureListResp 获取签名列表响应
-type GetSignatureListResp struct {
+type GetUserPrivacySettingsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Signatures    []*SignatureInfo       `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"` // 签名列表
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`          // 总数（如果分页）
+	Settings      *UserPrivacySettings   `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignatureListResp) Reset() {
-	*x = GetSignatureListResp{}
-	mi := &file_user_user_proto_msgTypes[109]
+func (x *GetUserPrivacySettingsResp) Reset() {
+	*x = GetUserPrivacySettingsResp{}
+	mi := &file_user_user_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignatureListResp) String() string {
+func (x *GetUserPrivacySettingsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignatureListResp) ProtoMessage() {}
+func (*GetUserPrivacySettingsResp) ProtoMessage() {}
 
-func (x *GetSignatureListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[109]
+func (x *GetUserPrivacySettingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5836,48 +10033,47 @@ func (x *GetSignatureListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignatureListResp.ProtoReflect.Descriptor instead.
-func (*GetSignatureListResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use GetUserPrivacySettingsResp.ProtoReflect.Descriptor instead.
+func (*GetUserPrivacySettingsResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *GetSignatureListResp) GetSignatures() []*SignatureInfo {
+func (x *GetUserPrivacySettingsResp) GetSettings() *UserPrivacySettings {
 	if x != nil {
-		return x.Signatures
+		return x.Settings
 	}
 	return nil
 }
 
-func (x *GetSignatureListResp) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-// updateAvatarReq 更新用户头像请求（文件需先上传至 OSS，再提交 faceURL）
-type UpdateAvatarReq struct {
+// setUserPrivacySettingsReq 只更新传入的项
+type SetUserPrivacySettingsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FaceURL       string                 `protobuf:"bytes,1,opt,name=faceURL,proto3" json:"face_url"` // 头像 URL（必填）
+	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	FriendRequest *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=friendRequest,proto3" json:"friendRequest,omitempty"`
+	OnlineStatus  *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=onlineStatus,proto3" json:"onlineStatus,omitempty"`
+	LastSeen      *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	Signature     *wrapperspb.Int32Value `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	Avatar        *wrapperspb.Int32Value `protobuf:"bytes,6,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Discoverable  *wrapperspb.Int32Value `protobuf:"bytes,7,opt,name=discoverable,proto3" json:"discoverable,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAvatarReq) Reset() {
-	*x = UpdateAvatarReq{}
-	mi := &file_user_user_proto_msgTypes[110]
+func (x *SetUserPrivacySettingsReq) Reset() {
+	*x = SetUserPrivacySettingsReq{}
+	mi := &file_user_user_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAvatarReq) String() string {
+func (x *SetUserPrivacySettingsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAvatarReq) ProtoMessage() {}
+func (*SetUserPrivacySettingsReq) ProtoMessage() {}
 
-func (x *UpdateAvatarReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[110]
+func (x *SetUserPrivacySettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5888,109 +10084,82 @@ func (x *UpdateAvatarReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAvatarReq.ProtoReflect.Descriptor instead.
-func (*UpdateAvatarReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use SetUserPrivacySettingsReq.ProtoReflect.Descriptor instead.
+func (*SetUserPrivacySettingsReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *UpdateAvatarReq) GetFaceURL() string {
+func (x *SetUserPrivacySettingsReq) GetUserID() string {
 	if x != nil {
-		return x.FaceURL
+		return x.UserID
 	}
 	return ""
 }
 
-// updateAvatarResp 更新用户头像响应
-type UpdateAvatarResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FaceURL       string                 `protobuf:"bytes,1,opt,name=faceURL,proto3" json:"face_url"`          // 更新后的头像 URL
-	UploadCount   int32                  `protobuf:"varint,2,opt,name=uploadCount,proto3" json:"upload_count"` // 当前用户已上传/修改次数
-	UploadLimit   int32                  `protobuf:"varint,3,opt,name=uploadLimit,proto3" json:"upload_limit"` // 系统允许的最大次数（0 表示不限制）
-	Remaining     int32                  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining"`     // 剩余可上传次数（uploadLimit=0 时为 -1）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateAvatarResp) Reset() {
-	*x = UpdateAvatarResp{}
-	mi := &file_user_user_proto_msgTypes[111]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateAvatarResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateAvatarResp) ProtoMessage() {}
-
-func (x *UpdateAvatarResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[111]
+func (x *SetUserPrivacySettingsReq) GetFriendRequest() *wrapperspb.Int32Value {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FriendRequest
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UpdateAvatarResp.ProtoReflect.Descriptor instead.
-func (*UpdateAvatarResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{111}
+func (x *SetUserPrivacySettingsReq) GetOnlineStatus() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.OnlineStatus
+	}
+	return nil
 }
 
-func (x *UpdateAvatarResp) GetFaceURL() string {
+func (x *SetUserPrivacySettingsReq) GetLastSeen() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.FaceURL
+		return x.LastSeen
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateAvatarResp) GetUploadCount() int32 {
+func (x *SetUserPrivacySettingsReq) GetSignature() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.UploadCount
+		return x.Signature
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateAvatarResp) GetUploadLimit() int32 {
+func (x *SetUserPrivacySettingsReq) GetAvatar() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.UploadLimit
+		return x.Avatar
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateAvatarResp) GetRemaining() int32 {
+func (x *SetUserPrivacySettingsReq) GetDiscoverable() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.Remaining
+		return x.Discoverable
 	}
-	return 0
+	return nil
 }
 
-// getAvatarUploadQuotaReq 获取头像上传配额请求
-type GetAvatarUploadQuotaReq struct {
+type SetUserPrivacySettingsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *UserPrivacySettings   `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAvatarUploadQuotaReq) Reset() {
-	*x = GetAvatarUploadQuotaReq{}
-	mi := &file_user_user_proto_msgTypes[112]
+func (x *SetUserPrivacySettingsResp) Reset() {
+	*x = SetUserPrivacySettingsResp{}
+	mi := &file_user_user_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvatarUploadQuotaReq) String() string {
+func (x *SetUserPrivacySettingsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvatarUploadQuotaReq) ProtoMessage() {}
+func (*SetUserPrivacySettingsResp) ProtoMessage() {}
 
-func (x *GetAvatarUploadQuotaReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[112]
+func (x *SetUserPrivacySettingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6001,36 +10170,43 @@ func (x *GetAvatarUploadQuotaReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvatarUploadQuotaReq.ProtoReflect.Descriptor instead.
-func (*GetAvatarUploadQuotaReq) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use SetUserPrivacySettingsResp.ProtoReflect.Descriptor instead.
+func (*SetUserPrivacySettingsResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{185}
 }
 
-// getAvatarUploadQuotaResp 获取头像上传配额响应
-type GetAvatarUploadQuotaResp struct {
+func (x *SetUserPrivacySettingsResp) GetSettings() *UserPrivacySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// checkUserPrivacyReq 供关系链、在线状态订阅等调用方校验 viewerUserID 能否访问 ownerUserIDs 的某一隐私项
+type CheckUserPrivacyReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UploadCount   int32                  `protobuf:"varint,1,opt,name=uploadCount,proto3" json:"upload_count"` // 当前用户已上传/修改次数
-	UploadLimit   int32                  `protobuf:"varint,2,opt,name=uploadLimit,proto3" json:"upload_limit"` // 系统允许的最大次数（0 表示不限制）
-	Remaining     int32                  `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining"`     // 剩余可上传次数（uploadLimit=0 时为 -1）
+	ViewerUserID  string                 `protobuf:"bytes,1,opt,name=viewerUserID,proto3" json:"viewerUserID,omitempty"`
+	OwnerUserIDs  []string               `protobuf:"bytes,2,rep,name=ownerUserIDs,proto3" json:"ownerUserIDs,omitempty"`
+	Item          int32                  `protobuf:"varint,3,opt,name=item,proto3" json:"item,omitempty"` // 隐私项 constant.PrivacyItem*
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAvatarUploadQuotaResp) Reset() {
-	*x = GetAvatarUploadQuotaResp{}
-	mi := &file_user_user_proto_msgTypes[113]
+func (x *CheckUserPrivacyReq) Reset() {
+	*x = CheckUserPrivacyReq{}
+	mi := &file_user_user_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvatarUploadQuotaResp) String() string {
+func (x *CheckUserPrivacyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvatarUploadQuotaResp) ProtoMessage() {}
+func (*CheckUserPrivacyReq) ProtoMessage() {}
 
-func (x *GetAvatarUploadQuotaResp) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[113]
+func (x *CheckUserPrivacyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6041,32 +10217,76 @@ func (x *GetAvatarUploadQuotaResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvatarUploadQuotaResp.ProtoReflect.Descriptor instead.
-func (*GetAvatarUploadQuotaResp) Descriptor() ([]byte, []int) {
-	return file_user_user_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use CheckUserPrivacyReq.ProtoReflect.Descriptor instead.
+func (*CheckUserPrivacyReq) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{186}
 }
 
-func (x *GetAvatarUploadQuotaResp) GetUploadCount() int32 {
+func (x *CheckUserPrivacyReq) GetViewerUserID() string {
 	if x != nil {
-		return x.UploadCount
+		return x.ViewerUserID
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAvatarUploadQuotaResp) GetUploadLimit() int32 {
+func (x *CheckUserPrivacyReq) GetOwnerUserIDs() []string {
 	if x != nil {
-		return x.UploadLimit
+		return x.OwnerUserIDs
 	}
-	return 0
+	return nil
 }
 
-func (x *GetAvatarUploadQuotaResp) GetRemaining() int32 {
+func (x *CheckUserPrivacyReq) GetItem() int32 {
 	if x != nil {
-		return x.Remaining
+		return x.Item
 	}
 	return 0
 }
 
+type CheckUserPrivacyResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       map[string]bool        `protobuf:"bytes,1,rep,name=allowed,proto3" json:"allowed,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // ownerUserID -> 是否允许
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckUserPrivacyResp) Reset() {
+	*x = CheckUserPrivacyResp{}
+	mi := &file_user_user_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUserPrivacyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserPrivacyResp) ProtoMessage() {}
+
+func (x *CheckUserPrivacyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_user_user_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserPrivacyResp.ProtoReflect.Descriptor instead.
+func (*CheckUserPrivacyResp) Descriptor() ([]byte, []int) {
+	return file_user_user_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *CheckUserPrivacyResp) GetAllowed() map[string]bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return nil
+}
+
 type AccountCheckRespSingleUserStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
@@ -6077,7 +10297,7 @@ type AccountCheckRespSingleUserStatus struct {
 
 func (x *AccountCheckRespSingleUserStatus) Reset() {
 	*x = AccountCheckRespSingleUserStatus{}
-	mi := &file_user_user_proto_msgTypes[114]
+	mi := &file_user_user_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6089,7 +10309,7 @@ func (x *AccountCheckRespSingleUserStatus) String() string {
 func (*AccountCheckRespSingleUserStatus) ProtoMessage() {}
 
 func (x *AccountCheckRespSingleUserStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_user_user_proto_msgTypes[114]
+	mi := &file_user_user_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6205,7 +10425,50 @@ const file_user_user_proto_rawDesc = "" +
 	"\x05users\x18\x02 \x03(\v2\x16.openim.sdkws.UserInfoR\x05users\"?\n" +
 	"\x0fuserRegisterReq\x12,\n" +
 	"\x05users\x18\x01 \x03(\v2\x16.openim.sdkws.UserInfoR\x05users\"\x12\n" +
-	"\x10userRegisterResp\"4\n" +
+	"\x10userRegisterResp\"E\n" +
+	"\x11deactivateUserReq\x12\x18\n" +
+	"\auserIDs\x18\x01 \x03(\tR\auserIDs\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x14\n" +
+	"\x12deactivateUserResp\"-\n" +
+	"\x11reactivateUserReq\x12\x18\n" +
+	"\auserIDs\x18\x01 \x03(\tR\auserIDs\"\x14\n" +
+	"\x12reactivateUserResp\"l\n" +
+	"\feraseUserReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12,\n" +
+	"\x11ownerTransferRule\x18\x02 \x01(\x05R\x11ownerTransferRule\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"%\n" +
+	"\reraseUserResp\x12\x14\n" +
+	"\x05jobID\x18\x01 \x01(\tR\x05jobID\"}\n" +
+	"\reraseUserStep\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04done\x18\x04 \x01(\x03R\x04done\x12\x16\n" +
+	"\x06errMsg\x18\x05 \x01(\tR\x06errMsg\"\xfc\x02\n" +
+	"\feraseUserJob\x12\x14\n" +
+	"\x05jobID\x18\x01 \x01(\tR\x05jobID\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x1a\n" +
+	"\bprogress\x18\x04 \x01(\x05R\bprogress\x120\n" +
+	"\x05steps\x18\x05 \x03(\v2\x1a.openim.user.eraseUserStepR\x05steps\x12,\n" +
+	"\x11ownerTransferRule\x18\x06 \x01(\x05R\x11ownerTransferRule\x12\x1a\n" +
+	"\bopUserID\x18\a \x01(\tR\bopUserID\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\t \x01(\x03R\n" +
+	"createTime\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\n" +
+	" \x01(\x03R\n" +
+	"updateTime\x12\x1e\n" +
+	"\n" +
+	"finishTime\x18\v \x01(\x03R\n" +
+	"finishTime\x12\x16\n" +
+	"\x06errMsg\x18\f \x01(\tR\x06errMsg\"*\n" +
+	"\x12getEraseUserJobReq\x12\x14\n" +
+	"\x05jobID\x18\x01 \x01(\tR\x05jobID\"B\n" +
+	"\x13getEraseUserJobResp\x12+\n" +
+	"\x03job\x18\x01 \x01(\v2\x19.openim.user.eraseUserJobR\x03job\"4\n" +
 	"\x1agetGlobalRecvMessageOptReq\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\"I\n" +
 	"\x1bgetGlobalRecvMessageOptResp\x12*\n" +
@@ -6234,17 +10497,23 @@ const file_user_user_proto_rawDesc = "" +
 	"\x1bgetSubscribeUsersStatusResp\x129\n" +
 	"\n" +
 	"statusList\x18\x01 \x03(\v2\x19.openim.user.onlineStatusR\n" +
-	"statusList\"H\n" +
+	"statusList\"d\n" +
 	"\x0eplatformDetail\x12\x1e\n" +
 	"\n" +
 	"platformID\x18\x01 \x01(\x05R\n" +
 	"platformID\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\x05R\x06status\"\xb1\x01\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1a\n" +
+	"\bactivity\x18\x03 \x01(\x05R\bactivity\"\xa5\x02\n" +
 	"\fonlineStatus\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12 \n" +
 	"\vplatformIDs\x18\x03 \x03(\x05R\vplatformIDs\x12O\n" +
-	"\x14detailPlatformStatus\x18\x04 \x03(\v2\x1b.openim.user.platformDetailR\x14detailPlatformStatus\"D\n" +
+	"\x14detailPlatformStatus\x18\x04 \x03(\v2\x1b.openim.user.platformDetailR\x14detailPlatformStatus\x12\x1e\n" +
+	"\n" +
+	"restricted\x18\x05 \x01(\bR\n" +
+	"restricted\x126\n" +
+	"\bpresence\x18\x06 \x01(\v2\x1a.openim.sdkws.UserPresenceR\bpresence\x12\x1a\n" +
+	"\bactivity\x18\a \x01(\x05R\bactivity\"D\n" +
 	"\x10getUserStatusReq\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x18\n" +
 	"\auserIDs\x18\x02 \x03(\tR\auserIDs\"N\n" +
@@ -6396,7 +10665,85 @@ const file_user_user_proto_rawDesc = "" +
 	"\fclientConfig\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
 	"\x06userID\x18\x02 \x01(\tR\x06userID\x12\x14\n" +
-	"\x05value\x18\x03 \x01(\tR\x05value\"\x8e\x03\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\xc0\x01\n" +
+	"\x12clientConfigSchema\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1c\n" +
+	"\tvalueType\x18\x02 \x01(\x05R\tvalueType\x12\"\n" +
+	"\fdefaultValue\x18\x03 \x01(\tR\fdefaultValue\x12\x14\n" +
+	"\x05scope\x18\x04 \x01(\x05R\x05scope\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\x06 \x01(\x03R\n" +
+	"updateTime\"[\n" +
+	"\x1eregisterClientConfigSchemasReq\x129\n" +
+	"\aschemas\x18\x01 \x03(\v2\x1f.openim.user.clientConfigSchemaR\aschemas\"!\n" +
+	"\x1fregisterClientConfigSchemasResp\"/\n" +
+	"\x19getClientConfigSchemasReq\x12\x12\n" +
+	"\x04keys\x18\x01 \x03(\tR\x04keys\"W\n" +
+	"\x1agetClientConfigSchemasResp\x129\n" +
+	"\aschemas\x18\x01 \x03(\v2\x1f.openim.user.clientConfigSchemaR\aschemas\"\x83\x02\n" +
+	"\x11typedClientConfig\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1c\n" +
+	"\tvalueType\x18\x03 \x01(\x05R\tvalueType\x12\x14\n" +
+	"\x05scope\x18\x04 \x01(\x05R\x05scope\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x05 \x01(\x05R\n" +
+	"platformID\x12\x1a\n" +
+	"\bdeviceID\x18\x06 \x01(\tR\bdeviceID\x12\x18\n" +
+	"\aversion\x18\a \x01(\x04R\aversion\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\b \x01(\x03R\n" +
+	"updateTime\x12\x1c\n" +
+	"\tisDefault\x18\t \x01(\bR\tisDefault\"\x81\x01\n" +
+	"\x17getTypedClientConfigReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x02 \x01(\x05R\n" +
+	"platformID\x12\x1a\n" +
+	"\bdeviceID\x18\x03 \x01(\tR\bdeviceID\x12\x12\n" +
+	"\x04keys\x18\x04 \x03(\tR\x04keys\"T\n" +
+	"\x18getTypedClientConfigResp\x128\n" +
+	"\aconfigs\x18\x01 \x03(\v2\x1e.openim.user.typedClientConfigR\aconfigs\"\xc6\x01\n" +
+	"\x17setTypedClientConfigReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x04 \x01(\x05R\n" +
+	"platformID\x12\x1a\n" +
+	"\bdeviceID\x18\x05 \x01(\tR\bdeviceID\x12!\n" +
+	"\tifVersion\x18\x06 \x01(\x04H\x00R\tifVersion\x88\x01\x01B\f\n" +
+	"\n" +
+	"_ifVersion\"R\n" +
+	"\x18setTypedClientConfigResp\x126\n" +
+	"\x06config\x18\x01 \x01(\v2\x1e.openim.user.typedClientConfigR\x06config\"\xb0\x01\n" +
+	"\x17delTypedClientConfigReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x03 \x01(\x05R\n" +
+	"platformID\x12\x1a\n" +
+	"\bdeviceID\x18\x04 \x01(\tR\bdeviceID\x12!\n" +
+	"\tifVersion\x18\x05 \x01(\x04H\x00R\tifVersion\x88\x01\x01B\f\n" +
+	"\n" +
+	"_ifVersion\"\x1a\n" +
+	"\x18delTypedClientConfigResp\"\xab\x01\n" +
+	"\x1dgetIncrementalClientConfigReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\x12\x1e\n" +
+	"\n" +
+	"platformID\x18\x04 \x01(\x05R\n" +
+	"platformID\x12\x1a\n" +
+	"\bdeviceID\x18\x05 \x01(\tR\bdeviceID\"\xf4\x01\n" +
+	"\x1egetIncrementalClientConfigResp\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x1c\n" +
+	"\tversionID\x18\x02 \x01(\tR\tversionID\x12\x12\n" +
+	"\x04full\x18\x03 \x01(\bR\x04full\x12\x16\n" +
+	"\x06delete\x18\x04 \x03(\tR\x06delete\x126\n" +
+	"\x06insert\x18\x05 \x03(\v2\x1e.openim.user.typedClientConfigR\x06insert\x126\n" +
+	"\x06update\x18\x06 \x03(\v2\x1e.openim.user.typedClientConfigR\x06update\"\x8e\x03\n" +
 	"\x10saveUserEmojiReq\x12\x18\n" +
 	"\aemojiID\x18\x01 \x01(\tR\aemojiID\x12\x1c\n" +
 	"\temojiName\x18\x02 \x01(\tR\temojiName\x12\x1c\n" +
@@ -6450,7 +10797,111 @@ const file_user_user_proto_rawDesc = "" +
 	"\x13getAllUserEmojisReq\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\"M\n" +
 	"\x14getAllUserEmojisResp\x125\n" +
-	"\x06emojis\x18\x01 \x03(\v2\x1d.openim.user.getUserEmojiRespR\x06emojis\"\xc4\x02\n" +
+	"\x06emojis\x18\x01 \x03(\v2\x1d.openim.user.getUserEmojiRespR\x06emojis\"\xbd\x02\n" +
+	"\temojiPack\x12\x16\n" +
+	"\x06packID\x18\x01 \x01(\tR\x06packID\x12 \n" +
+	"\vownerUserID\x18\x02 \x01(\tR\vownerUserID\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcoverURL\x18\x04 \x01(\tR\bcoverURL\x12\x1a\n" +
+	"\bemojiIDs\x18\x05 \x03(\tR\bemojiIDs\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\x05R\x04sort\x12\"\n" +
+	"\fsourcePackID\x18\a \x01(\tR\fsourcePackID\x12\"\n" +
+	"\fsourceUserID\x18\b \x01(\tR\fsourceUserID\x12\x1e\n" +
+	"\n" +
+	"createTime\x18\t \x01(\x03R\n" +
+	"createTime\x12\x1e\n" +
+	"\n" +
+	"updateTime\x18\n" +
+	" \x01(\x03R\n" +
+	"updateTime\x12\x0e\n" +
+	"\x02ex\x18\v \x01(\tR\x02ex\"\x88\x01\n" +
+	"\x12createEmojiPackReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcoverURL\x18\x03 \x01(\tR\bcoverURL\x12\x1a\n" +
+	"\bemojiIDs\x18\x04 \x03(\tR\bemojiIDs\x12\x0e\n" +
+	"\x02ex\x18\x05 \x01(\tR\x02ex\"A\n" +
+	"\x13createEmojiPackResp\x12*\n" +
+	"\x04pack\x18\x01 \x01(\v2\x16.openim.user.emojiPackR\x04pack\"\xde\x01\n" +
+	"\x12updateEmojiPackReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06packID\x18\x02 \x01(\tR\x06packID\x120\n" +
+	"\x04name\x18\x03 \x01(\v2\x1c.openim.protobuf.StringValueR\x04name\x128\n" +
+	"\bcoverURL\x18\x04 \x01(\v2\x1c.openim.protobuf.StringValueR\bcoverURL\x12,\n" +
+	"\x02ex\x18\x05 \x01(\v2\x1c.openim.protobuf.StringValueR\x02ex\"\x15\n" +
+	"\x13updateEmojiPackResp\"h\n" +
+	"\x12deleteEmojiPackReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06packID\x18\x02 \x01(\tR\x06packID\x12\"\n" +
+	"\fdeleteEmojis\x18\x03 \x01(\bR\fdeleteEmojis\"\x15\n" +
+	"\x13deleteEmojiPackResp\"E\n" +
+	"\x11sortEmojiPacksReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x18\n" +
+	"\apackIDs\x18\x02 \x03(\tR\apackIDs\"\x14\n" +
+	"\x12sortEmojiPacksResp\"`\n" +
+	"\x12addEmojisToPackReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06packID\x18\x02 \x01(\tR\x06packID\x12\x1a\n" +
+	"\bemojiIDs\x18\x03 \x03(\tR\bemojiIDs\"\x15\n" +
+	"\x13addEmojisToPackResp\"e\n" +
+	"\x17removeEmojisFromPackReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06packID\x18\x02 \x01(\tR\x06packID\x12\x1a\n" +
+	"\bemojiIDs\x18\x03 \x03(\tR\bemojiIDs\"\x1a\n" +
+	"\x18removeEmojisFromPackResp\"_\n" +
+	"\x11sortPackEmojisReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06packID\x18\x02 \x01(\tR\x06packID\x12\x1a\n" +
+	"\bemojiIDs\x18\x03 \x03(\tR\bemojiIDs\"\x14\n" +
+	"\x12sortPackEmojisResp\"D\n" +
+	"\x10getEmojiPacksReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x18\n" +
+	"\apackIDs\x18\x02 \x03(\tR\apackIDs\"x\n" +
+	"\x11getEmojiPacksResp\x12,\n" +
+	"\x05packs\x18\x01 \x03(\v2\x16.openim.user.emojiPackR\x05packs\x125\n" +
+	"\x06emojis\x18\x02 \x03(\v2\x1d.openim.user.getUserEmojiRespR\x06emojis\"\x81\x01\n" +
+	"\x11shareEmojiPackReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06packID\x18\x02 \x01(\tR\x06packID\x12 \n" +
+	"\vrecvUserIDs\x18\x03 \x03(\tR\vrecvUserIDs\x12\x1a\n" +
+	"\bgroupIDs\x18\x04 \x03(\tR\bgroupIDs\".\n" +
+	"\x12shareEmojiPackResp\x12\x18\n" +
+	"\ashareID\x18\x01 \x01(\tR\ashareID\"J\n" +
+	"\x16saveSharedEmojiPackReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x18\n" +
+	"\ashareID\x18\x02 \x01(\tR\ashareID\"E\n" +
+	"\x17saveSharedEmojiPackResp\x12*\n" +
+	"\x04pack\x18\x01 \x01(\v2\x16.openim.user.emojiPackR\x04pack\"\xa3\x02\n" +
+	"\x15emojiPackManifestItem\x12\x1a\n" +
+	"\bfileName\x18\x01 \x01(\tR\bfileName\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1c\n" +
+	"\temojiName\x18\x03 \x01(\tR\temojiName\x12\x1c\n" +
+	"\temojiType\x18\x04 \x01(\x05R\temojiType\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x12\n" +
+	"\x04size\x18\a \x01(\x03R\x04size\x12\x1a\n" +
+	"\bduration\x18\b \x01(\x03R\bduration\x12\x1e\n" +
+	"\n" +
+	"isAnimated\x18\t \x01(\bR\n" +
+	"isAnimated\x12\"\n" +
+	"\fthumbnailURL\x18\n" +
+	" \x01(\tR\fthumbnailURL\"\xb1\x01\n" +
+	"\x11emojiPackManifest\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12$\n" +
+	"\rcoverFileName\x18\x03 \x01(\tR\rcoverFileName\x128\n" +
+	"\x05items\x18\x04 \x03(\v2\".openim.user.emojiPackManifestItemR\x05items\x12\x0e\n" +
+	"\x02ex\x18\x05 \x01(\tR\x02ex\"h\n" +
+	"\x12importEmojiPackReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12:\n" +
+	"\bmanifest\x18\x02 \x01(\v2\x1e.openim.user.emojiPackManifestR\bmanifest\"A\n" +
+	"\x13importEmojiPackResp\x12*\n" +
+	"\x04pack\x18\x01 \x01(\v2\x16.openim.user.emojiPackR\x04pack\"D\n" +
+	"\x12exportEmojiPackReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06packID\x18\x02 \x01(\tR\x06packID\"Q\n" +
+	"\x13exportEmojiPackResp\x12:\n" +
+	"\bmanifest\x18\x01 \x01(\v2\x1e.openim.user.emojiPackManifestR\bmanifest\"\xb7\x03\n" +
 	"\x0eQuickReplyInfo\x12\x18\n" +
 	"\areplyID\x18\x01 \x01(\tR\areplyID\x12 \n" +
 	"\vownerUserID\x18\x02 \x01(\tR\vownerUserID\x12\x18\n" +
@@ -6467,15 +10918,21 @@ const file_user_user_proto_rawDesc = "" +
 	"updateTime\x18\n" +
 	" \x01(\x03R\n" +
 	"updateTime\x12\x0e\n" +
-	"\x02ex\x18\v \x01(\tR\x02ex\"\xad\x01\n" +
+	"\x02ex\x18\v \x01(\tR\x02ex\x12\x1e\n" +
+	"\n" +
+	"isTemplate\x18\f \x01(\bR\n" +
+	"isTemplate\x12\x1c\n" +
+	"\tvariables\x18\r \x03(\tR\tvariables\x123\n" +
+	"\x05scope\x18\x0e \x01(\v2\x1d.openim.sdkws.QuickReplyScopeR\x05scope\"\xad\x01\n" +
 	"\x17QuickReplyRefreshStatus\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\"\n" +
 	"\fisRefreshing\x18\x02 \x01(\bR\fisRefreshing\x12(\n" +
 	"\x0flastRefreshTime\x18\x03 \x01(\x03R\x0flastRefreshTime\x12,\n" +
-	"\x11refreshByPlatform\x18\x04 \x01(\tR\x11refreshByPlatform\"J\n" +
+	"\x11refreshByPlatform\x18\x04 \x01(\tR\x11refreshByPlatform\"r\n" +
 	"\x12getQuickRepliesReq\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
-	"\treplyType\x18\x02 \x01(\x05R\treplyType\"v\n" +
+	"\treplyType\x18\x02 \x01(\x05R\treplyType\x12&\n" +
+	"\x0econversationID\x18\x03 \x01(\tR\x0econversationID\"v\n" +
 	"\x13getQuickRepliesResp\x125\n" +
 	"\areplies\x18\x01 \x03(\v2\x1b.openim.user.QuickReplyInfoR\areplies\x12(\n" +
 	"\x0flastRefreshTime\x18\x02 \x01(\x03R\x0flastRefreshTime\"-\n" +
@@ -6522,7 +10979,25 @@ const file_user_user_proto_rawDesc = "" +
 	"\x1dbatchUpsertAIQuickRepliesResp\x12\x1e\n" +
 	"\n" +
 	"updateTime\x18\x01 \x01(\x03R\n" +
-	"updateTime\"\x87\x01\n" +
+	"updateTime\"\x8a\x02\n" +
+	"\x13renderQuickReplyReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x18\n" +
+	"\areplyID\x18\x02 \x01(\tR\areplyID\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12&\n" +
+	"\x0econversationID\x18\x04 \x01(\tR\x0econversationID\x12D\n" +
+	"\x06values\x18\x05 \x03(\v2,.openim.user.renderQuickReplyReq.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd2\x01\n" +
+	"\x14renderQuickReplyResp\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12E\n" +
+	"\x06values\x18\x02 \x03(\v2-.openim.user.renderQuickReplyResp.ValuesEntryR\x06values\x12\x1e\n" +
+	"\n" +
+	"unresolved\x18\x03 \x03(\tR\n" +
+	"unresolved\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x87\x01\n" +
 	"\rSignatureInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04icon\x18\x02 \x01(\tR\x04icon\x12\x12\n" +
@@ -6554,7 +11029,71 @@ const file_user_user_proto_rawDesc = "" +
 	"\x18getAvatarUploadQuotaResp\x12 \n" +
 	"\vuploadCount\x18\x01 \x01(\x05R\vuploadCount\x12 \n" +
 	"\vuploadLimit\x18\x02 \x01(\x05R\vuploadLimit\x12\x1c\n" +
-	"\tremaining\x18\x03 \x01(\x05R\tremaining2\xb3\"\n" +
+	"\tremaining\x18\x03 \x01(\x05R\tremaining\"d\n" +
+	"\x12setUserPresenceReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x126\n" +
+	"\bpresence\x18\x02 \x01(\v2\x1a.openim.sdkws.UserPresenceR\bpresence\"\x15\n" +
+	"\x13setUserPresenceResp\".\n" +
+	"\x14clearUserPresenceReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"\x17\n" +
+	"\x15clearUserPresenceResp\".\n" +
+	"\x12getUserPresenceReq\x12\x18\n" +
+	"\auserIDs\x18\x01 \x03(\tR\auserIDs\"\xbe\x01\n" +
+	"\x13getUserPresenceResp\x12M\n" +
+	"\tpresences\x18\x01 \x03(\v2/.openim.user.getUserPresenceResp.PresencesEntryR\tpresences\x1aX\n" +
+	"\x0ePresencesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.openim.sdkws.UserPresenceR\x05value:\x028\x01\"d\n" +
+	"\x12setUserActivityReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x126\n" +
+	"\bactivity\x18\x02 \x01(\v2\x1a.openim.sdkws.UserActivityR\bactivity\"\x15\n" +
+	"\x13setUserActivityResp\"b\n" +
+	"\x14clearUserActivityReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1a\n" +
+	"\bsourceID\x18\x03 \x01(\tR\bsourceID\"\x17\n" +
+	"\x15clearUserActivityResp\".\n" +
+	"\x14getUserActivitiesReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"S\n" +
+	"\x15getUserActivitiesResp\x12:\n" +
+	"\n" +
+	"activities\x18\x01 \x03(\v2\x1a.openim.sdkws.UserActivityR\n" +
+	"activities\"Q\n" +
+	"\x1bclearExpiredUserPresenceReq\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"4\n" +
+	"\x1cclearExpiredUserPresenceResp\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"\xd5\x01\n" +
+	"\x13UserPrivacySettings\x12$\n" +
+	"\rfriendRequest\x18\x01 \x01(\x05R\rfriendRequest\x12\"\n" +
+	"\fonlineStatus\x18\x02 \x01(\x05R\fonlineStatus\x12\x1a\n" +
+	"\blastSeen\x18\x03 \x01(\x05R\blastSeen\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\x05R\tsignature\x12\x16\n" +
+	"\x06avatar\x18\x05 \x01(\x05R\x06avatar\x12\"\n" +
+	"\fdiscoverable\x18\x06 \x01(\x05R\fdiscoverable\"3\n" +
+	"\x19getUserPrivacySettingsReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"Z\n" +
+	"\x1agetUserPrivacySettingsResp\x12<\n" +
+	"\bsettings\x18\x01 \x01(\v2 .openim.user.UserPrivacySettingsR\bsettings\"\xa1\x03\n" +
+	"\x19setUserPrivacySettingsReq\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12A\n" +
+	"\rfriendRequest\x18\x02 \x01(\v2\x1b.openim.protobuf.Int32ValueR\rfriendRequest\x12?\n" +
+	"\fonlineStatus\x18\x03 \x01(\v2\x1b.openim.protobuf.Int32ValueR\fonlineStatus\x127\n" +
+	"\blastSeen\x18\x04 \x01(\v2\x1b.openim.protobuf.Int32ValueR\blastSeen\x129\n" +
+	"\tsignature\x18\x05 \x01(\v2\x1b.openim.protobuf.Int32ValueR\tsignature\x123\n" +
+	"\x06avatar\x18\x06 \x01(\v2\x1b.openim.protobuf.Int32ValueR\x06avatar\x12?\n" +
+	"\fdiscoverable\x18\a \x01(\v2\x1b.openim.protobuf.Int32ValueR\fdiscoverable\"Z\n" +
+	"\x1asetUserPrivacySettingsResp\x12<\n" +
+	"\bsettings\x18\x01 \x01(\v2 .openim.user.UserPrivacySettingsR\bsettings\"q\n" +
+	"\x13checkUserPrivacyReq\x12\"\n" +
+	"\fviewerUserID\x18\x01 \x01(\tR\fviewerUserID\x12\"\n" +
+	"\fownerUserIDs\x18\x02 \x03(\tR\fownerUserIDs\x12\x12\n" +
+	"\x04item\x18\x03 \x01(\x05R\x04item\"\x9c\x01\n" +
+	"\x14checkUserPrivacyResp\x12H\n" +
+	"\aallowed\x18\x01 \x03(\v2..openim.user.checkUserPrivacyResp.AllowedEntryR\aallowed\x1a:\n" +
+	"\fAllowedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x012\xa1:\n" +
 	"\x04user\x12Z\n" +
 	"\x11getDesignateUsers\x12!.openim.user.getDesignateUsersReq\x1a\".openim.user.getDesignateUsersResp\x12Q\n" +
 	"\x0eupdateUserInfo\x12\x1e.openim.user.updateUserInfoReq\x1a\x1f.openim.user.updateUserInfoResp\x12W\n" +
@@ -6563,7 +11102,11 @@ const file_user_user_proto_rawDesc = "" +
 	"\x17getGlobalRecvMessageOpt\x12'.openim.user.getGlobalRecvMessageOptReq\x1a(.openim.user.getGlobalRecvMessageOptResp\x12K\n" +
 	"\faccountCheck\x12\x1c.openim.user.accountCheckReq\x1a\x1d.openim.user.accountCheckResp\x12]\n" +
 	"\x12getPaginationUsers\x12\".openim.user.getPaginationUsersReq\x1a#.openim.user.getPaginationUsersResp\x12K\n" +
-	"\fuserRegister\x12\x1c.openim.user.userRegisterReq\x1a\x1d.openim.user.userRegisterResp\x12K\n" +
+	"\fuserRegister\x12\x1c.openim.user.userRegisterReq\x1a\x1d.openim.user.userRegisterResp\x12Q\n" +
+	"\x0edeactivateUser\x12\x1e.openim.user.deactivateUserReq\x1a\x1f.openim.user.deactivateUserResp\x12Q\n" +
+	"\x0ereactivateUser\x12\x1e.openim.user.reactivateUserReq\x1a\x1f.openim.user.reactivateUserResp\x12B\n" +
+	"\teraseUser\x12\x19.openim.user.eraseUserReq\x1a\x1a.openim.user.eraseUserResp\x12T\n" +
+	"\x0fgetEraseUserJob\x12\x1f.openim.user.getEraseUserJobReq\x1a .openim.user.getEraseUserJobResp\x12K\n" +
 	"\fgetAllUserID\x12\x1c.openim.user.getAllUserIDReq\x1a\x1d.openim.user.getAllUserIDResp\x12Z\n" +
 	"\x11userRegisterCount\x12!.openim.user.userRegisterCountReq\x1a\".openim.user.userRegisterCountResp\x12{\n" +
 	"\x1csubscribeOrCancelUsersStatus\x12,.openim.user.subscribeOrCancelUsersStatusReq\x1a-.openim.user.subscribeOrCancelUsersStatusResp\x12l\n" +
@@ -6585,23 +11128,52 @@ const file_user_user_proto_rawDesc = "" +
 	"\x13getUserClientConfig\x12#.openim.user.getUserClientConfigReq\x1a$.openim.user.getUserClientConfigResp\x12`\n" +
 	"\x13setUserClientConfig\x12#.openim.user.setUserClientConfigReq\x1a$.openim.user.setUserClientConfigResp\x12`\n" +
 	"\x13delUserClientConfig\x12#.openim.user.delUserClientConfigReq\x1a$.openim.user.delUserClientConfigResp\x12c\n" +
-	"\x14pageUserClientConfig\x12$.openim.user.pageUserClientConfigReq\x1a%.openim.user.pageUserClientConfigResp\x12N\n" +
+	"\x14pageUserClientConfig\x12$.openim.user.pageUserClientConfigReq\x1a%.openim.user.pageUserClientConfigResp\x12x\n" +
+	"\x1bregisterClientConfigSchemas\x12+.openim.user.registerClientConfigSchemasReq\x1a,.openim.user.registerClientConfigSchemasResp\x12i\n" +
+	"\x16getClientConfigSchemas\x12&.openim.user.getClientConfigSchemasReq\x1a'.openim.user.getClientConfigSchemasResp\x12c\n" +
+	"\x14getTypedClientConfig\x12$.openim.user.getTypedClientConfigReq\x1a%.openim.user.getTypedClientConfigResp\x12c\n" +
+	"\x14setTypedClientConfig\x12$.openim.user.setTypedClientConfigReq\x1a%.openim.user.setTypedClientConfigResp\x12c\n" +
+	"\x14delTypedClientConfig\x12$.openim.user.delTypedClientConfigReq\x1a%.openim.user.delTypedClientConfigResp\x12u\n" +
+	"\x1agetIncrementalClientConfig\x12*.openim.user.getIncrementalClientConfigReq\x1a+.openim.user.getIncrementalClientConfigResp\x12N\n" +
 	"\rsaveUserEmoji\x12\x1d.openim.user.saveUserEmojiReq\x1a\x1e.openim.user.saveUserEmojiResp\x12T\n" +
 	"\x0fdeleteUserEmoji\x12\x1f.openim.user.deleteUserEmojiReq\x1a .openim.user.deleteUserEmojiResp\x12K\n" +
 	"\fgetUserEmoji\x12\x1c.openim.user.getUserEmojiReq\x1a\x1d.openim.user.getUserEmojiResp\x12W\n" +
 	"\x10getAllUserEmojis\x12 .openim.user.getAllUserEmojisReq\x1a!.openim.user.getAllUserEmojisResp\x12T\n" +
+	"\x0fcreateEmojiPack\x12\x1f.openim.user.createEmojiPackReq\x1a .openim.user.createEmojiPackResp\x12T\n" +
+	"\x0fupdateEmojiPack\x12\x1f.openim.user.updateEmojiPackReq\x1a .openim.user.updateEmojiPackResp\x12T\n" +
+	"\x0fdeleteEmojiPack\x12\x1f.openim.user.deleteEmojiPackReq\x1a .openim.user.deleteEmojiPackResp\x12Q\n" +
+	"\x0esortEmojiPacks\x12\x1e.openim.user.sortEmojiPacksReq\x1a\x1f.openim.user.sortEmojiPacksResp\x12T\n" +
+	"\x0faddEmojisToPack\x12\x1f.openim.user.addEmojisToPackReq\x1a .openim.user.addEmojisToPackResp\x12c\n" +
+	"\x14removeEmojisFromPack\x12$.openim.user.removeEmojisFromPackReq\x1a%.openim.user.removeEmojisFromPackResp\x12Q\n" +
+	"\x0esortPackEmojis\x12\x1e.openim.user.sortPackEmojisReq\x1a\x1f.openim.user.sortPackEmojisResp\x12N\n" +
+	"\rgetEmojiPacks\x12\x1d.openim.user.getEmojiPacksReq\x1a\x1e.openim.user.getEmojiPacksResp\x12Q\n" +
+	"\x0eshareEmojiPack\x12\x1e.openim.user.shareEmojiPackReq\x1a\x1f.openim.user.shareEmojiPackResp\x12`\n" +
+	"\x13saveSharedEmojiPack\x12#.openim.user.saveSharedEmojiPackReq\x1a$.openim.user.saveSharedEmojiPackResp\x12T\n" +
+	"\x0fimportEmojiPack\x12\x1f.openim.user.importEmojiPackReq\x1a .openim.user.importEmojiPackResp\x12T\n" +
+	"\x0fexportEmojiPack\x12\x1f.openim.user.exportEmojiPackReq\x1a .openim.user.exportEmojiPackResp\x12T\n" +
 	"\x0fgetQuickReplies\x12\x1f.openim.user.getQuickRepliesReq\x1a .openim.user.getQuickRepliesResp\x12W\n" +
 	"\x10syncQuickReplies\x12 .openim.user.syncQuickRepliesReq\x1a!.openim.user.syncQuickRepliesResp\x12W\n" +
 	"\x10upsertQuickReply\x12 .openim.user.upsertQuickReplyReq\x1a!.openim.user.upsertQuickReplyResp\x12W\n" +
 	"\x10deleteQuickReply\x12 .openim.user.deleteQuickReplyReq\x1a!.openim.user.deleteQuickReplyResp\x12N\n" +
-	"\rpinQuickReply\x12\x1d.openim.user.pinQuickReplyReq\x1a\x1e.openim.user.pinQuickReplyResp\x12i\n" +
+	"\rpinQuickReply\x12\x1d.openim.user.pinQuickReplyReq\x1a\x1e.openim.user.pinQuickReplyResp\x12W\n" +
+	"\x10renderQuickReply\x12 .openim.user.renderQuickReplyReq\x1a!.openim.user.renderQuickReplyResp\x12i\n" +
 	"\x16refreshFrequentReplies\x12&.openim.user.refreshFrequentRepliesReq\x1a'.openim.user.refreshFrequentRepliesResp\x12`\n" +
 	"\x13submitRefreshResult\x12#.openim.user.submitRefreshResultReq\x1a$.openim.user.submitRefreshResultResp\x12W\n" +
 	"\x10getRefreshStatus\x12 .openim.user.getRefreshStatusReq\x1a!.openim.user.getRefreshStatusResp\x12r\n" +
 	"\x19batchUpsertAIQuickReplies\x12).openim.user.batchUpsertAIQuickRepliesReq\x1a*.openim.user.batchUpsertAIQuickRepliesResp\x12W\n" +
 	"\x10getSignatureList\x12 .openim.user.GetSignatureListReq\x1a!.openim.user.GetSignatureListResp\x12K\n" +
 	"\fupdateAvatar\x12\x1c.openim.user.updateAvatarReq\x1a\x1d.openim.user.updateAvatarResp\x12c\n" +
-	"\x14getAvatarUploadQuota\x12$.openim.user.getAvatarUploadQuotaReq\x1a%.openim.user.getAvatarUploadQuotaRespB$Z\"github.com/openimsdk/protocol/userb\x06proto3"
+	"\x14getAvatarUploadQuota\x12$.openim.user.getAvatarUploadQuotaReq\x1a%.openim.user.getAvatarUploadQuotaResp\x12i\n" +
+	"\x16getUserPrivacySettings\x12&.openim.user.getUserPrivacySettingsReq\x1a'.openim.user.getUserPrivacySettingsResp\x12i\n" +
+	"\x16setUserPrivacySettings\x12&.openim.user.setUserPrivacySettingsReq\x1a'.openim.user.setUserPrivacySettingsResp\x12W\n" +
+	"\x10checkUserPrivacy\x12 .openim.user.checkUserPrivacyReq\x1a!.openim.user.checkUserPrivacyResp\x12T\n" +
+	"\x0fsetUserPresence\x12\x1f.openim.user.setUserPresenceReq\x1a .openim.user.setUserPresenceResp\x12Z\n" +
+	"\x11clearUserPresence\x12!.openim.user.clearUserPresenceReq\x1a\".openim.user.clearUserPresenceResp\x12T\n" +
+	"\x0fgetUserPresence\x12\x1f.openim.user.getUserPresenceReq\x1a .openim.user.getUserPresenceResp\x12o\n" +
+	"\x18clearExpiredUserPresence\x12(.openim.user.clearExpiredUserPresenceReq\x1a).openim.user.clearExpiredUserPresenceResp\x12T\n" +
+	"\x0fsetUserActivity\x12\x1f.openim.user.setUserActivityReq\x1a .openim.user.setUserActivityResp\x12Z\n" +
+	"\x11clearUserActivity\x12!.openim.user.clearUserActivityReq\x1a\".openim.user.clearUserActivityResp\x12Z\n" +
+	"\x11getUserActivities\x12!.openim.user.getUserActivitiesReq\x1a\".openim.user.getUserActivitiesRespB$Z\"github.com/openimsdk/protocol/userb\x06proto3"
 
 var (
 	file_user_user_proto_rawDescOnce sync.Once
@@ -6615,7 +11187,7 @@ func file_user_user_proto_rawDescGZIP() []byte {
 	return file_user_user_proto_rawDescData
 }
 
-var file_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 119)
+var file_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 197)
 var file_user_user_proto_goTypes = []any{
 	(*GetAllUserIDReq)(nil),                   // 0: openim.user.getAllUserIDReq
 	(*GetAllUserIDResp)(nil),                  // 1: openim.user.getAllUserIDResp
@@ -6645,247 +11217,432 @@ var file_user_user_proto_goTypes = []any{
 	(*GetPaginationUsersResp)(nil),            // 25: openim.user.getPaginationUsersResp
 	(*UserRegisterReq)(nil),                   // 26: openim.user.userRegisterReq
 	(*UserRegisterResp)(nil),                  // 27: openim.user.userRegisterResp
-	(*GetGlobalRecvMessageOptReq)(nil),        // 28: openim.user.getGlobalRecvMessageOptReq
-	(*GetGlobalRecvMessageOptResp)(nil),       // 29: openim.user.getGlobalRecvMessageOptResp
-	(*UserRegisterCountReq)(nil),              // 30: openim.user.userRegisterCountReq
-	(*UserRegisterCountResp)(nil),             // 31: openim.user.userRegisterCountResp
-	(*SubscribeOrCancelUsersStatusReq)(nil),   // 32: openim.user.subscribeOrCancelUsersStatusReq
-	(*SubscribeOrCancelUsersStatusResp)(nil),  // 33: openim.user.subscribeOrCancelUsersStatusResp
-	(*GetSubscribeUsersStatusReq)(nil),        // 34: openim.user.getSubscribeUsersStatusReq
-	(*GetSubscribeUsersStatusResp)(nil),       // 35: openim.user.getSubscribeUsersStatusResp
-	(*PlatformDetail)(nil),                    // 36: openim.user.platformDetail
-	(*OnlineStatus)(nil),                      // 37: openim.user.onlineStatus
-	(*GetUserStatusReq)(nil),                  // 38: openim.user.getUserStatusReq
-	(*GetUserStatusResp)(nil),                 // 39: openim.user.getUserStatusResp
-	(*SetUserStatusReq)(nil),                  // 40: openim.user.setUserStatusReq
-	(*SetUserStatusResp)(nil),                 // 41: openim.user.setUserStatusResp
-	(*UserOnlineStatus)(nil),                  // 42: openim.user.userOnlineStatus
-	(*SetUserOnlineStatusReq)(nil),            // 43: openim.user.setUserOnlineStatusReq
-	(*SetUserOnlineStatusResp)(nil),           // 44: openim.user.setUserOnlineStatusResp
-	(*ProcessUserCommandAddReq)(nil),          // 45: openim.user.processUserCommandAddReq
-	(*ProcessUserCommandAddResp)(nil),         // 46: openim.user.processUserCommandAddResp
-	(*ProcessUserCommandDeleteReq)(nil),       // 47: openim.user.processUserCommandDeleteReq
-	(*ProcessUserCommandDeleteResp)(nil),      // 48: openim.user.processUserCommandDeleteResp
-	(*ProcessUserCommandUpdateReq)(nil),       // 49: openim.user.processUserCommandUpdateReq
-	(*ProcessUserCommandUpdateResp)(nil),      // 50: openim.user.processUserCommandUpdateResp
-	(*ProcessUserCommandGetReq)(nil),          // 51: openim.user.processUserCommandGetReq
-	(*CommandInfoResp)(nil),                   // 52: openim.user.CommandInfoResp
-	(*ProcessUserCommandGetResp)(nil),         // 53: openim.user.processUserCommandGetResp
-	(*ProcessUserCommandGetAllReq)(nil),       // 54: openim.user.processUserCommandGetAllReq
-	(*AllCommandInfoResp)(nil),                // 55: openim.user.AllCommandInfoResp
-	(*ProcessUserCommandGetAllResp)(nil),      // 56: openim.user.processUserCommandGetAllResp
-	(*AddNotificationAccountReq)(nil),         // 57: openim.user.addNotificationAccountReq
-	(*AddNotificationAccountResp)(nil),        // 58: openim.user.addNotificationAccountResp
-	(*UpdateNotificationAccountInfoReq)(nil),  // 59: openim.user.updateNotificationAccountInfoReq
-	(*UpdateNotificationAccountInfoResp)(nil), // 60: openim.user.updateNotificationAccountInfoResp
-	(*SearchNotificationAccountReq)(nil),      // 61: openim.user.searchNotificationAccountReq
-	(*NotificationAccountInfo)(nil),           // 62: openim.user.notificationAccountInfo
-	(*SearchNotificationAccountResp)(nil),     // 63: openim.user.searchNotificationAccountResp
-	(*GetNotificationAccountReq)(nil),         // 64: openim.user.getNotificationAccountReq
-	(*GetNotificationAccountResp)(nil),        // 65: openim.user.getNotificationAccountResp
-	(*SortQueryReq)(nil),                      // 66: openim.user.sortQueryReq
-	(*SortQueryResp)(nil),                     // 67: openim.user.sortQueryResp
-	(*GetAllOnlineUsersReq)(nil),              // 68: openim.user.getAllOnlineUsersReq
-	(*GetAllOnlineUsersResp)(nil),             // 69: openim.user.getAllOnlineUsersResp
-	(*GetUserClientConfigReq)(nil),            // 70: openim.user.getUserClientConfigReq
-	(*GetUserClientConfigResp)(nil),           // 71: openim.user.getUserClientConfigResp
-	(*SetUserClientConfigReq)(nil),            // 72: openim.user.setUserClientConfigReq
-	(*SetUserClientConfigResp)(nil),           // 73: openim.user.setUserClientConfigResp
-	(*DelUserClientConfigReq)(nil),            // 74: openim.user.delUserClientConfigReq
-	(*DelUserClientConfigResp)(nil),           // 75: openim.user.delUserClientConfigResp
-	(*PageUserClientConfigReq)(nil),           // 76: openim.user.pageUserClientConfigReq
-	(*PageUserClientConfigResp)(nil),          // 77: openim.user.pageUserClientConfigResp
-	(*ClientConfig)(nil),                      // 78: openim.user.clientConfig
-	(*SaveUserEmojiReq)(nil),                  // 79: openim.user.saveUserEmojiReq
-	(*SaveUserEmojiResp)(nil),                 // 80: openim.user.saveUserEmojiResp
-	(*DeleteUserEmojiReq)(nil),                // 81: openim.user.deleteUserEmojiReq
-	(*DeleteUserEmojiResp)(nil),               // 82: openim.user.deleteUserEmojiResp
-	(*GetUserEmojiReq)(nil),                   // 83: openim.user.getUserEmojiReq
-	(*GetUserEmojiResp)(nil),                  // 84: openim.user.getUserEmojiResp
-	(*GetAllUserEmojisReq)(nil),               // 85: openim.user.getAllUserEmojisReq
-	(*GetAllUserEmojisResp)(nil),              // 86: openim.user.getAllUserEmojisResp
-	(*QuickReplyInfo)(nil),                    // 87: openim.user.QuickReplyInfo
-	(*QuickReplyRefreshStatus)(nil),           // 88: openim.user.QuickReplyRefreshStatus
-	(*GetQuickRepliesReq)(nil),                // 89: openim.user.getQuickRepliesReq
-	(*GetQuickRepliesResp)(nil),               // 90: openim.user.getQuickRepliesResp
-	(*SyncQuickRepliesReq)(nil),               // 91: openim.user.syncQuickRepliesReq
-	(*SyncQuickRepliesResp)(nil),              // 92: openim.user.syncQuickRepliesResp
-	(*UpsertQuickReplyReq)(nil),               // 93: openim.user.upsertQuickReplyReq
-	(*UpsertQuickReplyResp)(nil),              // 94: openim.user.upsertQuickReplyResp
-	(*DeleteQuickReplyReq)(nil),               // 95: openim.user.deleteQuickReplyReq
-	(*DeleteQuickReplyResp)(nil),              // 96: openim.user.deleteQuickReplyResp
-	(*PinQuickReplyReq)(nil),                  // 97: openim.user.pinQuickReplyReq
-	(*PinQuickReplyResp)(nil),                 // 98: openim.user.pinQuickReplyResp
-	(*RefreshFrequentRepliesReq)(nil),         // 99: openim.user.refreshFrequentRepliesReq
-	(*RefreshFrequentRepliesResp)(nil),        // 100: openim.user.refreshFrequentRepliesResp
-	(*SubmitRefreshResultReq)(nil),            // 101: openim.user.submitRefreshResultReq
-	(*SubmitRefreshResultResp)(nil),           // 102: openim.user.submitRefreshResultResp
-	(*GetRefreshStatusReq)(nil),               // 103: openim.user.getRefreshStatusReq
-	(*GetRefreshStatusResp)(nil),              // 104: openim.user.getRefreshStatusResp
-	(*BatchUpsertAIQuickRepliesReq)(nil),      // 105: openim.user.batchUpsertAIQuickRepliesReq
-	(*BatchUpsertAIQuickRepliesResp)(nil),     // 106: openim.user.batchUpsertAIQuickRepliesResp
-	(*SignatureInfo)(nil),                     // 107: openim.user.SignatureInfo
-	(*GetSignatureListReq)(nil),               // 108: openim.user.GetSignatureListReq
-	(*GetSignatureListResp)(nil),              // 109: openim.user.GetSignatureListResp
-	(*UpdateAvatarReq)(nil),                   // 110: openim.user.updateAvatarReq
-	(*UpdateAvatarResp)(nil),                  // 111: openim.user.updateAvatarResp
-	(*GetAvatarUploadQuotaReq)(nil),           // 112: openim.user.getAvatarUploadQuotaReq
-	(*GetAvatarUploadQuotaResp)(nil),          // 113: openim.user.getAvatarUploadQuotaResp
-	(*AccountCheckRespSingleUserStatus)(nil),  // 114: openim.user.accountCheckResp.singleUserStatus
-	nil,                                       // 115: openim.user.userRegisterCountResp.CountEntry
-	nil,                                       // 116: openim.user.sortQueryReq.UserIDNameEntry
-	nil,                                       // 117: openim.user.getUserClientConfigResp.ConfigsEntry
-	nil,                                       // 118: openim.user.setUserClientConfigReq.ConfigsEntry
-	(*sdkws.RequestPagination)(nil),           // 119: openim.sdkws.RequestPagination
-	(*sdkws.UserInfo)(nil),                    // 120: openim.sdkws.UserInfo
-	(*sdkws.UserInfoWithEx)(nil),              // 121: openim.sdkws.UserInfoWithEx
-	(*conversation.Conversation)(nil),         // 122: openim.conversation.Conversation
-	(*wrapperspb.StringValue)(nil),            // 123: openim.protobuf.StringValue
+	(*DeactivateUserReq)(nil),                 // 28: openim.user.deactivateUserReq
+	(*DeactivateUserResp)(nil),                // 29: openim.user.deactivateUserResp
+	(*ReactivateUserReq)(nil),                 // 30: openim.user.reactivateUserReq
+	(*ReactivateUserResp)(nil),                // 31: openim.user.reactivateUserResp
+	(*EraseUserReq)(nil),                      // 32: openim.user.eraseUserReq
+	(*EraseUserResp)(nil),                     // 33: openim.user.eraseUserResp
+	(*EraseUserStep)(nil),                     // 34: openim.user.eraseUserStep
+	(*EraseUserJob)(nil),                      // 35: openim.user.eraseUserJob
+	(*GetEraseUserJobReq)(nil),                // 36: openim.user.getEraseUserJobReq
+	(*GetEraseUserJobResp)(nil),               // 37: openim.user.getEraseUserJobResp
+	(*GetGlobalRecvMessageOptReq)(nil),        // 38: openim.user.getGlobalRecvMessageOptReq
+	(*GetGlobalRecvMessageOptResp)(nil),       // 39: openim.user.getGlobalRecvMessageOptResp
+	(*UserRegisterCountReq)(nil),              // 40: openim.user.userRegisterCountReq
+	(*UserRegisterCountResp)(nil),             // 41: openim.user.userRegisterCountResp
+	(*SubscribeOrCancelUsersStatusReq)(nil),   // 42: openim.user.subscribeOrCancelUsersStatusReq
+	(*SubscribeOrCancelUsersStatusResp)(nil),  // 43: openim.user.subscribeOrCancelUsersStatusResp
+	(*GetSubscribeUsersStatusReq)(nil),        // 44: openim.user.getSubscribeUsersStatusReq
+	(*GetSubscribeUsersStatusResp)(nil),       // 45: openim.user.getSubscribeUsersStatusResp
+	(*PlatformDetail)(nil),                    // 46: openim.user.platformDetail
+	(*OnlineStatus)(nil),                      // 47: openim.user.onlineStatus
+	(*GetUserStatusReq)(nil),                  // 48: openim.user.getUserStatusReq
+	(*GetUserStatusResp)(nil),                 // 49: openim.user.getUserStatusResp
+	(*SetUserStatusReq)(nil),                  // 50: openim.user.setUserStatusReq
+	(*SetUserStatusResp)(nil),                 // 51: openim.user.setUserStatusResp
+	(*UserOnlineStatus)(nil),                  // 52: openim.user.userOnlineStatus
+	(*SetUserOnlineStatusReq)(nil),            // 53: openim.user.setUserOnlineStatusReq
+	(*SetUserOnlineStatusResp)(nil),           // 54: openim.user.setUserOnlineStatusResp
+	(*ProcessUserCommandAddReq)(nil),          // 55: openim.user.processUserCommandAddReq
+	(*ProcessUserCommandAddResp)(nil),         // 56: openim.user.processUserCommandAddResp
+	(*ProcessUserCommandDeleteReq)(nil),       // 57: openim.user.processUserCommandDeleteReq
+	(*ProcessUserCommandDeleteResp)(nil),      // 58: openim.user.processUserCommandDeleteResp
+	(*ProcessUserCommandUpdateReq)(nil),       // 59: openim.user.processUserCommandUpdateReq
+	(*ProcessUserCommandUpdateResp)(nil),      // 60: openim.user.processUserCommandUpdateResp
+	(*ProcessUserCommandGetReq)(nil),          // 61: openim.user.processUserCommandGetReq
+	(*CommandInfoResp)(nil),                   // 62: openim.user.CommandInfoResp
+	(*ProcessUserCommandGetResp)(nil),         // 63: openim.user.processUserCommandGetResp
+	(*ProcessUserCommandGetAllReq)(nil),       // 64: openim.user.processUserCommandGetAllReq
+	(*AllCommandInfoResp)(nil),                // 65: openim.user.AllCommandInfoResp
+	(*ProcessUserCommandGetAllResp)(nil),      // 66: openim.user.processUserCommandGetAllResp
+	(*AddNotificationAccountReq)(nil),         // 67: openim.user.addNotificationAccountReq
+	(*AddNotificationAccountResp)(nil),        // 68: openim.user.addNotificationAccountResp
+	(*UpdateNotificationAccountInfoReq)(nil),  // 69: openim.user.updateNotificationAccountInfoReq
+	(*UpdateNotificationAccountInfoResp)(nil), // 70: openim.user.updateNotificationAccountInfoResp
+	(*SearchNotificationAccountReq)(nil),      // 71: openim.user.searchNotificationAccountReq
+	(*NotificationAccountInfo)(nil),           // 72: openim.user.notificationAccountInfo
+	(*SearchNotificationAccountResp)(nil),     // 73: openim.user.searchNotificationAccountResp
+	(*GetNotificationAccountReq)(nil),         // 74: openim.user.getNotificationAccountReq
+	(*GetNotificationAccountResp)(nil),        // 75: openim.user.getNotificationAccountResp
+	(*SortQueryReq)(nil),                      // 76: openim.user.sortQueryReq
+	(*SortQueryResp)(nil),                     // 77: openim.user.sortQueryResp
+	(*GetAllOnlineUsersReq)(nil),              // 78: openim.user.getAllOnlineUsersReq
+	(*GetAllOnlineUsersResp)(nil),             // 79: openim.user.getAllOnlineUsersResp
+	(*GetUserClientConfigReq)(nil),            // 80: openim.user.getUserClientConfigReq
+	(*GetUserClientConfigResp)(nil),           // 81: openim.user.getUserClientConfigResp
+	(*SetUserClientConfigReq)(nil),            // 82: openim.user.setUserClientConfigReq
+	(*SetUserClientConfigResp)(nil),           // 83: openim.user.setUserClientConfigResp
+	(*DelUserClientConfigReq)(nil),            // 84: openim.user.delUserClientConfigReq
+	(*DelUserClientConfigResp)(nil),           // 85: openim.user.delUserClientConfigResp
+	(*PageUserClientConfigReq)(nil),           // 86: openim.user.pageUserClientConfigReq
+	(*PageUserClientConfigResp)(nil),          // 87: openim.user.pageUserClientConfigResp
+	(*ClientConfig)(nil),                      // 88: openim.user.clientConfig
+	(*ClientConfigSchema)(nil),                // 89: openim.user.clientConfigSchema
+	(*RegisterClientConfigSchemasReq)(nil),    // 90: openim.user.registerClientConfigSchemasReq
+	(*RegisterClientConfigSchemasResp)(nil),   // 91: openim.user.registerClientConfigSchemasResp
+	(*GetClientConfigSchemasReq)(nil),         // 92: openim.user.getClientConfigSchemasReq
+	(*GetClientConfigSchemasResp)(nil),        // 93: openim.user.getClientConfigSchemasResp
+	(*TypedClientConfig)(nil),                 // 94: openim.user.typedClientConfig
+	(*GetTypedClientConfigReq)(nil),           // 95: openim.user.getTypedClientConfigReq
+	(*GetTypedClientConfigResp)(nil),          // 96: openim.user.getTypedClientConfigResp
+	(*SetTypedClientConfigReq)(nil),           // 97: openim.user.setTypedClientConfigReq
+	(*SetTypedClientConfigResp)(nil),          // 98: openim.user.setTypedClientConfigResp
+	(*DelTypedClientConfigReq)(nil),           // 99: openim.user.delTypedClientConfigReq
+	(*DelTypedClientConfigResp)(nil),          // 100: openim.user.delTypedClientConfigResp
+	(*GetIncrementalClientConfigReq)(nil),     // 101: openim.user.getIncrementalClientConfigReq
+	(*GetIncrementalClientConfigResp)(nil),    // 102: openim.user.getIncrementalClientConfigResp
+	(*SaveUserEmojiReq)(nil),                  // 103: openim.user.saveUserEmojiReq
+	(*SaveUserEmojiResp)(nil),                 // 104: openim.user.saveUserEmojiResp
+	(*DeleteUserEmojiReq)(nil),                // 105: openim.user.deleteUserEmojiReq
+	(*DeleteUserEmojiResp)(nil),               // 106: openim.user.deleteUserEmojiResp
+	(*GetUserEmojiReq)(nil),                   // 107: openim.user.getUserEmojiReq
+	(*GetUserEmojiResp)(nil),                  // 108: openim.user.getUserEmojiResp
+	(*GetAllUserEmojisReq)(nil),               // 109: openim.user.getAllUserEmojisReq
+	(*GetAllUserEmojisResp)(nil),              // 110: openim.user.getAllUserEmojisResp
+	(*EmojiPack)(nil),                         // 111: openim.user.emojiPack
+	(*CreateEmojiPackReq)(nil),                // 112: openim.user.createEmojiPackReq
+	(*CreateEmojiPackResp)(nil),               // 113: openim.user.createEmojiPackResp
+	(*UpdateEmojiPackReq)(nil),                // 114: openim.user.updateEmojiPackReq
+	(*UpdateEmojiPackResp)(nil),               // 115: openim.user.updateEmojiPackResp
+	(*DeleteEmojiPackReq)(nil),                // 116: openim.user.deleteEmojiPackReq
+	(*DeleteEmojiPackResp)(nil),               // 117: openim.user.deleteEmojiPackResp
+	(*SortEmojiPacksReq)(nil),                 // 118: openim.user.sortEmojiPacksReq
+	(*SortEmojiPacksResp)(nil),                // 119: openim.user.sortEmojiPacksResp
+	(*AddEmojisToPackReq)(nil),                // 120: openim.user.addEmojisToPackReq
+	(*AddEmojisToPackResp)(nil),               // 121: openim.user.addEmojisToPackResp
+	(*RemoveEmojisFromPackReq)(nil),           // 122: openim.user.removeEmojisFromPackReq
+	(*RemoveEmojisFromPackResp)(nil),          // 123: openim.user.removeEmojisFromPackResp
+	(*SortPackEmojisReq)(nil),                 // 124: openim.user.sortPackEmojisReq
+	(*SortPackEmojisResp)(nil),                // 125: openim.user.sortPackEmojisResp
+	(*GetEmojiPacksReq)(nil),                  // 126: openim.user.getEmojiPacksReq
+	(*GetEmojiPacksResp)(nil),                 // 127: openim.user.getEmojiPacksResp
+	(*ShareEmojiPackReq)(nil),                 // 128: openim.user.shareEmojiPackReq
+	(*ShareEmojiPackResp)(nil),                // 129: openim.user.shareEmojiPackResp
+	(*SaveSharedEmojiPackReq)(nil),            // 130: openim.user.saveSharedEmojiPackReq
+	(*SaveSharedEmojiPackResp)(nil),           // 131: openim.user.saveSharedEmojiPackResp
+	(*EmojiPackManifestItem)(nil),             // 132: openim.user.emojiPackManifestItem
+	(*EmojiPackManifest)(nil),                 // 133: openim.user.emojiPackManifest
+	(*ImportEmojiPackReq)(nil),                // 134: openim.user.importEmojiPackReq
+	(*ImportEmojiPackResp)(nil),               // 135: openim.user.importEmojiPackResp
+	(*ExportEmojiPackReq)(nil),                // 136: openim.user.exportEmojiPackReq
+	(*ExportEmojiPackResp)(nil),               // 137: openim.user.exportEmojiPackResp
+	(*QuickReplyInfo)(nil),                    // 138: openim.user.QuickReplyInfo
+	(*QuickReplyRefreshStatus)(nil),           // 139: openim.user.QuickReplyRefreshStatus
+	(*GetQuickRepliesReq)(nil),                // 140: openim.user.getQuickRepliesReq
+	(*GetQuickRepliesResp)(nil),               // 141: openim.user.getQuickRepliesResp
+	(*SyncQuickRepliesReq)(nil),               // 142: openim.user.syncQuickRepliesReq
+	(*SyncQuickRepliesResp)(nil),              // 143: openim.user.syncQuickRepliesResp
+	(*UpsertQuickReplyReq)(nil),               // 144: openim.user.upsertQuickReplyReq
+	(*UpsertQuickReplyResp)(nil),              // 145: openim.user.upsertQuickReplyResp
+	(*DeleteQuickReplyReq)(nil),               // 146: openim.user.deleteQuickReplyReq
+	(*DeleteQuickReplyResp)(nil),              // 147: openim.user.deleteQuickReplyResp
+	(*PinQuickReplyReq)(nil),                  // 148: openim.user.pinQuickReplyReq
+	(*PinQuickReplyResp)(nil),                 // 149: openim.user.pinQuickReplyResp
+	(*RefreshFrequentRepliesReq)(nil),         // 150: openim.user.refreshFrequentRepliesReq
+	(*RefreshFrequentRepliesResp)(nil),        // 151: openim.user.refreshFrequentRepliesResp
+	(*SubmitRefreshResultReq)(nil),            // 152: openim.user.submitRefreshResultReq
+	(*SubmitRefreshResultResp)(nil),           // 153: openim.user.submitRefreshResultResp
+	(*GetRefreshStatusReq)(nil),               // 154: openim.user.getRefreshStatusReq
+	(*GetRefreshStatusResp)(nil),              // 155: openim.user.getRefreshStatusResp
+	(*BatchUpsertAIQuickRepliesReq)(nil),      // 156: openim.user.batchUpsertAIQuickRepliesReq
+	(*BatchUpsertAIQuickRepliesResp)(nil),     // 157: openim.user.batchUpsertAIQuickRepliesResp
+	(*RenderQuickReplyReq)(nil),               // 158: openim.user.renderQuickReplyReq
+	(*RenderQuickReplyResp)(nil),              // 159: openim.user.renderQuickReplyResp
+	(*SignatureInfo)(nil),                     // 160: openim.user.SignatureInfo
+	(*GetSignatureListReq)(nil),               // 161: openim.user.GetSignatureListReq
+	(*GetSignatureListResp)(nil),              // 162: openim.user.GetSignatureListResp
+	(*UpdateAvatarReq)(nil),                   // 163: openim.user.updateAvatarReq
+	(*UpdateAvatarResp)(nil),                  // 164: openim.user.updateAvatarResp
+	(*GetAvatarUploadQuotaReq)(nil),           // 165: openim.user.getAvatarUploadQuotaReq
+	(*GetAvatarUploadQuotaResp)(nil),          // 166: openim.user.getAvatarUploadQuotaResp
+	(*SetUserPresenceReq)(nil),                // 167: openim.user.setUserPresenceReq
+	(*SetUserPresenceResp)(nil),               // 168: openim.user.setUserPresenceResp
+	(*ClearUserPresenceReq)(nil),              // 169: openim.user.clearUserPresenceReq
+	(*ClearUserPresenceResp)(nil),             // 170: openim.user.clearUserPresenceResp
+	(*GetUserPresenceReq)(nil),                // 171: openim.user.getUserPresenceReq
+	(*GetUserPresenceResp)(nil),               // 172: openim.user.getUserPresenceResp
+	(*SetUserActivityReq)(nil),                // 173: openim.user.setUserActivityReq
+	(*SetUserActivityResp)(nil),               // 174: openim.user.setUserActivityResp
+	(*ClearUserActivityReq)(nil),              // 175: openim.user.clearUserActivityReq
+	(*ClearUserActivityResp)(nil),             // 176: openim.user.clearUserActivityResp
+	(*GetUserActivitiesReq)(nil),              // 177: openim.user.getUserActivitiesReq
+	(*GetUserActivitiesResp)(nil),             // 178: openim.user.getUserActivitiesResp
+	(*ClearExpiredUserPresenceReq)(nil),       // 179: openim.user.clearExpiredUserPresenceReq
+	(*ClearExpiredUserPresenceResp)(nil),      // 180: openim.user.clearExpiredUserPresenceResp
+	(*UserPrivacySettings)(nil),               // 181: openim.user.UserPrivacySettings
+	(*GetUserPrivacySettingsReq)(nil),         // 182: openim.user.getUserPrivacySettingsReq
+	(*GetUserPrivacySettingsResp)(nil),        // 183: openim.user.getUserPrivacySettingsResp
+	(*SetUserPrivacySettingsReq)(nil),         // 184: openim.user.setUserPrivacySettingsReq
+	(*SetUserPrivacySettingsResp)(nil),        // 185: openim.user.setUserPrivacySettingsResp
+	(*CheckUserPrivacyReq)(nil),               // 186: openim.user.checkUserPrivacyReq
+	(*CheckUserPrivacyResp)(nil),              // 187: openim.user.checkUserPrivacyResp
+	(*AccountCheckRespSingleUserStatus)(nil),  // 188: openim.user.accountCheckResp.singleUserStatus
+	nil,                                       // 189: openim.user.userRegisterCountResp.CountEntry
+	nil,                                       // 190: openim.user.sortQueryReq.UserIDNameEntry
+	nil,                                       // 191: openim.user.getUserClientConfigResp.ConfigsEntry
+	nil,                                       // 192: openim.user.setUserClientConfigReq.ConfigsEntry
+	nil,                                       // 193: openim.user.renderQuickReplyReq.ValuesEntry
+	nil,                                       // 194: openim.user.renderQuickReplyResp.ValuesEntry
+	nil,                                       // 195: openim.user.getUserPresenceResp.PresencesEntry
+	nil,                                       // 196: openim.user.checkUserPrivacyResp.AllowedEntry
+	(*sdkws.RequestPagination)(nil),           // 197: openim.sdkws.RequestPagination
+	(*sdkws.UserInfo)(nil),                    // 198: openim.sdkws.UserInfo
+	(*sdkws.UserInfoWithEx)(nil),              // 199: openim.sdkws.UserInfoWithEx
+	(*conversation.Conversation)(nil),         // 200: openim.conversation.Conversation
+	(*sdkws.UserPresence)(nil),                // 201: openim.sdkws.UserPresence
+	(*wrapperspb.StringValue)(nil),            // 202: openim.protobuf.StringValue
+	(*sdkws.QuickReplyScope)(nil),             // 203: openim.sdkws.QuickReplyScope
+	(*sdkws.UserActivity)(nil),                // 204: openim.sdkws.UserActivity
+	(*wrapperspb.Int32Value)(nil),             // 205: openim.protobuf.Int32Value
 }
 var file_user_user_proto_depIdxs = []int32{
-	119, // 0: openim.user.getAllUserIDReq.pagination:type_name -> openim.sdkws.RequestPagination
-	114, // 1: openim.user.accountCheckResp.results:type_name -> openim.user.accountCheckResp.singleUserStatus
-	120, // 2: openim.user.getDesignateUsersResp.usersInfo:type_name -> openim.sdkws.UserInfo
-	120, // 3: openim.user.updateUserInfoReq.userInfo:type_name -> openim.sdkws.UserInfo
-	121, // 4: openim.user.updateUserInfoExReq.userInfo:type_name -> openim.sdkws.UserInfoWithEx
-	122, // 5: openim.user.setConversationReq.conversation:type_name -> openim.conversation.Conversation
-	122, // 6: openim.user.getConversationResp.conversation:type_name -> openim.conversation.Conversation
-	122, // 7: openim.user.getConversationsResp.conversations:type_name -> openim.conversation.Conversation
-	122, // 8: openim.user.getAllConversationsResp.conversations:type_name -> openim.conversation.Conversation
-	122, // 9: openim.user.batchSetConversationsReq.conversations:type_name -> openim.conversation.Conversation
-	119, // 10: openim.user.getPaginationUsersReq.pagination:type_name -> openim.sdkws.RequestPagination
-	120, // 11: openim.user.getPaginationUsersResp.users:type_name -> openim.sdkws.UserInfo
-	120, // 12: openim.user.userRegisterReq.users:type_name -> openim.sdkws.UserInfo
-	115, // 13: openim.user.userRegisterCountResp.count:type_name -> openim.user.userRegisterCountResp.CountEntry
-	37,  // 14: openim.user.subscribeOrCancelUsersStatusResp.statusList:type_name -> openim.user.onlineStatus
-	37,  // 15: openim.user.getSubscribeUsersStatusResp.statusList:type_name -> openim.user.onlineStatus
-	36,  // 16: openim.user.onlineStatus.detailPlatformStatus:type_name -> openim.user.platformDetail
-	37,  // 17: openim.user.getUserStatusResp.statusList:type_name -> openim.user.onlineStatus
-	42,  // 18: openim.user.setUserOnlineStatusReq.status:type_name -> openim.user.userOnlineStatus
-	123, // 19: openim.user.processUserCommandAddReq.value:type_name -> openim.protobuf.StringValue
-	123, // 20: openim.user.processUserCommandAddReq.ex:type_name -> openim.protobuf.StringValue
-	123, // 21: openim.user.processUserCommandUpdateReq.value:type_name -> openim.protobuf.StringValue
-	123, // 22: openim.user.processUserCommandUpdateReq.ex:type_name -> openim.protobuf.StringValue
-	52,  // 23: openim.user.processUserCommandGetResp.CommandResp:type_name -> openim.user.CommandInfoResp
-	55,  // 24: openim.user.processUserCommandGetAllResp.CommandResp:type_name -> openim.user.AllCommandInfoResp
-	119, // 25: openim.user.searchNotificationAccountReq.pagination:type_name -> openim.sdkws.RequestPagination
-	62,  // 26: openim.user.searchNotificationAccountResp.notificationAccounts:type_name -> openim.user.notificationAccountInfo
-	62,  // 27: openim.user.getNotificationAccountResp.account:type_name -> openim.user.notificationAccountInfo
-	116, // 28: openim.user.sortQueryReq.userIDName:type_name -> openim.user.sortQueryReq.UserIDNameEntry
-	120, // 29: openim.user.sortQueryResp.users:type_name -> openim.sdkws.UserInfo
-	37,  // 30: openim.user.getAllOnlineUsersResp.StatusList:type_name -> openim.user.onlineStatus
-	117, // 31: openim.user.getUserClientConfigResp.configs:type_name -> openim.user.getUserClientConfigResp.ConfigsEntry
-	118, // 32: openim.user.setUserClientConfigReq.configs:type_name -> openim.user.setUserClientConfigReq.ConfigsEntry
-	119, // 33: openim.user.pageUserClientConfigReq.pagination:type_name -> openim.sdkws.RequestPagination
-	78,  // 34: openim.user.pageUserClientConfigResp.configs:type_name -> openim.user.clientConfig
-	84,  // 35: openim.user.getAllUserEmojisResp.emojis:type_name -> openim.user.getUserEmojiResp
-	87,  // 36: openim.user.getQuickRepliesResp.replies:type_name -> openim.user.QuickReplyInfo
-	87,  // 37: openim.user.syncQuickRepliesResp.replies:type_name -> openim.user.QuickReplyInfo
-	87,  // 38: openim.user.upsertQuickReplyReq.reply:type_name -> openim.user.QuickReplyInfo
-	87,  // 39: openim.user.upsertQuickReplyResp.reply:type_name -> openim.user.QuickReplyInfo
-	87,  // 40: openim.user.refreshFrequentRepliesResp.replies:type_name -> openim.user.QuickReplyInfo
-	87,  // 41: openim.user.submitRefreshResultReq.replies:type_name -> openim.user.QuickReplyInfo
-	88,  // 42: openim.user.getRefreshStatusResp.status:type_name -> openim.user.QuickReplyRefreshStatus
-	87,  // 43: openim.user.batchUpsertAIQuickRepliesReq.replies:type_name -> openim.user.QuickReplyInfo
-	119, // 44: openim.user.GetSignatureListReq.pagination:type_name -> openim.sdkws.RequestPagination
-	107, // 45: openim.user.GetSignatureListResp.signatures:type_name -> openim.user.SignatureInfo
-	4,   // 46: openim.user.user.getDesignateUsers:input_type -> openim.user.getDesignateUsersReq
-	6,   // 47: openim.user.user.updateUserInfo:input_type -> openim.user.updateUserInfoReq
-	8,   // 48: openim.user.user.updateUserInfoEx:input_type -> openim.user.updateUserInfoExReq
-	10,  // 49: openim.user.user.setGlobalRecvMessageOpt:input_type -> openim.user.setGlobalRecvMessageOptReq
-	28,  // 50: openim.user.user.getGlobalRecvMessageOpt:input_type -> openim.user.getGlobalRecvMessageOptReq
-	2,   // 51: openim.user.user.accountCheck:input_type -> openim.user.accountCheckReq
-	24,  // 52: openim.user.user.getPaginationUsers:input_type -> openim.user.getPaginationUsersReq
-	26,  // 53: openim.user.user.userRegister:input_type -> openim.user.userRegisterReq
-	0,   // 54: openim.user.user.getAllUserID:input_type -> openim.user.getAllUserIDReq
-	30,  // 55: openim.user.user.userRegisterCount:input_type -> openim.user.userRegisterCountReq
-	32,  // 56: openim.user.user.subscribeOrCancelUsersStatus:input_type -> openim.user.subscribeOrCancelUsersStatusReq
-	34,  // 57: openim.user.user.getSubscribeUsersStatus:input_type -> openim.user.getSubscribeUsersStatusReq
-	38,  // 58: openim.user.user.getUserStatus:input_type -> openim.user.getUserStatusReq
-	40,  // 59: openim.user.user.setUserStatus:input_type -> openim.user.setUserStatusReq
-	45,  // 60: openim.user.user.processUserCommandAdd:input_type -> openim.user.processUserCommandAddReq
-	49,  // 61: openim.user.user.processUserCommandUpdate:input_type -> openim.user.processUserCommandUpdateReq
-	47,  // 62: openim.user.user.processUserCommandDelete:input_type -> openim.user.processUserCommandDeleteReq
-	51,  // 63: openim.user.user.processUserCommandGet:input_type -> openim.user.processUserCommandGetReq
-	54,  // 64: openim.user.user.processUserCommandGetAll:input_type -> openim.user.processUserCommandGetAllReq
-	57,  // 65: openim.user.user.addNotificationAccount:input_type -> openim.user.addNotificationAccountReq
-	59,  // 66: openim.user.user.updateNotificationAccountInfo:input_type -> openim.user.updateNotificationAccountInfoReq
-	61,  // 67: openim.user.user.searchNotificationAccount:input_type -> openim.user.searchNotificationAccountReq
-	64,  // 68: openim.user.user.getNotificationAccount:input_type -> openim.user.getNotificationAccountReq
-	66,  // 69: openim.user.user.sortQuery:input_type -> openim.user.sortQueryReq
-	43,  // 70: openim.user.user.setUserOnlineStatus:input_type -> openim.user.setUserOnlineStatusReq
-	68,  // 71: openim.user.user.getAllOnlineUsers:input_type -> openim.user.getAllOnlineUsersReq
-	70,  // 72: openim.user.user.getUserClientConfig:input_type -> openim.user.getUserClientConfigReq
-	72,  // 73: openim.user.user.setUserClientConfig:input_type -> openim.user.setUserClientConfigReq
-	74,  // 74: openim.user.user.delUserClientConfig:input_type -> openim.user.delUserClientConfigReq
-	76,  // 75: openim.user.user.pageUserClientConfig:input_type -> openim.user.pageUserClientConfigReq
-	79,  // 76: openim.user.user.saveUserEmoji:input_type -> openim.user.saveUserEmojiReq
-	81,  // 77: openim.user.user.deleteUserEmoji:input_type -> openim.user.deleteUserEmojiReq
-	83,  // 78: openim.user.user.getUserEmoji:input_type -> openim.user.getUserEmojiReq
-	85,  // 79: openim.user.user.getAllUserEmojis:input_type -> openim.user.getAllUserEmojisReq
-	89,  // 80: openim.user.user.getQuickReplies:input_type -> openim.user.getQuickRepliesReq
-	91,  // 81: openim.user.user.syncQuickReplies:input_type -> openim.user.syncQuickRepliesReq
-	93,  // 82: openim.user.user.upsertQuickReply:input_type -> openim.user.upsertQuickReplyReq
-	95,  // 83: openim.user.user.deleteQuickReply:input_type -> openim.user.deleteQuickReplyReq
-	97,  // 84: openim.user.user.pinQuickReply:input_type -> openim.user.pinQuickReplyReq
-	99,  // 85: openim.user.user.refreshFrequentReplies:input_type -> openim.user.refreshFrequentRepliesReq
-	101, // 86: openim.user.user.submitRefreshResult:input_type -> openim.user.submitRefreshResultReq
-	103, // 87: openim.user.user.getRefreshStatus:input_type -> openim.user.getRefreshStatusReq
-	105, // 88: openim.user.user.batchUpsertAIQuickReplies:input_type -> openim.user.batchUpsertAIQuickRepliesReq
-	108, // 89: openim.user.user.getSignatureList:input_type -> openim.user.GetSignatureListReq
-	110, // 90: openim.user.user.updateAvatar:input_type -> openim.user.updateAvatarReq
-	112, // 91: openim.user.user.getAvatarUploadQuota:input_type -> openim.user.getAvatarUploadQuotaReq
-	5,   // 92: openim.user.user.getDesignateUsers:output_type -> openim.user.getDesignateUsersResp
-	7,   // 93: openim.user.user.updateUserInfo:output_type -> openim.user.updateUserInfoResp
-	9,   // 94: openim.user.user.updateUserInfoEx:output_type -> openim.user.updateUserInfoExResp
-	11,  // 95: openim.user.user.setGlobalRecvMessageOpt:output_type -> openim.user.setGlobalRecvMessageOptResp
-	29,  // 96: openim.user.user.getGlobalRecvMessageOpt:output_type -> openim.user.getGlobalRecvMessageOptResp
-	3,   // 97: openim.user.user.accountCheck:output_type -> openim.user.accountCheckResp
-	25,  // 98: openim.user.user.getPaginationUsers:output_type -> openim.user.getPaginationUsersResp
-	27,  // 99: openim.user.user.userRegister:output_type -> openim.user.userRegisterResp
-	1,   // 100: openim.user.user.getAllUserID:output_type -> openim.user.getAllUserIDResp
-	31,  // 101: openim.user.user.userRegisterCount:output_type -> openim.user.userRegisterCountResp
-	33,  // 102: openim.user.user.subscribeOrCancelUsersStatus:output_type -> openim.user.subscribeOrCancelUsersStatusResp
-	35,  // 103: openim.user.user.getSubscribeUsersStatus:output_type -> openim.user.getSubscribeUsersStatusResp
-	39,  // 104: openim.user.user.getUserStatus:output_type -> openim.user.getUserStatusResp
-	41,  // 105: openim.user.user.setUserStatus:output_type -> openim.user.setUserStatusResp
-	46,  // 106: openim.user.user.processUserCommandAdd:output_type -> openim.user.processUserCommandAddResp
-	50,  // 107: openim.user.user.processUserCommandUpdate:output_type -> openim.user.processUserCommandUpdateResp
-	48,  // 108: openim.user.user.processUserCommandDelete:output_type -> openim.user.processUserCommandDeleteResp
-	53,  // 109: openim.user.user.processUserCommandGet:output_type -> openim.user.processUserCommandGetResp
-	56,  // 110: openim.user.user.processUserCommandGetAll:output_type -> openim.user.processUserCommandGetAllResp
-	58,  // 111: openim.user.user.addNotificationAccount:output_type -> openim.user.addNotificationAccountResp
-	60,  // 112: openim.user.user.updateNotificationAccountInfo:output_type -> openim.user.updateNotificationAccountInfoResp
-	63,  // 113: openim.user.user.searchNotificationAccount:output_type -> openim.user.searchNotificationAccountResp
-	65,  // 114: openim.user.user.getNotificationAccount:output_type -> openim.user.getNotificationAccountResp
-	67,  // 115: openim.user.user.sortQuery:output_type -> openim.user.sortQueryResp
-	44,  // 116: openim.user.user.setUserOnlineStatus:output_type -> openim.user.setUserOnlineStatusResp
-	69,  // 117: openim.user.user.getAllOnlineUsers:output_type -> openim.user.getAllOnlineUsersResp
-	71,  // 118: openim.user.user.getUserClientConfig:output_type -> openim.user.getUserClientConfigResp
-	73,  // 119: openim.user.user.setUserClientConfig:output_type -> openim.user.setUserClientConfigResp
-	75,  // 120: openim.user.user.delUserClientConfig:output_type -> openim.user.delUserClientConfigResp
-	77,  // 121: openim.user.user.pageUserClientConfig:output_type -> openim.user.pageUserClientConfigResp
-	80,  // 122: openim.user.user.saveUserEmoji:output_type -> openim.user.saveUserEmojiResp
-	82,  // 123: openim.user.user.deleteUserEmoji:output_type -> openim.user.deleteUserEmojiResp
-	84,  // 124: openim.user.user.getUserEmoji:output_type -> openim.user.getUserEmojiResp
-	86,  // 125: openim.user.user.getAllUserEmojis:output_type -> openim.user.getAllUserEmojisResp
-	90,  // 126: openim.user.user.getQuickReplies:output_type -> openim.user.getQuickRepliesResp
-	92,  // 127: openim.user.user.syncQuickReplies:output_type -> openim.user.syncQuickRepliesResp
-	94,  // 128: openim.user.user.upsertQuickReply:output_type -> openim.user.upsertQuickReplyResp
-	96,  // 129: openim.user.user.deleteQuickReply:output_type -> openim.user.deleteQuickReplyResp
-	98,  // 130: openim.user.user.pinQuickReply:output_type -> openim.user.pinQuickReplyResp
-	100, // 131: openim.user.user.refreshFrequentReplies:output_type -> openim.user.refreshFrequentRepliesResp
-	102, // 132: openim.user.user.submitRefreshResult:output_type -> openim.user.submitRefreshResultResp
-	104, // 133: openim.user.user.getRefreshStatus:output_type -> openim.user.getRefreshStatusResp
-	106, // 134: openim.user.user.batchUpsertAIQuickReplies:output_type -> openim.user.batchUpsertAIQuickRepliesResp
-	109, // 135: openim.user.user.getSignatureList:output_type -> openim.user.GetSignatureListResp
-	111, // 136: openim.user.user.updateAvatar:output_type -> openim.user.updateAvatarResp
-	113, // 137: openim.user.user.getAvatarUploadQuota:output_type -> openim.user.getAvatarUploadQuotaResp
-	92,  // [92:138] is the sub-list for method output_type
-	46,  // [46:92] is the sub-list for method input_type
-	46,  // [46:46] is the sub-list for extension type_name
-	46,  // [46:46] is the sub-list for extension extendee
-	0,   // [0:46] is the sub-list for field type_name
+	197, // 0: openim.user.getAllUserIDReq.pagination:type_name -> openim.sdkws.RequestPagination
+	188, // 1: openim.user.accountCheckResp.results:type_name -> openim.user.accountCheckResp.singleUserStatus
+	198, // 2: openim.user.getDesignateUsersResp.usersInfo:type_name -> openim.sdkws.UserInfo
+	198, // 3: openim.user.updateUserInfoReq.userInfo:type_name -> openim.sdkws.UserInfo
+	199, // 4: openim.user.updateUserInfoExReq.userInfo:type_name -> openim.sdkws.UserInfoWithEx
+	200, // 5: openim.user.setConversationReq.conversation:type_name -> openim.conversation.Conversation
+	200, // 6: openim.user.getConversationResp.conversation:type_name -> openim.conversation.Conversation
+	200, // 7: openim.user.getConversationsResp.conversations:type_name -> openim.conversation.Conversation
+	200, // 8: openim.user.getAllConversationsResp.conversations:type_name -> openim.conversation.Conversation
+	200, // 9: openim.user.batchSetConversationsReq.conversations:type_name -> openim.conversation.Conversation
+	197, // 10: openim.user.getPaginationUsersReq.pagination:type_name -> openim.sdkws.RequestPagination
+	198, // 11: openim.user.getPaginationUsersResp.users:type_name -> openim.sdkws.UserInfo
+	198, // 12: openim.user.userRegisterReq.users:type_name -> openim.sdkws.UserInfo
+	34,  // 13: openim.user.eraseUserJob.steps:type_name -> openim.user.eraseUserStep
+	35,  // 14: openim.user.getEraseUserJobResp.job:type_name -> openim.user.eraseUserJob
+	189, // 15: openim.user.userRegisterCountResp.count:type_name -> openim.user.userRegisterCountResp.CountEntry
+	47,  // 16: openim.user.subscribeOrCancelUsersStatusResp.statusList:type_name -> openim.user.onlineStatus
+	47,  // 17: openim.user.getSubscribeUsersStatusResp.statusList:type_name -> openim.user.onlineStatus
+	46,  // 18: openim.user.onlineStatus.detailPlatformStatus:type_name -> openim.user.platformDetail
+	201, // 19: openim.user.onlineStatus.presence:type_name -> openim.sdkws.UserPresence
+	47,  // 20: openim.user.getUserStatusResp.statusList:type_name -> openim.user.onlineStatus
+	52,  // 21: openim.user.setUserOnlineStatusReq.status:type_name -> openim.user.userOnlineStatus
+	202, // 22: openim.user.processUserCommandAddReq.value:type_name -> openim.protobuf.StringValue
+	202, // 23: openim.user.processUserCommandAddReq.ex:type_name -> openim.protobuf.StringValue
+	202, // 24: openim.user.processUserCommandUpdateReq.value:type_name -> openim.protobuf.StringValue
+	202, // 25: openim.user.processUserCommandUpdateReq.ex:type_name -> openim.protobuf.StringValue
+	62,  // 26: openim.user.processUserCommandGetResp.CommandResp:type_name -> openim.user.CommandInfoResp
+	65,  // 27: openim.user.processUserCommandGetAllResp.CommandResp:type_name -> openim.user.AllCommandInfoResp
+	197, // 28: openim.user.searchNotificationAccountReq.pagination:type_name -> openim.sdkws.RequestPagination
+	72,  // 29: openim.user.searchNotificationAccountResp.notificationAccounts:type_name -> openim.user.notificationAccountInfo
+	72,  // 30: openim.user.getNotificationAccountResp.account:type_name -> openim.user.notificationAccountInfo
+	190, // 31: openim.user.sortQueryReq.userIDName:type_name -> openim.user.sortQueryReq.UserIDNameEntry
+	198, // 32: openim.user.sortQueryResp.users:type_name -> openim.sdkws.UserInfo
+	47,  // 33: openim.user.getAllOnlineUsersResp.StatusList:type_name -> openim.user.onlineStatus
+	191, // 34: openim.user.getUserClientConfigResp.configs:type_name -> openim.user.getUserClientConfigResp.ConfigsEntry
+	192, // 35: openim.user.setUserClientConfigReq.configs:type_name -> openim.user.setUserClientConfigReq.ConfigsEntry
+	197, // 36: openim.user.pageUserClientConfigReq.pagination:type_name -> openim.sdkws.RequestPagination
+	88,  // 37: openim.user.pageUserClientConfigResp.configs:type_name -> openim.user.clientConfig
+	89,  // 38: openim.user.registerClientConfigSchemasReq.schemas:type_name -> openim.user.clientConfigSchema
+	89,  // 39: openim.user.getClientConfigSchemasResp.schemas:type_name -> openim.user.clientConfigSchema
+	94,  // 40: openim.user.getTypedClientConfigResp.configs:type_name -> openim.user.typedClientConfig
+	94,  // 41: openim.user.setTypedClientConfigResp.config:type_name -> openim.user.typedClientConfig
+	94,  // 42: openim.user.getIncrementalClientConfigResp.insert:type_name -> openim.user.typedClientConfig
+	94,  // 43: openim.user.getIncrementalClientConfigResp.update:type_name -> openim.user.typedClientConfig
+	108, // 44: openim.user.getAllUserEmojisResp.emojis:type_name -> openim.user.getUserEmojiResp
+	111, // 45: openim.user.createEmojiPackResp.pack:type_name -> openim.user.emojiPack
+	202, // 46: openim.user.updateEmojiPackReq.name:type_name -> openim.protobuf.StringValue
+	202, // 47: openim.user.updateEmojiPackReq.coverURL:type_name -> openim.protobuf.StringValue
+	202, // 48: openim.user.updateEmojiPackReq.ex:type_name -> openim.protobuf.StringValue
+	111, // 49: openim.user.getEmojiPacksResp.packs:type_name -> openim.user.emojiPack
+	108, // 50: openim.user.getEmojiPacksResp.emojis:type_name -> openim.user.getUserEmojiResp
+	111, // 51: openim.user.saveSharedEmojiPackResp.pack:type_name -> openim.user.emojiPack
+	132, // 52: openim.user.emojiPackManifest.items:type_name -> openim.user.emojiPackManifestItem
+	133, // 53: openim.user.importEmojiPackReq.manifest:type_name -> openim.user.emojiPackManifest
+	111, // 54: openim.user.importEmojiPackResp.pack:type_name -> openim.user.emojiPack
+	133, // 55: openim.user.exportEmojiPackResp.manifest:type_name -> openim.user.emojiPackManifest
+	203, // 56: openim.user.QuickReplyInfo.scope:type_name -> openim.sdkws.QuickReplyScope
+	138, // 57: openim.user.getQuickRepliesResp.replies:type_name -> openim.user.QuickReplyInfo
+	138, // 58: openim.user.syncQuickRepliesResp.replies:type_name -> openim.user.QuickReplyInfo
+	138, // 59: openim.user.upsertQuickReplyReq.reply:type_name -> openim.user.QuickReplyInfo
+	138, // 60: openim.user.upsertQuickReplyResp.reply:type_name -> openim.user.QuickReplyInfo
+	138, // 61: openim.user.refreshFrequentRepliesResp.replies:type_name -> openim.user.QuickReplyInfo
+	138, // 62: openim.user.submitRefreshResultReq.replies:type_name -> openim.user.QuickReplyInfo
+	139, // 63: openim.user.getRefreshStatusResp.status:type_name -> openim.user.QuickReplyRefreshStatus
+	138, // 64: openim.user.batchUpsertAIQuickRepliesReq.replies:type_name -> openim.user.QuickReplyInfo
+	193, // 65: openim.user.renderQuickReplyReq.values:type_name -> openim.user.renderQuickReplyReq.ValuesEntry
+	194, // 66: openim.user.renderQuickReplyResp.values:type_name -> openim.user.renderQuickReplyResp.ValuesEntry
+	197, // 67: openim.user.GetSignatureListReq.pagination:type_name -> openim.sdkws.RequestPagination
+	160, // 68: openim.user.GetSignatureListResp.signatures:type_name -> openim.user.SignatureInfo
+	201, // 69: openim.user.setUserPresenceReq.presence:type_name -> openim.sdkws.UserPresence
+	195, // 70: openim.user.getUserPresenceResp.presences:type_name -> openim.user.getUserPresenceResp.PresencesEntry
+	204, // 71: openim.user.setUserActivityReq.activity:type_name -> openim.sdkws.UserActivity
+	204, // 72: openim.user.getUserActivitiesResp.activities:type_name -> openim.sdkws.UserActivity
+	181, // 73: openim.user.getUserPrivacySettingsResp.settings:type_name -> openim.user.UserPrivacySettings
+	205, // 74: openim.user.setUserPrivacySettingsReq.friendRequest:type_name -> openim.protobuf.Int32Value
+	205, // 75: openim.user.setUserPrivacySettingsReq.onlineStatus:type_name -> openim.protobuf.Int32Value
+	205, // 76: openim.user.setUserPrivacySettingsReq.lastSeen:type_name -> openim.protobuf.Int32Value
+	205, // 77: openim.user.setUserPrivacySettingsReq.signature:type_name -> openim.protobuf.Int32Value
+	205, // 78: openim.user.setUserPrivacySettingsReq.avatar:type_name -> openim.protobuf.Int32Value
+	205, // 79: openim.user.setUserPrivacySettingsReq.discoverable:type_name -> openim.protobuf.Int32Value
+	181, // 80: openim.user.setUserPrivacySettingsResp.settings:type_name -> openim.user.UserPrivacySettings
+	196, // 81: openim.user.checkUserPrivacyResp.allowed:type_name -> openim.user.checkUserPrivacyResp.AllowedEntry
+	201, // 82: openim.user.getUserPresenceResp.PresencesEntry.value:type_name -> openim.sdkws.UserPresence
+	4,   // 83: openim.user.user.getDesignateUsers:input_type -> openim.user.getDesignateUsersReq
+	6,   // 84: openim.user.user.updateUserInfo:input_type -> openim.user.updateUserInfoReq
+	8,   // 85: openim.user.user.updateUserInfoEx:input_type -> openim.user.updateUserInfoExReq
+	10,  // 86: openim.user.user.setGlobalRecvMessageOpt:input_type -> openim.user.setGlobalRecvMessageOptReq
+	38,  // 87: openim.user.user.getGlobalRecvMessageOpt:input_type -> openim.user.getGlobalRecvMessageOptReq
+	2,   // 88: openim.user.user.accountCheck:input_type -> openim.user.accountCheckReq
+	24,  // 89: openim.user.user.getPaginationUsers:input_type -> openim.user.getPaginationUsersReq
+	26,  // 90: openim.user.user.userRegister:input_type -> openim.user.userRegisterReq
+	28,  // 91: openim.user.user.deactivateUser:input_type -> openim.user.deactivateUserReq
+	30,  // 92: openim.user.user.reactivateUser:input_type -> openim.user.reactivateUserReq
+	32,  // 93: openim.user.user.eraseUser:input_type -> openim.user.eraseUserReq
+	36,  // 94: openim.user.user.getEraseUserJob:input_type -> openim.user.getEraseUserJobReq
+	0,   // 95: openim.user.user.getAllUserID:input_type -> openim.user.getAllUserIDReq
+	40,  // 96: openim.user.user.userRegisterCount:input_type -> openim.user.userRegisterCountReq
+	42,  // 97: openim.user.user.subscribeOrCancelUsersStatus:input_type -> openim.user.subscribeOrCancelUsersStatusReq
+	44,  // 98: openim.user.user.getSubscribeUsersStatus:input_type -> openim.user.getSubscribeUsersStatusReq
+	48,  // 99: openim.user.user.getUserStatus:input_type -> openim.user.getUserStatusReq
+	50,  // 100: openim.user.user.setUserStatus:input_type -> openim.user.setUserStatusReq
+	55,  // 101: openim.user.user.processUserCommandAdd:input_type -> openim.user.processUserCommandAddReq
+	59,  // 102: openim.user.user.processUserCommandUpdate:input_type -> openim.user.processUserCommandUpdateReq
+	57,  // 103: openim.user.user.processUserCommandDelete:input_type -> openim.user.processUserCommandDeleteReq
+	61,  // 104: openim.user.user.processUserCommandGet:input_type -> openim.user.processUserCommandGetReq
+	64,  // 105: openim.user.user.processUserCommandGetAll:input_type -> openim.user.processUserCommandGetAllReq
+	67,  // 106: openim.user.user.addNotificationAccount:input_type -> openim.user.addNotificationAccountReq
+	69,  // 107: openim.user.user.updateNotificationAccountInfo:input_type -> openim.user.updateNotificationAccountInfoReq
+	71,  // 108: openim.user.user.searchNotificationAccount:input_type -> openim.user.searchNotificationAccountReq
+	74,  // 109: openim.user.user.getNotificationAccount:input_type -> openim.user.getNotificationAccountReq
+	76,  // 110: openim.user.user.sortQuery:input_type -> openim.user.sortQueryReq
+	53,  // 111: openim.user.user.setUserOnlineStatus:input_type -> openim.user.setUserOnlineStatusReq
+	78,  // 112: openim.user.user.getAllOnlineUsers:input_type -> openim.user.getAllOnlineUsersReq
+	80,  // 113: openim.user.user.getUserClientConfig:input_type -> openim.user.getUserClientConfigReq
+	82,  // 114: openim.user.user.setUserClientConfig:input_type -> openim.user.setUserClientConfigReq
+	84,  // 115: openim.user.user.delUserClientConfig:input_type -> openim.user.delUserClientConfigReq
+	86,  // 116: openim.user.user.pageUserClientConfig:input_type -> openim.user.pageUserClientConfigReq
+	90,  // 117: openim.user.user.registerClientConfigSchemas:input_type -> openim.user.registerClientConfigSchemasReq
+	92,  // 118: openim.user.user.getClientConfigSchemas:input_type -> openim.user.getClientConfigSchemasReq
+	95,  // 119: openim.user.user.getTypedClientConfig:input_type -> openim.user.getTypedClientConfigReq
+	97,  // 120: openim.user.user.setTypedClientConfig:input_type -> openim.user.setTypedClientConfigReq
+	99,  // 121: openim.user.user.delTypedClientConfig:input_type -> openim.user.delTypedClientConfigReq
+	101, // 122: openim.user.user.getIncrementalClientConfig:input_type -> openim.user.getIncrementalClientConfigReq
+	103, // 123: openim.user.user.saveUserEmoji:input_type -> openim.user.saveUserEmojiReq
+	105, // 124: openim.user.user.deleteUserEmoji:input_type -> openim.user.deleteUserEmojiReq
+	107, // 125: openim.user.user.getUserEmoji:input_type -> openim.user.getUserEmojiReq
+	109, // 126: openim.user.user.getAllUserEmojis:input_type -> openim.user.getAllUserEmojisReq
+	112, // 127: openim.user.user.createEmojiPack:input_type -> openim.user.createEmojiPackReq
+	114, // 128: openim.user.user.updateEmojiPack:input_type -> openim.user.updateEmojiPackReq
+	116, // 129: openim.user.user.deleteEmojiPack:input_type -> openim.user.deleteEmojiPackReq
+	118, // 130: openim.user.user.sortEmojiPacks:input_type -> openim.user.sortEmojiPacksReq
+	120, // 131: openim.user.user.addEmojisToPack:input_type -> openim.user.addEmojisToPackReq
+	122, // 132: openim.user.user.removeEmojisFromPack:input_type -> openim.user.removeEmojisFromPackReq
+	124, // 133: openim.user.user.sortPackEmojis:input_type -> openim.user.sortPackEmojisReq
+	126, // 134: openim.user.user.getEmojiPacks:input_type -> openim.user.getEmojiPacksReq
+	128, // 135: openim.user.user.shareEmojiPack:input_type -> openim.user.shareEmojiPackReq
+	130, // 136: openim.user.user.saveSharedEmojiPack:input_type -> openim.user.saveSharedEmojiPackReq
+	134, // 137: openim.user.user.importEmojiPack:input_type -> openim.user.importEmojiPackReq
+	136, // 138: openim.user.user.exportEmojiPack:input_type -> openim.user.exportEmojiPackReq
+	140, // 139: openim.user.user.getQuickReplies:input_type -> openim.user.getQuickRepliesReq
+	142, // 140: openim.user.user.syncQuickReplies:input_type -> openim.user.syncQuickRepliesReq
+	144, // 141: openim.user.user.upsertQuickReply:input_type -> openim.user.upsertQuickReplyReq
+	146, // 142: openim.user.user.deleteQuickReply:input_type -> openim.user.deleteQuickReplyReq
+	148, // 143: openim.user.user.pinQuickReply:input_type -> openim.user.pinQuickReplyReq
+	158, // 144: openim.user.user.renderQuickReply:input_type -> openim.user.renderQuickReplyReq
+	150, // 145: openim.user.user.refreshFrequentReplies:input_type -> openim.user.refreshFrequentRepliesReq
+	152, // 146: openim.user.user.submitRefreshResult:input_type -> openim.user.submitRefreshResultReq
+	154, // 147: openim.user.user.getRefreshStatus:input_type -> openim.user.getRefreshStatusReq
+	156, // 148: openim.user.user.batchUpsertAIQuickReplies:input_type -> openim.user.batchUpsertAIQuickRepliesReq
+	161, // 149: openim.user.user.getSignatureList:input_type -> openim.user.GetSignatureListReq
+	163, // 150: openim.user.user.updateAvatar:input_type -> openim.user.updateAvatarReq
+	165, // 151: openim.user.user.getAvatarUploadQuota:input_type -> openim.user.getAvatarUploadQuotaReq
+	182, // 152: openim.user.user.getUserPrivacySettings:input_type -> openim.user.getUserPrivacySettingsReq
+	184, // 153: openim.user.user.setUserPrivacySettings:input_type -> openim.user.setUserPrivacySettingsReq
+	186, // 154: openim.user.user.checkUserPrivacy:input_type -> openim.user.checkUserPrivacyReq
+	167, // 155: openim.user.user.setUserPresence:input_type -> openim.user.setUserPresenceReq
+	169, // 156: openim.user.user.clearUserPresence:input_type -> openim.user.clearUserPresenceReq
+	171, // 157: openim.user.user.getUserPresence:input_type -> openim.user.getUserPresenceReq
+	179, // 158: openim.user.user.clearExpiredUserPresence:input_type -> openim.user.clearExpiredUserPresenceReq
+	173, // 159: openim.user.user.setUserActivity:input_type -> openim.user.setUserActivityReq
+	175, // 160: openim.user.user.clearUserActivity:input_type -> openim.user.clearUserActivityReq
+	177, // 161: openim.user.user.getUserActivities:input_type -> openim.user.getUserActivitiesReq
+	5,   // 162: openim.user.user.getDesignateUsers:output_type -> openim.user.getDesignateUsersResp
+	7,   // 163: openim.user.user.updateUserInfo:output_type -> openim.user.updateUserInfoResp
+	9,   // 164: openim.user.user.updateUserInfoEx:output_type -> openim.user.updateUserInfoExResp
+	11,  // 165: openim.user.user.setGlobalRecvMessageOpt:output_type -> openim.user.setGlobalRecvMessageOptResp
+	39,  // 166: openim.user.user.getGlobalRecvMessageOpt:output_type -> openim.user.getGlobalRecvMessageOptResp
+	3,   // 167: openim.user.user.accountCheck:output_type -> openim.user.accountCheckResp
+	25,  // 168: openim.user.user.getPaginationUsers:output_type -> openim.user.getPaginationUsersResp
+	27,  // 169: openim.user.user.userRegister:output_type -> openim.user.userRegisterResp
+	29,  // 170: openim.user.user.deactivateUser:output_type -> openim.user.deactivateUserResp
+	31,  // 171: openim.user.user.reactivateUser:output_type -> openim.user.reactivateUserResp
+	33,  // 172: openim.user.user.eraseUser:output_type -> openim.user.eraseUserResp
+	37,  // 173: openim.user.user.getEraseUserJob:output_type -> openim.user.getEraseUserJobResp
+	1,   // 174: openim.user.user.getAllUserID:output_type -> openim.user.getAllUserIDResp
+	41,  // 175: openim.user.user.userRegisterCount:output_type -> openim.user.userRegisterCountResp
+	43,  // 176: openim.user.user.subscribeOrCancelUsersStatus:output_type -> openim.user.subscribeOrCancelUsersStatusResp
+	45,  // 177: openim.user.user.getSubscribeUsersStatus:output_type -> openim.user.getSubscribeUsersStatusResp
+	49,  // 178: openim.user.user.getUserStatus:output_type -> openim.user.getUserStatusResp
+	51,  // 179: openim.user.user.setUserStatus:output_type -> openim.user.setUserStatusResp
+	56,  // 180: openim.user.user.processUserCommandAdd:output_type -> openim.user.processUserCommandAddResp
+	60,  // 181: openim.user.user.processUserCommandUpdate:output_type -> openim.user.processUserCommandUpdateResp
+	58,  // 182: openim.user.user.processUserCommandDelete:output_type -> openim.user.processUserCommandDeleteResp
+	63,  // 183: openim.user.user.processUserCommandGet:output_type -> openim.user.processUserCommandGetResp
+	66,  // 184: openim.user.user.processUserCommandGetAll:output_type -> openim.user.processUserCommandGetAllResp
+	68,  // 185: openim.user.user.addNotificationAccount:output_type -> openim.user.addNotificationAccountResp
+	70,  // 186: openim.user.user.updateNotificationAccountInfo:output_type -> openim.user.updateNotificationAccountInfoResp
+	73,  // 187: openim.user.user.searchNotificationAccount:output_type -> openim.user.searchNotificationAccountResp
+	75,  // 188: openim.user.user.getNotificationAccount:output_type -> openim.user.getNotificationAccountResp
+	77,  // 189: openim.user.user.sortQuery:output_type -> openim.user.sortQueryResp
+	54,  // 190: openim.user.user.setUserOnlineStatus:output_type -> openim.user.setUserOnlineStatusResp
+	79,  // 191: openim.user.user.getAllOnlineUsers:output_type -> openim.user.getAllOnlineUsersResp
+	81,  // 192: openim.user.user.getUserClientConfig:output_type -> openim.user.getUserClientConfigResp
+	83,  // 193: openim.user.user.setUserClientConfig:output_type -> openim.user.setUserClientConfigResp
+	85,  // 194: openim.user.user.delUserClientConfig:output_type -> openim.user.delUserClientConfigResp
+	87,  // 195: openim.user.user.pageUserClientConfig:output_type -> openim.user.pageUserClientConfigResp
+	91,  // 196: openim.user.user.registerClientConfigSchemas:output_type -> openim.user.registerClientConfigSchemasResp
+	93,  // 197: openim.user.user.getClientConfigSchemas:output_type -> openim.user.getClientConfigSchemasResp
+	96,  // 198: openim.user.user.getTypedClientConfig:output_type -> openim.user.getTypedClientConfigResp
+	98,  // 199: openim.user.user.setTypedClientConfig:output_type -> openim.user.setTypedClientConfigResp
+	100, // 200: openim.user.user.delTypedClientConfig:output_type -> openim.user.delTypedClientConfigResp
+	102, // 201: openim.user.user.getIncrementalClientConfig:output_type -> openim.user.getIncrementalClientConfigResp
+	104, // 202: openim.user.user.saveUserEmoji:output_type -> openim.user.saveUserEmojiResp
+	106, // 203: openim.user.user.deleteUserEmoji:output_type -> openim.user.deleteUserEmojiResp
+	108, // 204: openim.user.user.getUserEmoji:output_type -> openim.user.getUserEmojiResp
+	110, // 205: openim.user.user.getAllUserEmojis:output_type -> openim.user.getAllUserEmojisResp
+	113, // 206: openim.user.user.createEmojiPack:output_type -> openim.user.createEmojiPackResp
+	115, // 207: openim.user.user.updateEmojiPack:output_type -> openim.user.updateEmojiPackResp
+	117, // 208: openim.user.user.deleteEmojiPack:output_type -> openim.user.deleteEmojiPackResp
+	119, // 209: openim.user.user.sortEmojiPacks:output_type -> openim.user.sortEmojiPacksResp
+	121, // 210: openim.user.user.addEmojisToPack:output_type -> openim.user.addEmojisToPackResp
+	123, // 211: openim.user.user.removeEmojisFromPack:output_type -> openim.user.removeEmojisFromPackResp
+	125, // 212: openim.user.user.sortPackEmojis:output_type -> openim.user.sortPackEmojisResp
+	127, // 213: openim.user.user.getEmojiPacks:output_type -> openim.user.getEmojiPacksResp
+	129, // 214: openim.user.user.shareEmojiPack:output_type -> openim.user.shareEmojiPackResp
+	131, // 215: openim.user.user.saveSharedEmojiPack:output_type -> openim.user.saveSharedEmojiPackResp
+	135, // 216: openim.user.user.importEmojiPack:output_type -> openim.user.importEmojiPackResp
+	137, // 217: openim.user.user.exportEmojiPack:output_type -> openim.user.exportEmojiPackResp
+	141, // 218: openim.user.user.getQuickReplies:output_type -> openim.user.getQuickRepliesResp
+	143, // 219: openim.user.user.syncQuickReplies:output_type -> openim.user.syncQuickRepliesResp
+	145, // 220: openim.user.user.upsertQuickReply:output_type -> openim.user.upsertQuickReplyResp
+	147, // 221: openim.user.user.deleteQuickReply:output_type -> openim.user.deleteQuickReplyResp
+	149, // 222: openim.user.user.pinQuickReply:output_type -> openim.user.pinQuickReplyResp
+	159, // 223: openim.user.user.renderQuickReply:output_type -> openim.user.renderQuickReplyResp
+	151, // 224: openim.user.user.refreshFrequentReplies:output_type -> openim.user.refreshFrequentRepliesResp
+	153, // 225: openim.user.user.submitRefreshResult:output_type -> openim.user.submitRefreshResultResp
+	155, // 226: openim.user.user.getRefreshStatus:output_type -> openim.user.getRefreshStatusResp
+	157, // 227: openim.user.user.batchUpsertAIQuickReplies:output_type -> openim.user.batchUpsertAIQuickRepliesResp
+	162, // 228: openim.user.user.getSignatureList:output_type -> openim.user.GetSignatureListResp
+	164, // 229: openim.user.user.updateAvatar:output_type -> openim.user.updateAvatarResp
+	166, // 230: openim.user.user.getAvatarUploadQuota:output_type -> openim.user.getAvatarUploadQuotaResp
+	183, // 231: openim.user.user.getUserPrivacySettings:output_type -> openim.user.getUserPrivacySettingsResp
+	185, // 232: openim.user.user.setUserPrivacySettings:output_type -> openim.user.setUserPrivacySettingsResp
+	187, // 233: openim.user.user.checkUserPrivacy:output_type -> openim.user.checkUserPrivacyResp
+	168, // 234: openim.user.user.setUserPresence:output_type -> openim.user.setUserPresenceResp
+	170, // 235: openim.user.user.clearUserPresence:output_type -> openim.user.clearUserPresenceResp
+	172, // 236: openim.user.user.getUserPresence:output_type -> openim.user.getUserPresenceResp
+	180, // 237: openim.user.user.clearExpiredUserPresence:output_type -> openim.user.clearExpiredUserPresenceResp
+	174, // 238: openim.user.user.setUserActivity:output_type -> openim.user.setUserActivityResp
+	176, // 239: openim.user.user.clearUserActivity:output_type -> openim.user.clearUserActivityResp
+	178, // 240: openim.user.user.getUserActivities:output_type -> openim.user.getUserActivitiesResp
+	162, // [162:241] is the sub-list for method output_type
+	83,  // [83:162] is the sub-list for method input_type
+	83,  // [83:83] is the sub-list for extension type_name
+	83,  // [83:83] is the sub-list for extension extendee
+	0,   // [0:83] is the sub-list for field type_name
 }
 
 func init() { file_user_user_proto_init() }
@@ -6893,15 +11650,17 @@ func file_user_user_proto_init() {
 	if File_user_user_proto != nil {
 		return
 	}
-	file_user_user_proto_msgTypes[61].OneofWrappers = []any{}
-	file_user_user_proto_msgTypes[108].OneofWrappers = []any{}
+	file_user_user_proto_msgTypes[71].OneofWrappers = []any{}
+	file_user_user_proto_msgTypes[97].OneofWrappers = []any{}
+	file_user_user_proto_msgTypes[99].OneofWrappers = []any{}
+	file_user_user_proto_msgTypes[161].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_user_proto_rawDesc), len(file_user_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   119,
+			NumMessages:   197,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
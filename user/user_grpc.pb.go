@@ -41,6 +41,10 @@ const (
 	User_AccountCheck_FullMethodName                  = "/openim.user.user/accountCheck"
 	User_GetPaginationUsers_FullMethodName            = "/openim.user.user/getPaginationUsers"
 	User_UserRegister_FullMethodName                  = "/openim.user.user/userRegister"
+	User_DeactivateUser_FullMethodName                = "/openim.user.user/deactivateUser"
+	User_ReactivateUser_FullMethodName                = "/openim.user.user/reactivateUser"
+	User_EraseUser_FullMethodName                     = "/openim.user.user/eraseUser"
+	User_GetEraseUserJob_FullMethodName               = "/openim.user.user/getEraseUserJob"
 	User_GetAllUserID_FullMethodName                  = "/openim.user.user/getAllUserID"
 	User_UserRegisterCount_FullMethodName             = "/openim.user.user/userRegisterCount"
 	User_SubscribeOrCancelUsersStatus_FullMethodName  = "/openim.user.user/subscribeOrCancelUsersStatus"
@@ -63,15 +67,34 @@ const (
 	User_SetUserClientConfig_FullMethodName           = "/openim.user.user/setUserClientConfig"
 	User_DelUserClientConfig_FullMethodName           = "/openim.user.user/delUserClientConfig"
 	User_PageUserClientConfig_FullMethodName          = "/openim.user.user/pageUserClientConfig"
+	User_RegisterClientConfigSchemas_FullMethodName   = "/openim.user.user/registerClientConfigSchemas"
+	User_GetClientConfigSchemas_FullMethodName        = "/openim.user.user/getClientConfigSchemas"
+	User_GetTypedClientConfig_FullMethodName          = "/openim.user.user/getTypedClientConfig"
+	User_SetTypedClientConfig_FullMethodName          = "/openim.user.user/setTypedClientConfig"
+	User_DelTypedClientConfig_FullMethodName          = "/openim.user.user/delTypedClientConfig"
+	User_GetIncrementalClientConfig_FullMethodName    = "/openim.user.user/getIncrementalClientConfig"
 	User_SaveUserEmoji_FullMethodName                 = "/openim.user.user/saveUserEmoji"
 	User_DeleteUserEmoji_FullMethodName               = "/openim.user.user/deleteUserEmoji"
 	User_GetUserEmoji_FullMethodName                  = "/openim.user.user/getUserEmoji"
 	User_GetAllUserEmojis_FullMethodName              = "/openim.user.user/getAllUserEmojis"
+	User_CreateEmojiPack_FullMethodName               = "/openim.user.user/createEmojiPack"
+	User_UpdateEmojiPack_FullMethodName               = "/openim.user.user/updateEmojiPack"
+	User_DeleteEmojiPack_FullMethodName               = "/openim.user.user/deleteEmojiPack"
+	User_SortEmojiPacks_FullMethodName                = "/openim.user.user/sortEmojiPacks"
+	User_AddEmojisToPack_FullMethodName               = "/openim.user.user/addEmojisToPack"
+	User_RemoveEmojisFromPack_FullMethodName          = "/openim.user.user/removeEmojisFromPack"
+	User_SortPackEmojis_FullMethodName                = "/openim.user.user/sortPackEmojis"
+	User_GetEmojiPacks_FullMethodName                 = "/openim.user.user/getEmojiPacks"
+	User_ShareEmojiPack_FullMethodName                = "/openim.user.user/shareEmojiPack"
+	User_SaveSharedEmojiPack_FullMethodName           = "/openim.user.user/saveSharedEmojiPack"
+	User_ImportEmojiPack_FullMethodName               = "/openim.user.user/importEmojiPack"
+	User_ExportEmojiPack_FullMethodName               = "/openim.user.user/exportEmojiPack"
 	User_GetQuickReplies_FullMethodName               = "/openim.user.user/getQuickReplies"
 	User_SyncQuickReplies_FullMethodName              = "/openim.user.user/syncQuickReplies"
 	User_UpsertQuickReply_FullMethodName              = "/openim.user.user/upsertQuickReply"
 	User_DeleteQuickReply_FullMethodName              = "/openim.user.user/deleteQuickReply"
 	User_PinQuickReply_FullMethodName                 = "/openim.user.user/pinQuickReply"
+	User_RenderQuickReply_FullMethodName              = "/openim.user.user/renderQuickReply"
 	User_RefreshFrequentReplies_FullMethodName        = "/openim.user.user/refreshFrequentReplies"
 	User_SubmitRefreshResult_FullMethodName           = "/openim.user.user/submitRefreshResult"
 	User_GetRefreshStatus_FullMethodName              = "/openim.user.user/getRefreshStatus"
@@ -79,6 +102,16 @@ const (
 	User_GetSignatureList_FullMethodName              = "/openim.user.user/getSignatureList"
 	User_UpdateAvatar_FullMethodName                  = "/openim.user.user/updateAvatar"
 	User_GetAvatarUploadQuota_FullMethodName          = "/openim.user.user/getAvatarUploadQuota"
+	User_GetUserPrivacySettings_FullMethodName        = "/openim.user.user/getUserPrivacySettings"
+	User_SetUserPrivacySettings_FullMethodName        = "/openim.user.user/setUserPrivacySettings"
+	User_CheckUserPrivacy_FullMethodName              = "/openim.user.user/checkUserPrivacy"
+	User_SetUserPresence_FullMethodName               = "/openim.user.user/setUserPresence"
+	User_ClearUserPresence_FullMethodName             = "/openim.user.user/clearUserPresence"
+	User_GetUserPresence_FullMethodName               = "/openim.user.user/getUserPresence"
+	User_ClearExpiredUserPresence_FullMethodName      = "/openim.user.user/clearExpiredUserPresence"
+	User_SetUserActivity_FullMethodName               = "/openim.user.user/setUserActivity"
+	User_ClearUserActivity_FullMethodName             = "/openim.user.user/clearUserActivity"
+	User_GetUserActivities_FullMethodName             = "/openim.user.user/getUserActivities"
 )
 
 // UserClient is the client API for User service.
@@ -100,6 +133,14 @@ type UserClient interface {
 	GetPaginationUsers(ctx context.Context, in *GetPaginationUsersReq, opts ...grpc.CallOption) (*GetPaginationUsersResp, error)
 	// user registration
 	UserRegister(ctx context.Context, in *UserRegisterReq, opts ...grpc.CallOption) (*UserRegisterResp, error)
+	// Deactivate accounts, blocks login and kicks all tokens
+	DeactivateUser(ctx context.Context, in *DeactivateUserReq, opts ...grpc.CallOption) (*DeactivateUserResp, error)
+	// Reactivate deactivated accounts
+	ReactivateUser(ctx context.Context, in *ReactivateUserReq, opts ...grpc.CallOption) (*ReactivateUserResp, error)
+	// Start an async job that erases the account
+	EraseUser(ctx context.Context, in *EraseUserReq, opts ...grpc.CallOption) (*EraseUserResp, error)
+	// Get the progress of an erase job
+	GetEraseUserJob(ctx context.Context, in *GetEraseUserJobReq, opts ...grpc.CallOption) (*GetEraseUserJobResp, error)
 	// Get all user IDs
 	GetAllUserID(ctx context.Context, in *GetAllUserIDReq, opts ...grpc.CallOption) (*GetAllUserIDResp, error)
 	// Get the total number of users and the user increment within a specified time period
@@ -138,10 +179,30 @@ type UserClient interface {
 	SetUserClientConfig(ctx context.Context, in *SetUserClientConfigReq, opts ...grpc.CallOption) (*SetUserClientConfigResp, error)
 	DelUserClientConfig(ctx context.Context, in *DelUserClientConfigReq, opts ...grpc.CallOption) (*DelUserClientConfigResp, error)
 	PageUserClientConfig(ctx context.Context, in *PageUserClientConfigReq, opts ...grpc.CallOption) (*PageUserClientConfigResp, error)
+	// 带类型、作用域和版本的客户端配置
+	RegisterClientConfigSchemas(ctx context.Context, in *RegisterClientConfigSchemasReq, opts ...grpc.CallOption) (*RegisterClientConfigSchemasResp, error)
+	GetClientConfigSchemas(ctx context.Context, in *GetClientConfigSchemasReq, opts ...grpc.CallOption) (*GetClientConfigSchemasResp, error)
+	GetTypedClientConfig(ctx context.Context, in *GetTypedClientConfigReq, opts ...grpc.CallOption) (*GetTypedClientConfigResp, error)
+	SetTypedClientConfig(ctx context.Context, in *SetTypedClientConfigReq, opts ...grpc.CallOption) (*SetTypedClientConfigResp, error)
+	DelTypedClientConfig(ctx context.Context, in *DelTypedClientConfigReq, opts ...grpc.CallOption) (*DelTypedClientConfigResp, error)
+	GetIncrementalClientConfig(ctx context.Context, in *GetIncrementalClientConfigReq, opts ...grpc.CallOption) (*GetIncrementalClientConfigResp, error)
 	SaveUserEmoji(ctx context.Context, in *SaveUserEmojiReq, opts ...grpc.CallOption) (*SaveUserEmojiResp, error)
 	DeleteUserEmoji(ctx context.Context, in *DeleteUserEmojiReq, opts ...grpc.CallOption) (*DeleteUserEmojiResp, error)
 	GetUserEmoji(ctx context.Context, in *GetUserEmojiReq, opts ...grpc.CallOption) (*GetUserEmojiResp, error)
 	GetAllUserEmojis(ctx context.Context, in *GetAllUserEmojisReq, opts ...grpc.CallOption) (*GetAllUserEmojisResp, error)
+	// 表情包
+	CreateEmojiPack(ctx context.Context, in *CreateEmojiPackReq, opts ...grpc.CallOption) (*CreateEmojiPackResp, error)
+	UpdateEmojiPack(ctx context.Context, in *UpdateEmojiPackReq, opts ...grpc.CallOption) (*UpdateEmojiPackResp, error)
+	DeleteEmojiPack(ctx context.Context, in *DeleteEmojiPackReq, opts ...grpc.CallOption) (*DeleteEmojiPackResp, error)
+	SortEmojiPacks(ctx context.Context, in *SortEmojiPacksReq, opts ...grpc.CallOption) (*SortEmojiPacksResp, error)
+	AddEmojisToPack(ctx context.Context, in *AddEmojisToPackReq, opts ...grpc.CallOption) (*AddEmojisToPackResp, error)
+	RemoveEmojisFromPack(ctx context.Context, in *RemoveEmojisFromPackReq, opts ...grpc.CallOption) (*RemoveEmojisFromPackResp, error)
+	SortPackEmojis(ctx context.Context, in *SortPackEmojisReq, opts ...grpc.CallOption) (*SortPackEmojisResp, error)
+	GetEmojiPacks(ctx context.Context, in *GetEmojiPacksReq, opts ...grpc.CallOption) (*GetEmojiPacksResp, error)
+	ShareEmojiPack(ctx context.Context, in *ShareEmojiPackReq, opts ...grpc.CallOption) (*ShareEmojiPackResp, error)
+	SaveSharedEmojiPack(ctx context.Context, in *SaveSharedEmojiPackReq, opts ...grpc.CallOption) (*SaveSharedEmojiPackResp, error)
+	ImportEmojiPack(ctx context.Context, in *ImportEmojiPackReq, opts ...grpc.CallOption) (*ImportEmojiPackResp, error)
+	ExportEmojiPack(ctx context.Context, in *ExportEmojiPackReq, opts ...grpc.CallOption) (*ExportEmojiPackResp, error)
 	// 快捷回复相关RPC
 	// 获取快捷回复列表
 	GetQuickReplies(ctx context.Context, in *GetQuickRepliesReq, opts ...grpc.CallOption) (*GetQuickRepliesResp, error)
@@ -153,6 +214,8 @@ type UserClient interface {
 	DeleteQuickReply(ctx context.Context, in *DeleteQuickReplyReq, opts ...grpc.CallOption) (*DeleteQuickReplyResp, error)
 	// 置顶快捷回复
 	PinQuickReply(ctx context.Context, in *PinQuickReplyReq, opts ...grpc.CallOption) (*PinQuickReplyResp, error)
+	// 渲染快捷回复模板（预览）
+	RenderQuickReply(ctx context.Context, in *RenderQuickReplyReq, opts ...grpc.CallOption) (*RenderQuickReplyResp, error)
 	// 刷新常用回复（一周一次，带锁）
 	RefreshFrequentReplies(ctx context.Context, in *RefreshFrequentRepliesReq, opts ...grpc.CallOption) (*RefreshFrequentRepliesResp, error)
 	// 提交刷新结果
@@ -167,6 +230,26 @@ type UserClient interface {
 	UpdateAvatar(ctx context.Context, in *UpdateAvatarReq, opts ...grpc.CallOption) (*UpdateAvatarResp, error)
 	// 获取头像上传配额
 	GetAvatarUploadQuota(ctx context.Context, in *GetAvatarUploadQuotaReq, opts ...grpc.CallOption) (*GetAvatarUploadQuotaResp, error)
+	// 获取隐私设置
+	GetUserPrivacySettings(ctx context.Context, in *GetUserPrivacySettingsReq, opts ...grpc.CallOption) (*GetUserPrivacySettingsResp, error)
+	// 设置隐私设置
+	SetUserPrivacySettings(ctx context.Context, in *SetUserPrivacySettingsReq, opts ...grpc.CallOption) (*SetUserPrivacySettingsResp, error)
+	// 校验隐私访问权限
+	CheckUserPrivacy(ctx context.Context, in *CheckUserPrivacyReq, opts ...grpc.CallOption) (*CheckUserPrivacyResp, error)
+	// 设置自定义状态
+	SetUserPresence(ctx context.Context, in *SetUserPresenceReq, opts ...grpc.CallOption) (*SetUserPresenceResp, error)
+	// 清除自定义状态
+	ClearUserPresence(ctx context.Context, in *ClearUserPresenceReq, opts ...grpc.CallOption) (*ClearUserPresenceResp, error)
+	// 批量获取自定义状态
+	GetUserPresence(ctx context.Context, in *GetUserPresenceReq, opts ...grpc.CallOption) (*GetUserPresenceResp, error)
+	// 清除已过期的自定义状态
+	ClearExpiredUserPresence(ctx context.Context, in *ClearExpiredUserPresenceReq, opts ...grpc.CallOption) (*ClearExpiredUserPresenceResp, error)
+	// 设置自动状态（通话中/会议中）
+	SetUserActivity(ctx context.Context, in *SetUserActivityReq, opts ...grpc.CallOption) (*SetUserActivityResp, error)
+	// 清除自动状态
+	ClearUserActivity(ctx context.Context, in *ClearUserActivityReq, opts ...grpc.CallOption) (*ClearUserActivityResp, error)
+	// 获取用户当前所有自动状态来源
+	GetUserActivities(ctx context.Context, in *GetUserActivitiesReq, opts ...grpc.CallOption) (*GetUserActivitiesResp, error)
 }
 
 type userClient struct {
@@ -257,6 +340,46 @@ func (c *userClient) UserRegister(ctx context.Context, in *UserRegisterReq, opts
 	return out, nil
 }
 
+func (c *userClient) DeactivateUser(ctx context.Context, in *DeactivateUserReq, opts ...grpc.CallOption) (*DeactivateUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateUserResp)
+	err := c.cc.Invoke(ctx, User_DeactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ReactivateUser(ctx context.Context, in *ReactivateUserReq, opts ...grpc.CallOption) (*ReactivateUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateUserResp)
+	err := c.cc.Invoke(ctx, User_ReactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) EraseUser(ctx context.Context, in *EraseUserReq, opts ...grpc.CallOption) (*EraseUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EraseUserResp)
+	err := c.cc.Invoke(ctx, User_EraseUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetEraseUserJob(ctx context.Context, in *GetEraseUserJobReq, opts ...grpc.CallOption) (*GetEraseUserJobResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEraseUserJobResp)
+	err := c.cc.Invoke(ctx, User_GetEraseUserJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) GetAllUserID(ctx context.Context, in *GetAllUserIDReq, opts ...grpc.CallOption) (*GetAllUserIDResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAllUserIDResp)
@@ -477,6 +600,66 @@ func (c *userClient) PageUserClientConfig(ctx context.Context, in *PageUserClien
 	return out, nil
 }
 
+func (c *userClient) RegisterClientConfigSchemas(ctx context.Context, in *RegisterClientConfigSchemasReq, opts ...grpc.CallOption) (*RegisterClientConfigSchemasResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterClientConfigSchemasResp)
+	err := c.cc.Invoke(ctx, User_RegisterClientConfigSchemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetClientConfigSchemas(ctx context.Context, in *GetClientConfigSchemasReq, opts ...grpc.CallOption) (*GetClientConfigSchemasResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClientConfigSchemasResp)
+	err := c.cc.Invoke(ctx, User_GetClientConfigSchemas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetTypedClientConfig(ctx context.Context, in *GetTypedClientConfigReq, opts ...grpc.CallOption) (*GetTypedClientConfigResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTypedClientConfigResp)
+	err := c.cc.Invoke(ctx, User_GetTypedClientConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetTypedClientConfig(ctx context.Context, in *SetTypedClientConfigReq, opts ...grpc.CallOption) (*SetTypedClientConfigResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTypedClientConfigResp)
+	err := c.cc.Invoke(ctx, User_SetTypedClientConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DelTypedClientConfig(ctx context.Context, in *DelTypedClientConfigReq, opts ...grpc.CallOption) (*DelTypedClientConfigResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelTypedClientConfigResp)
+	err := c.cc.Invoke(ctx, User_DelTypedClientConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetIncrementalClientConfig(ctx context.Context, in *GetIncrementalClientConfigReq, opts ...grpc.CallOption) (*GetIncrementalClientConfigResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIncrementalClientConfigResp)
+	err := c.cc.Invoke(ctx, User_GetIncrementalClientConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) SaveUserEmoji(ctx context.Context, in *SaveUserEmojiReq, opts ...grpc.CallOption) (*SaveUserEmojiResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SaveUserEmojiResp)
@@ -517,6 +700,126 @@ func (c *userClient) GetAllUserEmojis(ctx context.Context, in *GetAllUserEmojisR
 	return out, nil
 }
 
+func (c *userClient) CreateEmojiPack(ctx context.Context, in *CreateEmojiPackReq, opts ...grpc.CallOption) (*CreateEmojiPackResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEmojiPackResp)
+	err := c.cc.Invoke(ctx, User_CreateEmojiPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdateEmojiPack(ctx context.Context, in *UpdateEmojiPackReq, opts ...grpc.CallOption) (*UpdateEmojiPackResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEmojiPackResp)
+	err := c.cc.Invoke(ctx, User_UpdateEmojiPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeleteEmojiPack(ctx context.Context, in *DeleteEmojiPackReq, opts ...grpc.CallOption) (*DeleteEmojiPackResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEmojiPackResp)
+	err := c.cc.Invoke(ctx, User_DeleteEmojiPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SortEmojiPacks(ctx context.Context, in *SortEmojiPacksReq, opts ...grpc.CallOption) (*SortEmojiPacksResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SortEmojiPacksResp)
+	err := c.cc.Invoke(ctx, User_SortEmojiPacks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) AddEmojisToPack(ctx context.Context, in *AddEmojisToPackReq, opts ...grpc.CallOption) (*AddEmojisToPackResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddEmojisToPackResp)
+	err := c.cc.Invoke(ctx, User_AddEmojisToPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RemoveEmojisFromPack(ctx context.Context, in *RemoveEmojisFromPackReq, opts ...grpc.CallOption) (*RemoveEmojisFromPackResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveEmojisFromPackResp)
+	err := c.cc.Invoke(ctx, User_RemoveEmojisFromPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SortPackEmojis(ctx context.Context, in *SortPackEmojisReq, opts ...grpc.CallOption) (*SortPackEmojisResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SortPackEmojisResp)
+	err := c.cc.Invoke(ctx, User_SortPackEmojis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetEmojiPacks(ctx context.Context, in *GetEmojiPacksReq, opts ...grpc.CallOption) (*GetEmojiPacksResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmojiPacksResp)
+	err := c.cc.Invoke(ctx, User_GetEmojiPacks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ShareEmojiPack(ctx context.Context, in *ShareEmojiPackReq, opts ...grpc.CallOption) (*ShareEmojiPackResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareEmojiPackResp)
+	err := c.cc.Invoke(ctx, User_ShareEmojiPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SaveSharedEmojiPack(ctx context.Context, in *SaveSharedEmojiPackReq, opts ...grpc.CallOption) (*SaveSharedEmojiPackResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveSharedEmojiPackResp)
+	err := c.cc.Invoke(ctx, User_SaveSharedEmojiPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ImportEmojiPack(ctx context.Context, in *ImportEmojiPackReq, opts ...grpc.CallOption) (*ImportEmojiPackResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportEmojiPackResp)
+	err := c.cc.Invoke(ctx, User_ImportEmojiPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ExportEmojiPack(ctx context.Context, in *ExportEmojiPackReq, opts ...grpc.CallOption) (*ExportEmojiPackResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportEmojiPackResp)
+	err := c.cc.Invoke(ctx, User_ExportEmojiPack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) GetQuickReplies(ctx context.Context, in *GetQuickRepliesReq, opts ...grpc.CallOption) (*GetQuickRepliesResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetQuickRepliesResp)
@@ -567,6 +870,16 @@ func (c *userClient) PinQuickReply(ctx context.Context, in *PinQuickReplyReq, op
 	return out, nil
 }
 
+func (c *userClient) RenderQuickReply(ctx context.Context, in *RenderQuickReplyReq, opts ...grpc.CallOption) (*RenderQuickReplyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderQuickReplyResp)
+	err := c.cc.Invoke(ctx, User_RenderQuickReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) RefreshFrequentReplies(ctx context.Context, in *RefreshFrequentRepliesReq, opts ...grpc.CallOption) (*RefreshFrequentRepliesResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RefreshFrequentRepliesResp)
@@ -637,6 +950,106 @@ func (c *userClient) GetAvatarUploadQuota(ctx context.Context, in *GetAvatarUplo
 	return out, nil
 }
 
+func (c *userClient) GetUserPrivacySettings(ctx context.Context, in *GetUserPrivacySettingsReq, opts ...grpc.CallOption) (*GetUserPrivacySettingsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserPrivacySettingsResp)
+	err := c.cc.Invoke(ctx, User_GetUserPrivacySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetUserPrivacySettings(ctx context.Context, in *SetUserPrivacySettingsReq, opts ...grpc.CallOption) (*SetUserPrivacySettingsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserPrivacySettingsResp)
+	err := c.cc.Invoke(ctx, User_SetUserPrivacySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CheckUserPrivacy(ctx context.Context, in *CheckUserPrivacyReq, opts ...grpc.CallOption) (*CheckUserPrivacyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckUserPrivacyResp)
+	err := c.cc.Invoke(ctx, User_CheckUserPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetUserPresence(ctx context.Context, in *SetUserPresenceReq, opts ...grpc.CallOption) (*SetUserPresenceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserPresenceResp)
+	err := c.cc.Invoke(ctx, User_SetUserPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ClearUserPresence(ctx context.Context, in *ClearUserPresenceReq, opts ...grpc.CallOption) (*ClearUserPresenceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearUserPresenceResp)
+	err := c.cc.Invoke(ctx, User_ClearUserPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserPresence(ctx context.Context, in *GetUserPresenceReq, opts ...grpc.CallOption) (*GetUserPresenceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserPresenceResp)
+	err := c.cc.Invoke(ctx, User_GetUserPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ClearExpiredUserPresence(ctx context.Context, in *ClearExpiredUserPresenceReq, opts ...grpc.CallOption) (*ClearExpiredUserPresenceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearExpiredUserPresenceResp)
+	err := c.cc.Invoke(ctx, User_ClearExpiredUserPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetUserActivity(ctx context.Context, in *SetUserActivityReq, opts ...grpc.CallOption) (*SetUserActivityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserActivityResp)
+	err := c.cc.Invoke(ctx, User_SetUserActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ClearUserActivity(ctx context.Context, in *ClearUserActivityReq, opts ...grpc.CallOption) (*ClearUserActivityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearUserActivityResp)
+	err := c.cc.Invoke(ctx, User_ClearUserActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUserActivities(ctx context.Context, in *GetUserActivitiesReq, opts ...grpc.CallOption) (*GetUserActivitiesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserActivitiesResp)
+	err := c.cc.Invoke(ctx, User_GetUserActivities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility.
@@ -656,6 +1069,14 @@ type UserServer interface {
 	GetPaginationUsers(context.Context, *GetPaginationUsersReq) (*GetPaginationUsersResp, error)
 	// user registration
 	UserRegister(context.Context, *UserRegisterReq) (*UserRegisterResp, error)
+	// Deactivate accounts, blocks login and kicks all tokens
+	DeactivateUser(context.Context, *DeactivateUserReq) (*DeactivateUserResp, error)
+	// Reactivate deactivated accounts
+	ReactivateUser(context.Context, *ReactivateUserReq) (*ReactivateUserResp, error)
+	// Start an async job that erases the account
+	EraseUser(context.Context, *EraseUserReq) (*EraseUserResp, error)
+	// Get the progress of an erase job
+	GetEraseUserJob(context.Context, *GetEraseUserJobReq) (*GetEraseUserJobResp, error)
 	// Get all user IDs
 	GetAllUserID(context.Context, *GetAllUserIDReq) (*GetAllUserIDResp, error)
 	// Get the total number of users and the user increment within a specified time period
@@ -694,10 +1115,30 @@ type UserServer interface {
 	SetUserClientConfig(context.Context, *SetUserClientConfigReq) (*SetUserClientConfigResp, error)
 	DelUserClientConfig(context.Context, *DelUserClientConfigReq) (*DelUserClientConfigResp, error)
 	PageUserClientConfig(context.Context, *PageUserClientConfigReq) (*PageUserClientConfigResp, error)
+	// 带类型、作用域和版本的客户端配置
+	RegisterClientConfigSchemas(context.Context, *RegisterClientConfigSchemasReq) (*RegisterClientConfigSchemasResp, error)
+	GetClientConfigSchemas(context.Context, *GetClientConfigSchemasReq) (*GetClientConfigSchemasResp, error)
+	GetTypedClientConfig(context.Context, *GetTypedClientConfigReq) (*GetTypedClientConfigResp, error)
+	SetTypedClientConfig(context.Context, *SetTypedClientConfigReq) (*SetTypedClientConfigResp, error)
+	DelTypedClientConfig(context.Context, *DelTypedClientConfigReq) (*DelTypedClientConfigResp, error)
+	GetIncrementalClientConfig(context.Context, *GetIncrementalClientConfigReq) (*GetIncrementalClientConfigResp, error)
 	SaveUserEmoji(context.Context, *SaveUserEmojiReq) (*SaveUserEmojiResp, error)
 	DeleteUserEmoji(context.Context, *DeleteUserEmojiReq) (*DeleteUserEmojiResp, error)
 	GetUserEmoji(context.Context, *GetUserEmojiReq) (*GetUserEmojiResp, error)
 	GetAllUserEmojis(context.Context, *GetAllUserEmojisReq) (*GetAllUserEmojisResp, error)
+	// 表情包
+	CreateEmojiPack(context.Context, *CreateEmojiPackReq) (*CreateEmojiPackResp, error)
+	UpdateEmojiPack(context.Context, *UpdateEmojiPackReq) (*UpdateEmojiPackResp, error)
+	DeleteEmojiPack(context.Context, *DeleteEmojiPackReq) (*DeleteEmojiPackResp, error)
+	SortEmojiPacks(context.Context, *SortEmojiPacksReq) (*SortEmojiPacksResp, error)
+	AddEmojisToPack(context.Context, *AddEmojisToPackReq) (*AddEmojisToPackResp, error)
+	RemoveEmojisFromPack(context.Context, *RemoveEmojisFromPackReq) (*RemoveEmojisFromPackResp, error)
+	SortPackEmojis(context.Context, *SortPackEmojisReq) (*SortPackEmojisResp, error)
+	GetEmojiPacks(context.Context, *GetEmojiPacksReq) (*GetEmojiPacksResp, error)
+	ShareEmojiPack(context.Context, *ShareEmojiPackReq) (*ShareEmojiPackResp, error)
+	SaveSharedEmojiPack(context.Context, *SaveSharedEmojiPackReq) (*SaveSharedEmojiPackResp, error)
+	ImportEmojiPack(context.Context, *ImportEmojiPackReq) (*ImportEmojiPackResp, error)
+	ExportEmojiPack(context.Context, *ExportEmojiPackReq) (*ExportEmojiPackResp, error)
 	// 快捷回复相关RPC
 	// 获取快捷回复列表
 	GetQuickReplies(context.Context, *GetQuickRepliesReq) (*GetQuickRepliesResp, error)
@@ -709,6 +1150,8 @@ type UserServer interface {
 	DeleteQuickReply(context.Context, *DeleteQuickReplyReq) (*DeleteQuickReplyResp, error)
 	// 置顶快捷回复
 	PinQuickReply(context.Context, *PinQuickReplyReq) (*PinQuickReplyResp, error)
+	// 渲染快捷回复模板（预览）
+	RenderQuickReply(context.Context, *RenderQuickReplyReq) (*RenderQuickReplyResp, error)
 	// 刷新常用回复（一周一次，带锁）
 	RefreshFrequentReplies(context.Context, *RefreshFrequentRepliesReq) (*RefreshFrequentRepliesResp, error)
 	// 提交刷新结果
@@ -723,6 +1166,26 @@ type UserServer interface {
 	UpdateAvatar(context.Context, *UpdateAvatarReq) (*UpdateAvatarResp, error)
 	// 获取头像上传配额
 	GetAvatarUploadQuota(context.Context, *GetAvatarUploadQuotaReq) (*GetAvatarUploadQuotaResp, error)
+	// 获取隐私设置
+	GetUserPrivacySettings(context.Context, *GetUserPrivacySettingsReq) (*GetUserPrivacySettingsResp, error)
+	// 设置隐私设置
+	SetUserPrivacySettings(context.Context, *SetUserPrivacySettingsReq) (*SetUserPrivacySettingsResp, error)
+	// 校验隐私访问权限
+	CheckUserPrivacy(context.Context, *CheckUserPrivacyReq) (*CheckUserPrivacyResp, error)
+	// 设置自定义状态
+	SetUserPresence(context.Context, *SetUserPresenceReq) (*SetUserPresenceResp, error)
+	// 清除自定义状态
+	ClearUserPresence(context.Context, *ClearUserPresenceReq) (*ClearUserPresenceResp, error)
+	// 批量获取自定义状态
+	GetUserPresence(context.Context, *GetUserPresenceReq) (*GetUserPresenceResp, error)
+	// 清除已过期的自定义状态
+	ClearExpiredUserPresence(context.Context, *ClearExpiredUserPresenceReq) (*ClearExpiredUserPresenceResp, error)
+	// 设置自动状态（通话中/会议中）
+	SetUserActivity(context.Context, *SetUserActivityReq) (*SetUserActivityResp, error)
+	// 清除自动状态
+	ClearUserActivity(context.Context, *ClearUserActivityReq) (*ClearUserActivityResp, error)
+	// 获取用户当前所有自动状态来源
+	GetUserActivities(context.Context, *GetUserActivitiesReq) (*GetUserActivitiesResp, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -757,6 +1220,18 @@ func (UnimplementedUserServer) GetPaginationUsers(context.Context, *GetPaginatio
 func (UnimplementedUserServer) UserRegister(context.Context, *UserRegisterReq) (*UserRegisterResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method UserRegister not implemented")
 }
+func (UnimplementedUserServer) DeactivateUser(context.Context, *DeactivateUserReq) (*DeactivateUserResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateUser not implemented")
+}
+func (UnimplementedUserServer) ReactivateUser(context.Context, *ReactivateUserReq) (*ReactivateUserResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReactivateUser not implemented")
+}
+func (UnimplementedUserServer) EraseUser(context.Context, *EraseUserReq) (*EraseUserResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method EraseUser not implemented")
+}
+func (UnimplementedUserServer) GetEraseUserJob(context.Context, *GetEraseUserJobReq) (*GetEraseUserJobResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEraseUserJob not implemented")
+}
 func (UnimplementedUserServer) GetAllUserID(context.Context, *GetAllUserIDReq) (*GetAllUserIDResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAllUserID not implemented")
 }
@@ -823,6 +1298,24 @@ func (UnimplementedUserServer) DelUserClientConfig(context.Context, *DelUserClie
 func (UnimplementedUserServer) PageUserClientConfig(context.Context, *PageUserClientConfigReq) (*PageUserClientConfigResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method PageUserClientConfig not implemented")
 }
+func (UnimplementedUserServer) RegisterClientConfigSchemas(context.Context, *RegisterClientConfigSchemasReq) (*RegisterClientConfigSchemasResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterClientConfigSchemas not implemented")
+}
+func (UnimplementedUserServer) GetClientConfigSchemas(context.Context, *GetClientConfigSchemasReq) (*GetClientConfigSchemasResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClientConfigSchemas not implemented")
+}
+func (UnimplementedUserServer) GetTypedClientConfig(context.Context, *GetTypedClientConfigReq) (*GetTypedClientConfigResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTypedClientConfig not implemented")
+}
+func (UnimplementedUserServer) SetTypedClientConfig(context.Context, *SetTypedClientConfigReq) (*SetTypedClientConfigResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTypedClientConfig not implemented")
+}
+func (UnimplementedUserServer) DelTypedClientConfig(context.Context, *DelTypedClientConfigReq) (*DelTypedClientConfigResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method DelTypedClientConfig not implemented")
+}
+func (UnimplementedUserServer) GetIncrementalClientConfig(context.Context, *GetIncrementalClientConfigReq) (*GetIncrementalClientConfigResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIncrementalClientConfig not implemented")
+}
 func (UnimplementedUserServer) SaveUserEmoji(context.Context, *SaveUserEmojiReq) (*SaveUserEmojiResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method SaveUserEmoji not implemented")
 }
@@ -835,6 +1328,42 @@ func (UnimplementedUserServer) GetUserEmoji(context.Context, *GetUserEmojiReq) (
 func (UnimplementedUserServer) GetAllUserEmojis(context.Context, *GetAllUserEmojisReq) (*GetAllUserEmojisResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAllUserEmojis not implemented")
 }
+func (UnimplementedUserServer) CreateEmojiPack(context.Context, *CreateEmojiPackReq) (*CreateEmojiPackResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEmojiPack not implemented")
+}
+func (UnimplementedUserServer) UpdateEmojiPack(context.Context, *UpdateEmojiPackReq) (*UpdateEmojiPackResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateEmojiPack not implemented")
+}
+func (UnimplementedUserServer) DeleteEmojiPack(context.Context, *DeleteEmojiPackReq) (*DeleteEmojiPackResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteEmojiPack not implemented")
+}
+func (UnimplementedUserServer) SortEmojiPacks(context.Context, *SortEmojiPacksReq) (*SortEmojiPacksResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SortEmojiPacks not implemented")
+}
+func (UnimplementedUserServer) AddEmojisToPack(context.Context, *AddEmojisToPackReq) (*AddEmojisToPackResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddEmojisToPack not implemented")
+}
+func (UnimplementedUserServer) RemoveEmojisFromPack(context.Context, *RemoveEmojisFromPackReq) (*RemoveEmojisFromPackResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveEmojisFromPack not implemented")
+}
+func (UnimplementedUserServer) SortPackEmojis(context.Context, *SortPackEmojisReq) (*SortPackEmojisResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SortPackEmojis not implemented")
+}
+func (UnimplementedUserServer) GetEmojiPacks(context.Context, *GetEmojiPacksReq) (*GetEmojiPacksResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEmojiPacks not implemented")
+}
+func (UnimplementedUserServer) ShareEmojiPack(context.Context, *ShareEmojiPackReq) (*ShareEmojiPackResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShareEmojiPack not implemented")
+}
+func (UnimplementedUserServer) SaveSharedEmojiPack(context.Context, *SaveSharedEmojiPackReq) (*SaveSharedEmojiPackResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveSharedEmojiPack not implemented")
+}
+func (UnimplementedUserServer) ImportEmojiPack(context.Context, *ImportEmojiPackReq) (*ImportEmojiPackResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportEmojiPack not implemented")
+}
+func (UnimplementedUserServer) ExportEmojiPack(context.Context, *ExportEmojiPackReq) (*ExportEmojiPackResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportEmojiPack not implemented")
+}
 func (UnimplementedUserServer) GetQuickReplies(context.Context, *GetQuickRepliesReq) (*GetQuickRepliesResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetQuickReplies not implemented")
 }
@@ -850,6 +1379,9 @@ func (UnimplementedUserServer) DeleteQuickReply(context.Context, *DeleteQuickRep
 func (UnimplementedUserServer) PinQuickReply(context.Context, *PinQuickReplyReq) (*PinQuickReplyResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method PinQuickReply not implemented")
 }
+func (UnimplementedUserServer) RenderQuickReply(context.Context, *RenderQuickReplyReq) (*RenderQuickReplyResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenderQuickReply not implemented")
+}
 func (UnimplementedUserServer) RefreshFrequentReplies(context.Context, *RefreshFrequentRepliesReq) (*RefreshFrequentRepliesResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method RefreshFrequentReplies not implemented")
 }
@@ -871,8 +1403,38 @@ func (UnimplementedUserServer) UpdateAvatar(context.Context, *UpdateAvatarReq) (
 func (UnimplementedUserServer) GetAvatarUploadQuota(context.Context, *GetAvatarUploadQuotaReq) (*GetAvatarUploadQuotaResp, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAvatarUploadQuota not implemented")
 }
-func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
-func (UnimplementedUserServer) testEmbeddedByValue()              {}
+func (UnimplementedUserServer) GetUserPrivacySettings(context.Context, *GetUserPrivacySettingsReq) (*GetUserPrivacySettingsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserPrivacySettings not implemented")
+}
+func (UnimplementedUserServer) SetUserPrivacySettings(context.Context, *SetUserPrivacySettingsReq) (*SetUserPrivacySettingsResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserPrivacySettings not implemented")
+}
+func (UnimplementedUserServer) CheckUserPrivacy(context.Context, *CheckUserPrivacyReq) (*CheckUserPrivacyResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckUserPrivacy not implemented")
+}
+func (UnimplementedUserServer) SetUserPresence(context.Context, *SetUserPresenceReq) (*SetUserPresenceResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserPresence not implemented")
+}
+func (UnimplementedUserServer) ClearUserPresence(context.Context, *ClearUserPresenceReq) (*ClearUserPresenceResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearUserPresence not implemented")
+}
+func (UnimplementedUserServer) GetUserPresence(context.Context, *GetUserPresenceReq) (*GetUserPresenceResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserPresence not implemented")
+}
+func (UnimplementedUserServer) ClearExpiredUserPresence(context.Context, *ClearExpiredUserPresenceReq) (*ClearExpiredUserPresenceResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearExpiredUserPresence not implemented")
+}
+func (UnimplementedUserServer) SetUserActivity(context.Context, *SetUserActivityReq) (*SetUserActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserActivity not implemented")
+}
+func (UnimplementedUserServer) ClearUserActivity(context.Context, *ClearUserActivityReq) (*ClearUserActivityResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearUserActivity not implemented")
+}
+func (UnimplementedUserServer) GetUserActivities(context.Context, *GetUserActivitiesReq) (*GetUserActivitiesResp, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserActivities not implemented")
+}
+func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
+func (UnimplementedUserServer) testEmbeddedByValue()              {}
 
 // UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to UserServer will
@@ -1036,6 +1598,78 @@ func _User_UserRegister_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_DeactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_DeactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeactivateUser(ctx, req.(*DeactivateUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ReactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ReactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ReactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ReactivateUser(ctx, req.(*ReactivateUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_EraseUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).EraseUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_EraseUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).EraseUser(ctx, req.(*EraseUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetEraseUserJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEraseUserJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetEraseUserJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetEraseUserJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetEraseUserJob(ctx, req.(*GetEraseUserJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_GetAllUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAllUserIDReq)
 	if err := dec(in); err != nil {
@@ -1432,6 +2066,114 @@ func _User_PageUserClientConfig_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_RegisterClientConfigSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterClientConfigSchemasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RegisterClientConfigSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RegisterClientConfigSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RegisterClientConfigSchemas(ctx, req.(*RegisterClientConfigSchemasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetClientConfigSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientConfigSchemasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetClientConfigSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetClientConfigSchemas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetClientConfigSchemas(ctx, req.(*GetClientConfigSchemasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetTypedClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTypedClientConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetTypedClientConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetTypedClientConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetTypedClientConfig(ctx, req.(*GetTypedClientConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetTypedClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTypedClientConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetTypedClientConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetTypedClientConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetTypedClientConfig(ctx, req.(*SetTypedClientConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DelTypedClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelTypedClientConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DelTypedClientConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_DelTypedClientConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DelTypedClientConfig(ctx, req.(*DelTypedClientConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetIncrementalClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncrementalClientConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetIncrementalClientConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetIncrementalClientConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetIncrementalClientConfig(ctx, req.(*GetIncrementalClientConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_SaveUserEmoji_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SaveUserEmojiReq)
 	if err := dec(in); err != nil {
@@ -1504,6 +2246,222 @@ func _User_GetAllUserEmojis_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_CreateEmojiPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmojiPackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CreateEmojiPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CreateEmojiPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CreateEmojiPack(ctx, req.(*CreateEmojiPackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdateEmojiPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmojiPackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdateEmojiPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdateEmojiPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdateEmojiPack(ctx, req.(*UpdateEmojiPackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeleteEmojiPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmojiPackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeleteEmojiPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_DeleteEmojiPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeleteEmojiPack(ctx, req.(*DeleteEmojiPackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SortEmojiPacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortEmojiPacksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SortEmojiPacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SortEmojiPacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SortEmojiPacks(ctx, req.(*SortEmojiPacksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_AddEmojisToPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEmojisToPackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).AddEmojisToPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_AddEmojisToPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).AddEmojisToPack(ctx, req.(*AddEmojisToPackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RemoveEmojisFromPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEmojisFromPackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RemoveEmojisFromPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RemoveEmojisFromPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RemoveEmojisFromPack(ctx, req.(*RemoveEmojisFromPackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SortPackEmojis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortPackEmojisReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SortPackEmojis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SortPackEmojis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SortPackEmojis(ctx, req.(*SortPackEmojisReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetEmojiPacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmojiPacksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetEmojiPacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetEmojiPacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetEmojiPacks(ctx, req.(*GetEmojiPacksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ShareEmojiPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareEmojiPackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ShareEmojiPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ShareEmojiPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ShareEmojiPack(ctx, req.(*ShareEmojiPackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SaveSharedEmojiPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSharedEmojiPackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SaveSharedEmojiPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SaveSharedEmojiPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SaveSharedEmojiPack(ctx, req.(*SaveSharedEmojiPackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ImportEmojiPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEmojiPackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ImportEmojiPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ImportEmojiPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ImportEmojiPack(ctx, req.(*ImportEmojiPackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ExportEmojiPack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEmojiPackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ExportEmojiPack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ExportEmojiPack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ExportEmojiPack(ctx, req.(*ExportEmojiPackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_GetQuickReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetQuickRepliesReq)
 	if err := dec(in); err != nil {
@@ -1594,6 +2552,24 @@ func _User_PinQuickReply_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_RenderQuickReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderQuickReplyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RenderQuickReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RenderQuickReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RenderQuickReply(ctx, req.(*RenderQuickReplyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _User_RefreshFrequentReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RefreshFrequentRepliesReq)
 	if err := dec(in); err != nil {
@@ -1720,6 +2696,186 @@ func _User_GetAvatarUploadQuota_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_GetUserPrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPrivacySettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserPrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetUserPrivacySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUserPrivacySettings(ctx, req.(*GetUserPrivacySettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetUserPrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserPrivacySettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetUserPrivacySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetUserPrivacySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetUserPrivacySettings(ctx, req.(*SetUserPrivacySettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CheckUserPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUserPrivacyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CheckUserPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CheckUserPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CheckUserPrivacy(ctx, req.(*CheckUserPrivacyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetUserPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserPresenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetUserPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetUserPresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetUserPresence(ctx, req.(*SetUserPresenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ClearUserPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearUserPresenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ClearUserPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ClearUserPresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ClearUserPresence(ctx, req.(*ClearUserPresenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetUserPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPresenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetUserPresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUserPresence(ctx, req.(*GetUserPresenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ClearExpiredUserPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearExpiredUserPresenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ClearExpiredUserPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ClearExpiredUserPresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ClearExpiredUserPresence(ctx, req.(*ClearExpiredUserPresenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetUserActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetUserActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetUserActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetUserActivity(ctx, req.(*SetUserActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ClearUserActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearUserActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ClearUserActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ClearUserActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ClearUserActivity(ctx, req.(*ClearUserActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetUserActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserActivitiesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUserActivities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetUserActivities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUserActivities(ctx, req.(*GetUserActivitiesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1759,6 +2915,22 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "userRegister",
 			Handler:    _User_UserRegister_Handler,
 		},
+		{
+			MethodName: "deactivateUser",
+			Handler:    _User_DeactivateUser_Handler,
+		},
+		{
+			MethodName: "reactivateUser",
+			Handler:    _User_ReactivateUser_Handler,
+		},
+		{
+			MethodName: "eraseUser",
+			Handler:    _User_EraseUser_Handler,
+		},
+		{
+			MethodName: "getEraseUserJob",
+			Handler:    _User_GetEraseUserJob_Handler,
+		},
 		{
 			MethodName: "getAllUserID",
 			Handler:    _User_GetAllUserID_Handler,
@@ -1847,6 +3019,30 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "pageUserClientConfig",
 			Handler:    _User_PageUserClientConfig_Handler,
 		},
+		{
+			MethodName: "registerClientConfigSchemas",
+			Handler:    _User_RegisterClientConfigSchemas_Handler,
+		},
+		{
+			MethodName: "getClientConfigSchemas",
+			Handler:    _User_GetClientConfigSchemas_Handler,
+		},
+		{
+			MethodName: "getTypedClientConfig",
+			Handler:    _User_GetTypedClientConfig_Handler,
+		},
+		{
+			MethodName: "setTypedClientConfig",
+			Handler:    _User_SetTypedClientConfig_Handler,
+		},
+		{
+			MethodName: "delTypedClientConfig",
+			Handler:    _User_DelTypedClientConfig_Handler,
+		},
+		{
+			MethodName: "getIncrementalClientConfig",
+			Handler:    _User_GetIncrementalClientConfig_Handler,
+		},
 		{
 			MethodName: "saveUserEmoji",
 			Handler:    _User_SaveUserEmoji_Handler,
@@ -1863,6 +3059,54 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "getAllUserEmojis",
 			Handler:    _User_GetAllUserEmojis_Handler,
 		},
+		{
+			MethodName: "createEmojiPack",
+			Handler:    _User_CreateEmojiPack_Handler,
+		},
+		{
+			MethodName: "updateEmojiPack",
+			Handler:    _User_UpdateEmojiPack_Handler,
+		},
+		{
+			MethodName: "deleteEmojiPack",
+			Handler:    _User_DeleteEmojiPack_Handler,
+		},
+		{
+			MethodName: "sortEmojiPacks",
+			Handler:    _User_SortEmojiPacks_Handler,
+		},
+		{
+			MethodName: "addEmojisToPack",
+			Handler:    _User_AddEmojisToPack_Handler,
+		},
+		{
+			MethodName: "removeEmojisFromPack",
+			Handler:    _User_RemoveEmojisFromPack_Handler,
+		},
+		{
+			MethodName: "sortPackEmojis",
+			Handler:    _User_SortPackEmojis_Handler,
+		},
+		{
+			MethodName: "getEmojiPacks",
+			Handler:    _User_GetEmojiPacks_Handler,
+		},
+		{
+			MethodName: "shareEmojiPack",
+			Handler:    _User_ShareEmojiPack_Handler,
+		},
+		{
+			MethodName: "saveSharedEmojiPack",
+			Handler:    _User_SaveSharedEmojiPack_Handler,
+		},
+		{
+			MethodName: "importEmojiPack",
+			Handler:    _User_ImportEmojiPack_Handler,
+		},
+		{
+			MethodName: "exportEmojiPack",
+			Handler:    _User_ExportEmojiPack_Handler,
+		},
 		{
 			MethodName: "getQuickReplies",
 			Handler:    _User_GetQuickReplies_Handler,
@@ -1883,6 +3127,10 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "pinQuickReply",
 			Handler:    _User_PinQuickReply_Handler,
 		},
+		{
+			MethodName: "renderQuickReply",
+			Handler:    _User_RenderQuickReply_Handler,
+		},
 		{
 			MethodName: "refreshFrequentReplies",
 			Handler:    _User_RefreshFrequentReplies_Handler,
@@ -1911,6 +3159,46 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "getAvatarUploadQuota",
 			Handler:    _User_GetAvatarUploadQuota_Handler,
 		},
+		{
+			MethodName: "getUserPrivacySettings",
+			Handler:    _User_GetUserPrivacySettings_Handler,
+		},
+		{
+			MethodName: "setUserPrivacySettings",
+			Handler:    _User_SetUserPrivacySettings_Handler,
+		},
+		{
+			MethodName: "checkUserPrivacy",
+			Handler:    _User_CheckUserPrivacy_Handler,
+		},
+		{
+			MethodName: "setUserPresence",
+			Handler:    _User_SetUserPresence_Handler,
+		},
+		{
+			MethodName: "clearUserPresence",
+			Handler:    _User_ClearUserPresence_Handler,
+		},
+		{
+			MethodName: "getUserPresence",
+			Handler:    _User_GetUserPresence_Handler,
+		},
+		{
+			MethodName: "clearExpiredUserPresence",
+			Handler:    _User_ClearExpiredUserPresence_Handler,
+		},
+		{
+			MethodName: "setUserActivity",
+			Handler:    _User_SetUserActivity_Handler,
+		},
+		{
+			MethodName: "clearUserActivity",
+			Handler:    _User_ClearUserActivity_Handler,
+		},
+		{
+			MethodName: "getUserActivities",
+			Handler:    _User_GetUserActivities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/user.proto",
@@ -0,0 +1,47 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"testing"
+
+	"github.com/openimsdk/protocol/constant"
+)
+
+func TestCheckClientConfigValue(t *testing.T) {
+	tests := []struct {
+		valueType int32
+		value     string
+		valid     bool
+	}{
+		{constant.ClientConfigTypeBool, "true", true},
+		{constant.ClientConfigTypeBool, "false", true},
+		{constant.ClientConfigTypeBool, "1", false},
+		{constant.ClientConfigTypeBool, "T", false},
+		{constant.ClientConfigTypeBool, "TRUE", false},
+		{constant.ClientConfigTypeBool, "", false},
+		{constant.ClientConfigTypeInt, "-12", true},
+		{constant.ClientConfigTypeInt, "1.5", false},
+		{constant.ClientConfigTypeJSON, `{"a":1}`, true},
+		{constant.ClientConfigTypeJSON, `{a}`, false},
+		{constant.ClientConfigTypeString, "", true},
+	}
+	for _, tt := range tests {
+		err := CheckClientConfigValue(tt.valueType, tt.value)
+		if (err == nil) != tt.valid {
+			t.Errorf("CheckClientConfigValue(%d, %q) = %v, want valid %v", tt.valueType, tt.value, err, tt.valid)
+		}
+	}
+}